@@ -18,26 +18,53 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 var _ Generator = (*GitGenerator)(nil)
 
 type GitGenerator struct {
-	repos     services.Repos
-	namespace string
+	repos       services.Repos
+	namespace   string
+	settingsMgr *settings.SettingsManager
 }
 
 // NewGitGenerator creates a new instance of Git Generator
-func NewGitGenerator(repos services.Repos, namespace string) Generator {
+func NewGitGenerator(repos services.Repos, namespace string, settingsMgr *settings.SettingsManager) Generator {
 	g := &GitGenerator{
-		repos:     repos,
-		namespace: namespace,
+		repos:       repos,
+		namespace:   namespace,
+		settingsMgr: settingsMgr,
 	}
 
 	return g
 }
 
+// signatureKeysRequired returns whether commits must be signed for the applications of the project. Like the virtual
+// project of the application controller, this includes the signature keys of the global projects of the project.
+func (g *GitGenerator) signatureKeysRequired(ctx context.Context, c client.Client, appProject *argoprojiov1alpha1.AppProject) (bool, error) {
+	if len(appProject.Spec.SignatureKeys) > 0 {
+		return true, nil
+	}
+	gps, err := g.settingsMgr.GetGlobalProjectsSettings()
+	if err != nil {
+		return false, fmt.Errorf("error getting global project settings: %w", err)
+	}
+	globalProjects, err := argo.MatchGlobalProjects(appProject, gps, func(name string) (*argoprojiov1alpha1.AppProject, error) {
+		globalProject := &argoprojiov1alpha1.AppProject{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: appProject.Namespace}, globalProject); err != nil {
+			return nil, err
+		}
+		return globalProject, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(argo.MergeGlobalProjects(appProject, globalProjects).Spec.SignatureKeys) > 0, nil
+}
+
 // GetTemplate returns the ApplicationSetTemplate associated with the Git generator
 // from the provided ApplicationSetGenerator. This template defines how each
 // generated Argo CD Application should be rendered.
@@ -86,7 +113,11 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 			return nil, fmt.Errorf("error getting project %s: %w", project, err)
 		}
 		// we need to verify the signature on the Git revision if GPG is enabled
-		verifyCommit = len(appProject.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()
+		signatureKeysRequired, err := g.signatureKeysRequired(context.TODO(), client, appProject)
+		if err != nil {
+			return nil, err
+		}
+		verifyCommit = signatureKeysRequired && gpg.IsGPGEnabled()
 	}
 
 	// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
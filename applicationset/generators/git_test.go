@@ -7,13 +7,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 func Test_generateParamsFromGitFile(t *testing.T) {
@@ -324,7 +329,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 
 			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -627,7 +632,7 @@ func TestGitGenerateParamsFromDirectoriesGoTemplate(t *testing.T) {
 
 			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -1004,7 +1009,7 @@ cluster:
 			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 				Return(testCaseCopy.repoFileContents, testCaseCopy.repoPathsError)
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -1350,7 +1355,7 @@ env: testing
 					Return(testCaseCopy.includeFiles, testCaseCopy.repoPathsError)
 			}
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -1691,7 +1696,7 @@ env: testing
 					Return(testCaseCopy.includeFiles, testCaseCopy.repoPathsError)
 			}
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -1926,7 +1931,7 @@ func TestGitGeneratorParamsFromFilesWithExcludeOptionGoTemplate(t *testing.T) {
 					Return(testCaseCopy.includeFiles, testCaseCopy.repoPathsError)
 			}
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -2283,7 +2288,7 @@ cluster:
 			argoCDServiceMock.On("GetFiles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 				Return(testCaseCopy.repoFileContents, testCaseCopy.repoPathsError)
 
-			gitGenerator := NewGitGenerator(&argoCDServiceMock, "")
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "", newTestSettingsManager(t, nil))
 			applicationSetInfo := v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "set",
@@ -2494,7 +2499,7 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 
 			argoCDServiceMock.On("GetDirectories", mock.Anything, mock.Anything, mock.Anything, project, mock.Anything, mock.Anything).Return(testCase.repoApps, testCase.repoPathsError)
 		}
-		gitGenerator := NewGitGenerator(&argoCDServiceMock, "argocd")
+		gitGenerator := NewGitGenerator(&argoCDServiceMock, "argocd", newTestSettingsManager(t, nil))
 
 		scheme := runtime.NewScheme()
 		err := v1alpha1.AddToScheme(scheme)
@@ -2514,3 +2519,74 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 		argoCDServiceMock.AssertExpectations(t)
 	}
 }
+
+func TestGitGenerator_GenerateParams_GlobalSignatureKeys(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "true")
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "argocd", Labels: map[string]string{"team": "a"}},
+	}
+	globalProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "global-signed", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}},
+	}
+	globalProjects := func(selector string) map[string]string {
+		return map[string]string{"globalProjects": `
+- projectName: global-signed
+  labelSelector:
+    matchLabels:
+      team: ` + selector}
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				Git: &v1alpha1.GitGenerator{
+					RepoURL:     "RepoURL",
+					Revision:    "Revision",
+					Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "*"}},
+				},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{Spec: v1alpha1.ApplicationSpec{Project: "project"}},
+		},
+	}
+
+	for _, testCase := range []struct {
+		name         string
+		argoCDCM     map[string]string
+		objects      []ctrlclient.Object
+		verifyCommit bool
+	}{
+		{name: "NoGlobalProjects", objects: []ctrlclient.Object{project, globalProject}, verifyCommit: false},
+		{name: "GlobalProjectWithKeys", argoCDCM: globalProjects("a"), objects: []ctrlclient.Object{project, globalProject}, verifyCommit: true},
+		{name: "GlobalProjectNotSelected", argoCDCM: globalProjects("b"), objects: []ctrlclient.Object{project, globalProject}, verifyCommit: false},
+		{name: "GlobalProjectMissing", argoCDCM: globalProjects("a"), objects: []ctrlclient.Object{project}, verifyCommit: false},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.Repos{}
+			argoCDServiceMock.On("GetDirectories", mock.Anything, "RepoURL", "Revision", "project", false, testCase.verifyCommit).Return([]string{"app1"}, nil)
+			gitGenerator := NewGitGenerator(&argoCDServiceMock, "argocd", newTestSettingsManager(t, testCase.argoCDCM))
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, v1alpha1.AddToScheme(scheme))
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testCase.objects...).Build()
+
+			_, err := gitGenerator.GenerateParams(&appSet.Spec.Generators[0], &appSet, client)
+			require.NoError(t, err)
+			argoCDServiceMock.AssertExpectations(t)
+		})
+	}
+}
+
+// newTestSettingsManager returns a settings manager reading an argocd-cm with the given data
+func newTestSettingsManager(t *testing.T, data map[string]string) *settings.SettingsManager {
+	t.Helper()
+	kubeClient := kubefake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: data,
+	})
+	return settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+}
@@ -2,10 +2,14 @@ package generators
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,17 +25,33 @@ import (
 
 const (
 	DefaultPullRequestRequeueAfter = 30 * time.Minute
+	// maxPullRequestParamsCacheEntries bounds the number of ApplicationSets whose pull request parameters are cached
+	maxPullRequestParamsCacheEntries = 1000
 )
 
 type PullRequestGenerator struct {
 	client                    client.Client
 	selectServiceProviderFunc func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error)
+	// randFloat returns a pseudo-random number in [0.0, 1.0), used to jitter the requeue interval
+	randFloat func() float64
+	// paramsCache caches the parameters generated for the pull requests of each ApplicationSet by the fingerprint of
+	// the pull request, so that pull requests which did not change since the last poll are not templated again
+	paramsCache     map[string]pullRequestParamsCacheEntry
+	paramsCacheLock sync.Mutex
 	SCMConfig
 }
 
+// pullRequestParamsCacheEntry holds the parameters generated for the pull requests of an ApplicationSet by the
+// fingerprint of the pull request. They are only valid for the generator configuration they were generated with.
+type pullRequestParamsCacheEntry struct {
+	configKey string
+	params    map[string]map[string]any
+}
+
 func NewPullRequestGenerator(client client.Client, scmConfig SCMConfig) Generator {
 	g := &PullRequestGenerator{
 		client:    client,
+		randFloat: rand.Float64,
 		SCMConfig: scmConfig,
 	}
 	g.selectServiceProviderFunc = g.selectServiceProvider
@@ -40,12 +60,21 @@ func NewPullRequestGenerator(client client.Client, scmConfig SCMConfig) Generato
 
 func (g *PullRequestGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
 	// Return a requeue default of 30 minutes, if no default is specified.
-
+	requeueAfter := DefaultPullRequestRequeueAfter
 	if appSetGenerator.PullRequest.RequeueAfterSeconds != nil {
-		return time.Duration(*appSetGenerator.PullRequest.RequeueAfterSeconds) * time.Second
+		requeueAfter = time.Duration(*appSetGenerator.PullRequest.RequeueAfterSeconds) * time.Second
 	}
 
-	return DefaultPullRequestRequeueAfter
+	return jitterDuration(requeueAfter, g.pullRequestRequeueJitter, g.randFloat)
+}
+
+// jitterDuration randomly lengthens or shortens the duration by up to the given fraction of it, using randFloat as
+// source of randomness. The duration is returned as is if the fraction is not positive.
+func jitterDuration(d time.Duration, fraction float64, randFloat func() float64) time.Duration {
+	if fraction <= 0 || d <= 0 || randFloat == nil {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + fraction*(2*randFloat()-1)))
 }
 
 func (g *PullRequestGenerator) GetContinueOnRepoNotFoundError(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) bool {
@@ -81,6 +110,25 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		}
 		return nil, fmt.Errorf("error listing repos: %w", err)
 	}
+	pulls, err = pullrequest.ResolveMissingHeadBranches(pulls, appSetGenerator.PullRequest.MissingHeadBranch)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving head branches: %w", err)
+	}
+	if appSetGenerator.PullRequest.ResolveHeadCommitAuthor {
+		if err := pullrequest.ResolveHeadCommitAuthors(ctx, svc, pulls); err != nil {
+			return nil, fmt.Errorf("error resolving head commit authors: %w", err)
+		}
+	}
+	if appSetGenerator.PullRequest.ResolveApprovals {
+		if err := pullrequest.ResolveApprovals(ctx, svc, pulls); err != nil {
+			return nil, fmt.Errorf("error resolving approvals: %w", err)
+		}
+	}
+	if appSetGenerator.PullRequest.SortBy != "" {
+		if err := pullrequest.SortPullRequests(pulls, appSetGenerator.PullRequest.SortBy); err != nil {
+			return nil, fmt.Errorf("error sorting pull requests: %w", err)
+		}
+	}
 
 	// In order to follow the DNS label standard as defined in RFC 1123,
 	// we need to limit the 'branch' to 50 to give room to append/suffix-ing it
@@ -93,9 +141,23 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		"_": "-",
 	}
 
+	cacheKey, configKey, err := pullRequestParamsCacheKeys(appSetGenerator.PullRequest, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("error computing the pull request parameters cache key: %w", err)
+	}
+	cachedParams := g.cachedParams(cacheKey, configKey)
+	generatedParams := make(map[string]map[string]any, len(pulls))
+
 	var shortSHALength int
 	var shortSHALength7 int
 	for _, pull := range pulls {
+		fingerprint := pull.Fingerprint()
+		if paramMap, ok := cachedParams[fingerprint]; ok {
+			generatedParams[fingerprint] = paramMap
+			params = append(params, maps.Clone(paramMap))
+			continue
+		}
+
 		shortSHALength = 8
 		if len(pull.HeadSHA) < 8 {
 			shortSHALength = len(pull.HeadSHA)
@@ -116,7 +178,19 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			"head_sha":           pull.HeadSHA,
 			"head_short_sha":     pull.HeadSHA[:shortSHALength],
 			"head_short_sha_7":   pull.HeadSHA[:shortSHALength7],
+			"base_sha":           pull.BaseSHA,
 			"author":             pull.Author,
+			"draft":              strconv.FormatBool(pull.IsDraft),
+		}
+		if pull.Repository != "" {
+			paramMap["repository"] = pull.Repository
+		}
+		if appSetGenerator.PullRequest.ResolveHeadCommitAuthor {
+			paramMap["head_commit_author_name"] = pull.HeadCommitAuthor.Name
+			paramMap["head_commit_author_email"] = pull.HeadCommitAuthor.Email
+		}
+		if appSetGenerator.PullRequest.ResolveApprovals {
+			paramMap["approvals"] = strconv.Itoa(pull.Approvals)
 		}
 
 		err := appendTemplatedValues(appSetGenerator.PullRequest.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
@@ -127,14 +201,141 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		// PR lables will only be supported for Go Template appsets, since fasttemplate will be deprecated.
 		if applicationSetInfo != nil && applicationSetInfo.Spec.GoTemplate {
 			paramMap["labels"] = pull.Labels
+			// the map is always set, so that templates can index it whether or not the provider reported any attribute
+			attributes := pull.Attributes
+			if attributes == nil {
+				attributes = map[string]string{}
+			}
+			paramMap["attributes"] = attributes
 		}
-		params = append(params, paramMap)
+		generatedParams[fingerprint] = paramMap
+		// the cached parameters are cloned, so that callers modifying the returned parameters do not modify them
+		params = append(params, maps.Clone(paramMap))
 	}
+	g.cacheParams(cacheKey, configKey, generatedParams)
 	return params, nil
 }
 
-// selectServiceProvider selects the provider to get pull requests from the configuration
+// pullRequestParamsCacheKeys returns the key of the ApplicationSet in the parameters cache, and the key of the
+// generator configuration which the parameters of a pull request depend on besides the pull request itself.
+func pullRequestParamsCacheKeys(generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (string, string, error) {
+	config := struct {
+		Values                  map[string]string
+		ResolveHeadCommitAuthor bool
+		ResolveApprovals        bool
+		GoTemplate              bool
+		GoTemplateOptions       []string
+	}{
+		Values:                  generatorConfig.Values,
+		ResolveHeadCommitAuthor: generatorConfig.ResolveHeadCommitAuthor,
+		ResolveApprovals:        generatorConfig.ResolveApprovals,
+	}
+	cacheKey := ""
+	if applicationSetInfo != nil {
+		cacheKey = applicationSetInfo.Namespace + "/" + applicationSetInfo.Name
+		config.GoTemplate = applicationSetInfo.Spec.GoTemplate
+		config.GoTemplateOptions = applicationSetInfo.Spec.GoTemplateOptions
+	}
+	configKey, err := json.Marshal(config)
+	if err != nil {
+		return "", "", err
+	}
+	return cacheKey, string(configKey), nil
+}
+
+// cachedParams returns the parameters cached for the pull requests of the ApplicationSet by fingerprint, if they were
+// generated with the same generator configuration
+func (g *PullRequestGenerator) cachedParams(cacheKey string, configKey string) map[string]map[string]any {
+	g.paramsCacheLock.Lock()
+	defer g.paramsCacheLock.Unlock()
+	entry, ok := g.paramsCache[cacheKey]
+	if !ok || entry.configKey != configKey {
+		return nil
+	}
+	return entry.params
+}
+
+// cacheParams replaces the parameters cached for the pull requests of the ApplicationSet, so that the parameters of
+// closed pull requests are dropped
+func (g *PullRequestGenerator) cacheParams(cacheKey string, configKey string, params map[string]map[string]any) {
+	g.paramsCacheLock.Lock()
+	defer g.paramsCacheLock.Unlock()
+	if g.paramsCache == nil {
+		g.paramsCache = map[string]pullRequestParamsCacheEntry{}
+	}
+	if _, ok := g.paramsCache[cacheKey]; !ok && len(g.paramsCache) >= maxPullRequestParamsCacheEntries {
+		clear(g.paramsCache)
+	}
+	g.paramsCache[cacheKey] = pullRequestParamsCacheEntry{configKey: configKey, params: params}
+}
+
+const (
+	// combineProvidersAll combines the pull requests of all configured providers, failing if any of them fails
+	combineProvidersAll = "all"
+	// combineProvidersAvailable combines the pull requests of the configured providers which succeed
+	combineProvidersAvailable = "available"
+)
+
+// selectServiceProvider selects the provider to get pull requests from the configuration, or combines all the
+// configured providers if requested
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+	switch generatorConfig.CombineProviders {
+	case "":
+		return g.selectSingleServiceProvider(ctx, generatorConfig, applicationSetInfo)
+	case combineProvidersAll, combineProvidersAvailable:
+	default:
+		return nil, fmt.Errorf("unknown combineProviders value %q", generatorConfig.CombineProviders)
+	}
+
+	var services []pullrequest.PullRequestService
+	for _, providerConfig := range splitProviders(generatorConfig) {
+		svc, err := g.selectSingleServiceProvider(ctx, providerConfig, applicationSetInfo)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, svc)
+	}
+	return pullrequest.NewCompositeService(services, generatorConfig.CombineProviders == combineProvidersAvailable)
+}
+
+// splitProviders returns a copy of the generator configuration per configured provider, each with only that provider
+// set, in the order in which selectSingleServiceProvider picks them.
+func splitProviders(generatorConfig *argoprojiov1alpha1.PullRequestGenerator) []*argoprojiov1alpha1.PullRequestGenerator {
+	var configs []*argoprojiov1alpha1.PullRequestGenerator
+	single := func(set func(*argoprojiov1alpha1.PullRequestGenerator)) {
+		providerConfig := generatorConfig.DeepCopy()
+		providerConfig.Github = nil
+		providerConfig.GitLab = nil
+		providerConfig.Gitea = nil
+		providerConfig.BitbucketServer = nil
+		providerConfig.Bitbucket = nil
+		providerConfig.AzureDevOps = nil
+		set(providerConfig)
+		configs = append(configs, providerConfig)
+	}
+	if generatorConfig.Github != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.Github = generatorConfig.Github })
+	}
+	if generatorConfig.GitLab != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.GitLab = generatorConfig.GitLab })
+	}
+	if generatorConfig.Gitea != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.Gitea = generatorConfig.Gitea })
+	}
+	if generatorConfig.BitbucketServer != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.BitbucketServer = generatorConfig.BitbucketServer })
+	}
+	if generatorConfig.Bitbucket != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.Bitbucket = generatorConfig.Bitbucket })
+	}
+	if generatorConfig.AzureDevOps != nil {
+		single(func(c *argoprojiov1alpha1.PullRequestGenerator) { c.AzureDevOps = generatorConfig.AzureDevOps })
+	}
+	return configs
+}
+
+// selectSingleServiceProvider selects the first configured provider to get pull requests from the configuration
+func (g *PullRequestGenerator) selectSingleServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
 		return nil, ErrSCMProvidersDisabled
 	}
@@ -155,7 +356,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", prErr)
 			}
 		}
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.TokenRef, providerConfig.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
@@ -163,7 +364,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	}
 	if generatorConfig.Gitea != nil {
 		providerConfig := generatorConfig.Gitea
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.TokenRef, providerConfig.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
@@ -181,13 +382,13 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 			}
 		}
 		if providerConfig.BearerToken != nil {
-			appToken, err := utils.GetSecretRef(ctx, g.client, providerConfig.BearerToken.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			appToken, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BearerToken.TokenRef, providerConfig.BearerToken.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret Bearer token: %w", err)
 			}
 			return pullrequest.NewBitbucketServiceBearerToken(ctx, appToken, providerConfig.API, providerConfig.Project, providerConfig.Repo, g.scmRootCAPath, providerConfig.Insecure, caCerts)
 		} else if providerConfig.BasicAuth != nil {
-			password, err := utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			password, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BasicAuth.PasswordRef, providerConfig.BasicAuth.PasswordFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
@@ -198,13 +399,13 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	if generatorConfig.Bitbucket != nil {
 		providerConfig := generatorConfig.Bitbucket
 		if providerConfig.BearerToken != nil {
-			appToken, err := utils.GetSecretRef(ctx, g.client, providerConfig.BearerToken.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			appToken, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BearerToken.TokenRef, providerConfig.BearerToken.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret Bearer token: %w", err)
 			}
 			return pullrequest.NewBitbucketCloudServiceBearerToken(providerConfig.API, appToken, providerConfig.Owner, providerConfig.Repo)
 		} else if providerConfig.BasicAuth != nil {
-			password, err := utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			password, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BasicAuth.PasswordRef, providerConfig.BasicAuth.PasswordFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
@@ -214,11 +415,25 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	}
 	if generatorConfig.AzureDevOps != nil {
 		providerConfig := generatorConfig.AzureDevOps
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.TokenRef, providerConfig.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
-		return pullrequest.NewAzureDevOpsService(token, providerConfig.API, providerConfig.Organization, providerConfig.Project, providerConfig.Repo, providerConfig.Labels)
+		var maxPRAge time.Duration
+		if providerConfig.MaxPRAge != "" {
+			maxPRAge, err = time.ParseDuration(providerConfig.MaxPRAge)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing maxPRAge %q: %w", providerConfig.MaxPRAge, err)
+			}
+		}
+		var repos []string
+		if providerConfig.Repo != "" {
+			repos = append(repos, providerConfig.Repo)
+		}
+		repos = append(repos, providerConfig.Repos...)
+		// the update time is only resolved when needed, since it costs one additional API call per pull request
+		resolveUpdateTime := generatorConfig.SortBy == pullrequest.SortByUpdatedAt
+		return pullrequest.NewAzureDevOpsService(token, providerConfig.API, providerConfig.Organization, providerConfig.Project, repos, providerConfig.Labels, maxPRAge, resolveUpdateTime, providerConfig.RequireSucceededStatuses, g.scmRootCAPath)
 	}
 	return nil, errors.New("no Pull Request provider implementation configured")
 }
@@ -232,7 +447,7 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 			AppSetNamespace: applicationSetInfo.Namespace,
 			AppSetName:      applicationSetInfo.Name,
 		}
-		httpClient = services.NewGitHubMetricsClient(metricsCtx)
+		httpClient = &http.Client{Transport: services.NewDefaultGitHubMetricsTransport(pullrequest.NewGithubTransport(), metricsCtx)}
 	}
 
 	// use an app if it was configured
@@ -243,19 +458,19 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 		}
 
 		if g.enableGitHubAPIMetrics {
-			return pullrequest.NewGithubAppService(*auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
+			return pullrequest.NewGithubAppService(*auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, cfg.RequireApproval, httpClient)
 		}
-		return pullrequest.NewGithubAppService(*auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels)
+		return pullrequest.NewGithubAppService(*auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, cfg.RequireApproval)
 	}
 
 	// always default to token, even if not set (public access)
-	token, err := utils.GetSecretRef(ctx, g.client, cfg.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	token, err := utils.GetSecretRefOrFile(ctx, g.client, cfg.TokenRef, cfg.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching Secret token: %w", err)
 	}
 
 	if g.enableGitHubAPIMetrics {
-		return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
+		return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, cfg.RequireApproval, httpClient)
 	}
-	return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels)
+	return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, cfg.RequireApproval)
 }
@@ -3,7 +3,9 @@ package generators
 import (
 	"context"
 	"errors"
+	"math/rand/v2"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,6 +24,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 		expectedErr                 error
 		applicationSet              argoprojiov1alpha1.ApplicationSet
 		continueOnRepoNotFoundError bool
+		missingHeadBranch           string
+		resolveApprovals            bool
 	}{
 		{
 			selectFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
@@ -51,7 +55,45 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
 					"head_short_sha":     "089d92cb",
 					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
 					"author":             "testName",
+					"draft":              "false",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			selectFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+				return pullrequest.NewFakeService(
+					ctx,
+					[]*pullrequest.PullRequest{
+						{
+							Number:       1,
+							Title:        "title1",
+							Branch:       "branch1",
+							TargetBranch: "master",
+							HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							Author:       "testName",
+							IsDraft:      true,
+						},
+					},
+					nil,
+				)
+			},
+			expected: []map[string]any{
+				{
+					"number":             "1",
+					"title":              "title1",
+					"branch":             "branch1",
+					"branch_slug":        "branch1",
+					"target_branch":      "master",
+					"target_branch_slug": "master",
+					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"head_short_sha":     "089d92cb",
+					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
+					"author":             "testName",
+					"draft":              "true",
 				},
 			},
 			expectedErr: nil,
@@ -84,7 +126,9 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "9b34ff5bd418e57d58891eb0aa0728043ca1e8be",
 					"head_short_sha":     "9b34ff5b",
 					"head_short_sha_7":   "9b34ff5",
+					"base_sha":           "",
 					"author":             "testName",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -117,7 +161,9 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "abcd",
 					"head_short_sha":     "abcd",
 					"head_short_sha_7":   "abcd",
+					"base_sha":           "",
 					"author":             "testName",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -154,7 +200,9 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "abcd",
 					"head_short_sha":     "abcd",
 					"head_short_sha_7":   "abcd",
+					"base_sha":           "",
 					"author":             "testName",
+					"draft":              "false",
 					"values.foo":         "bar",
 					"values.pr_branch":   "my_branch",
 				},
@@ -225,8 +273,11 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
 					"head_short_sha":     "089d92cb",
 					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
 					"labels":             []string{"preview"},
+					"attributes":         map[string]string{},
 					"author":             "testName",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -266,7 +317,9 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
 					"head_short_sha":     "089d92cb",
 					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
 					"author":             "testName",
+					"draft":              "false",
 				},
 			},
 			expectedErr: nil,
@@ -277,6 +330,98 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 				},
 			},
 		},
+		{
+			selectFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+				return pullrequest.NewFakeService(
+					ctx,
+					[]*pullrequest.PullRequest{
+						{
+							Number:       1,
+							Title:        "title1",
+							TargetBranch: "master",
+							HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							Author:       "testName",
+						},
+					},
+					nil,
+				)
+			},
+			expected:    []map[string]any{},
+			expectedErr: nil,
+		},
+		{
+			selectFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+				return pullrequest.NewFakeService(
+					ctx,
+					[]*pullrequest.PullRequest{
+						{
+							Number:       1,
+							Title:        "title1",
+							TargetBranch: "master",
+							HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							Author:       "testName",
+						},
+					},
+					nil,
+				)
+			},
+			missingHeadBranch: pullrequest.MissingHeadBranchUseHeadSHA,
+			expected: []map[string]any{
+				{
+					"number":             "1",
+					"title":              "title1",
+					"branch":             "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"branch_slug":        "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"target_branch":      "master",
+					"target_branch_slug": "master",
+					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"head_short_sha":     "089d92cb",
+					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
+					"author":             "testName",
+					"draft":              "false",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			selectFunc: func(context.Context, *argoprojiov1alpha1.PullRequestGenerator, *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+				return pullrequest.NewFakeService(
+					ctx,
+					[]*pullrequest.PullRequest{
+						{
+							Number:       1,
+							Title:        "title1",
+							Branch:       "branch1",
+							TargetBranch: "master",
+							HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+							Author:       "testName",
+							Approvals:    2,
+						},
+					},
+					nil,
+				)
+			},
+			resolveApprovals: true,
+			expected: []map[string]any{
+				{
+					"number":             "1",
+					"title":              "title1",
+					"branch":             "branch1",
+					"branch_slug":        "branch1",
+					"target_branch":      "master",
+					"target_branch_slug": "master",
+					"head_sha":           "089d92cbf9ff857a39e6feccd32798ca700fb958",
+					"head_short_sha":     "089d92cb",
+					"head_short_sha_7":   "089d92c",
+					"base_sha":           "",
+					"author":             "testName",
+					"draft":              "false",
+					"approvals":          "2",
+				},
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, c := range cases {
@@ -287,6 +432,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 			PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
 				Values:                      c.values,
 				ContinueOnRepoNotFoundError: c.continueOnRepoNotFoundError,
+				MissingHeadBranch:           c.missingHeadBranch,
+				ResolveApprovals:            c.resolveApprovals,
 			},
 		}
 
@@ -300,6 +447,65 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	}
 }
 
+func TestPullRequestGenerateParamsCache(t *testing.T) {
+	pull := &pullrequest.PullRequest{
+		Number:       1,
+		Title:        "title1",
+		Branch:       "branch1",
+		TargetBranch: "master",
+		HeadSHA:      "089d92cbf9ff857a39e6feccd32798ca700fb958",
+		Author:       "testName",
+	}
+	gen := PullRequestGenerator{
+		selectServiceProviderFunc: func(ctx context.Context, _ *argoprojiov1alpha1.PullRequestGenerator, _ *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+			copied := *pull
+			return pullrequest.NewFakeService(ctx, []*pullrequest.PullRequest{&copied}, nil)
+		},
+	}
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+			Values: map[string]string{"name": "app-{{ number }}"},
+		},
+	}
+	applicationSet := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+	}
+
+	got, err := gen.GenerateParams(&generatorConfig, &applicationSet, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "app-1", got[0]["values.name"])
+	require.Len(t, gen.paramsCache, 1)
+	cached := gen.paramsCache["argocd/set"].params[pull.Fingerprint()]
+	require.NotNil(t, cached)
+
+	// the returned parameters are not the cached ones
+	got[0]["title"] = "modified"
+	assert.Equal(t, "title1", cached["title"])
+
+	// unchanged pull requests reuse the cached parameters
+	cached["values.name"] = "cached"
+	got, err = gen.GenerateParams(&generatorConfig, &applicationSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", got[0]["values.name"])
+	assert.Equal(t, "title1", got[0]["title"])
+
+	// changed values invalidate the cached parameters
+	generatorConfig.PullRequest.Values = map[string]string{"name": "pr-{{ number }}"}
+	got, err = gen.GenerateParams(&generatorConfig, &applicationSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1", got[0]["values.name"])
+
+	// changed pull requests are generated again, and the parameters of the previous head SHA are dropped
+	previousFingerprint := pull.Fingerprint()
+	pull.HeadSHA = "5ed0a8cc2d8ebd07e6b29c62db60e5ab4e6b2cbc"
+	got, err = gen.GenerateParams(&generatorConfig, &applicationSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "5ed0a8cc2d8ebd07e6b29c62db60e5ab4e6b2cbc", got[0]["head_sha"])
+	assert.NotContains(t, gen.paramsCache["argocd/set"].params, previousFingerprint)
+	assert.Contains(t, gen.paramsCache["argocd/set"].params, pull.Fingerprint())
+}
+
 func TestAllowedSCMProviderPullRequest(t *testing.T) {
 	t.Parallel()
 
@@ -339,6 +545,18 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Error combined Gitea",
+			providerConfig: &argoprojiov1alpha1.PullRequestGenerator{
+				Github: &argoprojiov1alpha1.PullRequestGeneratorGithub{
+					API: "github.myorg.com",
+				},
+				Gitea: &argoprojiov1alpha1.PullRequestGeneratorGitea{
+					API: "https://myservice.mynamespace.svc.cluster.local",
+				},
+				CombineProviders: "all",
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -353,7 +571,7 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 				"gitea.myorg.com",
 				"bitbucket.myorg.com",
 				"azuredevops.myorg.com",
-			}, true, true, nil, true))
+			}, true, true, nil, true, false, 0))
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -375,8 +593,53 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 	}
 }
 
+func TestSplitProviders(t *testing.T) {
+	generatorConfig := &argoprojiov1alpha1.PullRequestGenerator{
+		Github:           &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "myorg", Repo: "myrepo"},
+		AzureDevOps:      &argoprojiov1alpha1.PullRequestGeneratorAzureDevOps{Organization: "myorg", Project: "myproject"},
+		GitLab:           &argoprojiov1alpha1.PullRequestGeneratorGitLab{Project: "myproject"},
+		SortBy:           "updatedAt",
+		CombineProviders: "available",
+	}
+
+	configs := splitProviders(generatorConfig)
+
+	require.Len(t, configs, 3)
+	assert.Equal(t, &argoprojiov1alpha1.PullRequestGenerator{Github: generatorConfig.Github, SortBy: "updatedAt", CombineProviders: "available"}, configs[0])
+	assert.Equal(t, &argoprojiov1alpha1.PullRequestGenerator{GitLab: generatorConfig.GitLab, SortBy: "updatedAt", CombineProviders: "available"}, configs[1])
+	assert.Equal(t, &argoprojiov1alpha1.PullRequestGenerator{AzureDevOps: generatorConfig.AzureDevOps, SortBy: "updatedAt", CombineProviders: "available"}, configs[2])
+	// the original configuration is left untouched
+	assert.NotNil(t, generatorConfig.Github)
+	assert.NotNil(t, generatorConfig.GitLab)
+	assert.NotNil(t, generatorConfig.AzureDevOps)
+}
+
+func TestSelectServiceProviderCombineProviders(t *testing.T) {
+	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, true, true, nil, true, false, 0)).(*PullRequestGenerator)
+	applicationSetInfo := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set"}}
+
+	svc, err := generator.selectServiceProvider(t.Context(), &argoprojiov1alpha1.PullRequestGenerator{
+		Github:           &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "myorg", Repo: "myrepo"},
+		GitLab:           &argoprojiov1alpha1.PullRequestGeneratorGitLab{Project: "myproject"},
+		CombineProviders: "all",
+	}, applicationSetInfo)
+	require.NoError(t, err)
+	assert.IsType(t, &pullrequest.CompositeService{}, svc)
+
+	_, err = generator.selectServiceProvider(t.Context(), &argoprojiov1alpha1.PullRequestGenerator{
+		CombineProviders: "all",
+	}, applicationSetInfo)
+	require.Error(t, err)
+
+	_, err = generator.selectServiceProvider(t.Context(), &argoprojiov1alpha1.PullRequestGenerator{
+		Github:           &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "myorg", Repo: "myrepo"},
+		CombineProviders: "some",
+	}, applicationSetInfo)
+	require.ErrorContains(t, err, "unknown combineProviders value")
+}
+
 func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
-	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, false, true, nil, true))
+	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, false, true, nil, true, false, 0))
 
 	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -396,3 +659,45 @@ func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
 	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
 	assert.ErrorIs(t, err, ErrSCMProvidersDisabled)
 }
+
+func TestPullRequestGetRequeueAfterJitter(t *testing.T) {
+	requeueAfterSeconds := int64(600)
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		PullRequest: &argoprojiov1alpha1.PullRequestGenerator{RequeueAfterSeconds: &requeueAfterSeconds},
+	}
+
+	t.Run("no jitter", func(t *testing.T) {
+		generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, true, false, nil, true, false, 0))
+		assert.Equal(t, 10*time.Minute, generator.GetRequeueAfter(appSetGenerator))
+		assert.Equal(t, DefaultPullRequestRequeueAfter, generator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+			PullRequest: &argoprojiov1alpha1.PullRequestGenerator{},
+		}))
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		newGenerator := func() *PullRequestGenerator {
+			generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, true, false, nil, true, false, 0.2)).(*PullRequestGenerator)
+			generator.randFloat = rand.New(rand.NewPCG(1, 2)).Float64
+			return generator
+		}
+		generator, other := newGenerator(), newGenerator()
+		distinct := map[time.Duration]bool{}
+		for range 100 {
+			requeueAfter := generator.GetRequeueAfter(appSetGenerator)
+			assert.GreaterOrEqual(t, requeueAfter, 8*time.Minute)
+			assert.LessOrEqual(t, requeueAfter, 12*time.Minute)
+			// the same seed results in the same intervals
+			assert.Equal(t, requeueAfter, other.GetRequeueAfter(appSetGenerator))
+			distinct[requeueAfter] = true
+		}
+		assert.Greater(t, len(distinct), 1)
+	})
+}
+
+func Test_jitterDuration(t *testing.T) {
+	assert.Equal(t, time.Minute, jitterDuration(time.Minute, 0.5, func() float64 { return 0.5 }))
+	assert.Equal(t, 30*time.Second, jitterDuration(time.Minute, 0.5, func() float64 { return 0 }))
+	assert.Equal(t, 75*time.Second, jitterDuration(time.Minute, 0.5, func() float64 { return 0.75 }))
+	assert.Equal(t, time.Minute, jitterDuration(time.Minute, 0, func() float64 { return 0 }))
+	assert.Equal(t, time.Duration(0), jitterDuration(0, 0.5, func() float64 { return 0 }))
+}
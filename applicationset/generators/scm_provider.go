@@ -39,16 +39,23 @@ type SCMConfig struct {
 	enableGitHubAPIMetrics bool
 	GitHubApps             github_app_auth.Credentials
 	tokenRefStrictMode     bool
+	// enableTokenFiles allows the generators to read credentials from token files mounted into the controller
+	enableTokenFiles bool
+	// pullRequestRequeueJitter is the fraction by which the requeue interval of the pull request generator is randomly
+	// lengthened or shortened, so that ApplicationSets polling on identical intervals do not hit the SCM simultaneously
+	pullRequestRequeueJitter float64
 }
 
-func NewSCMConfig(scmRootCAPath string, allowedSCMProviders []string, enableSCMProviders bool, enableGitHubAPIMetrics bool, gitHubApps github_app_auth.Credentials, tokenRefStrictMode bool) SCMConfig {
+func NewSCMConfig(scmRootCAPath string, allowedSCMProviders []string, enableSCMProviders bool, enableGitHubAPIMetrics bool, gitHubApps github_app_auth.Credentials, tokenRefStrictMode bool, enableTokenFiles bool, pullRequestRequeueJitter float64) SCMConfig {
 	return SCMConfig{
-		scmRootCAPath:          scmRootCAPath,
-		allowedSCMProviders:    allowedSCMProviders,
-		enableSCMProviders:     enableSCMProviders,
-		enableGitHubAPIMetrics: enableGitHubAPIMetrics,
-		GitHubApps:             gitHubApps,
-		tokenRefStrictMode:     tokenRefStrictMode,
+		scmRootCAPath:            scmRootCAPath,
+		allowedSCMProviders:      allowedSCMProviders,
+		enableSCMProviders:       enableSCMProviders,
+		enableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+		GitHubApps:               gitHubApps,
+		tokenRefStrictMode:       tokenRefStrictMode,
+		enableTokenFiles:         enableTokenFiles,
+		pullRequestRequeueJitter: pullRequestRequeueJitter,
 	}
 }
 
@@ -190,13 +197,13 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		}
 		switch {
 		case providerConfig.BearerToken != nil:
-			appToken, err := utils.GetSecretRef(ctx, g.client, providerConfig.BearerToken.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			appToken, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BearerToken.TokenRef, providerConfig.BearerToken.TokenFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret Bearer token: %w", err)
 			}
 			provider, scmError = scm_provider.NewBitbucketServerProviderBearerToken(ctx, appToken, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts)
 		case providerConfig.BasicAuth != nil:
-			password, err := utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			password, err := utils.GetSecretRefOrFile(ctx, g.client, providerConfig.BasicAuth.PasswordRef, providerConfig.BasicAuth.PasswordFile, applicationSetInfo.Namespace, g.tokenRefStrictMode, g.enableTokenFiles)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
@@ -212,7 +219,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Azure Devops access token: %w", err)
 		}
-		provider, err = scm_provider.NewAzureDevOpsProvider(token, providerConfig.AzureDevOps.Organization, providerConfig.AzureDevOps.API, providerConfig.AzureDevOps.TeamProject, providerConfig.AzureDevOps.AllBranches)
+		provider, err = scm_provider.NewAzureDevOpsProvider(token, providerConfig.AzureDevOps.Organization, providerConfig.AzureDevOps.API, providerConfig.AzureDevOps.TeamProject, providerConfig.AzureDevOps.AllBranches, g.scmRootCAPath)
 		if err != nil {
 			return nil, fmt.Errorf("error initializing Azure Devops service: %w", err)
 		}
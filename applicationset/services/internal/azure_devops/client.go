@@ -0,0 +1,158 @@
+package azure_devops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+)
+
+// DefaultURL is the Azure DevOps API URL used if none is configured.
+const DefaultURL = "https://dev.azure.com"
+
+// Credentials configure the access to an Azure DevOps organization, shared by the SCM provider and the pull request
+// generators.
+type Credentials struct {
+	// Token is the personal access token. Requests are anonymous if it is empty.
+	Token string
+	// URL is the Azure DevOps API URL, DefaultURL if empty.
+	URL string
+	// Organization is the name of the Azure DevOps organization.
+	Organization string
+	// ScmRootCAPath is the path of a file with PEM encoded root CAs trusted in addition to the system ones.
+	ScmRootCAPath string
+	// CACerts are PEM encoded root CAs trusted in addition to the system ones.
+	CACerts []byte
+	// Proxy is the URL of the proxy requests are sent through. The proxy of the environment is used if it is empty.
+	Proxy string
+}
+
+// OrganizationURL returns the URL of the organization of the credentials. The URL is not validated.
+func (c Credentials) OrganizationURL() string {
+	apiURL := c.URL
+	if apiURL == "" {
+		apiURL = DefaultURL
+	}
+	separator := ""
+	if !strings.HasSuffix(apiURL, "/") {
+		separator = "/"
+	}
+	return fmt.Sprintf("%s%s%s", apiURL, separator, c.Organization)
+}
+
+// hasTransportConfig returns true if the credentials configure the CAs or the proxy of the requests
+func (c Credentials) hasTransportConfig() bool {
+	return c.ScmRootCAPath != "" || len(c.CACerts) > 0 || c.Proxy != ""
+}
+
+// ConfigureTransport configures the transport to trust the CAs and to use the proxy of the credentials.
+func (c Credentials) ConfigureTransport(tr *http.Transport) error {
+	if c.ScmRootCAPath != "" || len(c.CACerts) > 0 {
+		tr.TLSClientConfig = utils.GetTlsConfig(c.ScmRootCAPath, false, c.CACerts)
+	}
+	if c.Proxy != "" {
+		proxyURL, err := url.Parse(c.Proxy)
+		if err != nil {
+			return fmt.Errorf("got an invalid Azure DevOps proxy URL: %w", err)
+		}
+		tr.Proxy = http.ProxyURL(proxyURL)
+	}
+	return nil
+}
+
+// ClientFactory creates Azure DevOps git clients for a connection to an organization.
+type ClientFactory struct {
+	connection *azuredevops.Connection
+	// generator names the generator the clients are created for in errors
+	generator string
+	// httpClient sends the requests of the clients, if set. Otherwise the clients use the default client of the
+	// Azure DevOps library.
+	httpClient *http.Client
+	// gitURL caches the URL of the git resource area resolved for the HTTP client
+	gitURL     string
+	gitURLLock sync.Mutex
+}
+
+// NewClientFactory returns a factory of git clients authenticated with the credentials. The generator name is used
+// in errors creating clients. The clients send their requests with the HTTP client if it is not nil, whose transport
+// must be configured with ConfigureTransport. Otherwise they use the default client of the Azure DevOps library,
+// unless the credentials configure the CAs or the proxy of the requests.
+func NewClientFactory(creds Credentials, generator string, httpClient *http.Client) (*ClientFactory, error) {
+	if httpClient == nil && creds.hasTransportConfig() {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		if err := creds.ConfigureTransport(tr); err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{Transport: tr}
+	}
+	organizationURL := creds.OrganizationURL()
+	var connection *azuredevops.Connection
+	if creds.Token == "" {
+		connection = azuredevops.NewAnonymousConnection(organizationURL)
+	} else {
+		connection = azuredevops.NewPatConnection(organizationURL, creds.Token)
+	}
+	return &ClientFactory{connection: connection, generator: generator, httpClient: httpClient}, nil
+}
+
+// GetClient returns a new git client for the organization.
+func (f *ClientFactory) GetClient(ctx context.Context) (git.Client, error) {
+	if f.httpClient == nil {
+		gitClient, err := git.NewClient(ctx, f.connection)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get new Azure DevOps git client for %s: %w", f.generator, err)
+		}
+		return gitClient, nil
+	}
+	// the library only lets its clients use a custom HTTP client if they are created directly for the URL of their
+	// resource area, so the URL is resolved the same way the library does
+	gitURL, err := f.resolveGitURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get new Azure DevOps git client for %s: %w", f.generator, err)
+	}
+	return &git.ClientImpl{Client: *azuredevops.NewClientWithOptions(f.connection, gitURL, azuredevops.WithHTTPClient(f.httpClient))}, nil
+}
+
+// resolveGitURL returns the URL of the git resource area of the organization, which is the organization URL for on
+// premises servers
+func (f *ClientFactory) resolveGitURL(ctx context.Context) (string, error) {
+	f.gitURLLock.Lock()
+	defer f.gitURLLock.Unlock()
+	if f.gitURL != "" {
+		return f.gitURL, nil
+	}
+	client := azuredevops.NewClientWithOptions(f.connection, normalizeURL(f.connection.BaseUrl), azuredevops.WithHTTPClient(f.httpClient))
+	resourceAreas, err := client.GetResourceAreas(ctx)
+	if err != nil {
+		return "", err
+	}
+	// on premises servers return an empty list
+	if resourceAreas == nil || len(*resourceAreas) == 0 {
+		f.gitURL = normalizeURL(f.connection.BaseUrl)
+		return f.gitURL, nil
+	}
+	for _, resourceArea := range *resourceAreas {
+		if resourceArea.Id != nil && *resourceArea.Id == git.ResourceAreaId && resourceArea.LocationUrl != nil {
+			f.gitURL = normalizeURL(*resourceArea.LocationUrl)
+			return f.gitURL, nil
+		}
+	}
+	return "", &azuredevops.ResourceAreaIdNotRegisteredError{ResourceAreaId: git.ResourceAreaId, Url: f.connection.BaseUrl}
+}
+
+// normalizeURL normalizes a URL of a resource area like the Azure DevOps library
+func normalizeURL(url string) string {
+	return strings.ToLower(strings.TrimRight(url, "/"))
+}
+
+// Connection returns the connection the clients are created for.
+func (f *ClientFactory) Connection() *azuredevops.Connection {
+	return f.connection
+}
@@ -0,0 +1,146 @@
+package azure_devops
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+)
+
+func TestCredentialsOrganizationURL(t *testing.T) {
+	testCases := []struct {
+		name         string
+		url          string
+		organization string
+		expected     string
+	}{
+		{
+			name:         "Provided default URL and organization",
+			url:          "https://dev.azure.com/",
+			organization: "myorganization",
+			expected:     "https://dev.azure.com/myorganization",
+		},
+		{
+			name:         "Provided default URL and organization without trailing slash",
+			url:          "https://dev.azure.com",
+			organization: "myorganization",
+			expected:     "https://dev.azure.com/myorganization",
+		},
+		{
+			name:         "Provided no URL and organization",
+			url:          "",
+			organization: "myorganization",
+			expected:     "https://dev.azure.com/myorganization",
+		},
+		{
+			name:         "Provided custom URL and organization",
+			url:          "https://azuredevops.example.com/",
+			organization: "myorganization",
+			expected:     "https://azuredevops.example.com/myorganization",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Credentials{URL: tc.url, Organization: tc.organization}.OrganizationURL())
+		})
+	}
+}
+
+func TestCredentialsConfigureTransport(t *testing.T) {
+	tr := &http.Transport{}
+	require.NoError(t, Credentials{}.ConfigureTransport(tr))
+	assert.Nil(t, tr.TLSClientConfig)
+	assert.Nil(t, tr.Proxy)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caCerts := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tr = &http.Transport{}
+	require.NoError(t, Credentials{CACerts: caCerts}.ConfigureTransport(tr))
+	resp, err := (&http.Client{Transport: tr}).Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	tr = &http.Transport{}
+	require.NoError(t, Credentials{Proxy: "http://proxy.example.com:3128"}.ConfigureTransport(tr))
+	proxyURL, err := tr.Proxy(httptest.NewRequest(http.MethodGet, "https://dev.azure.com/myorganization", http.NoBody))
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+
+	err = Credentials{Proxy: "://proxy"}.ConfigureTransport(&http.Transport{})
+	require.ErrorContains(t, err, "invalid Azure DevOps proxy URL")
+}
+
+func TestNewClientFactory(t *testing.T) {
+	factory, err := NewClientFactory(Credentials{Token: "token", Organization: "myorganization"}, "SCM generator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.azure.com/myorganization", factory.Connection().BaseUrl)
+	assert.NotEmpty(t, factory.Connection().AuthorizationString)
+
+	anonymous, err := NewClientFactory(Credentials{Organization: "myorganization"}, "pull request generator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.azure.com/myorganization", anonymous.Connection().BaseUrl)
+	assert.Empty(t, anonymous.Connection().AuthorizationString)
+	assert.Nil(t, anonymous.httpClient)
+
+	withProxy, err := NewClientFactory(Credentials{Organization: "myorganization", Proxy: "http://proxy.example.com:3128"}, "SCM generator", nil)
+	require.NoError(t, err)
+	require.NotNil(t, withProxy.httpClient)
+	assert.NotNil(t, withProxy.httpClient.Transport.(*http.Transport).Proxy)
+
+	_, err = NewClientFactory(Credentials{Organization: "myorganization", Proxy: "://proxy"}, "SCM generator", nil)
+	require.ErrorContains(t, err, "invalid Azure DevOps proxy URL")
+}
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientFactoryGetClientWithHTTPClient(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodOptions && strings.EqualFold(r.URL.Path, "/MyOrganization/_apis"):
+			_, _ = w.Write([]byte(`{"count":1,"value":[{"id":"e81700f7-3be2-46de-8624-2eb35882fcaa","area":"Location","resourceName":"ResourceAreas","routeTemplate":"_apis/{resource}","minVersion":"1.0","maxVersion":"7.1","releasedVersion":"0.0","resourceVersion":1}]}`))
+		case r.Method == http.MethodGet && strings.EqualFold(r.URL.Path, "/MyOrganization/_apis/ResourceAreas"):
+			_, _ = fmt.Fprintf(w, `{"count":1,"value":[{"id":"%s","locationUrl":"%s/MyOrganization/","name":"git"}]}`, git.ResourceAreaId, serverURL)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	transport := &countingTransport{}
+	factory, err := NewClientFactory(Credentials{URL: server.URL, Organization: "MyOrganization"}, "pull request generator", &http.Client{Transport: transport})
+	require.NoError(t, err)
+
+	gitURL, err := factory.resolveGitURL(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, strings.ToLower(server.URL)+"/myorganization", gitURL)
+	assert.Equal(t, 2, transport.requests)
+
+	// the URL is resolved only once
+	_, err = factory.GetClient(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, transport.requests)
+}
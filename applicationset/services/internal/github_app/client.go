@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/go-github/v69/github"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
@@ -21,10 +22,14 @@ func getOptionalHTTPClientAndTransport(optionalHTTPClient ...*http.Client) (*htt
 	return httpClient, http.DefaultTransport
 }
 
-// Client builds a github client for the given app authentication.
+// Client builds a github client for the given app authentication. The client mints installation tokens with the
+// private key of the app, and refreshes them shortly before they expire.
 func Client(g github_app_auth.Authentication, url string, optionalHTTPClient ...*http.Client) (*github.Client, error) {
 	httpClient, transport := getOptionalHTTPClientAndTransport(optionalHTTPClient...)
 
+	if _, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(g.PrivateKey)); err != nil {
+		return nil, fmt.Errorf("failed to parse the private key of github app %d: %w", g.Id, err)
+	}
 	rt, err := ghinstallation.New(transport, g.Id, g.InstallationId, []byte(g.PrivateKey))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create github app install: %w", err)
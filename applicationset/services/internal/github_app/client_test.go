@@ -0,0 +1,84 @@
+package github_app
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+)
+
+func newPrivateKey(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// newGitHubServer returns a server minting installation tokens which expire after the given duration, and listing
+// the pull requests of argoproj/argo-cd if the request is authenticated with the latest minted token
+func newGitHubServer(t *testing.T, expiresIn time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	minted := &atomic.Int32{}
+	mux := http.NewServeMux()
+	// installation tokens are requested from the base URL of the client, without the API path
+	mux.HandleFunc("/app/installations/2/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		// the token is requested with a JWT signed by the private key of the app
+		assert.Regexp(t, `^Bearer [\w-]+\.[\w-]+\.[\w-]+$`, r.Header.Get("Authorization"))
+		count := minted.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"token": "token-%d", "expires_at": %q}`, count, time.Now().Add(expiresIn).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("token token-%d", minted.Load()), r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, minted
+}
+
+func TestClientMintsInstallationToken(t *testing.T) {
+	server, minted := newGitHubServer(t, time.Hour)
+	client, err := Client(github_app_auth.Authentication{Id: 1, InstallationId: 2, PrivateKey: newPrivateKey(t)}, server.URL)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, _, err = client.PullRequests.List(t.Context(), "argoproj", "argo-cd", &github.PullRequestListOptions{})
+		require.NoError(t, err)
+	}
+	// the token is reused until it is about to expire
+	assert.Equal(t, int32(1), minted.Load())
+}
+
+func TestClientRefreshesExpiringInstallationToken(t *testing.T) {
+	// tokens expiring within a minute are refreshed before they are used
+	server, minted := newGitHubServer(t, 30*time.Second)
+	client, err := Client(github_app_auth.Authentication{Id: 1, InstallationId: 2, PrivateKey: newPrivateKey(t)}, server.URL)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, _, err = client.PullRequests.List(t.Context(), "argoproj", "argo-cd", &github.PullRequestListOptions{})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), minted.Load())
+}
+
+func TestClientInvalidPrivateKey(t *testing.T) {
+	_, err := Client(github_app_auth.Authentication{Id: 1, InstallationId: 2, PrivateKey: "not a key"}, "")
+	require.ErrorContains(t, err, "failed to parse the private key of github app 1")
+}
@@ -2,17 +2,26 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/azure_devops"
 )
 
 const (
-	AZURE_DEVOPS_DEFAULT_URL             = "https://dev.azure.com"
+	AZURE_DEVOPS_DEFAULT_URL             = azure_devops.DefaultURL
 	AZURE_DEVOPS_PROJECT_NOT_FOUND_ERROR = "The following project does not exist"
+	// AZURE_DEVOPS_REPOSITORY_NOT_FOUND_ERROR is the code of the error returned by the repository scoped APIs for a
+	// repository which does not exist
+	AZURE_DEVOPS_REPOSITORY_NOT_FOUND_ERROR = "TF401019"
 )
 
 type AzureDevOpsClientFactory interface {
@@ -21,44 +30,81 @@ type AzureDevOpsClientFactory interface {
 }
 
 type devopsFactoryImpl struct {
-	connection *azuredevops.Connection
+	factory *azure_devops.ClientFactory
 }
 
 func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (git.Client, error) {
-	gitClient, err := git.NewClient(ctx, factory.connection)
+	gitClient, err := factory.factory.GetClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get new Azure DevOps git client for pull request generator: %w", err)
+		if isAzureDevOpsAuthScopeError(err) {
+			return nil, NewAuthScopeError(err)
+		}
+		return nil, err
 	}
 	return gitClient, nil
 }
 
+// isAzureDevOpsAuthScopeError returns whether the error is an Azure DevOps API error with a 403 status, which is
+// returned for credentials that are valid for the organization, but lack the scope or permissions for the request.
+func isAzureDevOpsAuthScopeError(err error) bool {
+	var wrappedErr azuredevops.WrappedError
+	if errors.As(err, &wrappedErr) {
+		return wrappedErr.StatusCode != nil && *wrappedErr.StatusCode == http.StatusForbidden
+	}
+	var wrappedErrPtr *azuredevops.WrappedError
+	if errors.As(err, &wrappedErrPtr) {
+		return wrappedErrPtr.StatusCode != nil && *wrappedErrPtr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
 type AzureDevOpsService struct {
 	clientFactory AzureDevOpsClientFactory
-	project       string
-	repo          string
-	labels        []string
+	// organizationURL is the URL of the organization the web URLs of the pull requests are built from.
+	organizationURL string
+	project         string
+	// repos are the names or IDs of the repositories to list pull requests of.
+	repos  []string
+	labels []string
+	// maxPRAge excludes pull requests which were not updated within this duration. Zero disables the check.
+	maxPRAge time.Duration
+	// resolveUpdateTime resolves the time of the last update of each pull request from its iterations, which costs one
+	// additional API call per pull request. It is always resolved if maxPRAge is set, the creation date is used
+	// otherwise.
+	resolveUpdateTime bool
+	// requireSucceededStatuses excludes pull requests with a latest status which did not succeed.
+	requireSucceededStatuses bool
 }
 
 var (
 	_ PullRequestService       = (*AzureDevOpsService)(nil)
+	_ ChangedFilesService      = (*AzureDevOpsService)(nil)
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
-func NewAzureDevOpsService(token, url, organization, project, repo string, labels []string) (PullRequestService, error) {
-	organizationURL := buildURL(url, organization)
-
-	var connection *azuredevops.Connection
-	if token == "" {
-		connection = azuredevops.NewAnonymousConnection(organizationURL)
-	} else {
-		connection = azuredevops.NewPatConnection(organizationURL, token)
+func NewAzureDevOpsService(token, url, organization, project string, repos []string, labels []string, maxPRAge time.Duration, resolveUpdateTime bool, requireSucceededStatuses bool, scmRootCAPath string) (PullRequestService, error) {
+	if len(repos) == 0 {
+		return nil, errors.New("at least one Azure DevOps repo must be set")
+	}
+	creds := azure_devops.Credentials{Token: token, URL: url, Organization: organization, ScmRootCAPath: scmRootCAPath}
+	tr := newTransport(nil)
+	if err := creds.ConfigureTransport(tr); err != nil {
+		return nil, err
+	}
+	factory, err := azure_devops.NewClientFactory(creds, "pull request generator", &http.Client{Transport: traceRequests("azure_devops", tr)})
+	if err != nil {
+		return nil, err
 	}
 
 	return &AzureDevOpsService{
-		clientFactory: &devopsFactoryImpl{connection: connection},
-		project:       project,
-		repo:          repo,
-		labels:        labels,
+		clientFactory:            &devopsFactoryImpl{factory: factory},
+		organizationURL:          creds.OrganizationURL(),
+		project:                  project,
+		repos:                    repos,
+		labels:                   labels,
+		maxPRAge:                 maxPRAge,
+		resolveUpdateTime:        resolveUpdateTime,
+		requireSucceededStatuses: requireSucceededStatuses,
 	}, nil
 }
 
@@ -68,25 +114,30 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
 	}
 
-	args := git.GetPullRequestsByProjectArgs{
-		Project:        &a.project,
-		SearchCriteria: &git.GitPullRequestSearchCriteria{},
-	}
-
 	pullRequests := []*PullRequest{}
+	// statusesSucceeded caches whether the statuses of a pull request succeeded, by pull request ID
+	statusesSucceeded := map[int]bool{}
 
-	azurePullRequests, err := client.GetPullRequestsByProject(ctx, args)
+	azurePullRequests, err := a.listPullRequests(ctx, client)
 	if err != nil {
+		// A personal access token without access to the project is rejected with a 403, which must not be mistaken
+		// for a missing project
+		if isAzureDevOpsAuthScopeError(err) {
+			return nil, NewAuthScopeError(fmt.Errorf("failed to get pull requests of project '%s', the token may lack the required scope: %w", a.project, err))
+		}
 		// A standard Http 404 error is not returned for Azure DevOps,
 		// so checking the error message for a specific pattern.
-		// NOTE: Since the repos are filtered later, only existence of the project
-		// is relevant for AzureDevOps
-		if strings.Contains(err.Error(), AZURE_DEVOPS_PROJECT_NOT_FOUND_ERROR) {
+		if strings.Contains(err.Error(), AZURE_DEVOPS_PROJECT_NOT_FOUND_ERROR) || strings.Contains(err.Error(), AZURE_DEVOPS_REPOSITORY_NOT_FOUND_ERROR) {
 			// return a custom error indicating that the repository is not found,
 			// but also return the empty result since the decision to continue or not in this case is made by the caller
 			return pullRequests, NewRepositoryNotFoundError(err)
 		}
-		return nil, fmt.Errorf("failed to get pull requests by project: %w", err)
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	// the client returns a nil list if the response body is empty
+	if azurePullRequests == nil {
+		return pullRequests, nil
 	}
 
 	for _, pr := range *azurePullRequests {
@@ -105,22 +156,278 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 			continue
 		}
 
-		if *pr.Repository.Name == a.repo {
-			pullRequests = append(pullRequests, &PullRequest{
-				Number:       *pr.PullRequestId,
-				Title:        *pr.Title,
-				Branch:       strings.Replace(*pr.SourceRefName, "refs/heads/", "", 1),
-				TargetBranch: strings.Replace(*pr.TargetRefName, "refs/heads/", "", 1),
-				HeadSHA:      *pr.LastMergeSourceCommit.CommitId,
-				Labels:       azureDevOpsLabels,
-				Author:       strings.Split(*pr.CreatedBy.UniqueName, "@")[0], // Get the part before the @ in the email-address
-			})
+		if !a.hasRepository(pr.Repository) {
+			continue
 		}
+
+		var updatedAt time.Time
+		if pr.CreationDate != nil {
+			updatedAt = pr.CreationDate.Time
+		}
+		if a.resolveUpdateTime || a.maxPRAge > 0 {
+			updatedAt, err = a.lastUpdateTime(ctx, client, pr, updatedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if a.maxPRAge > 0 && !updatedAt.IsZero() && time.Since(updatedAt) > a.maxPRAge {
+			continue
+		}
+
+		if a.requireSucceededStatuses {
+			succeeded, err := a.statusesSucceeded(ctx, client, pr, statusesSucceeded)
+			if err != nil {
+				return nil, err
+			}
+			if !succeeded {
+				continue
+			}
+		}
+
+		pullRequests = append(pullRequests, &PullRequest{
+			Number:       *pr.PullRequestId,
+			Title:        *pr.Title,
+			Branch:       strings.Replace(*pr.SourceRefName, "refs/heads/", "", 1),
+			TargetBranch: strings.Replace(*pr.TargetRefName, "refs/heads/", "", 1),
+			HeadSHA:      *pr.LastMergeSourceCommit.CommitId,
+			BaseSHA:      lastMergeTargetCommitID(pr),
+			Labels:       azureDevOpsLabels,
+			Author:       strings.Split(*pr.CreatedBy.UniqueName, "@")[0], // Get the part before the @ in the email-address
+			UpdatedAt:    updatedAt,
+			Repository:   *pr.Repository.Name,
+			IsDraft:      pr.IsDraft != nil && *pr.IsDraft,
+			Approvals:    azureDevOpsApprovals(pr.Reviewers),
+			URL:          azureDevOpsPullRequestURL(a.organizationURL, a.project, *pr.Repository.Name, *pr.PullRequestId),
+			Attributes:   azureDevOpsAttributes(pr),
+		})
 	}
 
 	return pullRequests, nil
 }
 
+// listPullRequests lists the pull requests of the repository with the repository scoped API if a single repository is
+// configured, as listing all pull requests of a large project is wasteful. Otherwise, it lists the pull requests of the
+// whole project, which are filtered by repository afterwards.
+func (a *AzureDevOpsService) listPullRequests(ctx context.Context, client git.Client) (*[]git.GitPullRequest, error) {
+	if len(a.repos) == 1 {
+		return client.GetPullRequests(ctx, git.GetPullRequestsArgs{
+			Project:        &a.project,
+			RepositoryId:   &a.repos[0],
+			SearchCriteria: &git.GitPullRequestSearchCriteria{},
+		})
+	}
+	return client.GetPullRequestsByProject(ctx, git.GetPullRequestsByProjectArgs{
+		Project:        &a.project,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	})
+}
+
+// azureDevOpsPullRequestURL returns the web URL of a pull request, built from the URL of its organization, the names of
+// its project and repository and its ID, as the pull requests returned by the API only link to the API itself
+func azureDevOpsPullRequestURL(organizationURL, project, repo string, id int) string {
+	return fmt.Sprintf("%s/%s/_git/%s/pullrequest/%d", strings.TrimSuffix(organizationURL, "/"), url.PathEscape(project), url.PathEscape(repo), id)
+}
+
+// azureDevOpsApprovals returns the number of reviewers who voted to approve the pull request, with or without
+// suggestions.
+func azureDevOpsApprovals(reviewers *[]git.IdentityRefWithVote) int {
+	if reviewers == nil {
+		return 0
+	}
+	approvals := 0
+	for _, reviewer := range *reviewers {
+		if reviewer.Vote != nil && *reviewer.Vote > 0 {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// azureDevOpsAttributes returns the attributes of a pull request, i.e. its mergeStatus and status if reported
+func azureDevOpsAttributes(pr git.GitPullRequest) map[string]string {
+	attributes := map[string]string{}
+	if pr.MergeStatus != nil {
+		attributes["mergeStatus"] = string(*pr.MergeStatus)
+	}
+	if pr.Status != nil {
+		attributes["status"] = string(*pr.Status)
+	}
+	return attributes
+}
+
+// statusesSucceeded returns true if the latest status of every status context of the pull request succeeded or is not
+// applicable. A pull request without statuses is considered succeeded. The result is cached by pull request ID.
+func (a *AzureDevOpsService) statusesSucceeded(ctx context.Context, client git.Client, pr git.GitPullRequest, cache map[int]bool) (bool, error) {
+	if succeeded, ok := cache[*pr.PullRequestId]; ok {
+		return succeeded, nil
+	}
+	statuses, err := client.GetPullRequestStatuses(ctx, git.GetPullRequestStatusesArgs{
+		Project:       &a.project,
+		RepositoryId:  pr.Repository.Name,
+		PullRequestId: pr.PullRequestId,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get statuses of pull request %d: %w", *pr.PullRequestId, err)
+	}
+	latest := map[string]git.GitPullRequestStatus{}
+	if statuses != nil {
+		for _, status := range *statuses {
+			key := statusContextKey(status)
+			if current, ok := latest[key]; !ok || statusID(status) > statusID(current) {
+				latest[key] = status
+			}
+		}
+	}
+	succeeded := true
+	for _, status := range latest {
+		if status.State == nil || (*status.State != git.GitStatusStateValues.Succeeded && *status.State != git.GitStatusStateValues.NotApplicable) {
+			succeeded = false
+			break
+		}
+	}
+	cache[*pr.PullRequestId] = succeeded
+	return succeeded, nil
+}
+
+// statusContextKey returns the genre and name of the context of a pull request status.
+func statusContextKey(status git.GitPullRequestStatus) string {
+	if status.Context == nil {
+		return ""
+	}
+	var genre, name string
+	if status.Context.Genre != nil {
+		genre = *status.Context.Genre
+	}
+	if status.Context.Name != nil {
+		name = *status.Context.Name
+	}
+	return genre + "/" + name
+}
+
+// statusID returns the ID of a pull request status, which increases with every status posted to the pull request.
+func statusID(status git.GitPullRequestStatus) int {
+	if status.Id == nil {
+		return 0
+	}
+	return *status.Id
+}
+
+// hasRepository returns true if the repository is one of the repositories of the service, by name or ID.
+func (a *AzureDevOpsService) hasRepository(repository *git.GitRepository) bool {
+	for _, repo := range a.repos {
+		if *repository.Name == repo || (repository.Id != nil && repository.Id.String() == repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangedFiles returns the files changed by the latest iteration of the pull request, compared to the common commit
+// of the source and target branches.
+func (a *AzureDevOpsService) ChangedFiles(ctx context.Context, pullRequest *PullRequest) ([]string, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	iterations, err := client.GetPullRequestIterations(ctx, git.GetPullRequestIterationsArgs{
+		Project:       &a.project,
+		RepositoryId:  &pullRequest.Repository,
+		PullRequestId: &pullRequest.Number,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request iterations: %w", err)
+	}
+	if iterations == nil {
+		return []string{}, nil
+	}
+	latestIteration := 0
+	for _, iteration := range *iterations {
+		if iteration.Id != nil && *iteration.Id > latestIteration {
+			latestIteration = *iteration.Id
+		}
+	}
+	if latestIteration == 0 {
+		return []string{}, nil
+	}
+
+	files := []string{}
+	skip := 0
+	for {
+		changes, err := client.GetPullRequestIterationChanges(ctx, git.GetPullRequestIterationChangesArgs{
+			Project:       &a.project,
+			RepositoryId:  &pullRequest.Repository,
+			PullRequestId: &pullRequest.Number,
+			IterationId:   &latestIteration,
+			Skip:          &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request iteration changes: %w", err)
+		}
+		if changes == nil {
+			break
+		}
+		if changes.ChangeEntries != nil {
+			for _, change := range *changes.ChangeEntries {
+				if path := changedItemPath(change); path != "" {
+					files = append(files, strings.TrimPrefix(path, "/"))
+				}
+			}
+		}
+		if changes.NextSkip == nil || *changes.NextSkip == 0 {
+			break
+		}
+		skip = *changes.NextSkip
+	}
+	return files, nil
+}
+
+// changedItemPath returns the path of the item of a pull request change. The item is not typed by the Azure DevOps
+// client, so it is decoded as a generic JSON object.
+func changedItemPath(change git.GitPullRequestChange) string {
+	item, ok := change.Item.(map[string]any)
+	if !ok {
+		return ""
+	}
+	path, _ := item["path"].(string)
+	return path
+}
+
+// lastMergeTargetCommitID returns the ID of the target branch commit of the last merge, or an empty string if
+// Azure DevOps did not report it.
+func lastMergeTargetCommitID(pr git.GitPullRequest) string {
+	if pr.LastMergeTargetCommit == nil || pr.LastMergeTargetCommit.CommitId == nil {
+		return ""
+	}
+	return *pr.LastMergeTargetCommit.CommitId
+}
+
+// lastUpdateTime returns the most recent of the given creation date of the pull request and the times its iterations
+// were created or updated, i.e. when changes were last pushed to the pull request. The commit dates of the pushed
+// commits are not used, since commits may be pushed long after they were committed.
+func (a *AzureDevOpsService) lastUpdateTime(ctx context.Context, client git.Client, pr git.GitPullRequest, createdAt time.Time) (time.Time, error) {
+	iterations, err := client.GetPullRequestIterations(ctx, git.GetPullRequestIterationsArgs{
+		Project:       &a.project,
+		RepositoryId:  pr.Repository.Name,
+		PullRequestId: pr.PullRequestId,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get iterations of pull request %d: %w", *pr.PullRequestId, err)
+	}
+	updatedAt := createdAt
+	if iterations == nil {
+		return updatedAt, nil
+	}
+	for _, iteration := range *iterations {
+		for _, date := range []*azuredevops.Time{iteration.CreatedDate, iteration.UpdatedDate} {
+			if date != nil && date.Time.After(updatedAt) {
+				updatedAt = date.Time
+			}
+		}
+	}
+	return updatedAt, nil
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
@@ -149,15 +456,3 @@ func containAzureDevOpsLabels(expectedLabels []string, gotLabels []string) bool
 	}
 	return true
 }
-
-func buildURL(url, organization string) string {
-	if url == "" {
-		url = AZURE_DEVOPS_DEFAULT_URL
-	}
-	separator := ""
-	if !strings.HasSuffix(url, "/") {
-		separator = "/"
-	}
-	devOpsURL := fmt.Sprintf("%s%s%s", url, separator, organization)
-	return devOpsURL
-}
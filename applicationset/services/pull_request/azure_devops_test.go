@@ -3,8 +3,13 @@ package pull_request
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
@@ -12,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/azure_devops"
 	azureMock "github.com/argoproj/argo-cd/v3/applicationset/services/scm_provider/azure_devops/git/mocks"
 )
 
@@ -64,6 +70,7 @@ func TestListPullRequest(t *testing.T) {
 	prID := 123
 	prTitle := "feat(123)"
 	prHeadSha := "cd4973d9d14a08ffe6b641a89a68891d6aac8056"
+	prBaseSha := "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a"
 	ctx := t.Context()
 	uniqueName := "testName"
 
@@ -76,6 +83,9 @@ func TestListPullRequest(t *testing.T) {
 			LastMergeSourceCommit: &git.GitCommitRef{
 				CommitId: createStringPtr(prHeadSha),
 			},
+			LastMergeTargetCommit: &git.GitCommitRef{
+				CommitId: createStringPtr(prBaseSha),
+			},
 			Labels: &[]core.WebApiTagDefinition{},
 			Repository: &git.GitRepository{
 				Name: createStringPtr(repoName),
@@ -86,34 +96,531 @@ func TestListPullRequest(t *testing.T) {
 		},
 	}
 
-	args := git.GetPullRequestsByProjectArgs{
+	args := git.GetPullRequestsArgs{
 		Project:        &teamProject,
+		RepositoryId:   &repoName,
 		SearchCriteria: &git.GitPullRequestSearchCriteria{},
 	}
 
 	gitClientMock := azureMock.Client{}
 	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
 	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
-	gitClientMock.On("GetPullRequestsByProject", ctx, args).Return(&pullRequestMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return(&pullRequestMock, nil)
 
 	provider := AzureDevOpsService{
-		clientFactory: clientFactoryMock,
-		project:       teamProject,
-		repo:          repoName,
-		labels:        nil,
+		clientFactory:   clientFactoryMock,
+		organizationURL: "https://dev.azure.com/myorg",
+		project:         teamProject,
+		repos:           []string{repoName},
+		labels:          nil,
 	}
 
 	list, err := provider.List(ctx)
 	require.NoError(t, err)
 	assert.Len(t, list, 1)
+	assert.Equal(t, "https://dev.azure.com/myorg/myorg_project/_git/myorg_project_repo/pullrequest/123", list[0].URL)
 	assert.Equal(t, "feature-branch", list[0].Branch)
 	assert.Equal(t, "main", list[0].TargetBranch)
 	assert.Equal(t, prHeadSha, list[0].HeadSHA)
+	assert.Equal(t, prBaseSha, list[0].BaseSHA)
 	assert.Equal(t, "feat(123)", list[0].Title)
 	assert.Equal(t, prID, list[0].Number)
 	assert.Equal(t, uniqueName, list[0].Author)
 }
 
+func TestAzureDevOpsPullRequestURL(t *testing.T) {
+	testCases := []struct {
+		name            string
+		organizationURL string
+		project         string
+		repo            string
+		expected        string
+	}{
+		{
+			name:            "default URL",
+			organizationURL: "https://dev.azure.com/myorg",
+			project:         "myproject",
+			repo:            "myrepo",
+			expected:        "https://dev.azure.com/myorg/myproject/_git/myrepo/pullrequest/42",
+		},
+		{
+			name:            "custom URL with trailing slash",
+			organizationURL: "https://azuredevops.example.com/collection/",
+			project:         "myproject",
+			repo:            "myrepo",
+			expected:        "https://azuredevops.example.com/collection/myproject/_git/myrepo/pullrequest/42",
+		},
+		{
+			name:            "names with spaces are escaped",
+			organizationURL: "https://dev.azure.com/myorg",
+			project:         "my project",
+			repo:            "my repo",
+			expected:        "https://dev.azure.com/myorg/my%20project/_git/my%20repo/pullrequest/42",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, azureDevOpsPullRequestURL(tc.organizationURL, tc.project, tc.repo, 42))
+		})
+	}
+}
+
+func TestListPullRequestDraft(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	pullRequest := func(id int, isDraft *bool) git.GitPullRequest {
+		return git.GitPullRequest{
+			PullRequestId: createIntPtr(id),
+			Title:         createStringPtr(fmt.Sprintf("pr %d", id)),
+			SourceRefName: createStringPtr(fmt.Sprintf("refs/heads/branch-%d", id)),
+			TargetRefName: createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{
+				CommitId: createStringPtr(fmt.Sprintf("sha-%d", id)),
+			},
+			Labels: &[]core.WebApiTagDefinition{},
+			Repository: &git.GitRepository{
+				Name: createStringPtr(repoName),
+			},
+			CreatedBy: &webapi.IdentityRef{
+				UniqueName: createUniqueNamePtr("testName@example.com"),
+			},
+			IsDraft: isDraft,
+		}
+	}
+	pullRequestMock := []git.GitPullRequest{
+		pullRequest(1, createBoolPtr(true)),
+		pullRequest(2, createBoolPtr(false)),
+		pullRequest(3, nil),
+	}
+
+	args := git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return(&pullRequestMock, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.True(t, list[0].IsDraft)
+	assert.False(t, list[1].IsDraft)
+	assert.False(t, list[2].IsDraft)
+}
+
+func TestListPullRequestAttributes(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	mergeStatus := git.PullRequestAsyncStatusValues.Conflicts
+	status := git.PullRequestStatusValues.Active
+	pullRequestMock := []git.GitPullRequest{
+		{
+			PullRequestId: createIntPtr(1),
+			Title:         createStringPtr("pr 1"),
+			SourceRefName: createStringPtr("refs/heads/branch-1"),
+			TargetRefName: createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{
+				CommitId: createStringPtr("sha-1"),
+			},
+			Labels: &[]core.WebApiTagDefinition{},
+			Repository: &git.GitRepository{
+				Name: createStringPtr(repoName),
+			},
+			CreatedBy: &webapi.IdentityRef{
+				UniqueName: createUniqueNamePtr("testName@example.com"),
+			},
+			MergeStatus: &mergeStatus,
+			Status:      &status,
+		},
+	}
+
+	args := git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return(&pullRequestMock, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, map[string]string{"mergeStatus": "conflicts", "status": "active"}, list[0].Attributes)
+}
+
+func TestListPullRequestApprovals(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	pullRequest := func(id int, reviewers *[]git.IdentityRefWithVote) git.GitPullRequest {
+		return git.GitPullRequest{
+			PullRequestId: createIntPtr(id),
+			Title:         createStringPtr(fmt.Sprintf("pr %d", id)),
+			SourceRefName: createStringPtr(fmt.Sprintf("refs/heads/branch-%d", id)),
+			TargetRefName: createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{
+				CommitId: createStringPtr(fmt.Sprintf("sha-%d", id)),
+			},
+			Labels: &[]core.WebApiTagDefinition{},
+			Repository: &git.GitRepository{
+				Name: createStringPtr(repoName),
+			},
+			CreatedBy: &webapi.IdentityRef{
+				UniqueName: createUniqueNamePtr("testName@example.com"),
+			},
+			Reviewers: reviewers,
+		}
+	}
+	vote := func(v int) git.IdentityRefWithVote {
+		return git.IdentityRefWithVote{Vote: createIntPtr(v)}
+	}
+	pullRequestMock := []git.GitPullRequest{
+		// approved, approved with suggestions, no vote, waiting for author and rejected
+		pullRequest(1, &[]git.IdentityRefWithVote{vote(10), vote(5), vote(0), vote(-5), vote(-10)}),
+		pullRequest(2, nil),
+	}
+
+	args := git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return(&pullRequestMock, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, 2, list[0].Approvals)
+	assert.Zero(t, list[1].Approvals)
+}
+
+func TestListPullRequestMultipleRepos(t *testing.T) {
+	teamProject := "myorg_project"
+	ctx := t.Context()
+	otherRepoID := uuid.MustParse("4a1b7a3e-5c3f-4b1e-9a55-2d2d5f0e8c11")
+
+	newPullRequest := func(id int, repository *git.GitRepository) git.GitPullRequest {
+		return git.GitPullRequest{
+			PullRequestId:         createIntPtr(id),
+			Title:                 createStringPtr("feat"),
+			SourceRefName:         createStringPtr("refs/heads/feature-branch"),
+			TargetRefName:         createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{CommitId: createStringPtr("cd4973d9d14a08ffe6b641a89a68891d6aac8056")},
+			Repository:            repository,
+			CreatedBy:             &webapi.IdentityRef{UniqueName: createUniqueNamePtr("testName@example.com")},
+		}
+	}
+	pullRequestMock := []git.GitPullRequest{
+		newPullRequest(1, &git.GitRepository{Name: createStringPtr("repo1")}),
+		newPullRequest(2, &git.GitRepository{Name: createStringPtr("repo2"), Id: &otherRepoID}),
+		newPullRequest(3, &git.GitRepository{Name: createStringPtr("repo3")}),
+	}
+
+	args := git.GetPullRequestsByProjectArgs{
+		Project:        &teamProject,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequestsByProject", ctx, args).Return(&pullRequestMock, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		// repositories can be given by name or by ID
+		repos: []string{"repo1", otherRepoID.String()},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, 1, list[0].Number)
+	assert.Equal(t, "repo1", list[0].Repository)
+	assert.Equal(t, 2, list[1].Number)
+	assert.Equal(t, "repo2", list[1].Repository)
+	gitClientMock.AssertNotCalled(t, "GetPullRequests", mock.Anything, mock.Anything)
+}
+
+func TestListPullRequestSingleRepoUsesRepositoryScopedAPI(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	pullRequestMock := []git.GitPullRequest{{
+		PullRequestId:         createIntPtr(1),
+		Title:                 createStringPtr("feat"),
+		SourceRefName:         createStringPtr("refs/heads/feature-branch"),
+		TargetRefName:         createStringPtr("refs/heads/main"),
+		LastMergeSourceCommit: &git.GitCommitRef{CommitId: createStringPtr("cd4973d9d14a08ffe6b641a89a68891d6aac8056")},
+		Repository:            &git.GitRepository{Name: createStringPtr(repoName)},
+		CreatedBy:             &webapi.IdentityRef{UniqueName: createUniqueNamePtr("testName@example.com")},
+	}}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}).Return(&pullRequestMock, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, 1, list[0].Number)
+	gitClientMock.AssertNumberOfCalls(t, "GetPullRequests", 1)
+	gitClientMock.AssertNotCalled(t, "GetPullRequestsByProject", mock.Anything, mock.Anything)
+
+	// a missing repository is reported like a missing project
+	gitClientMock = azureMock.Client{}
+	clientFactoryMock = &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, mock.Anything).Return(nil,
+		errors.New("TF401019: The Git repository with name or identifier myorg_project_repo does not exist or you do not have permissions for the operation you are attempting."))
+	provider.clientFactory = clientFactoryMock
+
+	list, err = provider.List(ctx)
+	assert.Empty(t, list)
+	require.Error(t, err)
+	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
+}
+
+func TestListPullRequestRequireSucceededStatuses(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	newPullRequest := func(id int) git.GitPullRequest {
+		return git.GitPullRequest{
+			PullRequestId:         createIntPtr(id),
+			Title:                 createStringPtr("feat"),
+			SourceRefName:         createStringPtr("refs/heads/feature-branch"),
+			TargetRefName:         createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{CommitId: createStringPtr("cd4973d9d14a08ffe6b641a89a68891d6aac8056")},
+			Repository:            &git.GitRepository{Name: createStringPtr(repoName)},
+			CreatedBy:             &webapi.IdentityRef{UniqueName: createUniqueNamePtr("testName@example.com")},
+		}
+	}
+	newStatus := func(id int, name string, state git.GitStatusState) git.GitPullRequestStatus {
+		return git.GitPullRequestStatus{
+			Id:      createIntPtr(id),
+			Context: &git.GitStatusContext{Genre: createStringPtr("ci"), Name: createStringPtr(name)},
+			State:   &state,
+		}
+	}
+	statuses := map[int][]git.GitPullRequestStatus{
+		// passing: the failed build was re-run successfully
+		1: {
+			newStatus(1, "build", git.GitStatusStateValues.Failed),
+			newStatus(2, "lint", git.GitStatusStateValues.Succeeded),
+			newStatus(3, "build", git.GitStatusStateValues.Succeeded),
+		},
+		// failing
+		2: {
+			newStatus(1, "build", git.GitStatusStateValues.Succeeded),
+			newStatus(2, "lint", git.GitStatusStateValues.Failed),
+		},
+		// pending
+		3: {
+			newStatus(1, "build", git.GitStatusStateValues.Succeeded),
+			newStatus(2, "build", git.GitStatusStateValues.Pending),
+		},
+		// no status
+		4: {},
+	}
+	pullRequestMock := []git.GitPullRequest{newPullRequest(1), newPullRequest(2), newPullRequest(3), newPullRequest(4)}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}).Return(&pullRequestMock, nil)
+	for id, prStatuses := range statuses {
+		gitClientMock.On("GetPullRequestStatuses", ctx, git.GetPullRequestStatusesArgs{
+			Project:       &teamProject,
+			RepositoryId:  &repoName,
+			PullRequestId: createIntPtr(id),
+		}).Return(&prStatuses, nil).Once()
+	}
+
+	provider := AzureDevOpsService{
+		clientFactory:            clientFactoryMock,
+		project:                  teamProject,
+		repos:                    []string{repoName},
+		requireSucceededStatuses: true,
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, 1, list[0].Number)
+	assert.Equal(t, 4, list[1].Number)
+	gitClientMock.AssertExpectations(t)
+
+	provider.requireSucceededStatuses = false
+	list, err = provider.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, list, 4)
+}
+
+func TestListPullRequestMaxPRAge(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+	now := time.Now()
+
+	gitClientMock := azureMock.Client{}
+	newPullRequest := func(id int, created time.Time, iterations []git.GitPullRequestIteration) git.GitPullRequest {
+		gitClientMock.On("GetPullRequestIterations", ctx, git.GetPullRequestIterationsArgs{
+			Project:       &teamProject,
+			RepositoryId:  &repoName,
+			PullRequestId: createIntPtr(id),
+		}).Return(&iterations, nil)
+		return git.GitPullRequest{
+			PullRequestId: createIntPtr(id),
+			Title:         createStringPtr("feat"),
+			SourceRefName: createStringPtr("refs/heads/feature-branch"),
+			TargetRefName: createStringPtr("refs/heads/main"),
+			LastMergeSourceCommit: &git.GitCommitRef{
+				CommitId: createStringPtr("cd4973d9d14a08ffe6b641a89a68891d6aac8056"),
+				// the commit date is not the time the commit was pushed, and must be ignored
+				Committer: &git.GitUserDate{Date: &azuredevops.Time{Time: now}},
+			},
+			Repository:   &git.GitRepository{Name: createStringPtr(repoName)},
+			CreatedBy:    &webapi.IdentityRef{UniqueName: createUniqueNamePtr("testName@example.com")},
+			CreationDate: &azuredevops.Time{Time: created},
+		}
+	}
+	longAgo := now.Add(-30 * 24 * time.Hour)
+	recentPush := now.Add(-2 * time.Hour)
+	pullRequestMock := []git.GitPullRequest{
+		// created recently
+		newPullRequest(1, now.Add(-time.Hour), nil),
+		// created long ago, never updated since
+		newPullRequest(2, longAgo, []git.GitPullRequestIteration{{Id: createIntPtr(1), CreatedDate: &azuredevops.Time{Time: longAgo}}}),
+		// created long ago, but a new iteration was pushed recently
+		newPullRequest(3, longAgo, []git.GitPullRequestIteration{
+			{Id: createIntPtr(1), CreatedDate: &azuredevops.Time{Time: longAgo}},
+			{Id: createIntPtr(2), CreatedDate: &azuredevops.Time{Time: recentPush}},
+		}),
+	}
+
+	args := git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return(&pullRequestMock, nil)
+
+	testCases := []struct {
+		name            string
+		maxPRAge        time.Duration
+		expectedNumbers []int
+	}{
+		{
+			name:            "disabled",
+			maxPRAge:        0,
+			expectedNumbers: []int{1, 2, 3},
+		},
+		{
+			name:            "one day",
+			maxPRAge:        24 * time.Hour,
+			expectedNumbers: []int{1, 3},
+		},
+		{
+			name:            "ninety minutes",
+			maxPRAge:        90 * time.Minute,
+			expectedNumbers: []int{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := AzureDevOpsService{
+				clientFactory: clientFactoryMock,
+				project:       teamProject,
+				repos:         []string{repoName},
+				maxPRAge:      tc.maxPRAge,
+			}
+
+			list, err := provider.List(ctx)
+			require.NoError(t, err)
+			numbers := []int{}
+			for _, pr := range list {
+				numbers = append(numbers, pr.Number)
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+		})
+	}
+
+	provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repos: []string{repoName}, resolveUpdateTime: true}
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.True(t, list[1].UpdatedAt.Equal(longAgo))
+	assert.True(t, list[2].UpdatedAt.Equal(recentPush))
+	// no last merge target commit reported
+	assert.Empty(t, list[0].BaseSHA)
+
+	// without resolving the update time, the iterations are not fetched and the creation date is used
+	gitClientMock.Calls = nil
+	provider = AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repos: []string{repoName}}
+	list, err = provider.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.True(t, list[2].UpdatedAt.Equal(longAgo))
+	gitClientMock.AssertNotCalled(t, "GetPullRequestIterations", mock.Anything, mock.Anything)
+}
+
 func TestConvertLabes(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -196,50 +703,29 @@ func TestContainAzureDevOpsLabels(t *testing.T) {
 	}
 }
 
-func TestBuildURL(t *testing.T) {
-	testCases := []struct {
-		name         string
-		url          string
-		organization string
-		expected     string
-	}{
-		{
-			name:         "Provided default URL and organization",
-			url:          "https://dev.azure.com/",
-			organization: "myorganization",
-			expected:     "https://dev.azure.com/myorganization",
-		},
-		{
-			name:         "Provided default URL and organization without trailing slash",
-			url:          "https://dev.azure.com",
-			organization: "myorganization",
-			expected:     "https://dev.azure.com/myorganization",
-		},
-		{
-			name:         "Provided no URL and organization",
-			url:          "",
-			organization: "myorganization",
-			expected:     "https://dev.azure.com/myorganization",
-		},
-		{
-			name:         "Provided custom URL and organization",
-			url:          "https://azuredevops.example.com/",
-			organization: "myorganization",
-			expected:     "https://azuredevops.example.com/myorganization",
-		},
-	}
+func TestNewAzureDevOpsServiceSharedClientFactory(t *testing.T) {
+	svc, err := NewAzureDevOpsService("token", "https://azuredevops.example.com/", "myorganization", "project", []string{"repo"}, nil, 0, false, false, "")
+	require.NoError(t, err)
+	factory, err := azure_devops.NewClientFactory(azure_devops.Credentials{Token: "token", URL: "https://azuredevops.example.com/", Organization: "myorganization"}, "pull request generator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, factory.Connection(), svc.(*AzureDevOpsService).clientFactory.(*devopsFactoryImpl).factory.Connection())
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			result := buildURL(tc.url, tc.organization)
-			assert.Equal(t, tc.expected, result)
-		})
-	}
+	// pull requests of public projects can be listed anonymously
+	svc, err = NewAzureDevOpsService("", "", "myorganization", "project", []string{"repo"}, nil, 0, false, false, "")
+	require.NoError(t, err)
+	connection := svc.(*AzureDevOpsService).clientFactory.(*devopsFactoryImpl).factory.Connection()
+	assert.Equal(t, "https://dev.azure.com/myorganization", connection.BaseUrl)
+	assert.Empty(t, connection.AuthorizationString)
+
+	// the URL is not validated
+	_, err = NewAzureDevOpsService("token", "invalid", "myorganization", "project", []string{"repo"}, nil, 0, false, false, "")
+	require.NoError(t, err)
 }
 
 func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
-	args := git.GetPullRequestsByProjectArgs{
+	args := git.GetPullRequestsArgs{
 		Project:        createStringPtr("nonexistent"),
+		RepositoryId:   createStringPtr("nonexistent"),
 		SearchCriteria: &git.GitPullRequestSearchCriteria{},
 	}
 
@@ -249,14 +735,14 @@ func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
 	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
 	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
 
-	// Mock the GetPullRequestsByProject to return an error containing "404"
-	gitClientMock.On("GetPullRequestsByProject", t.Context(), args).Return(&pullRequestMock,
+	// Mock the GetPullRequests to return an error containing "404"
+	gitClientMock.On("GetPullRequests", t.Context(), args).Return(&pullRequestMock,
 		errors.New("The following project does not exist:"))
 
 	provider := AzureDevOpsService{
 		clientFactory: clientFactoryMock,
 		project:       "nonexistent",
-		repo:          "nonexistent",
+		repos:         []string{"nonexistent"},
 		labels:        nil,
 	}
 
@@ -269,3 +755,175 @@ func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestAzureDevOpsListReturnsAuthScopeError(t *testing.T) {
+	args := git.GetPullRequestsArgs{
+		Project:        createStringPtr("project"),
+		RepositoryId:   createStringPtr("repo"),
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+
+	// A token without access to the project is rejected with a 403, even though the message may mention the project
+	message := "The following project does not exist: project. Verify that the name of the project is correct and that the project exists on the specified Azure DevOps Server."
+	gitClientMock.On("GetPullRequests", t.Context(), args).Return(nil,
+		azuredevops.WrappedError{Message: &message, StatusCode: createIntPtr(http.StatusForbidden)})
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       "project",
+		repos:         []string{"repo"},
+	}
+
+	prs, err := provider.List(t.Context())
+	assert.Empty(t, prs)
+	require.Error(t, err)
+	assert.True(t, IsAuthScopeError(err), "Expected AuthScopeError but got: %v", err)
+	assert.False(t, IsRepositoryNotFoundError(err))
+
+	// the same applies to errors creating the client
+	clientFactoryMock = &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(nil,
+		NewAuthScopeError(&azuredevops.WrappedError{StatusCode: createIntPtr(http.StatusForbidden)}))
+	provider.clientFactory = clientFactoryMock
+	_, err = provider.List(t.Context())
+	require.Error(t, err)
+	assert.True(t, IsAuthScopeError(err), "Expected AuthScopeError but got: %v", err)
+}
+
+func TestIsAzureDevOpsAuthScopeError(t *testing.T) {
+	assert.True(t, isAzureDevOpsAuthScopeError(azuredevops.WrappedError{StatusCode: createIntPtr(http.StatusForbidden)}))
+	assert.True(t, isAzureDevOpsAuthScopeError(fmt.Errorf("wrapped: %w", &azuredevops.WrappedError{StatusCode: createIntPtr(http.StatusForbidden)})))
+	assert.False(t, isAzureDevOpsAuthScopeError(azuredevops.WrappedError{StatusCode: createIntPtr(http.StatusNotFound)}))
+	assert.False(t, isAzureDevOpsAuthScopeError(azuredevops.WrappedError{}))
+	assert.False(t, isAzureDevOpsAuthScopeError(errors.New("forbidden")))
+}
+
+func TestAzureDevOpsChangedFiles(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	prID := 123
+	ctx := t.Context()
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+
+	iterations := []git.GitPullRequestIteration{{Id: createIntPtr(1)}, {Id: createIntPtr(2)}}
+	gitClientMock.On("GetPullRequestIterations", ctx, git.GetPullRequestIterationsArgs{
+		Project:       &teamProject,
+		RepositoryId:  &repoName,
+		PullRequestId: &prID,
+	}).Return(&iterations, nil)
+
+	firstPage := git.GitPullRequestIterationChanges{
+		ChangeEntries: &[]git.GitPullRequestChange{
+			{Item: map[string]any{"path": "/apps/guestbook/deployment.yaml"}},
+			{Item: map[string]any{"path": "/README.md"}},
+		},
+		NextSkip: createIntPtr(2),
+	}
+	secondPage := git.GitPullRequestIterationChanges{
+		ChangeEntries: &[]git.GitPullRequestChange{
+			{Item: map[string]any{"path": "/docs/index.md"}},
+			{Item: nil},
+		},
+		NextSkip: createIntPtr(0),
+	}
+	changesArgs := func(skip int) git.GetPullRequestIterationChangesArgs {
+		return git.GetPullRequestIterationChangesArgs{
+			Project:       &teamProject,
+			RepositoryId:  &repoName,
+			PullRequestId: &prID,
+			IterationId:   createIntPtr(2),
+			Skip:          &skip,
+		}
+	}
+	gitClientMock.On("GetPullRequestIterationChanges", ctx, changesArgs(0)).Return(&firstPage, nil)
+	gitClientMock.On("GetPullRequestIterationChanges", ctx, changesArgs(2)).Return(&secondPage, nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	files, err := provider.ChangedFiles(ctx, &PullRequest{Number: prID, Repository: repoName})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/guestbook/deployment.yaml", "README.md", "docs/index.md"}, files)
+}
+
+func TestAzureDevOpsChangedFilesNilResponse(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	prID := 123
+	ctx := t.Context()
+
+	newProvider := func(gitClientMock *azureMock.Client) AzureDevOpsService {
+		clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+		clientFactoryMock.mock.On("GetClient", mock.Anything).Return(gitClientMock, nil)
+		return AzureDevOpsService{
+			clientFactory: clientFactoryMock,
+			project:       teamProject,
+			repos:         []string{repoName},
+		}
+	}
+	iterationsArgs := git.GetPullRequestIterationsArgs{
+		Project:       &teamProject,
+		RepositoryId:  &repoName,
+		PullRequestId: &prID,
+	}
+
+	t.Run("NilIterations", func(t *testing.T) {
+		gitClientMock := &azureMock.Client{}
+		gitClientMock.On("GetPullRequestIterations", ctx, iterationsArgs).Return(nil, nil)
+		provider := newProvider(gitClientMock)
+
+		files, err := provider.ChangedFiles(ctx, &PullRequest{Number: prID, Repository: repoName})
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("NilChanges", func(t *testing.T) {
+		gitClientMock := &azureMock.Client{}
+		iterations := []git.GitPullRequestIteration{{Id: createIntPtr(1)}}
+		gitClientMock.On("GetPullRequestIterations", ctx, iterationsArgs).Return(&iterations, nil)
+		gitClientMock.On("GetPullRequestIterationChanges", ctx, mock.Anything).Return(nil, nil)
+		provider := newProvider(gitClientMock)
+
+		files, err := provider.ChangedFiles(ctx, &PullRequest{Number: prID, Repository: repoName})
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
+
+func TestListPullRequestNilResponse(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	ctx := t.Context()
+
+	args := git.GetPullRequestsArgs{
+		Project:        &teamProject,
+		RepositoryId:   &repoName,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{},
+	}
+
+	gitClientMock := azureMock.Client{}
+	clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+	clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+	gitClientMock.On("GetPullRequests", ctx, args).Return((*[]git.GitPullRequest)(nil), nil)
+
+	provider := AzureDevOpsService{
+		clientFactory: clientFactoryMock,
+		project:       teamProject,
+		repos:         []string{repoName},
+	}
+
+	list, err := provider.List(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, list)
+	assert.Empty(t, list)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -23,16 +24,30 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Links       BitbucketCloudPullRequestLinks       `json:"links"`
+}
+
+type BitbucketCloudPullRequestLinks struct {
+	HTML BitbucketCloudLink `json:"html"`
+}
+
+type BitbucketCloudLink struct {
+	Href string `json:"href"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
 	Branch BitbucketCloudPullRequestDestinationBranch `json:"branch"`
+	Commit BitbucketCloudPullRequestDestinationCommit `json:"commit"`
 }
 
 type BitbucketCloudPullRequestDestinationBranch struct {
 	Name string `json:"name"`
 }
 
+type BitbucketCloudPullRequestDestinationCommit struct {
+	Hash string `json:"hash"`
+}
+
 type BitbucketCloudPullRequestSource struct {
 	Branch BitbucketCloudPullRequestSourceBranch `json:"branch"`
 	Commit BitbucketCloudPullRequestSourceCommit `json:"commit"`
@@ -83,6 +98,7 @@ func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repos
 
 	bitbucketClient := bitbucket.NewBasicAuth(username, password)
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = &http.Client{Transport: traceRequests("bitbucket_cloud", newTransport(nil))}
 
 	return &BitbucketCloudService{
 		client:         bitbucketClient,
@@ -99,6 +115,7 @@ func NewBitbucketCloudServiceBearerToken(baseURL, bearerToken, owner, repository
 
 	bitbucketClient := bitbucket.NewOAuthbearerToken(bearerToken)
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = &http.Client{Transport: traceRequests("bitbucket_cloud", newTransport(nil))}
 
 	return &BitbucketCloudService{
 		client:         bitbucketClient,
@@ -132,10 +149,17 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.owner, b.repositorySlug, err)
 	}
 
+	// an empty response body or a null list of values is an empty result
+	if response == nil {
+		return pullRequests, nil
+	}
 	resp, ok := response.(map[string]any)
 	if !ok {
 		return nil, errors.New("unknown type returned from bitbucket pull requests")
 	}
+	if resp["values"] == nil {
+		return pullRequests, nil
+	}
 
 	repoArray, ok := resp["values"].([]any)
 	if !ok {
@@ -159,7 +183,10 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 			Branch:       pull.Source.Branch.Name,
 			TargetBranch: pull.Destination.Branch.Name,
 			HeadSHA:      pull.Source.Commit.Hash,
+			BaseSHA:      pull.Destination.Commit.Hash,
+			Labels:       []string{}, // Bitbucket Cloud pull requests have no labels
 			Author:       pull.Author.Nickname,
+			URL:          pull.Links.HTML.Href,
 		})
 	}
 
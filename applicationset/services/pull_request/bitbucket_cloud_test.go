@@ -132,6 +132,28 @@ func TestListPullRequestBasicAuthCloud(t *testing.T) {
 	assert.Equal(t, "testName", pullRequests[0].Author)
 }
 
+func TestListPullRequestLabelsCloud(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(defaultHandlerCloud(t)))
+	defer ts.Close()
+	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	require.NoError(t, err)
+
+	// Bitbucket Cloud pull requests have no labels, so they are mapped to an empty list
+	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 1)
+	assert.NotNil(t, pullRequests[0].Labels)
+	assert.Empty(t, pullRequests[0].Labels)
+
+	// the shared label filters then apply like to pull requests without labels of other providers
+	pullRequests, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{{LabelsAll: []string{"preview"}}})
+	require.NoError(t, err)
+	assert.Empty(t, pullRequests)
+	pullRequests, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{{ExcludeLabels: []string{"preview"}}})
+	require.NoError(t, err)
+	assert.Len(t, pullRequests, 1)
+}
+
 func TestListPullRequestPaginationCloud(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -221,6 +243,7 @@ func TestListPullRequestPaginationCloud(t *testing.T) {
 		Title:   "feat(101)",
 		Branch:  "feature-101",
 		HeadSHA: "1a8dd249c04a",
+		Labels:  []string{},
 		Author:  "testName",
 	}, *pullRequests[0])
 	assert.Equal(t, PullRequest{
@@ -228,6 +251,7 @@ func TestListPullRequestPaginationCloud(t *testing.T) {
 		Title:   "feat(102)",
 		Branch:  "feature-102",
 		HeadSHA: "4cf807e67a6d",
+		Labels:  []string{},
 		Author:  "testName",
 	}, *pullRequests[1])
 	assert.Equal(t, PullRequest{
@@ -235,6 +259,7 @@ func TestListPullRequestPaginationCloud(t *testing.T) {
 		Title:   "feat(103)",
 		Branch:  "feature-103",
 		HeadSHA: "6344d9623e3b",
+		Labels:  []string{},
 		Author:  "testName",
 	}, *pullRequests[2])
 }
@@ -432,6 +457,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 		Title:        "feat(101)",
 		Branch:       "feature-101",
 		HeadSHA:      "1a8dd249c04a",
+		Labels:       []string{},
 		Author:       "testName",
 		TargetBranch: "master",
 	}, *pullRequests[0])
@@ -440,6 +466,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 		Title:        "feat(102)",
 		Branch:       "feature-102",
 		HeadSHA:      "6344d9623e3b",
+		Labels:       []string{},
 		Author:       "testName",
 		TargetBranch: "master",
 	}, *pullRequests[1])
@@ -459,6 +486,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 		Title:        "feat(102)",
 		Branch:       "feature-102",
 		HeadSHA:      "6344d9623e3b",
+		Labels:       []string{},
 		Author:       "testName",
 		TargetBranch: "master",
 	}, *pullRequests[0])
@@ -488,6 +516,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 		Title:        "feat(200)",
 		Branch:       "feature-200",
 		HeadSHA:      "4cf807e67a6d",
+		Labels:       []string{},
 		Author:       "testName",
 		TargetBranch: "branch-200",
 	}, *pullRequests[0])
@@ -518,3 +547,22 @@ func TestBitbucketCloudListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestListPullRequestNullValuesCloud(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/repositories/OWNER/REPO/pullrequests/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"size": 0, "pagelen": 10, "page": 1, "values": null}`))
+	})
+
+	svc, err := NewBitbucketCloudServiceNoAuth(server.URL, "OWNER", "REPO")
+	require.NoError(t, err)
+
+	pullRequests, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, pullRequests)
+	assert.Empty(t, pullRequests)
+}
@@ -51,9 +51,7 @@ func NewBitbucketServiceNoAuth(ctx context.Context, url, projectKey, repositoryS
 func newBitbucketService(ctx context.Context, bitbucketConfig *bitbucketv1.Configuration, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	bitbucketConfig.BasePath = utils.NormalizeBitbucketBasePath(bitbucketConfig.BasePath)
 	tlsConfig := utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
-	bitbucketConfig.HTTPClient = &http.Client{Transport: &http.Transport{
-		TLSClientConfig: tlsConfig,
-	}}
+	bitbucketConfig.HTTPClient = &http.Client{Transport: traceRequests("bitbucket_server", newTransport(tlsConfig))}
 	bitbucketClient := bitbucketv1.NewAPIClient(ctx, bitbucketConfig)
 
 	return &BitbucketService{
@@ -92,8 +90,10 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 				Branch:       pull.FromRef.DisplayID, // ID: refs/heads/main DisplayID: main
 				TargetBranch: pull.ToRef.DisplayID,
 				HeadSHA:      pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
-				Labels:       []string{},                // Not supported by library
+				BaseSHA:      pull.ToRef.LatestCommit,
+				Labels:       []string{}, // Bitbucket Server pull requests have no labels
 				Author:       pull.Author.User.Name,
+				URL:          bitbucketServerPullRequestURL(pull),
 			})
 		}
 
@@ -105,3 +105,11 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// bitbucketServerPullRequestURL returns the web URL of the pull request, which Bitbucket Server reports as its self link
+func bitbucketServerPullRequestURL(pull bitbucketv1.PullRequest) string {
+	if len(pull.Links.Self) == 0 {
+		return ""
+	}
+	return pull.Links.Self[0].Href
+}
@@ -76,6 +76,28 @@ func TestListPullRequestNoAuth(t *testing.T) {
 	assert.Equal(t, "testName", pullRequests[0].Author)
 }
 
+func TestListPullRequestLabels(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(defaultHandler(t)))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceNoAuth(t.Context(), ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+
+	// Bitbucket Server pull requests have no labels, so they are mapped to an empty list
+	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 1)
+	assert.NotNil(t, pullRequests[0].Labels)
+	assert.Empty(t, pullRequests[0].Labels)
+
+	// the shared label filters then apply like to pull requests without labels of other providers
+	pullRequests, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{{LabelsAny: []string{"preview"}}})
+	require.NoError(t, err)
+	assert.Empty(t, pullRequests)
+	pullRequests, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{{ExcludeLabels: []string{"preview"}}})
+	require.NoError(t, err)
+	assert.Len(t, pullRequests, 1)
+}
+
 func TestListPullRequestPagination(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -175,6 +197,7 @@ func TestListPullRequestPagination(t *testing.T) {
 		Branch:       "feature-101",
 		TargetBranch: "master",
 		HeadSHA:      "ab3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[0])
@@ -184,6 +207,7 @@ func TestListPullRequestPagination(t *testing.T) {
 		Branch:       "feature-102",
 		TargetBranch: "branch",
 		HeadSHA:      "bb3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[1])
@@ -193,6 +217,7 @@ func TestListPullRequestPagination(t *testing.T) {
 		Branch:       "feature-200",
 		TargetBranch: "master",
 		HeadSHA:      "cb3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[2])
@@ -467,6 +492,7 @@ func TestListPullRequestBranchMatch(t *testing.T) {
 		Branch:       "feature-101",
 		TargetBranch: "master",
 		HeadSHA:      "ab3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[0])
@@ -476,6 +502,7 @@ func TestListPullRequestBranchMatch(t *testing.T) {
 		Branch:       "feature-102",
 		TargetBranch: "branch",
 		HeadSHA:      "bb3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[1])
@@ -496,6 +523,7 @@ func TestListPullRequestBranchMatch(t *testing.T) {
 		Branch:       "feature-102",
 		TargetBranch: "branch",
 		HeadSHA:      "bb3cf2e4d1517c83e720d2585b9402dbef71f992",
+		BaseSHA:      "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 		Labels:       []string{},
 		Author:       "testName",
 	}, *pullRequests[0])
@@ -536,3 +564,31 @@ func TestBitbucketServerListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestListResponseNullValues(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.RequestURI {
+		case "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests?limit=100":
+			_, err := io.WriteString(w, `{
+					"size": 0,
+					"limit": 100,
+					"isLastPage": true,
+					"values": null,
+					"start": 0
+				}`)
+			if err != nil {
+				t.Fail()
+			}
+		default:
+			t.Fail()
+		}
+	}))
+	defer ts.Close()
+	svc, err := NewBitbucketServiceNoAuth(t.Context(), ts.URL, "PROJECT", "REPO", "", false, nil)
+	require.NoError(t, err)
+	pullRequests, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, pullRequests)
+	assert.Empty(t, pullRequests)
+}
@@ -0,0 +1,180 @@
+package pull_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CompositeService lists the pull requests of several pull request services as one list, e.g. of a repository which
+// is mirrored on both GitHub and GitLab. The optional services are delegated to the service which listed the pull
+// request, and fail for pull requests of services which do not implement them.
+type CompositeService struct {
+	services            []PullRequestService
+	allowPartialResults bool
+	// origins are the services which listed the pull requests of the last call to List
+	origins     map[*PullRequest]PullRequestService
+	originsLock sync.RWMutex
+}
+
+var (
+	_ PullRequestService      = (*CompositeService)(nil)
+	_ ChangedFilesService     = (*CompositeService)(nil)
+	_ BranchProtectionService = (*CompositeService)(nil)
+	_ HeadCommitAuthorService = (*CompositeService)(nil)
+	_ ApprovalsService        = (*CompositeService)(nil)
+)
+
+// NewCompositeService returns a pull request service listing the pull requests of all the given services. If
+// allowPartialResults is true, the pull requests of the services which succeeded are returned as long as one of them
+// did, otherwise any failing service fails the whole list.
+func NewCompositeService(services []PullRequestService, allowPartialResults bool) (PullRequestService, error) {
+	if len(services) == 0 {
+		return nil, errors.New("a composite pull request service needs at least one service")
+	}
+	return &CompositeService{
+		services:            services,
+		allowPartialResults: allowPartialResults,
+	}, nil
+}
+
+// compositeKey identifies a pull request across mirrors of the same repository, whose numbers may differ
+type compositeKey struct {
+	branch       string
+	targetBranch string
+	headSHA      string
+}
+
+// List lists the pull requests of all services concurrently. Pull requests of different services with the same source
+// branch, target branch and head SHA are the same pull request, and only the one of the first service listing it is
+// kept.
+func (c *CompositeService) List(ctx context.Context) ([]*PullRequest, error) {
+	results := make([][]*PullRequest, len(c.services))
+	errs := make([]error, len(c.services))
+	var wg sync.WaitGroup
+	for i, service := range c.services {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pullRequests, err := service.List(ctx)
+			if err != nil {
+				errs[i] = fmt.Errorf("error listing pull requests of service %d: %w", i, err)
+				return
+			}
+			results[i] = pullRequests
+		}()
+	}
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		failed := 0
+		for _, serviceErr := range errs {
+			if serviceErr != nil {
+				failed++
+			}
+		}
+		if !c.allowPartialResults || failed == len(c.services) {
+			return nil, err
+		}
+		log.Warnf("Ignoring %d of %d failed pull request services: %v", failed, len(c.services), err)
+	}
+
+	seen := make(map[compositeKey]bool)
+	origins := make(map[*PullRequest]PullRequestService)
+	var pullRequests []*PullRequest
+	for i, result := range results {
+		for _, pullRequest := range result {
+			key := compositeKey{branch: pullRequest.Branch, targetBranch: pullRequest.TargetBranch, headSHA: pullRequest.HeadSHA}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			origins[pullRequest] = c.services[i]
+			pullRequests = append(pullRequests, pullRequest)
+		}
+	}
+	c.originsLock.Lock()
+	c.origins = origins
+	c.originsLock.Unlock()
+	return pullRequests, nil
+}
+
+// origin returns the service which listed the pull request in the last call to List
+func (c *CompositeService) origin(pullRequest *PullRequest) (PullRequestService, error) {
+	c.originsLock.RLock()
+	defer c.originsLock.RUnlock()
+	service, ok := c.origins[pullRequest]
+	if !ok {
+		return nil, fmt.Errorf("pull request %d was not listed by the composite pull request service", pullRequest.Number)
+	}
+	return service, nil
+}
+
+// ChangedFiles returns the files changed by the pull request, as listed by the service of the pull request.
+func (c *CompositeService) ChangedFiles(ctx context.Context, pullRequest *PullRequest) ([]string, error) {
+	service, err := c.origin(pullRequest)
+	if err != nil {
+		return nil, err
+	}
+	changedFilesService, ok := service.(ChangedFilesService)
+	if !ok {
+		return nil, fmt.Errorf("listing the changed files of pull request %d is not supported by its pull request provider", pullRequest.Number)
+	}
+	return changedFilesService.ChangedFiles(ctx, pullRequest)
+}
+
+// IsBranchProtected returns whether the branch is protected by any of the services which can tell. Mirrors may protect
+// different branches, so a branch protected on one of them counts as protected.
+func (c *CompositeService) IsBranchProtected(ctx context.Context, branch string) (bool, error) {
+	supported := false
+	for _, service := range c.services {
+		branchProtectionService, ok := service.(BranchProtectionService)
+		if !ok {
+			continue
+		}
+		supported = true
+		protected, err := branchProtectionService.IsBranchProtected(ctx, branch)
+		if err != nil {
+			return false, err
+		}
+		if protected {
+			return true, nil
+		}
+	}
+	if !supported {
+		return false, errors.New("branch protection is not supported by any pull request provider of the composite pull request service")
+	}
+	return false, nil
+}
+
+// HeadCommitAuthor returns the author of the head commit of the pull request, as resolved by the service of the pull
+// request.
+func (c *CompositeService) HeadCommitAuthor(ctx context.Context, pullRequest *PullRequest) (CommitAuthor, error) {
+	service, err := c.origin(pullRequest)
+	if err != nil {
+		return CommitAuthor{}, err
+	}
+	headCommitAuthorService, ok := service.(HeadCommitAuthorService)
+	if !ok {
+		return CommitAuthor{}, fmt.Errorf("resolving the head commit author of pull request %d is not supported by its pull request provider", pullRequest.Number)
+	}
+	return headCommitAuthorService.HeadCommitAuthor(ctx, pullRequest)
+}
+
+// Approvals returns the number of approvals of the pull request, as resolved by the service of the pull request.
+// Pull requests of services which cannot resolve approvals keep the number reported by their service, if any.
+func (c *CompositeService) Approvals(ctx context.Context, pullRequest *PullRequest) (int, error) {
+	service, err := c.origin(pullRequest)
+	if err != nil {
+		return 0, err
+	}
+	approvalsService, ok := service.(ApprovalsService)
+	if !ok {
+		return pullRequest.Approvals, nil
+	}
+	return approvalsService.Approvals(ctx, pullRequest)
+}
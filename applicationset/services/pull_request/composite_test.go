@@ -0,0 +1,130 @@
+package pull_request
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestCompositeServiceList(t *testing.T) {
+	github, _ := NewFakeService(t.Context(), []*PullRequest{
+		{Number: 1, Branch: "feature-a", TargetBranch: "main", HeadSHA: "aaa"},
+		{Number: 2, Branch: "feature-b", TargetBranch: "main", HeadSHA: "bbb"},
+	}, nil)
+	gitlab, _ := NewFakeService(t.Context(), []*PullRequest{
+		// the mirror of pull request 1 of the first service
+		{Number: 7, Branch: "feature-a", TargetBranch: "main", HeadSHA: "aaa"},
+		// same branch as pull request 2 of the first service, but a different head
+		{Number: 8, Branch: "feature-b", TargetBranch: "main", HeadSHA: "ccc"},
+		{Number: 9, Branch: "feature-c", TargetBranch: "release", HeadSHA: "ddd"},
+	}, nil)
+	failing, _ := NewFakeService(t.Context(), nil, errors.New("fake error"))
+
+	t.Run("MergesAndDeduplicates", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{github, gitlab}, false)
+		require.NoError(t, err)
+		pullRequests, err := svc.List(t.Context())
+		require.NoError(t, err)
+		numbers := make([]int, 0, len(pullRequests))
+		for _, pullRequest := range pullRequests {
+			numbers = append(numbers, pullRequest.Number)
+		}
+		assert.Equal(t, []int{1, 2, 8, 9}, numbers)
+	})
+
+	t.Run("FailsWithoutPartialResults", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{github, failing}, false)
+		require.NoError(t, err)
+		pullRequests, err := svc.List(t.Context())
+		require.ErrorContains(t, err, "error listing pull requests of service 1: fake error")
+		assert.Nil(t, pullRequests)
+	})
+
+	t.Run("PartialResults", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{failing, gitlab}, true)
+		require.NoError(t, err)
+		pullRequests, err := svc.List(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, pullRequests, 3)
+	})
+
+	t.Run("AggregatesErrors", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{failing, failing}, true)
+		require.NoError(t, err)
+		_, err = svc.List(t.Context())
+		require.ErrorContains(t, err, "error listing pull requests of service 0: fake error")
+		require.ErrorContains(t, err, "error listing pull requests of service 1: fake error")
+	})
+
+	t.Run("NoServices", func(t *testing.T) {
+		_, err := NewCompositeService(nil, false)
+		require.Error(t, err)
+	})
+}
+
+func TestCompositeServiceDelegation(t *testing.T) {
+	github := &changedFilesService{
+		pullRequests: []*PullRequest{{Number: 1, Branch: "feature-a", TargetBranch: "main", HeadSHA: "aaa"}},
+		files:        map[int][]string{1: {"apps/a.yaml"}},
+		calls:        map[int]int{},
+	}
+	// the numbers of pull requests of different services may collide
+	gitlab := &changedFilesService{
+		pullRequests: []*PullRequest{{Number: 1, Branch: "feature-b", TargetBranch: "main", HeadSHA: "bbb"}},
+		files:        map[int][]string{1: {"docs/b.md"}},
+		calls:        map[int]int{},
+	}
+	gitea, _ := NewFakeService(t.Context(), []*PullRequest{{Number: 2, Branch: "feature-c", TargetBranch: "main", HeadSHA: "ccc", Approvals: 3}}, nil)
+
+	t.Run("ChangedFiles", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{github, gitlab}, false)
+		require.NoError(t, err)
+		pullRequests, err := ListPullRequests(t.Context(), svc, []argoprojiov1alpha1.PullRequestGeneratorFilter{{PathsChanged: []string{"docs/*"}}})
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, "feature-b", pullRequests[0].Branch)
+	})
+
+	t.Run("UnsupportedByService", func(t *testing.T) {
+		svc, err := NewCompositeService([]PullRequestService{github, gitea}, false)
+		require.NoError(t, err)
+		pullRequests, err := svc.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 2)
+
+		files, err := svc.(ChangedFilesService).ChangedFiles(t.Context(), pullRequests[0])
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apps/a.yaml"}, files)
+		_, err = svc.(ChangedFilesService).ChangedFiles(t.Context(), pullRequests[1])
+		require.ErrorContains(t, err, "listing the changed files of pull request 2 is not supported by its pull request provider")
+		_, err = svc.(HeadCommitAuthorService).HeadCommitAuthor(t.Context(), pullRequests[1])
+		require.ErrorContains(t, err, "resolving the head commit author of pull request 2 is not supported by its pull request provider")
+		approvals, err := svc.(ApprovalsService).Approvals(t.Context(), pullRequests[1])
+		require.NoError(t, err)
+		assert.Equal(t, 3, approvals)
+
+		_, err = svc.(ChangedFilesService).ChangedFiles(t.Context(), &PullRequest{Number: 1})
+		require.ErrorContains(t, err, "pull request 1 was not listed by the composite pull request service")
+	})
+
+	t.Run("BranchProtection", func(t *testing.T) {
+		protection := &branchProtectionService{protected: map[string]bool{"main": true}, calls: map[string]int{}}
+		svc, err := NewCompositeService([]PullRequestService{gitea, protection}, false)
+		require.NoError(t, err)
+		protected, err := svc.(BranchProtectionService).IsBranchProtected(t.Context(), "main")
+		require.NoError(t, err)
+		assert.True(t, protected)
+		protected, err = svc.(BranchProtectionService).IsBranchProtected(t.Context(), "scratch")
+		require.NoError(t, err)
+		assert.False(t, protected)
+
+		svc, err = NewCompositeService([]PullRequestService{gitea}, false)
+		require.NoError(t, err)
+		_, err = svc.(BranchProtectionService).IsBranchProtected(t.Context(), "main")
+		require.ErrorContains(t, err, "branch protection is not supported by any pull request provider")
+	})
+}
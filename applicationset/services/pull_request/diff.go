@@ -0,0 +1,153 @@
+package pull_request
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// PullRequestDiff describes how the pull requests returned by a provider changed between two polls.
+type PullRequestDiff struct {
+	// Added contains the pull requests that were not present in the previous poll.
+	Added []*PullRequest
+	// Removed contains the pull requests that were present in the previous poll but are no longer returned.
+	Removed []*PullRequest
+	// Changed contains the pull requests that are present in both polls but whose details (e.g. HeadSHA) differ.
+	Changed []*PullRequest
+}
+
+// IsEmpty returns true if no pull request was added, removed or changed.
+func (d PullRequestDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffPullRequests computes the difference between two successive lists of pull requests, keyed by pull request
+// number. Each set in the result is sorted by number.
+func DiffPullRequests(previous, current []*PullRequest) PullRequestDiff {
+	previousByNumber := make(map[int]*PullRequest, len(previous))
+	for _, pr := range previous {
+		previousByNumber[pr.Number] = pr
+	}
+	currentByNumber := make(map[int]*PullRequest, len(current))
+	for _, pr := range current {
+		currentByNumber[pr.Number] = pr
+	}
+
+	diff := PullRequestDiff{}
+	for number, pr := range currentByNumber {
+		prev, ok := previousByNumber[number]
+		if !ok {
+			diff.Added = append(diff.Added, pr)
+		} else if !reflect.DeepEqual(*prev, *pr) {
+			diff.Changed = append(diff.Changed, pr)
+		}
+	}
+	for number, pr := range previousByNumber {
+		if _, ok := currentByNumber[number]; !ok {
+			diff.Removed = append(diff.Removed, pr)
+		}
+	}
+	sortPullRequestsByNumber(diff.Added)
+	sortPullRequestsByNumber(diff.Removed)
+	sortPullRequestsByNumber(diff.Changed)
+	return diff
+}
+
+func sortPullRequestsByNumber(pullRequests []*PullRequest) {
+	sort.Slice(pullRequests, func(i, j int) bool {
+		return pullRequests[i].Number < pullRequests[j].Number
+	})
+}
+
+// DiffingService wraps a PullRequestService and remembers the result of the previous successful List call, so that
+// consumers can find out which pull requests appeared, disappeared or changed since the last poll. The optional
+// services are forwarded to the wrapped service, and fail as the helpers asserting on them do if it does not implement
+// them.
+type DiffingService struct {
+	service PullRequestService
+
+	lock     sync.Mutex
+	previous []*PullRequest
+	lastDiff PullRequestDiff
+}
+
+var (
+	_ PullRequestService      = (*DiffingService)(nil)
+	_ ChangedFilesService     = (*DiffingService)(nil)
+	_ BranchProtectionService = (*DiffingService)(nil)
+	_ HeadCommitAuthorService = (*DiffingService)(nil)
+	_ ApprovalsService        = (*DiffingService)(nil)
+	_ RateLimitService        = (*DiffingService)(nil)
+)
+
+// NewDiffingService returns a DiffingService delegating to the given service.
+func NewDiffingService(service PullRequestService) *DiffingService {
+	return &DiffingService{service: service}
+}
+
+// List returns the pull requests of the wrapped service and records the difference to the previous successful call.
+// On the first call all pull requests are reported as added. Failed calls leave the recorded state untouched.
+func (d *DiffingService) List(ctx context.Context) ([]*PullRequest, error) {
+	pullRequests, err := d.service.List(ctx)
+	if err != nil {
+		return pullRequests, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.lastDiff = DiffPullRequests(d.previous, pullRequests)
+	d.previous = pullRequests
+	return pullRequests, nil
+}
+
+// LastDiff returns the difference computed by the most recent successful List call.
+func (d *DiffingService) LastDiff() PullRequestDiff {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.lastDiff
+}
+
+// ChangedFiles returns the files changed by the pull request, as listed by the wrapped service.
+func (d *DiffingService) ChangedFiles(ctx context.Context, pullRequest *PullRequest) ([]string, error) {
+	service, ok := d.service.(ChangedFilesService)
+	if !ok {
+		return nil, errors.New("the pathsChanged filter is not supported by this pull request provider")
+	}
+	return service.ChangedFiles(ctx, pullRequest)
+}
+
+// IsBranchProtected returns whether the branch is protected, as reported by the wrapped service.
+func (d *DiffingService) IsBranchProtected(ctx context.Context, branch string) (bool, error) {
+	service, ok := d.service.(BranchProtectionService)
+	if !ok {
+		return false, errors.New("the targetBranchProtected filter is not supported by this pull request provider")
+	}
+	return service.IsBranchProtected(ctx, branch)
+}
+
+// HeadCommitAuthor returns the author of the head commit of the pull request, as resolved by the wrapped service.
+func (d *DiffingService) HeadCommitAuthor(ctx context.Context, pullRequest *PullRequest) (CommitAuthor, error) {
+	service, ok := d.service.(HeadCommitAuthorService)
+	if !ok {
+		return CommitAuthor{}, errors.New("resolving the head commit author is not supported by this pull request provider")
+	}
+	return service.HeadCommitAuthor(ctx, pullRequest)
+}
+
+// Approvals returns the number of approvals of the pull request, as resolved by the wrapped service. Pull requests of
+// services which cannot resolve approvals keep the number reported by the service, if any.
+func (d *DiffingService) Approvals(ctx context.Context, pullRequest *PullRequest) (int, error) {
+	service, ok := d.service.(ApprovalsService)
+	if !ok {
+		return pullRequest.Approvals, nil
+	}
+	return service.Approvals(ctx, pullRequest)
+}
+
+// RateLimitInfo returns the rate limit of the provider API of the wrapped service, or ErrRateLimitInfoNotSupported if
+// it does not report it.
+func (d *DiffingService) RateLimitInfo() (RateLimit, error) {
+	return GetRateLimitInfo(d.service)
+}
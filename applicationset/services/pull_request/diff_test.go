@@ -0,0 +1,127 @@
+package pull_request
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type sequenceService struct {
+	results [][]*PullRequest
+	errs    []error
+	calls   int
+}
+
+func (s *sequenceService) List(_ context.Context) ([]*PullRequest, error) {
+	i := s.calls
+	s.calls++
+	return s.results[i], s.errs[i]
+}
+
+func TestDiffPullRequests(t *testing.T) {
+	previous := []*PullRequest{
+		{Number: 1, Branch: "one", HeadSHA: "sha1"},
+		{Number: 2, Branch: "two", HeadSHA: "sha2"},
+		{Number: 3, Branch: "three", HeadSHA: "sha3"},
+	}
+	current := []*PullRequest{
+		{Number: 4, Branch: "four", HeadSHA: "sha4"},
+		{Number: 3, Branch: "three", HeadSHA: "sha3-updated"},
+		{Number: 1, Branch: "one", HeadSHA: "sha1"},
+	}
+
+	diff := DiffPullRequests(previous, current)
+
+	assert.Equal(t, []*PullRequest{{Number: 4, Branch: "four", HeadSHA: "sha4"}}, diff.Added)
+	assert.Equal(t, []*PullRequest{{Number: 2, Branch: "two", HeadSHA: "sha2"}}, diff.Removed)
+	assert.Equal(t, []*PullRequest{{Number: 3, Branch: "three", HeadSHA: "sha3-updated"}}, diff.Changed)
+	assert.False(t, diff.IsEmpty())
+
+	assert.True(t, DiffPullRequests(current, current).IsEmpty())
+}
+
+func TestDiffingService(t *testing.T) {
+	first := []*PullRequest{
+		{Number: 1, Title: "PR one", Labels: []string{"preview"}},
+		{Number: 2, Title: "PR two"},
+	}
+	second := []*PullRequest{
+		{Number: 2, Title: "PR two (renamed)"},
+		{Number: 3, Title: "PR three"},
+	}
+	underlying := &sequenceService{
+		results: [][]*PullRequest{first, nil, second},
+		errs:    []error{nil, errors.New("boom"), nil},
+	}
+	service := NewDiffingService(underlying)
+
+	pullRequests, err := service.List(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, first, pullRequests)
+	diff := service.LastDiff()
+	assert.Equal(t, first, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Changed)
+
+	// a failed poll must not reset the previous state
+	_, err = service.List(t.Context())
+	require.Error(t, err)
+
+	pullRequests, err = service.List(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, second, pullRequests)
+	diff = service.LastDiff()
+	assert.Equal(t, []*PullRequest{{Number: 3, Title: "PR three"}}, diff.Added)
+	assert.Equal(t, []*PullRequest{{Number: 1, Title: "PR one", Labels: []string{"preview"}}}, diff.Removed)
+	assert.Equal(t, []*PullRequest{{Number: 2, Title: "PR two (renamed)"}}, diff.Changed)
+}
+
+func TestDiffingServiceForwardsOptionalServices(t *testing.T) {
+	pullRequest := &PullRequest{Number: 1, Branch: "app", TargetBranch: "main", Approvals: 2}
+
+	t.Run("Supported", func(t *testing.T) {
+		files := &changedFilesService{
+			pullRequests: []*PullRequest{pullRequest},
+			files:        map[int][]string{1: {"apps/guestbook/deployment.yaml"}},
+			calls:        map[int]int{},
+		}
+		service := NewDiffingService(files)
+		changedFiles, err := service.ChangedFiles(t.Context(), pullRequest)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apps/guestbook/deployment.yaml"}, changedFiles)
+
+		pullRequests, err := ListPullRequests(t.Context(), service, []argoprojiov1alpha1.PullRequestGeneratorFilter{{PathsChanged: []string{"apps/**"}}})
+		require.NoError(t, err)
+		assert.Equal(t, []*PullRequest{pullRequest}, pullRequests)
+
+		protection := &branchProtectionService{
+			pullRequests: []*PullRequest{pullRequest},
+			protected:    map[string]bool{"main": true},
+			calls:        map[string]int{},
+		}
+		protected, err := NewDiffingService(protection).IsBranchProtected(t.Context(), "main")
+		require.NoError(t, err)
+		assert.True(t, protected)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		fake, _ := NewFakeService(t.Context(), []*PullRequest{pullRequest}, nil)
+		service := NewDiffingService(fake)
+		_, err := service.ChangedFiles(t.Context(), pullRequest)
+		require.ErrorContains(t, err, "the pathsChanged filter is not supported")
+		_, err = service.IsBranchProtected(t.Context(), "main")
+		require.ErrorContains(t, err, "the targetBranchProtected filter is not supported")
+		_, err = service.HeadCommitAuthor(t.Context(), pullRequest)
+		require.ErrorContains(t, err, "resolving the head commit author is not supported")
+		approvals, err := service.Approvals(t.Context(), pullRequest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, approvals)
+		_, err = GetRateLimitInfo(service)
+		require.ErrorIs(t, err, ErrRateLimitInfoNotSupported)
+	})
+}
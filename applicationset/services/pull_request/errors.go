@@ -2,6 +2,10 @@ package pull_request
 
 import "errors"
 
+// ErrRateLimitInfoNotSupported is returned by GetRateLimitInfo for pull request providers which do not report their
+// rate limit
+var ErrRateLimitInfoNotSupported = errors.New("rate limit info is not supported by the pull request provider")
+
 // RepositoryNotFoundError represents an error when a repository is not found by a pull request provider
 type RepositoryNotFoundError struct {
 	causingError error
@@ -21,3 +25,28 @@ func IsRepositoryNotFoundError(err error) bool {
 	var repoErr *RepositoryNotFoundError
 	return errors.As(err, &repoErr)
 }
+
+// AuthScopeError represents an error when the credentials used by a pull request provider were accepted, but lack the
+// scope or permissions required to access the repository
+type AuthScopeError struct {
+	causingError error
+}
+
+func (e *AuthScopeError) Error() string {
+	return e.causingError.Error()
+}
+
+func (e *AuthScopeError) Unwrap() error {
+	return e.causingError
+}
+
+// NewAuthScopeError creates a new auth scope error
+func NewAuthScopeError(err error) error {
+	return &AuthScopeError{causingError: err}
+}
+
+// IsAuthScopeError checks if the given error is an auth scope error
+func IsAuthScopeError(err error) bool {
+	var scopeErr *AuthScopeError
+	return errors.As(err, &scopeErr)
+}
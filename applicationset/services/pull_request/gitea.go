@@ -23,16 +23,13 @@ func NewGiteaService(token, url, owner, repo string, labels []string, insecure b
 	if token == "" {
 		token = os.Getenv("GITEA_TOKEN")
 	}
-	httpClient := &http.Client{}
+	httpClient := &http.Client{Transport: traceRequests("gitea", newTransport(nil))}
 	if insecure {
 		cookieJar, _ := cookiejar.New(nil)
 
-		tr := http.DefaultTransport.(*http.Transport).Clone()
-		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 		httpClient = &http.Client{
 			Jar:       cookieJar,
-			Transport: tr,
+			Transport: traceRequests("gitea", newTransport(&tls.Config{InsecureSkipVerify: true})),
 		}
 	}
 	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
@@ -73,8 +70,10 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 			Branch:       pr.Head.Ref,
 			TargetBranch: pr.Base.Ref,
 			HeadSHA:      pr.Head.Sha,
+			BaseSHA:      pr.Base.Sha,
 			Labels:       getGiteaPRLabelNames(pr.Labels),
 			Author:       pr.Poster.UserName,
+			URL:          pr.HTMLURL,
 		})
 	}
 	return list, nil
@@ -2,32 +2,47 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 
 	"github.com/google/go-github/v69/github"
-
-	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
 type GithubService struct {
-	client *github.Client
-	owner  string
-	repo   string
-	labels []string
+	client          *github.Client
+	owner           string
+	repo            string
+	labels          []string
+	requireApproval bool
+	// reviews caches the latest review state of each reviewer of a pull request, by number and last update time
+	reviews map[githubApprovalKey]map[string]string
+	// rate is the rate limit reported by the last response of the GitHub API, nil until a response is received
+	rate *github.Rate
+}
+
+type githubApprovalKey struct {
+	number    int
+	updatedAt int64
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService      = (*GithubService)(nil)
+	_ RateLimitService        = (*GithubService)(nil)
+	_ HeadCommitAuthorService = (*GithubService)(nil)
+	_ BranchProtectionService = (*GithubService)(nil)
+	_ ApprovalsService        = (*GithubService)(nil)
+)
 
-func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
+func NewGithubService(token, url, owner, repo string, labels []string, requireApproval bool, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
 	if token == "" {
 		token = os.Getenv("GITHUB_TOKEN")
 	}
 
 	var client *github.Client
-	httpClient := appsetutils.GetOptionalHTTPClient(optionalHTTPClient...)
+	httpClient := githubHTTPClient(optionalHTTPClient...)
 
 	if url == "" {
 		if token == "" {
@@ -47,13 +62,29 @@ func NewGithubService(token, url, owner, repo string, labels []string, optionalH
 		}
 	}
 	return &GithubService{
-		client: client,
-		owner:  owner,
-		repo:   repo,
-		labels: labels,
+		client:          client,
+		owner:           owner,
+		repo:            repo,
+		labels:          labels,
+		requireApproval: requireApproval,
+		reviews:         map[githubApprovalKey]map[string]string{},
 	}, nil
 }
 
+// NewGithubTransport returns the transport used to talk to GitHub, tuned with the configured connection reuse settings.
+// It can be wrapped, e.g. to record metrics, and passed to the GitHub services with an HTTP client.
+func NewGithubTransport() http.RoundTripper {
+	return traceRequests("github", newTransport(nil))
+}
+
+// githubHTTPClient returns the given HTTP client, or a client using the GitHub transport if none is given
+func githubHTTPClient(optionalHTTPClient ...*http.Client) *http.Client {
+	if len(optionalHTTPClient) > 0 && optionalHTTPClient[0] != nil {
+		return optionalHTTPClient[0]
+	}
+	return &http.Client{Transport: NewGithubTransport()}
+}
+
 func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	opts := &github.PullRequestListOptions{
 		ListOptions: github.ListOptions{
@@ -63,6 +94,7 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	pullRequests := []*PullRequest{}
 	for {
 		pulls, resp, err := g.client.PullRequests.List(ctx, g.owner, g.repo, opts)
+		g.recordRate(resp)
 		if err != nil {
 			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				// return a custom error indicating that the repository is not found,
@@ -75,14 +107,30 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 			if !containLabels(g.labels, pull.Labels) {
 				continue
 			}
+			approvals := 0
+			if g.requireApproval {
+				states, err := g.latestReviewStates(ctx, pull)
+				if err != nil {
+					return nil, err
+				}
+				if !isApproved(states) {
+					continue
+				}
+				approvals = countApprovals(states)
+			}
 			pullRequests = append(pullRequests, &PullRequest{
 				Number:       *pull.Number,
 				Title:        *pull.Title,
-				Branch:       *pull.Head.Ref,
+				Branch:       pull.GetHead().GetRef(),
 				TargetBranch: *pull.Base.Ref,
 				HeadSHA:      *pull.Head.SHA,
+				BaseSHA:      pull.Base.GetSHA(),
 				Labels:       getGithubPRLabelNames(pull.Labels),
 				Author:       *pull.User.Login,
+				IsDraft:      pull.GetDraft(),
+				Approvals:    approvals,
+				URL:          pull.GetHTMLURL(),
+				Attributes:   getGithubPRAttributes(pull),
 			})
 		}
 		if resp.NextPage == 0 {
@@ -93,6 +141,126 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// HeadCommitAuthor returns the author of the head commit of the pull request, as recorded in the git commit.
+func (g *GithubService) HeadCommitAuthor(ctx context.Context, pullRequest *PullRequest) (CommitAuthor, error) {
+	commit, resp, err := g.client.Repositories.GetCommit(ctx, g.owner, g.repo, pullRequest.HeadSHA, nil)
+	g.recordRate(resp)
+	if err != nil {
+		return CommitAuthor{}, fmt.Errorf("error getting commit %s for %s/%s: %w", pullRequest.HeadSHA, g.owner, g.repo, err)
+	}
+	author := commit.GetCommit().GetAuthor()
+	return CommitAuthor{Name: author.GetName(), Email: author.GetEmail()}, nil
+}
+
+// IsBranchProtected returns whether the branch of the repository is protected.
+func (g *GithubService) IsBranchProtected(ctx context.Context, branch string) (bool, error) {
+	b, resp, err := g.client.Repositories.GetBranch(ctx, g.owner, g.repo, branch, 1)
+	g.recordRate(resp)
+	if err != nil {
+		return false, fmt.Errorf("error getting branch %s for %s/%s: %w", branch, g.owner, g.repo, err)
+	}
+	return b.GetProtected(), nil
+}
+
+// RateLimitInfo returns the rate limit reported by the X-RateLimit-* headers of the last response of the GitHub API.
+func (g *GithubService) RateLimitInfo() (RateLimit, error) {
+	if g.rate == nil {
+		return RateLimit{}, errors.New("no response received from the GitHub API yet")
+	}
+	return RateLimit{
+		Limit:     g.rate.Limit,
+		Remaining: g.rate.Remaining,
+		Reset:     g.rate.Reset.Time,
+	}, nil
+}
+
+// recordRate records the rate limit of the response, if it reported one.
+func (g *GithubService) recordRate(resp *github.Response) {
+	if resp == nil || resp.Rate.Limit == 0 {
+		return
+	}
+	rate := resp.Rate
+	g.rate = &rate
+}
+
+// Approvals returns the number of reviewers whose latest approving, changes requested or dismissed review approves
+// the pull request. The approvals of pull requests listed with requireApproval are already known from their reviews.
+func (g *GithubService) Approvals(ctx context.Context, pullRequest *PullRequest) (int, error) {
+	if g.requireApproval {
+		return pullRequest.Approvals, nil
+	}
+	states, err := g.listReviewStates(ctx, pullRequest.Number)
+	if err != nil {
+		return 0, err
+	}
+	return countApprovals(states), nil
+}
+
+// latestReviewStates returns the latest review state of each reviewer of the pull request, cached until the pull
+// request is updated.
+func (g *GithubService) latestReviewStates(ctx context.Context, pull *github.PullRequest) (map[string]string, error) {
+	key := githubApprovalKey{number: pull.GetNumber(), updatedAt: pull.GetUpdatedAt().Unix()}
+	if states, ok := g.reviews[key]; ok {
+		return states, nil
+	}
+	states, err := g.listReviewStates(ctx, pull.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	g.reviews[key] = states
+	return states, nil
+}
+
+// listReviewStates returns the state of the latest approving, changes requested or dismissed review of each reviewer
+// of the pull request.
+func (g *GithubService) listReviewStates(ctx context.Context, number int) (map[string]string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	states := map[string]string{}
+	for {
+		reviews, resp, err := g.client.PullRequests.ListReviews(ctx, g.owner, g.repo, number, opts)
+		g.recordRate(resp)
+		if err != nil {
+			return nil, fmt.Errorf("error listing reviews of pull request %d for %s/%s: %w", number, g.owner, g.repo, err)
+		}
+		for _, review := range reviews {
+			switch state := review.GetState(); state {
+			case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+				states[review.GetUser().GetLogin()] = state
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return states, nil
+}
+
+// isApproved returns true if at least one reviewer approves the pull request and no reviewer requests changes.
+func isApproved(states map[string]string) bool {
+	approved := false
+	for _, state := range states {
+		if state == "CHANGES_REQUESTED" {
+			return false
+		}
+		if state == "APPROVED" {
+			approved = true
+		}
+	}
+	return approved
+}
+
+// countApprovals returns the number of reviewers approving the pull request.
+func countApprovals(states map[string]string) int {
+	approvals := 0
+	for _, state := range states {
+		if state == "APPROVED" {
+			approvals++
+		}
+	}
+	return approvals
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
@@ -121,3 +289,16 @@ func getGithubPRLabelNames(gitHubLabels []*github.Label) []string {
 	}
 	return labelNames
 }
+
+// getGithubPRAttributes returns the attributes of a pull request. mergeable_state is only reported by the API once
+// GitHub computed it, which the list API does not trigger, so it is missing for most pull requests.
+func getGithubPRAttributes(pull *github.PullRequest) map[string]string {
+	attributes := map[string]string{}
+	if pull.AuthorAssociation != nil {
+		attributes["author_association"] = pull.GetAuthorAssociation()
+	}
+	if pull.MergeableState != nil {
+		attributes["mergeable_state"] = pull.GetMergeableState()
+	}
+	return attributes
+}
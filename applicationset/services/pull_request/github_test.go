@@ -1,9 +1,12 @@
 package pull_request
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/stretchr/testify/assert"
@@ -103,7 +106,7 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 		_, _ = w.Write([]byte(`{"message": "404 Project Not Found"}`))
 	})
 
-	svc, err := NewGithubService("", server.URL, "nonexistent", "nonexistent", []string{}, nil)
+	svc, err := NewGithubService("", server.URL, "nonexistent", "nonexistent", []string{}, false, nil)
 	require.NoError(t, err)
 
 	prs, err := svc.List(t.Context())
@@ -115,3 +118,222 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubListRequireApproval(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	pull := func(number int) string {
+		return fmt.Sprintf(`{"number": %d, "title": "pr %d", "updated_at": "2024-01-01T00:00:00Z", "head": {"ref": "branch-%d", "sha": "sha-%d"}, "base": {"ref": "main", "sha": "base"}, "user": {"login": "author"}}`, number, number, number, number)
+	}
+	review := func(login, state string) string {
+		return fmt.Sprintf(`{"user": {"login": %q}, "state": %q}`, login, state)
+	}
+	reviews := map[int][]string{
+		// approved
+		1: {review("alice", "COMMENTED"), review("alice", "APPROVED")},
+		// changes requested by another reviewer
+		2: {review("alice", "APPROVED"), review("bob", "CHANGES_REQUESTED")},
+		// no review
+		3: {},
+		// changes requested, then approved by the same reviewer
+		4: {review("bob", "CHANGES_REQUESTED"), review("bob", "APPROVED")},
+		// approval dismissed
+		5: {review("alice", "APPROVED"), review("alice", "DISMISSED")},
+	}
+	reviewCalls := map[int]int{}
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, "[%s,%s,%s,%s,%s]", pull(1), pull(2), pull(3), pull(4), pull(5))
+	})
+	for number, prReviews := range reviews {
+		mux.HandleFunc(fmt.Sprintf("/api/v3/repos/owner/repo/pulls/%d/reviews", number), func(w http.ResponseWriter, _ *http.Request) {
+			reviewCalls[number]++
+			_, _ = fmt.Fprintf(w, "[%s]", strings.Join(prReviews, ","))
+		})
+	}
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, true, nil)
+	require.NoError(t, err)
+
+	for range 2 {
+		prs, err := svc.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, prs, 2)
+		assert.Equal(t, 1, prs[0].Number)
+		assert.Equal(t, 1, prs[0].Approvals)
+		assert.Equal(t, 4, prs[1].Number)
+		assert.Equal(t, 1, prs[1].Approvals)
+	}
+	// reviews are fetched once per pull request as long as it is not updated
+	assert.Equal(t, map[int]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1}, reviewCalls)
+
+	svc, err = NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, prs, 5)
+}
+
+func TestGitHubListDraft(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		pull := func(number int, draft bool) string {
+			return fmt.Sprintf(`{"number": %d, "title": "pr %d", "draft": %t, "head": {"ref": "branch-%d", "sha": "sha-%d"}, "base": {"ref": "main", "sha": "base"}, "user": {"login": "author"}}`, number, number, draft, number, number)
+		}
+		_, _ = fmt.Fprintf(w, "[%s,%s]", pull(1, true), pull(2, false))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.True(t, prs[0].IsDraft)
+	assert.False(t, prs[1].IsDraft)
+}
+
+func TestGitHubRateLimitInfo(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4321")
+		w.Header().Set("X-RateLimit-Reset", "1704067200")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+
+	_, err = GetRateLimitInfo(svc)
+	require.ErrorContains(t, err, "no response received")
+
+	_, err = svc.List(t.Context())
+	require.NoError(t, err)
+
+	rateLimit, err := GetRateLimitInfo(svc)
+	require.NoError(t, err)
+	assert.Equal(t, 5000, rateLimit.Limit)
+	assert.Equal(t, 4321, rateLimit.Remaining)
+	assert.True(t, time.Unix(1704067200, 0).Equal(rateLimit.Reset))
+
+	fake, err := NewFakeService(t.Context(), nil, nil)
+	require.NoError(t, err)
+	_, err = GetRateLimitInfo(fake)
+	require.ErrorIs(t, err, ErrRateLimitInfoNotSupported)
+}
+
+func TestGitHubHeadCommitAuthor(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"number": 1, "title": "pr 1", "head": {"ref": "branch-1", "sha": "sha-1"}, "base": {"ref": "main", "sha": "base"}, "user": {"login": "opener"}}]`))
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/commits/sha-1", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"sha": "sha-1", "commit": {"author": {"name": "Jane Doe", "email": "jane@example.com"}}, "author": {"login": "jane"}}`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Empty(t, prs[0].HeadCommitAuthor)
+
+	require.NoError(t, ResolveHeadCommitAuthors(t.Context(), svc, prs))
+	assert.Equal(t, CommitAuthor{Name: "Jane Doe", Email: "jane@example.com"}, prs[0].HeadCommitAuthor)
+	assert.Equal(t, "opener", prs[0].Author)
+
+	fake, err := NewFakeService(t.Context(), prs, nil)
+	require.NoError(t, err)
+	require.ErrorContains(t, ResolveHeadCommitAuthors(t.Context(), fake, prs), "not supported by this pull request provider")
+}
+
+func TestGitHubApprovals(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"number": 1, "title": "pr 1", "head": {"ref": "branch-1", "sha": "sha-1"}, "base": {"ref": "main", "sha": "base"}, "user": {"login": "opener"}}]`))
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls/1/reviews", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"user": {"login": "alice"}, "state": "APPROVED"},
+			{"user": {"login": "alice"}, "state": "COMMENTED"},
+			{"user": {"login": "bob"}, "state": "APPROVED"},
+			{"user": {"login": "carol"}, "state": "APPROVED"},
+			{"user": {"login": "carol"}, "state": "DISMISSED"},
+			{"user": {"login": "dave"}, "state": "CHANGES_REQUESTED"}
+		]`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Zero(t, prs[0].Approvals)
+
+	require.NoError(t, ResolveApprovals(t.Context(), svc, prs))
+	assert.Equal(t, 2, prs[0].Approvals)
+
+	// providers which cannot resolve approvals keep the reported number
+	fake, err := NewFakeService(t.Context(), prs, nil)
+	require.NoError(t, err)
+	require.NoError(t, ResolveApprovals(t.Context(), fake, prs))
+	assert.Equal(t, 2, prs[0].Approvals)
+}
+
+func TestGitHubListNullResponse(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`null`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "argoproj", "argo-cd", []string{}, false, nil)
+	require.NoError(t, err)
+
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, prs)
+	assert.Empty(t, prs)
+}
+
+func TestGitHubIsBranchProtected(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/branches/main", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "main", "protected": true}`))
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/branches/scratch", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "scratch", "protected": false}`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{}, false, nil)
+	require.NoError(t, err)
+	service := svc.(BranchProtectionService)
+
+	protected, err := service.IsBranchProtected(t.Context(), "main")
+	require.NoError(t, err)
+	assert.True(t, protected)
+	protected, err = service.IsBranchProtected(t.Context(), "scratch")
+	require.NoError(t, err)
+	assert.False(t, protected)
+	_, err = service.IsBranchProtected(t.Context(), "missing")
+	require.ErrorContains(t, err, "error getting branch missing for owner/repo")
+}
@@ -7,11 +7,16 @@ import (
 	"os"
 
 	"github.com/hashicorp/go-retryablehttp"
+	log "github.com/sirupsen/logrus"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
 
+// gitlabMaxPages bounds the number of pages of merge requests fetched by a single List call, to protect against
+// servers reporting a next page indefinitely
+const gitlabMaxPages = 100
+
 type GitLabService struct {
 	client           *gitlab.Client
 	project          string
@@ -19,7 +24,12 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService      = (*GitLabService)(nil)
+	_ HeadCommitAuthorService = (*GitLabService)(nil)
+	_ BranchProtectionService = (*GitLabService)(nil)
+	_ ApprovalsService        = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -33,11 +43,10 @@ func NewGitLabService(token, url, project string, labels []string, pullRequestSt
 		token = os.Getenv("GITLAB_TOKEN")
 	}
 
-	tr := http.DefaultTransport.(*http.Transport).Clone()
-	tr.TLSClientConfig = utils.GetTlsConfig(scmRootCAPath, insecure, caCerts)
+	tr := newTransport(utils.GetTlsConfig(scmRootCAPath, insecure, caCerts))
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = tr
+	retryClient.HTTPClient.Transport = traceRequests("gitlab", tr)
 
 	clientOptionFns = append(clientOptionFns, gitlab.WithHTTPClient(retryClient.HTTPClient))
 
@@ -73,8 +82,8 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 
 	pullRequests := []*PullRequest{}
-	for {
-		mrs, resp, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, opts, gitlab.WithContext(ctx))
+	for page := 1; ; page++ {
+		mrs, resp, err := g.listMergeRequests(ctx, opts)
 		if err != nil {
 			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				// return a custom error indicating that the repository is not found,
@@ -84,20 +93,115 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
 		}
 		for _, mr := range mrs {
+			baseSHA := mr.baseSHA()
+			if baseSHA == "" {
+				baseSHA = g.baseSHA(ctx, mr.IID)
+			}
 			pullRequests = append(pullRequests, &PullRequest{
 				Number:       mr.IID,
 				Title:        mr.Title,
 				Branch:       mr.SourceBranch,
 				TargetBranch: mr.TargetBranch,
 				HeadSHA:      mr.SHA,
+				BaseSHA:      baseSHA,
 				Labels:       mr.Labels,
 				Author:       mr.Author.Username,
+				// draft replaces the deprecated work_in_progress flag of merge requests
+				IsDraft:    mr.Draft,
+				URL:        mr.WebURL,
+				Attributes: gitlabAttributes(&mr.BasicMergeRequest),
 			})
 		}
+		// the next page is taken from the X-Next-Page header, which is empty on the last page
 		if resp.NextPage == 0 {
 			break
 		}
+		if page >= gitlabMaxPages {
+			// returning a partial list would delete the applications of the merge requests on the remaining pages
+			return nil, fmt.Errorf("error listing merge requests for project '%s': more than %d pages of merge requests", g.project, gitlabMaxPages)
+		}
 		opts.Page = resp.NextPage
 	}
 	return pullRequests, nil
 }
+
+// gitlabAttributes returns the attributes of a merge request, i.e. its detailed_merge_status if reported
+func gitlabAttributes(mr *gitlab.BasicMergeRequest) map[string]string {
+	attributes := map[string]string{}
+	if mr.DetailedMergeStatus != "" {
+		attributes["detailed_merge_status"] = mr.DetailedMergeStatus
+	}
+	return attributes
+}
+
+// gitlabMergeRequest is a merge request of the list of merge requests, along with its diff refs, which only some GitLab
+// versions report in the list
+type gitlabMergeRequest struct {
+	gitlab.BasicMergeRequest
+	DiffRefs *struct {
+		BaseSha string `json:"base_sha"`
+	} `json:"diff_refs"`
+}
+
+// baseSHA returns the SHA of the target branch commit the merge request is compared against, if listed
+func (mr *gitlabMergeRequest) baseSHA() string {
+	if mr.DiffRefs == nil {
+		return ""
+	}
+	return mr.DiffRefs.BaseSha
+}
+
+// listMergeRequests lists the merge requests of the project like ListProjectMergeRequests, keeping their diff refs
+func (g *GitLabService) listMergeRequests(ctx context.Context, opts *gitlab.ListProjectMergeRequestsOptions) ([]*gitlabMergeRequest, *gitlab.Response, error) {
+	u := fmt.Sprintf("projects/%s/merge_requests", gitlab.PathEscape(g.project))
+	req, err := g.client.NewRequest(http.MethodGet, u, opts, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+	var mrs []*gitlabMergeRequest
+	resp, err := g.client.Do(req, &mrs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mrs, resp, nil
+}
+
+// baseSHA gets the SHA of the target branch commit the merge request is compared against, for the merge requests whose
+// diff refs are not listed. GitLab leaves it empty while the diff of the merge request is computed. The base SHA is
+// informational, so it is left empty if the merge request cannot be read rather than failing the whole list.
+func (g *GitLabService) baseSHA(ctx context.Context, iid int) string {
+	mr, _, err := g.client.MergeRequests.GetMergeRequest(g.project, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		log.WithError(err).Warnf("Could not get the base SHA of merge request %d of project '%s'", iid, g.project)
+		return ""
+	}
+	return mr.DiffRefs.BaseSha
+}
+
+// HeadCommitAuthor returns the author of the head commit of the merge request, as recorded in the git commit. The
+// commits of merge requests from forks are available in the target project too.
+func (g *GitLabService) HeadCommitAuthor(ctx context.Context, pullRequest *PullRequest) (CommitAuthor, error) {
+	commit, _, err := g.client.Commits.GetCommit(g.project, pullRequest.HeadSHA, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return CommitAuthor{}, fmt.Errorf("error getting commit %s for project '%s': %w", pullRequest.HeadSHA, g.project, err)
+	}
+	return CommitAuthor{Name: commit.AuthorName, Email: commit.AuthorEmail}, nil
+}
+
+// Approvals returns the number of users who approved the merge request.
+func (g *GitLabService) Approvals(ctx context.Context, pullRequest *PullRequest) (int, error) {
+	approvals, _, err := g.client.MergeRequestApprovals.GetConfiguration(g.project, pullRequest.Number, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("error getting approvals of merge request %d for project '%s': %w", pullRequest.Number, g.project, err)
+	}
+	return len(approvals.ApprovedBy), nil
+}
+
+// IsBranchProtected returns whether the branch of the project is protected.
+func (g *GitLabService) IsBranchProtected(ctx context.Context, branch string) (bool, error) {
+	b, _, err := g.client.Branches.GetBranch(g.project, branch, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("error getting branch %s for project '%s': %w", branch, g.project, err)
+	}
+	return b.Protected, nil
+}
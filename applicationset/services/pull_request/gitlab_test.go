@@ -3,10 +3,12 @@ package pull_request
 import (
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +24,15 @@ func writeMRListResponse(t *testing.T, w io.Writer) {
 	require.NoErrorf(t, err, "error writing response: %v", err)
 }
 
+// handleGetMR serves the merge requests of project 278964 with the diff refs, which most GitLab versions only report for
+// a single merge request
+func handleGetMR(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/{iid}", func(w http.ResponseWriter, r *http.Request) {
+		iid := r.PathValue("iid")
+		_, _ = fmt.Fprintf(w, `{"iid": %s, "diff_refs": {"base_sha": "base-%s", "head_sha": "head-%s", "start_sha": "base-%s"}}`, iid, iid, iid, iid)
+	})
+}
+
 func TestGitLabServiceCustomBaseURL(t *testing.T) {
 	mux := http.NewServeMux()
 	server := httptest.NewServer(mux)
@@ -34,6 +45,7 @@ func TestGitLabServiceCustomBaseURL(t *testing.T) {
 		writeMRListResponse(t, w)
 	})
 
+	handleGetMR(mux)
 	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
 	require.NoError(t, err)
 
@@ -53,6 +65,7 @@ func TestGitLabServiceToken(t *testing.T) {
 		writeMRListResponse(t, w)
 	})
 
+	handleGetMR(mux)
 	svc, err := NewGitLabService("token-123", server.URL, "278964", nil, "", "", false, nil)
 	require.NoError(t, err)
 
@@ -72,6 +85,7 @@ func TestList(t *testing.T) {
 		writeMRListResponse(t, w)
 	})
 
+	handleGetMR(mux)
 	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
 	require.NoError(t, err)
 
@@ -83,7 +97,64 @@ func TestList(t *testing.T) {
 	assert.Equal(t, "use-structured-logging-for-db-load-balancer", prs[0].Branch)
 	assert.Equal(t, "master", prs[0].TargetBranch)
 	assert.Equal(t, "2fc4e8b972ff3208ec63b6143e34ad67ff343ad7", prs[0].HeadSHA)
+	assert.Equal(t, "base-15442", prs[0].BaseSHA)
+	// the fixture has no detailed_merge_status
+	assert.Empty(t, prs[0].Attributes)
 	assert.Equal(t, "hfyngvason", prs[0].Author)
+	assert.True(t, prs[0].IsDraft)
+}
+
+func TestListPaginated(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mr := func(iid int) string {
+		return fmt.Sprintf(`{"iid": %d, "title": "mr %d", "source_branch": "branch-%d", "target_branch": "main", "sha": "sha-%d", "author": {"username": "author"}, "detailed_merge_status": "mergeable"}`, iid, iid, iid, iid)
+	}
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = fmt.Fprintf(w, "[%s,%s]", mr(1), mr(2))
+		case "2":
+			_, _ = fmt.Fprintf(w, "[%s]", mr(3))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	handleGetMR(mux)
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, prs, 3)
+	assert.Equal(t, 3, prs[2].Number)
+	assert.Equal(t, "base-3", prs[2].BaseSHA)
+	assert.Equal(t, map[string]string{"detailed_merge_status": "mergeable"}, prs[2].Attributes)
+}
+
+func TestListMaxPages(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		// the server always reports a next page
+		w.Header().Set("X-Next-Page", strconv.Itoa(requests+1))
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+
+	_, err = svc.List(t.Context())
+	require.ErrorContains(t, err, "more than 100 pages of merge requests")
+	assert.Equal(t, gitlabMaxPages, requests)
 }
 
 func TestListWithLabels(t *testing.T) {
@@ -98,6 +169,7 @@ func TestListWithLabels(t *testing.T) {
 		writeMRListResponse(t, w)
 	})
 
+	handleGetMR(mux)
 	svc, err := NewGitLabService("", server.URL, "278964", []string{"feature", "ready"}, "", "", false, nil)
 	require.NoError(t, err)
 
@@ -117,6 +189,7 @@ func TestListWithState(t *testing.T) {
 		writeMRListResponse(t, w)
 	})
 
+	handleGetMR(mux)
 	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "opened", "", false, nil)
 	require.NoError(t, err)
 
@@ -160,9 +233,12 @@ func TestListWithStateTLS(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
 				writeMRListResponse(t, w)
-			}))
+			})
+			handleGetMR(mux)
+			ts := httptest.NewTLSServer(mux)
 			defer ts.Close()
 
 			var certs []byte
@@ -217,3 +293,108 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestListNullResponse(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`null`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, prs)
+	assert.Empty(t, prs)
+}
+
+func TestGitLabIsBranchProtected(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/repository/branches/main", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "main", "protected": true}`))
+	})
+	mux.HandleFunc("/api/v4/projects/278964/repository/branches/scratch", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "scratch", "protected": false}`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+	service := svc.(BranchProtectionService)
+
+	protected, err := service.IsBranchProtected(t.Context(), "main")
+	require.NoError(t, err)
+	assert.True(t, protected)
+	protected, err = service.IsBranchProtected(t.Context(), "scratch")
+	require.NoError(t, err)
+	assert.False(t, protected)
+}
+
+func TestGitLabApprovals(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/15442/approvals", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"iid": 15442, "approvals_required": 2, "approvals_left": 0, "approved_by": [{"user": {"username": "alice"}}, {"user": {"username": "bob"}}]}`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{}, "", "", false, nil)
+	require.NoError(t, err)
+	prs := []*PullRequest{{Number: 15442}}
+	require.NoError(t, ResolveApprovals(t.Context(), svc, prs))
+	assert.Equal(t, 2, prs[0].Approvals)
+}
+
+func TestListBaseSHAError(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+		writeMRListResponse(t, w)
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/15442", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+	require.NoError(t, err)
+
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err, "the base SHA is best effort")
+	require.Len(t, prs, 1)
+	assert.Empty(t, prs[0].BaseSHA)
+	assert.Equal(t, "2fc4e8b972ff3208ec63b6143e34ad67ff343ad7", prs[0].HeadSHA)
+}
+
+func TestListBaseSHAFromList(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `[{"iid": 1, "source_branch": "feature", "sha": "head-1", "author": {"username": "alice"}, "diff_refs": {"base_sha": "listed-1"}}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/{iid}", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s: the base SHA is listed", r.URL.Path)
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+	require.NoError(t, err)
+
+	prs, err := svc.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, "listed-1", prs[0].BaseSHA)
+}
@@ -2,7 +2,15 @@ package pull_request
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
 	"regexp"
+	"slices"
+	"time"
+
+	"github.com/gobwas/glob"
 )
 
 type PullRequest struct {
@@ -16,10 +24,65 @@ type PullRequest struct {
 	TargetBranch string
 	// HeadSHA is the SHA of the HEAD from which the pull request originated.
 	HeadSHA string
+	// BaseSHA is the SHA of the target branch commit the pull request is compared against. It is empty if the
+	// provider does not report it.
+	BaseSHA string
 	// Labels of the pull request.
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// UpdatedAt is the time of the most recent update of the pull request, if known to the provider.
+	UpdatedAt time.Time
+	// Repository is the name of the repository of the pull request. It is only set by providers which can list the
+	// pull requests of several repositories.
+	Repository string
+	// IsDraft is true if the pull request is a draft, i.e. not ready for review yet. It is always false for
+	// providers which do not report it.
+	IsDraft bool
+	// HeadCommitAuthor is the author of the head commit of the pull request. It is only set once resolved by
+	// ResolveHeadCommitAuthors.
+	HeadCommitAuthor CommitAuthor
+	// Approvals is the number of approvals of the pull request. It is reported by Azure DevOps, and only set once
+	// resolved by ResolveApprovals for GitHub and GitLab. It is zero for providers which do not report approvals.
+	Approvals int
+	// URL is the web URL of the pull request, e.g. for links in notifications. It is empty if the provider does not
+	// report it.
+	URL string
+	// Attributes are additional, provider specific fields of the pull request, keyed by their name in the API of the
+	// provider. Only the keys documented for each provider are stable, and a key is missing if the provider did not
+	// report the field.
+	Attributes map[string]string
+}
+
+// Fingerprint returns a hash of the fields of the pull request which the pull request generator passes as parameters
+// to the generated applications, i.e. all fields except UpdatedAt and URL. Two pull requests with the same fingerprint
+// generate the same parameters, so consumers can skip pull requests whose fingerprint did not change since the last
+// poll. The order of the labels and attributes is ignored.
+func (pr *PullRequest) Fingerprint() string {
+	labels := slices.Clone(pr.Labels)
+	slices.Sort(labels)
+	h := sha256.New()
+	// fields are NUL terminated, so that the boundaries between them cannot be shifted
+	_, _ = fmt.Fprintf(h, "%d\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%t\x00%s\x00%s\x00%d\x00",
+		pr.Number, pr.Title, pr.Branch, pr.TargetBranch, pr.HeadSHA, pr.BaseSHA, pr.Author, pr.Repository, pr.IsDraft,
+		pr.HeadCommitAuthor.Name, pr.HeadCommitAuthor.Email, pr.Approvals)
+	// the number of labels separates them from the attributes
+	_, _ = fmt.Fprintf(h, "%d\x00", len(labels))
+	for _, label := range labels {
+		_, _ = fmt.Fprintf(h, "%s\x00", label)
+	}
+	for _, key := range slices.Sorted(maps.Keys(pr.Attributes)) {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00", key, pr.Attributes[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CommitAuthor is the author of a commit, as recorded in the commit.
+type CommitAuthor struct {
+	// Name is the name of the author.
+	Name string
+	// Email is the email address of the author.
+	Email string
 }
 
 type PullRequestService interface {
@@ -27,8 +90,66 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// ChangedFilesService is implemented by pull request services which can list the files changed by a pull request.
+type ChangedFilesService interface {
+	// ChangedFiles returns the paths, relative to the repository root, of the files changed by the pull request.
+	ChangedFiles(ctx context.Context, pullRequest *PullRequest) ([]string, error)
+}
+
+// BranchProtectionService is implemented by pull request services which can tell whether a branch of the repository
+// is protected.
+type BranchProtectionService interface {
+	// IsBranchProtected returns whether the branch is protected.
+	IsBranchProtected(ctx context.Context, branch string) (bool, error)
+}
+
+// HeadCommitAuthorService is implemented by pull request services which can resolve the author of the head commit of a
+// pull request.
+type HeadCommitAuthorService interface {
+	// HeadCommitAuthor returns the author of the head commit of the pull request.
+	HeadCommitAuthor(ctx context.Context, pullRequest *PullRequest) (CommitAuthor, error)
+}
+
+// ApprovalsService is implemented by pull request services which can resolve the number of approvals of a pull request.
+type ApprovalsService interface {
+	// Approvals returns the number of approvals of the pull request.
+	Approvals(ctx context.Context, pullRequest *PullRequest) (int, error)
+}
+
+// RateLimit is the rate limit of a pull request provider API, as reported by its last response.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current rate limit window.
+	Limit int
+	// Remaining is the number of requests remaining in the current rate limit window.
+	Remaining int
+	// Reset is the time at which the current rate limit window resets.
+	Reset time.Time
+}
+
+// RateLimitService is implemented by pull request services which report the rate limit of the provider API.
+type RateLimitService interface {
+	// RateLimitInfo returns the rate limit captured from the last response of the provider API.
+	RateLimitInfo() (RateLimit, error)
+}
+
+// GetRateLimitInfo returns the rate limit of the provider API of the pull request service, or
+// ErrRateLimitInfoNotSupported if the service does not report it.
+func GetRateLimitInfo(service PullRequestService) (RateLimit, error) {
+	rateLimitService, ok := service.(RateLimitService)
+	if !ok {
+		return RateLimit{}, ErrRateLimitInfoNotSupported
+	}
+	return rateLimitService.RateLimitInfo()
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
 	TitleMatch        *regexp.Regexp
+	PathsChanged      []glob.Glob
+	LabelsAll         []string
+	LabelsAny         []string
+	ExcludeLabels     []string
+	// TargetBranchProtected, if set, is whether the target branch of the pull request must be protected.
+	TargetBranchProtected *bool
 }
@@ -0,0 +1,52 @@
+package pull_request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestFingerprint(t *testing.T) {
+	newPullRequest := func() *PullRequest {
+		return &PullRequest{
+			Number: 1, Title: "Add feature", Branch: "feature", TargetBranch: "main", HeadSHA: "sha1", BaseSHA: "base1",
+			Labels: []string{"preview", "team-a"}, Author: "alice", Repository: "repo", IsDraft: false,
+			HeadCommitAuthor: CommitAuthor{Name: "Alice", Email: "alice@example.com"}, Approvals: 1,
+			Attributes: map[string]string{"state": "open", "mergeable": "true"},
+		}
+	}
+	pr := newPullRequest()
+
+	t.Run("EquivalentPullRequestsHashEqually", func(t *testing.T) {
+		equivalent := newPullRequest()
+		equivalent.Labels = []string{"team-a", "preview"}
+		// fields which are not passed as parameters are ignored
+		equivalent.URL = "https://example.com/pulls/1"
+		assert.Equal(t, pr.Fingerprint(), equivalent.Fingerprint())
+		assert.Equal(t, []string{"team-a", "preview"}, equivalent.Labels, "labels must not be sorted in place")
+	})
+
+	t.Run("ChangedFieldsHashDifferently", func(t *testing.T) {
+		for name, change := range map[string]func(pr *PullRequest){
+			"Number":           func(pr *PullRequest) { pr.Number = 2 },
+			"Title":            func(pr *PullRequest) { pr.Title = "Add feature (renamed)" },
+			"Branch":           func(pr *PullRequest) { pr.Branch = "feature-2" },
+			"TargetBranch":     func(pr *PullRequest) { pr.TargetBranch = "release" },
+			"HeadSHA":          func(pr *PullRequest) { pr.HeadSHA = "sha2" },
+			"BaseSHA":          func(pr *PullRequest) { pr.BaseSHA = "base2" },
+			"Labels":           func(pr *PullRequest) { pr.Labels = []string{"preview"} },
+			"Author":           func(pr *PullRequest) { pr.Author = "bob" },
+			"Repository":       func(pr *PullRequest) { pr.Repository = "other" },
+			"IsDraft":          func(pr *PullRequest) { pr.IsDraft = true },
+			"HeadCommitAuthor": func(pr *PullRequest) { pr.HeadCommitAuthor.Email = "alice@example.org" },
+			"Approvals":        func(pr *PullRequest) { pr.Approvals = 2 },
+			"Attributes":       func(pr *PullRequest) { pr.Attributes["state"] = "merged" },
+			"Shifted":          func(pr *PullRequest) { pr.Branch, pr.TargetBranch = "featuremain", "" },
+			"LabelToAttribute": func(pr *PullRequest) { pr.Labels = []string{"preview"}; pr.Attributes["team-a"] = "" },
+		} {
+			changed := newPullRequest()
+			change(changed)
+			assert.NotEqual(t, pr.Fingerprint(), changed.Fingerprint(), name)
+		}
+	})
+}
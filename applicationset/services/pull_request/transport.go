@@ -0,0 +1,173 @@
+package pull_request
+
+import (
+	"crypto/tls"
+	"math"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxIdleConns is the default maximum number of idle connections kept open to a provider
+	DefaultMaxIdleConns = 100
+	// DefaultIdleConnTimeout is the default time an idle connection to a provider is kept open
+	DefaultIdleConnTimeout = 90 * time.Second
+)
+
+// TransportConfig tunes the reuse of the connections the pull request services open to SCM providers
+type TransportConfig struct {
+	// MaxIdleConns is the maximum number of idle connections kept open to a provider. Zero means no limit.
+	MaxIdleConns int
+	// IdleConnTimeout is the time an idle connection is kept open before it is closed. Zero means no limit.
+	IdleConnTimeout time.Duration
+	// TraceRequests enables logging the timings of every request to a provider at trace level.
+	TraceRequests bool
+}
+
+var (
+	transportConfig = TransportConfig{
+		MaxIdleConns:    DefaultMaxIdleConns,
+		IdleConnTimeout: DefaultIdleConnTimeout,
+	}
+	transportConfigLock sync.RWMutex
+)
+
+// SetTransportConfig sets the connection reuse settings of the transports created by the pull request services
+func SetTransportConfig(config TransportConfig) {
+	transportConfigLock.Lock()
+	defer transportConfigLock.Unlock()
+	transportConfig = config
+}
+
+// GetTransportConfig returns the connection reuse settings of the transports created by the pull request services
+func GetTransportConfig() TransportConfig {
+	transportConfigLock.RLock()
+	defer transportConfigLock.RUnlock()
+	return transportConfig
+}
+
+// newTransport returns the transport used by the pull request services to talk to a provider with the given TLS
+// configuration, tuned with the configured connection reuse settings
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	config := GetTransportConfig()
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = tlsConfig
+	tr.MaxIdleConns = config.MaxIdleConns
+	// a transport only talks to a single provider, so all idle connections may be kept to the same host. Zero would
+	// fall back to the default of 2 idle connections per host instead of no limit.
+	tr.MaxIdleConnsPerHost = config.MaxIdleConns
+	if config.MaxIdleConns == 0 {
+		tr.MaxIdleConnsPerHost = math.MaxInt
+	}
+	tr.IdleConnTimeout = config.IdleConnTimeout
+	return tr
+}
+
+// RequestTrace contains the timings of a single request to a provider. Phases which did not happen, e.g. the DNS
+// lookup and the connection setup for a reused connection, are zero.
+type RequestTrace struct {
+	// Provider is the name of the provider the request was sent to.
+	Provider string
+	// Method is the HTTP method of the request.
+	Method string
+	// Host is the host the request was sent to.
+	Host string
+	// Path is the path of the request URL, without the query which may contain credentials.
+	Path string
+	// ConnReused is true if the request was sent over a reused connection.
+	ConnReused bool
+	// DNS is the duration of the DNS lookup.
+	DNS time.Duration
+	// Connect is the duration of establishing the TCP connection.
+	Connect time.Duration
+	// TLSHandshake is the duration of the TLS handshake.
+	TLSHandshake time.Duration
+	// TimeToFirstByte is the duration from sending the request until the first byte of the response was received.
+	TimeToFirstByte time.Duration
+	// Total is the duration until the response headers were received, or the request failed.
+	Total time.Duration
+}
+
+// tracingTransport records the timings of the requests sent by the wrapped transport with httptrace and passes them
+// to a hook.
+type tracingTransport struct {
+	provider string
+	next     http.RoundTripper
+	onTrace  func(RequestTrace)
+}
+
+// traceRequests wraps the transport of a provider so that the timings of its requests are logged at trace level, if
+// enabled by the transport configuration. Otherwise the transport is returned as is.
+func traceRequests(provider string, next http.RoundTripper) http.RoundTripper {
+	if !GetTransportConfig().TraceRequests {
+		return next
+	}
+	return &tracingTransport{provider: provider, next: next, onTrace: logRequestTrace}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := RequestTrace{Provider: t.provider, Method: req.Method, Host: req.URL.Host, Path: req.URL.Path}
+	// the connection may be dialed in another goroutine, which can outlive the request if an idle connection is used
+	// instead
+	var lock sync.Mutex
+	record := func(f func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		f()
+	}
+	var dnsStart, connectStart, tlsStart time.Time
+	start := time.Now()
+	clientTrace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			record(func() { trace.ConnReused = info.Reused })
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			record(func() { dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			record(func() { trace.DNS = time.Since(dnsStart) })
+		},
+		ConnectStart: func(_, _ string) {
+			record(func() { connectStart = time.Now() })
+		},
+		ConnectDone: func(_, _ string, _ error) {
+			record(func() { trace.Connect = time.Since(connectStart) })
+		},
+		TLSHandshakeStart: func() {
+			record(func() { tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, _ error) {
+			record(func() { trace.TLSHandshake = time.Since(tlsStart) })
+		},
+		GotFirstResponseByte: func() {
+			record(func() { trace.TimeToFirstByte = time.Since(start) })
+		},
+	}
+	resp, err := t.next.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), clientTrace)))
+	var result RequestTrace
+	record(func() {
+		trace.Total = time.Since(start)
+		result = trace
+	})
+	t.onTrace(result)
+	return resp, err
+}
+
+func logRequestTrace(trace RequestTrace) {
+	log.WithFields(log.Fields{
+		"provider":              trace.Provider,
+		"method":                trace.Method,
+		"host":                  trace.Host,
+		"path":                  trace.Path,
+		"conn_reused":           trace.ConnReused,
+		"dns_ms":                trace.DNS.Milliseconds(),
+		"connect_ms":            trace.Connect.Milliseconds(),
+		"tls_handshake_ms":      trace.TLSHandshake.Milliseconds(),
+		"time_to_first_byte_ms": trace.TimeToFirstByte.Milliseconds(),
+		"total_ms":              trace.Total.Milliseconds(),
+	}).Trace("SCM provider request")
+}
@@ -0,0 +1,109 @@
+package pull_request
+
+import (
+	"crypto/tls"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransport(t *testing.T) {
+	t.Cleanup(func() {
+		SetTransportConfig(TransportConfig{MaxIdleConns: DefaultMaxIdleConns, IdleConnTimeout: DefaultIdleConnTimeout})
+	})
+
+	tr := newTransport(nil)
+	assert.Equal(t, DefaultMaxIdleConns, tr.MaxIdleConns)
+	assert.Equal(t, DefaultMaxIdleConns, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, DefaultIdleConnTimeout, tr.IdleConnTimeout)
+
+	SetTransportConfig(TransportConfig{MaxIdleConns: 500, IdleConnTimeout: 5 * time.Minute})
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	tr = newTransport(tlsConfig)
+	assert.Equal(t, 500, tr.MaxIdleConns)
+	assert.Equal(t, 500, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, 5*time.Minute, tr.IdleConnTimeout)
+	assert.Same(t, tlsConfig, tr.TLSClientConfig)
+	// the default transport is not changed by the tuning
+	assert.NotEqual(t, 500, http.DefaultTransport.(*http.Transport).MaxIdleConns)
+
+	// no limit applies to the idle connections per host as well
+	SetTransportConfig(TransportConfig{MaxIdleConns: 0})
+	tr = newTransport(nil)
+	assert.Zero(t, tr.MaxIdleConns)
+	assert.Equal(t, math.MaxInt, tr.MaxIdleConnsPerHost)
+}
+
+func TestTracingTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var traces []RequestTrace
+	tr := &tracingTransport{
+		provider: "gitea",
+		next:     newTransport(&tls.Config{InsecureSkipVerify: true}),
+		onTrace:  func(trace RequestTrace) { traces = append(traces, trace) },
+	}
+	client := &http.Client{Transport: tr}
+	for range 2 {
+		resp, err := client.Get(server.URL + "/api/v1/repos/owner/repo/pulls?token=secret")
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	require.Len(t, traces, 2)
+	first := traces[0]
+	assert.Equal(t, "gitea", first.Provider)
+	assert.Equal(t, http.MethodGet, first.Method)
+	assert.Equal(t, strings.TrimPrefix(server.URL, "https://"), first.Host)
+	assert.Equal(t, "/api/v1/repos/owner/repo/pulls", first.Path)
+	assert.False(t, first.ConnReused)
+	assert.Positive(t, first.Connect)
+	assert.Positive(t, first.TLSHandshake)
+	assert.GreaterOrEqual(t, first.TimeToFirstByte, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, first.Total, first.TimeToFirstByte)
+
+	// the second request reuses the connection, so there is no connection setup to record
+	second := traces[1]
+	assert.True(t, second.ConnReused)
+	assert.Zero(t, second.Connect)
+	assert.Zero(t, second.TLSHandshake)
+	assert.GreaterOrEqual(t, second.TimeToFirstByte, 10*time.Millisecond)
+}
+
+func TestTraceRequests(t *testing.T) {
+	t.Cleanup(func() {
+		SetTransportConfig(TransportConfig{MaxIdleConns: DefaultMaxIdleConns, IdleConnTimeout: DefaultIdleConnTimeout})
+	})
+
+	tr := newTransport(nil)
+	assert.Same(t, tr, traceRequests("gitlab", tr))
+
+	SetTransportConfig(TransportConfig{MaxIdleConns: DefaultMaxIdleConns, IdleConnTimeout: DefaultIdleConnTimeout, TraceRequests: true})
+	traced, ok := traceRequests("gitlab", tr).(*tracingTransport)
+	require.True(t, ok)
+	assert.Equal(t, "gitlab", traced.provider)
+	assert.Same(t, tr, traced.next)
+
+	// the transports of the providers built on other client libraries are traced as well
+	github, ok := NewGithubTransport().(*tracingTransport)
+	require.True(t, ok)
+	assert.Equal(t, "github", github.provider)
+	bitbucketCloud, err := NewBitbucketCloudServiceNoAuth("https://api.bitbucket.org/2.0", "owner", "repo")
+	require.NoError(t, err)
+	bitbucketCloudTransport, ok := bitbucketCloud.(*BitbucketCloudService).client.HttpClient.Transport.(*tracingTransport)
+	require.True(t, ok)
+	assert.Equal(t, "bitbucket_cloud", bitbucketCloudTransport.provider)
+}
@@ -1,13 +1,117 @@
 package pull_request
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+	log "github.com/sirupsen/logrus"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
+const (
+	// SortByNumber orders pull requests by number, then by head SHA. This is the default order.
+	SortByNumber = "number"
+	// SortByUpdatedAt orders pull requests by the time of their last update, oldest first. Pull requests with the
+	// same update time (or providers which do not report it) are ordered by number.
+	SortByUpdatedAt = "updatedAt"
+
+	// MissingHeadBranchSkip skips pull requests whose head branch cannot be resolved. This is the default.
+	MissingHeadBranchSkip = "skip"
+	// MissingHeadBranchUseHeadSHA uses the head SHA as branch of pull requests whose head branch cannot be resolved.
+	MissingHeadBranchUseHeadSHA = "useHeadSHA"
+)
+
+// ResolveMissingHeadBranches handles the pull requests whose head branch cannot be resolved, e.g. because it was
+// deleted while the pull request is still open, according to the given policy. An empty policy skips them. It returns
+// the pull requests to generate parameters for.
+func ResolveMissingHeadBranches(pullRequests []*PullRequest, policy string) ([]*PullRequest, error) {
+	if policy != "" && policy != MissingHeadBranchSkip && policy != MissingHeadBranchUseHeadSHA {
+		return nil, fmt.Errorf("unsupported missing head branch policy %q, must be one of %q or %q", policy, MissingHeadBranchSkip, MissingHeadBranchUseHeadSHA)
+	}
+	resolved := make([]*PullRequest, 0, len(pullRequests))
+	for _, pullRequest := range pullRequests {
+		if pullRequest.Branch != "" {
+			resolved = append(resolved, pullRequest)
+			continue
+		}
+		logCtx := log.WithField("pullRequest", pullRequest.Number)
+		if policy != MissingHeadBranchUseHeadSHA || pullRequest.HeadSHA == "" {
+			logCtx.Warn("Skipping pull request since its head branch cannot be resolved")
+			continue
+		}
+		logCtx.Warnf("Head branch of pull request cannot be resolved, using head SHA %s instead", pullRequest.HeadSHA)
+		pullRequest.Branch = pullRequest.HeadSHA
+		resolved = append(resolved, pullRequest)
+	}
+	return resolved, nil
+}
+
+// ResolveHeadCommitAuthors sets the head commit author of the given pull requests, using one API call per pull request.
+func ResolveHeadCommitAuthors(ctx context.Context, provider PullRequestService, pullRequests []*PullRequest) error {
+	service, ok := provider.(HeadCommitAuthorService)
+	if !ok {
+		return errors.New("resolving the head commit author is not supported by this pull request provider")
+	}
+	for _, pullRequest := range pullRequests {
+		author, err := service.HeadCommitAuthor(ctx, pullRequest)
+		if err != nil {
+			return fmt.Errorf("error resolving the head commit author of pull request %d: %w", pullRequest.Number, err)
+		}
+		pullRequest.HeadCommitAuthor = author
+	}
+	return nil
+}
+
+// ResolveApprovals sets the number of approvals of the given pull requests, using up to one API call per pull request.
+// Pull requests of providers which cannot resolve approvals keep the number reported by the provider, if any.
+func ResolveApprovals(ctx context.Context, provider PullRequestService, pullRequests []*PullRequest) error {
+	service, ok := provider.(ApprovalsService)
+	if !ok {
+		return nil
+	}
+	for _, pullRequest := range pullRequests {
+		approvals, err := service.Approvals(ctx, pullRequest)
+		if err != nil {
+			return fmt.Errorf("error resolving the approvals of pull request %d: %w", pullRequest.Number, err)
+		}
+		pullRequest.Approvals = approvals
+	}
+	return nil
+}
+
+// SortPullRequests sorts the given pull requests in place by the given key, so that identical inputs produce an
+// identical order regardless of the order returned by the provider API. An empty key sorts by number.
+func SortPullRequests(pullRequests []*PullRequest, sortBy string) error {
+	switch sortBy {
+	case "", SortByNumber:
+		slices.SortStableFunc(pullRequests, compareByNumber)
+	case SortByUpdatedAt:
+		slices.SortStableFunc(pullRequests, func(a, b *PullRequest) int {
+			if c := a.UpdatedAt.Compare(b.UpdatedAt); c != 0 {
+				return c
+			}
+			return compareByNumber(a, b)
+		})
+	default:
+		return fmt.Errorf("unsupported sort key %q, must be one of %q or %q", sortBy, SortByNumber, SortByUpdatedAt)
+	}
+	return nil
+}
+
+func compareByNumber(a, b *PullRequest) int {
+	if c := cmp.Compare(a.Number, b.Number); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.HeadSHA, b.HeadSHA)
+}
+
 func compileFilters(filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]*Filter, error) {
 	outFilters := make([]*Filter, 0, len(filters))
 	for _, filter := range filters {
@@ -31,25 +135,131 @@ func compileFilters(filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]
 				return nil, fmt.Errorf("error compiling TitleMatch regexp %q: %w", *filter.TitleMatch, err)
 			}
 		}
+		for _, pattern := range filter.PathsChanged {
+			compiled, err := glob.Compile(strings.TrimPrefix(pattern, "/"), '/')
+			if err != nil {
+				return nil, fmt.Errorf("error compiling PathsChanged glob %q: %w", pattern, err)
+			}
+			outFilter.PathsChanged = append(outFilter.PathsChanged, compiled)
+		}
+		outFilter.LabelsAll = filter.LabelsAll
+		outFilter.LabelsAny = filter.LabelsAny
+		outFilter.ExcludeLabels = filter.ExcludeLabels
+		outFilter.TargetBranchProtected = filter.TargetBranchProtected
 		outFilters = append(outFilters, outFilter)
 	}
 	return outFilters, nil
 }
 
-func matchFilter(pullRequest *PullRequest, filter *Filter) bool {
+// changedFilesCache remembers the files changed by each pull request, so that they are fetched at most once per
+// ListPullRequests call even if several filters match on them. Pull requests are keyed by identity, since the numbers
+// of pull requests listed by a CompositeService may collide.
+type changedFilesCache struct {
+	provider PullRequestService
+	files    map[*PullRequest][]string
+}
+
+func (c *changedFilesCache) get(ctx context.Context, pullRequest *PullRequest) ([]string, error) {
+	if files, ok := c.files[pullRequest]; ok {
+		return files, nil
+	}
+	service, ok := c.provider.(ChangedFilesService)
+	if !ok {
+		return nil, errors.New("the pathsChanged filter is not supported by this pull request provider")
+	}
+	files, err := service.ChangedFiles(ctx, pullRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error listing changed files of pull request %d: %w", pullRequest.Number, err)
+	}
+	c.files[pullRequest] = files
+	return files, nil
+}
+
+// branchProtectionCache remembers whether each target branch is protected, so that the protection of a branch is
+// fetched at most once per ListPullRequests call, however many pull requests target it.
+type branchProtectionCache struct {
+	provider  PullRequestService
+	protected map[string]bool
+}
+
+func (c *branchProtectionCache) get(ctx context.Context, branch string) (bool, error) {
+	if protected, ok := c.protected[branch]; ok {
+		return protected, nil
+	}
+	service, ok := c.provider.(BranchProtectionService)
+	if !ok {
+		return false, errors.New("the targetBranchProtected filter is not supported by this pull request provider")
+	}
+	protected, err := service.IsBranchProtected(ctx, branch)
+	if err != nil {
+		return false, fmt.Errorf("error getting the protection of branch %s: %w", branch, err)
+	}
+	c.protected[branch] = protected
+	return protected, nil
+}
+
+func matchFilter(ctx context.Context, changedFiles *changedFilesCache, branchProtection *branchProtectionCache, pullRequest *PullRequest, filter *Filter) (bool, error) {
 	if filter.BranchMatch != nil && !filter.BranchMatch.MatchString(pullRequest.Branch) {
-		return false
+		return false, nil
 	}
 	if filter.TargetBranchMatch != nil && !filter.TargetBranchMatch.MatchString(pullRequest.TargetBranch) {
-		return false
+		return false, nil
 	}
 	if filter.TitleMatch != nil && !filter.TitleMatch.MatchString(pullRequest.Title) {
-		return false
+		return false, nil
+	}
+	for _, label := range filter.LabelsAll {
+		if !slices.Contains(pullRequest.Labels, label) {
+			return false, nil
+		}
+	}
+	if len(filter.LabelsAny) != 0 && !slices.ContainsFunc(filter.LabelsAny, func(label string) bool {
+		return slices.Contains(pullRequest.Labels, label)
+	}) {
+		return false, nil
+	}
+	// Excluded labels are checked after the inclusion criteria on labels, so a pull request matching both is dropped
+	if slices.ContainsFunc(filter.ExcludeLabels, func(label string) bool {
+		return slices.Contains(pullRequest.Labels, label)
+	}) {
+		return false, nil
+	}
+	if filter.TargetBranchProtected != nil {
+		protected, err := branchProtection.get(ctx, pullRequest.TargetBranch)
+		if err != nil {
+			return false, err
+		}
+		if protected != *filter.TargetBranchProtected {
+			return false, nil
+		}
+	}
+	if len(filter.PathsChanged) != 0 {
+		files, err := changedFiles.get(ctx, pullRequest)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, file := range files {
+			file = strings.TrimPrefix(file, "/")
+			for _, pattern := range filter.PathsChanged {
+				if pattern.Match(file) {
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
 	}
 
-	return true
+	return true, nil
 }
 
+// ListPullRequests lists the pull requests of the given provider which match any of the filters, sorted by number.
 func ListPullRequests(ctx context.Context, provider PullRequestService, filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]*PullRequest, error) {
 	compiledFilters, err := compileFilters(filters)
 	if err != nil {
@@ -60,15 +270,23 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 	if err != nil {
 		return nil, err
 	}
+	if err := SortPullRequests(pullRequests, SortByNumber); err != nil {
+		return nil, err
+	}
 
 	if len(compiledFilters) == 0 {
 		return pullRequests, nil
 	}
 
+	changedFiles := &changedFilesCache{provider: provider, files: map[*PullRequest][]string{}}
+	branchProtection := &branchProtectionCache{provider: provider, protected: map[string]bool{}}
 	filteredPullRequests := make([]*PullRequest, 0, len(pullRequests))
 	for _, pullRequest := range pullRequests {
 		for _, filter := range compiledFilters {
-			matches := matchFilter(pullRequest, filter)
+			matches, err := matchFilter(ctx, changedFiles, branchProtection, pullRequest, filter)
+			if err != nil {
+				return nil, err
+			}
 			if matches {
 				filteredPullRequests = append(filteredPullRequests, pullRequest)
 				break
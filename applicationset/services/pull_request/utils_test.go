@@ -1,10 +1,16 @@
 package pull_request
 
 import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -399,3 +405,310 @@ func TestNoFilters(t *testing.T) {
 	assert.Equal(t, "one", repos[0].Branch)
 	assert.Equal(t, "two", repos[1].Branch)
 }
+
+func TestListPullRequestsSortsByNumber(t *testing.T) {
+	expected := []*PullRequest{
+		{Number: 1, Branch: "one", HeadSHA: "189d92cbf9ff857a39e6feccd32798ca700fb958"},
+		{Number: 2, Branch: "two", HeadSHA: "289d92cbf9ff857a39e6feccd32798ca700fb958"},
+		{Number: 2, Branch: "two", HeadSHA: "389d92cbf9ff857a39e6feccd32798ca700fb958"},
+		{Number: 3, Branch: "three", HeadSHA: "489d92cbf9ff857a39e6feccd32798ca700fb958"},
+		{Number: 10, Branch: "ten", HeadSHA: "589d92cbf9ff857a39e6feccd32798ca700fb958"},
+	}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		shuffled := slices.Clone(expected)
+		r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		provider, _ := NewFakeService(t.Context(), shuffled, nil)
+
+		pullRequests, err := ListPullRequests(t.Context(), provider, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, pullRequests)
+	}
+}
+
+func TestSortPullRequests(t *testing.T) {
+	now := time.Now()
+	pullRequests := func() []*PullRequest {
+		return []*PullRequest{
+			{Number: 3, UpdatedAt: now.Add(-time.Hour)},
+			{Number: 1, UpdatedAt: now},
+			{Number: 4},
+			{Number: 2, UpdatedAt: now.Add(-time.Hour)},
+		}
+	}
+	numbers := func(pullRequests []*PullRequest) []int {
+		result := make([]int, 0, len(pullRequests))
+		for _, pr := range pullRequests {
+			result = append(result, pr.Number)
+		}
+		return result
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		prs := pullRequests()
+		require.NoError(t, SortPullRequests(prs, ""))
+		assert.Equal(t, []int{1, 2, 3, 4}, numbers(prs))
+	})
+
+	t.Run("UpdatedAt", func(t *testing.T) {
+		prs := pullRequests()
+		require.NoError(t, SortPullRequests(prs, SortByUpdatedAt))
+		assert.Equal(t, []int{4, 2, 3, 1}, numbers(prs))
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		require.ErrorContains(t, SortPullRequests(pullRequests(), "title"), "unsupported sort key")
+	})
+}
+
+// changedFilesService is a stubbed provider which reports the configured changed files for each pull request
+type changedFilesService struct {
+	pullRequests []*PullRequest
+	files        map[int][]string
+	calls        map[int]int
+}
+
+func (s *changedFilesService) List(_ context.Context) ([]*PullRequest, error) {
+	return s.pullRequests, nil
+}
+
+func (s *changedFilesService) ChangedFiles(_ context.Context, pullRequest *PullRequest) ([]string, error) {
+	s.calls[pullRequest.Number]++
+	return s.files[pullRequest.Number], nil
+}
+
+func TestFilterPathsChanged(t *testing.T) {
+	provider := &changedFilesService{
+		pullRequests: []*PullRequest{
+			{Number: 1, Branch: "docs"},
+			{Number: 2, Branch: "app"},
+			{Number: 3, Branch: "nested"},
+			{Number: 4, Branch: "empty"},
+		},
+		files: map[int][]string{
+			1: {"README.md", "docs/index.md"},
+			2: {"apps/guestbook/deployment.yaml"},
+			3: {"README.md", "charts/guestbook/templates/service.yaml"},
+		},
+		calls: map[int]int{},
+	}
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			PathsChanged: []string{"*.txt"},
+		},
+		{
+			PathsChanged: []string{"apps/*/*.yaml", "/charts/**"},
+		},
+	}
+
+	pullRequests, err := ListPullRequests(t.Context(), provider, filters)
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 2)
+	assert.Equal(t, "app", pullRequests[0].Branch)
+	assert.Equal(t, "nested", pullRequests[1].Branch)
+	// the changed files are fetched once per pull request, even if several filters match on them
+	assert.Equal(t, map[int]int{1: 1, 2: 1, 3: 1, 4: 1}, provider.calls)
+}
+
+// branchProtectionService is a stubbed provider which reports the configured protection of each branch
+type branchProtectionService struct {
+	pullRequests []*PullRequest
+	protected    map[string]bool
+	calls        map[string]int
+}
+
+func (s *branchProtectionService) List(_ context.Context) ([]*PullRequest, error) {
+	return s.pullRequests, nil
+}
+
+func (s *branchProtectionService) IsBranchProtected(_ context.Context, branch string) (bool, error) {
+	s.calls[branch]++
+	return s.protected[branch], nil
+}
+
+func TestFilterTargetBranchProtected(t *testing.T) {
+	newProvider := func() *branchProtectionService {
+		return &branchProtectionService{
+			pullRequests: []*PullRequest{
+				{Number: 1, Branch: "feature-1", TargetBranch: "main"},
+				{Number: 2, Branch: "feature-2", TargetBranch: "scratch"},
+				{Number: 3, Branch: "feature-3", TargetBranch: "main"},
+			},
+			protected: map[string]bool{"main": true},
+			calls:     map[string]int{},
+		}
+	}
+
+	provider := newProvider()
+	pullRequests, err := ListPullRequests(t.Context(), provider, []argoprojiov1alpha1.PullRequestGeneratorFilter{{TargetBranchProtected: ptr.To(true)}})
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 2)
+	assert.Equal(t, "feature-1", pullRequests[0].Branch)
+	assert.Equal(t, "feature-3", pullRequests[1].Branch)
+	// the protection of a branch is fetched once, however many pull requests target it
+	assert.Equal(t, map[string]int{"main": 1, "scratch": 1}, provider.calls)
+
+	pullRequests, err = ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{TargetBranchProtected: ptr.To(false)}})
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 1)
+	assert.Equal(t, "feature-2", pullRequests[0].Branch)
+}
+
+func TestFilterTargetBranchProtectedUnsupported(t *testing.T) {
+	provider, _ := NewFakeService(t.Context(), []*PullRequest{{Number: 1, Branch: "one", TargetBranch: "main"}}, nil)
+	_, err := ListPullRequests(t.Context(), provider, []argoprojiov1alpha1.PullRequestGeneratorFilter{{TargetBranchProtected: ptr.To(true)}})
+	require.ErrorContains(t, err, "the targetBranchProtected filter is not supported")
+}
+
+func TestFilterTitleMatchBadRegexp(t *testing.T) {
+	// the filters are compiled before the pull requests are listed
+	provider, _ := NewFakeService(t.Context(), nil, errors.New("should not be listed"))
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			TitleMatch: strp("[deploy"),
+		},
+	}
+	_, err := ListPullRequests(t.Context(), provider, filters)
+	require.ErrorContains(t, err, `error compiling TitleMatch regexp "[deploy"`)
+}
+
+func TestFilterPathsChangedBadGlob(t *testing.T) {
+	provider := &changedFilesService{calls: map[int]int{}}
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			PathsChanged: []string{"apps/["},
+		},
+	}
+	_, err := ListPullRequests(t.Context(), provider, filters)
+	require.ErrorContains(t, err, "error compiling PathsChanged glob")
+}
+
+func TestFilterPathsChangedUnsupported(t *testing.T) {
+	provider, _ := NewFakeService(t.Context(), []*PullRequest{{Number: 1, Branch: "one"}}, nil)
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			PathsChanged: []string{"apps/**"},
+		},
+	}
+	_, err := ListPullRequests(t.Context(), provider, filters)
+	require.ErrorContains(t, err, "not supported")
+}
+
+func TestFilterLabels(t *testing.T) {
+	provider, _ := NewFakeService(
+		t.Context(),
+		[]*PullRequest{
+			{Number: 1, Branch: "none"},
+			{Number: 2, Branch: "team-a", Labels: []string{"team-a"}},
+			{Number: 3, Branch: "team-a-preview", Labels: []string{"team-a", "preview"}},
+			{Number: 4, Branch: "team-a-deploy", Labels: []string{"deploy", "team-a", "other"}},
+			{Number: 5, Branch: "team-b-preview", Labels: []string{"team-b", "preview"}},
+		},
+		nil,
+	)
+
+	tests := []struct {
+		name     string
+		filters  []argoprojiov1alpha1.PullRequestGeneratorFilter
+		expected []string
+	}{
+		{
+			name:     "all",
+			filters:  []argoprojiov1alpha1.PullRequestGeneratorFilter{{LabelsAll: []string{"team-a", "preview"}}},
+			expected: []string{"team-a-preview"},
+		},
+		{
+			name:     "any",
+			filters:  []argoprojiov1alpha1.PullRequestGeneratorFilter{{LabelsAny: []string{"preview", "deploy"}}},
+			expected: []string{"team-a-preview", "team-a-deploy", "team-b-preview"},
+		},
+		{
+			name: "all and any",
+			filters: []argoprojiov1alpha1.PullRequestGeneratorFilter{{
+				LabelsAll: []string{"team-a"},
+				LabelsAny: []string{"preview", "deploy"},
+			}},
+			expected: []string{"team-a-preview", "team-a-deploy"},
+		},
+		{
+			name: "either filter",
+			filters: []argoprojiov1alpha1.PullRequestGeneratorFilter{
+				{LabelsAll: []string{"team-b"}},
+				{LabelsAll: []string{"team-a"}, LabelsAny: []string{"deploy"}},
+			},
+			expected: []string{"team-a-deploy", "team-b-preview"},
+		},
+		{
+			name: "combined with branch match",
+			filters: []argoprojiov1alpha1.PullRequestGeneratorFilter{{
+				BranchMatch: strp(".*-preview"),
+				LabelsAny:   []string{"team-a", "team-c"},
+			}},
+			expected: []string{"team-a-preview"},
+		},
+		{
+			name:     "no match",
+			filters:  []argoprojiov1alpha1.PullRequestGeneratorFilter{{LabelsAll: []string{"team-a", "team-b"}}},
+			expected: []string{},
+		},
+		{
+			name:     "exclude",
+			filters:  []argoprojiov1alpha1.PullRequestGeneratorFilter{{ExcludeLabels: []string{"preview"}}},
+			expected: []string{"none", "team-a", "team-a-deploy"},
+		},
+		{
+			name: "include and exclude",
+			filters: []argoprojiov1alpha1.PullRequestGeneratorFilter{{
+				LabelsAll:     []string{"team-a"},
+				ExcludeLabels: []string{"other"},
+			}},
+			expected: []string{"team-a", "team-a-preview"},
+		},
+		{
+			name: "exclude wins over include",
+			filters: []argoprojiov1alpha1.PullRequestGeneratorFilter{{
+				LabelsAny:     []string{"preview", "deploy"},
+				ExcludeLabels: []string{"preview"},
+			}},
+			expected: []string{"team-a-deploy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pullRequests, err := ListPullRequests(t.Context(), provider, tt.filters)
+			require.NoError(t, err)
+			branches := make([]string, 0, len(pullRequests))
+			for _, pullRequest := range pullRequests {
+				branches = append(branches, pullRequest.Branch)
+			}
+			assert.Equal(t, tt.expected, branches)
+		})
+	}
+}
+
+func TestResolveMissingHeadBranches(t *testing.T) {
+	newPullRequests := func() []*PullRequest {
+		return []*PullRequest{
+			{Number: 1, Branch: "one", HeadSHA: "sha1"},
+			{Number: 2, HeadSHA: "sha2"},
+			{Number: 3},
+		}
+	}
+
+	for _, policy := range []string{"", MissingHeadBranchSkip} {
+		pullRequests, err := ResolveMissingHeadBranches(newPullRequests(), policy)
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, "one", pullRequests[0].Branch)
+	}
+
+	pullRequests, err := ResolveMissingHeadBranches(newPullRequests(), MissingHeadBranchUseHeadSHA)
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 2)
+	assert.Equal(t, "one", pullRequests[0].Branch)
+	// the head SHA is used in place of the missing branch, pull requests without head SHA are still skipped
+	assert.Equal(t, "sha2", pullRequests[1].Branch)
+
+	_, err = ResolveMissingHeadBranches(newPullRequests(), "fail")
+	require.ErrorContains(t, err, "unsupported missing head branch policy")
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/azure_devops"
 )
 
-const AZURE_DEVOPS_DEFAULT_URL = "https://dev.azure.com"
+const AZURE_DEVOPS_DEFAULT_URL = azure_devops.DefaultURL
 
 type azureDevOpsErrorTypeKeyValuesType struct {
 	GitRepositoryNotFound string
@@ -29,18 +31,6 @@ type AzureDevOpsClientFactory interface {
 	GetClient(ctx context.Context) (azureGit.Client, error)
 }
 
-type devopsFactoryImpl struct {
-	connection *azuredevops.Connection
-}
-
-func (factory *devopsFactoryImpl) GetClient(ctx context.Context) (azureGit.Client, error) {
-	gitClient, err := azureGit.NewClient(ctx, factory.connection)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get new Azure DevOps git client for SCM generator: %w", err)
-	}
-	return gitClient, nil
-}
-
 // Contains Azure Devops REST API implementation of SCMProviderService.
 // See https://docs.microsoft.com/en-us/rest/api/azure/devops
 
@@ -53,22 +43,24 @@ type AzureDevOpsProvider struct {
 
 var (
 	_ SCMProviderService       = &AzureDevOpsProvider{}
-	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
+	_ AzureDevOpsClientFactory = &azure_devops.ClientFactory{}
 )
 
-func NewAzureDevOpsProvider(accessToken string, org string, url string, project string, allBranches bool) (*AzureDevOpsProvider, error) {
+func NewAzureDevOpsProvider(accessToken string, org string, url string, project string, allBranches bool, scmRootCAPath string) (*AzureDevOpsProvider, error) {
 	if accessToken == "" {
 		return nil, errors.New("no access token provided")
 	}
 
-	devOpsURL, err := getValidDevOpsURL(url, org)
+	creds := azure_devops.Credentials{Token: accessToken, URL: url, Organization: org, ScmRootCAPath: scmRootCAPath}
+	if _, err := netUrl.ParseRequestURI(creds.OrganizationURL()); err != nil {
+		return nil, fmt.Errorf("got an invalid URL for the Azure SCM generator: %w", err)
+	}
+	factory, err := azure_devops.NewClientFactory(creds, "SCM generator", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	connection := azuredevops.NewPatConnection(devOpsURL, accessToken)
-
-	return &AzureDevOpsProvider{organization: org, teamProject: project, clientFactory: &devopsFactoryImpl{connection: connection}, allBranches: allBranches}, nil
+	return &AzureDevOpsProvider{organization: org, teamProject: project, clientFactory: factory, allBranches: allBranches}, nil
 }
 
 func (g *AzureDevOpsProvider) ListRepos(ctx context.Context, _ string) ([]*Repository, error) {
@@ -198,23 +190,3 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 
 	return repos, nil
 }
-
-func getValidDevOpsURL(url string, org string) (string, error) {
-	if url == "" {
-		url = AZURE_DEVOPS_DEFAULT_URL
-	}
-	separator := ""
-	if !strings.HasSuffix(url, "/") {
-		separator = "/"
-	}
-
-	devOpsURL := fmt.Sprintf("%s%s%s", url, separator, org)
-
-	urlCheck, err := netUrl.ParseRequestURI(devOpsURL)
-	if err != nil {
-		return "", fmt.Errorf("got an invalid URL for the Azure SCM generator: %w", err)
-	}
-
-	ret := urlCheck.String()
-	return ret, nil
-}
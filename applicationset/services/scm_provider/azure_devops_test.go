@@ -15,6 +15,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
 	azureGit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/azure_devops"
 	azureMock "github.com/argoproj/argo-cd/v3/applicationset/services/scm_provider/azure_devops/git/mocks"
 )
 
@@ -525,3 +526,17 @@ func (m *AzureClientFactoryMock) GetClient(ctx context.Context) (azureGit.Client
 
 	return client, err
 }
+
+func TestNewAzureDevOpsProviderSharedClientFactory(t *testing.T) {
+	provider, err := NewAzureDevOpsProvider("token", "myorganization", "https://azuredevops.example.com/", "project", false, "")
+	require.NoError(t, err)
+	factory, err := azure_devops.NewClientFactory(azure_devops.Credentials{Token: "token", URL: "https://azuredevops.example.com/", Organization: "myorganization"}, "SCM generator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, factory, provider.clientFactory)
+
+	_, err = NewAzureDevOpsProvider("", "myorganization", "", "project", false, "")
+	require.ErrorContains(t, err, "no access token provided")
+
+	_, err = NewAzureDevOpsProvider("token", "myorganization", "invalid", "project", false, "")
+	require.ErrorContains(t, err, "got an invalid URL for the Azure SCM generator")
+}
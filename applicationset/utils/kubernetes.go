@@ -2,9 +2,14 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/env"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -12,7 +17,13 @@ import (
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-var ErrDisallowedSecretAccess = fmt.Errorf("secret must have label %q=%q", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds)
+var (
+	ErrDisallowedSecretAccess = fmt.Errorf("secret must have label %q=%q", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds)
+	// ErrTokenFilesDisabled is returned when a token file is referenced but token files are not enabled on the controller
+	ErrTokenFilesDisabled = errors.New("token files are not enabled on the ApplicationSet controller")
+	// ErrDisallowedTokenFile is returned when a token file is referenced while the controller runs in token ref strict mode
+	ErrDisallowedTokenFile = errors.New("token files are not allowed in token ref strict mode")
+)
 
 // GetSecretRef gets the value of the key for the specified Secret resource.
 func GetSecretRef(ctx context.Context, k8sClient client.Client, ref *argoprojiov1alpha1.SecretRef, namespace string, tokenRefStrictMode bool) (string, error) {
@@ -43,6 +54,37 @@ func GetSecretRef(ctx context.Context, k8sClient client.Client, ref *argoprojiov
 	return string(tokenBytes), nil
 }
 
+// GetSecretRefOrFile gets the value of the key for the specified Secret resource or, if no Secret is referenced, the
+// content of the token file. An empty string is returned if neither is set. Token files are only read if they are enabled
+// on the controller and the controller does not run in token ref strict mode, since any ApplicationSet could otherwise
+// reference any credential mounted into the controller.
+func GetSecretRefOrFile(ctx context.Context, k8sClient client.Client, ref *argoprojiov1alpha1.SecretRef, file string, namespace string, tokenRefStrictMode bool, enableTokenFiles bool) (string, error) {
+	if ref != nil || file == "" {
+		return GetSecretRef(ctx, k8sClient, ref, namespace, tokenRefStrictMode)
+	}
+	if tokenRefStrictMode {
+		return "", fmt.Errorf("token file %q cannot be used: %w", file, ErrDisallowedTokenFile)
+	}
+	if !enableTokenFiles {
+		return "", fmt.Errorf("token file %q cannot be used: %w", file, ErrTokenFilesDisabled)
+	}
+	return GetTokenFromFile(file)
+}
+
+// GetTokenFromFile reads a token from a file, whose path is relative to the SCM tokens directory, without its trailing
+// newlines.
+func GetTokenFromFile(file string) (string, error) {
+	if !filepath.IsLocal(file) {
+		return "", fmt.Errorf("token file %q must be a relative path within the SCM tokens directory", file)
+	}
+	dir := env.StringFromEnv(common.EnvVarSCMTokensDataPath, common.DefaultPathSCMTokens)
+	data, err := os.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return "", fmt.Errorf("error reading token file %q: %w", file, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
 func GetConfigMapData(ctx context.Context, k8sClient client.Client, ref *argoprojiov1alpha1.ConfigMapKeyRef, namespace string) ([]byte, error) {
 	if ref == nil {
 		return nil, nil
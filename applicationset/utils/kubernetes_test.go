@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -77,6 +80,48 @@ func TestGetSecretRef(t *testing.T) {
 	}
 }
 
+func TestGetSecretRefOrFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(common.EnvVarSCMTokensDataPath, dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "github"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "github", "token"), []byte("file-token\n"), 0o600))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test"},
+		Data: map[string][]byte{
+			"my-token": []byte("secret"),
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(secret).Build()
+	ref := &argoprojiov1alpha1.SecretRef{SecretName: "test-secret", Key: "my-token"}
+
+	token, err := GetSecretRefOrFile(t.Context(), client, nil, "github/token", "test", false, true)
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+
+	token, err = GetSecretRefOrFile(t.Context(), client, ref, "github/token", "test", false, true)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", token, "the Secret takes precedence over the file")
+
+	token, err = GetSecretRefOrFile(t.Context(), client, nil, "", "test", false, true)
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	_, err = GetSecretRefOrFile(t.Context(), client, nil, "gitlab/token", "test", false, true)
+	require.ErrorContains(t, err, `error reading token file "gitlab/token"`)
+
+	_, err = GetSecretRefOrFile(t.Context(), client, nil, "github/token", "test", false, false)
+	require.ErrorIs(t, err, ErrTokenFilesDisabled)
+
+	_, err = GetSecretRefOrFile(t.Context(), client, nil, "github/token", "test", true, true)
+	require.ErrorIs(t, err, ErrDisallowedTokenFile, "token files are refused in strict mode even if they are enabled")
+
+	_, err = GetTokenFromFile("../token")
+	require.ErrorContains(t, err, "must be a relative path within the SCM tokens directory")
+	_, err = GetTokenFromFile(filepath.Join(dir, "github", "token"))
+	require.ErrorContains(t, err, "must be a relative path within the SCM tokens directory")
+}
+
 func TestGetConfigMapData(t *testing.T) {
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "test"},
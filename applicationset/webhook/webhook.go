@@ -401,7 +401,7 @@ func shouldRefreshPRGenerator(gen *v1alpha1.PullRequestGenerator, info *prGenera
 		if gen.AzureDevOps.Project != info.Azuredevops.Project {
 			return false
 		}
-		if gen.AzureDevOps.Repo != info.Azuredevops.Repo {
+		if gen.AzureDevOps.Repo != info.Azuredevops.Repo && !slices.Contains(gen.AzureDevOps.Repos, info.Azuredevops.Repo) {
 			return false
 		}
 		return true
@@ -38,6 +38,7 @@ import (
 
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -78,7 +79,12 @@ func NewCommand() *cobra.Command {
 		metricsAplicationsetLabels   []string
 		enableScmProviders           bool
 		webhookParallelism           int
+		scmMaxIdleConns              int
+		scmIdleConnTimeout           time.Duration
+		scmTraceRequests             bool
 		tokenRefStrictMode           bool
+		enableScmTokenFiles          bool
+		pullRequestRequeueJitter     float64
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -177,7 +183,15 @@ func NewCommand() *cobra.Command {
 			argoSettingsMgr := argosettings.NewSettingsManager(ctx, k8sClient, namespace)
 			argoCDDB := db.NewDB(namespace, argoSettingsMgr, k8sClient)
 
-			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, enableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), tokenRefStrictMode)
+			pullrequest.SetTransportConfig(pullrequest.TransportConfig{
+				MaxIdleConns:    scmMaxIdleConns,
+				IdleConnTimeout: scmIdleConnTimeout,
+				TraceRequests:   scmTraceRequests,
+			})
+			if pullRequestRequeueJitter < 0 || pullRequestRequeueJitter >= 1 {
+				return fmt.Errorf("--pull-request-requeue-jitter must be at least 0 and less than 1, got %v", pullRequestRequeueJitter)
+			}
+			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, enableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), tokenRefStrictMode, enableScmTokenFiles, pullRequestRequeueJitter)
 
 			tlsConfig := apiclient.TLSConfiguration{
 				DisableTLS:       repoServerPlaintext,
@@ -196,7 +210,7 @@ func NewCommand() *cobra.Command {
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, repoServerTimeoutSeconds, tlsConfig)
 			argoCDService := services.NewArgoCDService(argoCDDB, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, argoSettingsMgr)
 
 			// start a webhook server that listens to incoming webhook payloads
 			webhookHandler, err := webhook.NewWebhookHandler(webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
@@ -263,6 +277,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&enableScmProviders, "enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
 	command.Flags().BoolVar(&dryRun, "dry-run", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DRY_RUN", false), "Enable dry run mode")
 	command.Flags().BoolVar(&tokenRefStrictMode, "token-ref-strict-mode", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TOKENREF_STRICT_MODE", false), fmt.Sprintf("Set to true to require secrets referenced by SCM providers to have the %s=%s label set (Default: false)", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds))
+	command.Flags().BoolVar(&enableScmTokenFiles, "enable-scm-token-files", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_TOKEN_FILES", false), "Allow SCM and PR generators to read credentials from token files mounted into the controller. Token files are always refused in token ref strict mode (Default: false)")
 	command.Flags().BoolVar(&enableProgressiveSyncs, "enable-progressive-syncs", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_PROGRESSIVE_SYNCS", false), "Enable use of the experimental progressive syncs feature.")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
@@ -270,6 +285,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
 	command.Flags().IntVar(&maxConcurrentReconciliations, "concurrent-reconciliations", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CONCURRENT_RECONCILIATIONS", 10, 1, math.MaxInt), "Max concurrent reconciliations limit for the controller")
 	command.Flags().StringVar(&scmRootCAPath, "scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
+	command.Flags().IntVar(&scmMaxIdleConns, "scm-max-idle-conns", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_MAX_IDLE_CONNS", pullrequest.DefaultMaxIdleConns, 0, math.MaxInt32), "Maximum number of idle connections kept open to an SCM provider by the pull request generator. Zero means no limit")
+	command.Flags().DurationVar(&scmIdleConnTimeout, "scm-idle-conn-timeout", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_IDLE_CONN_TIMEOUT", pullrequest.DefaultIdleConnTimeout, 0, math.MaxInt64), "Time an idle connection to an SCM provider is kept open by the pull request generator. Zero means no limit")
+	command.Flags().BoolVar(&scmTraceRequests, "scm-trace-requests", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_TRACE_REQUESTS", false), "Log the DNS, connect, TLS handshake and time to first byte timings of the requests of the pull request generator to the SCM providers at trace log level")
+	// the jitter must be less than 1, so the largest value accepted from the environment is the largest float below 1
+	command.Flags().Float64Var(&pullRequestRequeueJitter, "pull-request-requeue-jitter", env.ParseFloat64FromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_PULL_REQUEST_REQUEUE_JITTER", 0, 0, math.Nextafter(1, 0)), "Fraction by which the requeue interval of the pull request generator is randomly lengthened or shortened, to spread the requests of ApplicationSets polling on identical intervals. Zero disables the jitter")
 	command.Flags().StringSliceVar(&globalPreservedAnnotations, "preserved-annotations", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_ANNOTATIONS", []string{}, ","), "Sets global preserved field values for annotations")
 	command.Flags().StringSliceVar(&globalPreservedLabels, "preserved-labels", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_LABELS", []string{}, ","), "Sets global preserved field values for labels")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
@@ -1,36 +1,67 @@
 package commands
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"os"
+	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"text/template"
 	"time"
 
-	humanize "github.com/dustin/go-humanize"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 )
 
+type retryOpts struct {
+	retries int
+	backoff time.Duration
+}
+
+type waitOpts struct {
+	wait    bool
+	timeout time.Duration
+}
+
+// projectWaitPollInterval is the delay between two checks of whether the controller has observed a project update
+const projectWaitPollInterval = 2 * time.Second
+
 type policyOpts struct {
 	action     string
 	permission string
@@ -62,8 +93,12 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.AddCommand(NewProjectRoleCommand(clientOpts))
+	command.AddCommand(NewProjectTokenCommand(clientOpts))
 	command.AddCommand(NewProjectCreateCommand(clientOpts))
+	command.AddCommand(NewProjectImportCommand(clientOpts))
+	command.AddCommand(NewProjectValidateCommand())
 	command.AddCommand(NewProjectGetCommand(clientOpts))
+	command.AddCommand(NewProjectDescribeCommand(clientOpts))
 	command.AddCommand(NewProjectDeleteCommand(clientOpts))
 	command.AddCommand(NewProjectListCommand(clientOpts))
 	command.AddCommand(NewProjectSetCommand(clientOpts))
@@ -72,6 +107,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
+	command.AddCommand(NewProjectMigrateDestinationsCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowClusterResourceCommand(clientOpts))
@@ -85,6 +121,8 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveSourceNamespace(clientOpts))
 	command.AddCommand(NewProjectAddDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationServiceAccountCommand(clientOpts))
+	command.AddCommand(NewProjectCanICommand(clientOpts))
+	command.AddCommand(NewProjectSetDestinationServiceAccountCommand(clientOpts))
 	return command
 }
 
@@ -95,17 +133,104 @@ func addPolicyFlags(command *cobra.Command, opts *policyOpts) {
 	command.Flags().StringVarP(&opts.resource, "resource", "r", "applications", "Resource e.g. 'applications', 'applicationsets', 'logs', 'exec', etc.")
 }
 
-func humanizeTimestamp(epoch int64) string {
-	ts := time.Unix(epoch, 0)
-	return fmt.Sprintf("%s (%s)", ts.Format(time.RFC3339), humanize.Time(ts))
+func addRetryFlags(command *cobra.Command, opts *retryOpts) {
+	command.Flags().IntVar(&opts.retries, "retry", 0, "Number of times to retry the request if it fails with a transient error")
+	command.Flags().DurationVar(&opts.backoff, "retry-backoff", time.Second, "Delay before the first retry, doubled after each subsequent attempt")
+}
+
+// isRetryableError returns true if the error is likely transient, e.g. the API server is temporarily unreachable. With
+// --grpc-web, the gRPC-web proxy of the client reports unreachable servers and proxy errors such as 502, 503 and 504 as
+// unavailable too.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+	var netErr net.Error
+	return stderrors.As(err, &netErr) || stderrors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// runWithRetry calls fn until it succeeds, fails with a terminal error or the number of retries is exhausted. It must
+// only be used for idempotent requests.
+func runWithRetry(ctx context.Context, opts retryOpts, fn func() error) error {
+	backoff := opts.backoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt > opts.retries || !isRetryableError(err) {
+			return err
+		}
+		log.Warnf("Request failed with a transient error, retrying in %s (%d/%d): %v", backoff, attempt, opts.retries, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func addWaitFlags(command *cobra.Command, opts *waitOpts) {
+	command.Flags().BoolVar(&opts.wait, "wait", false, "Wait until the application controller has observed the updated project")
+	command.Flags().DurationVar(&opts.timeout, "wait-timeout", time.Minute, "Maximum time to wait for the application controller when --wait is set")
+}
+
+// updateProject updates the project and, if requested, waits until the application controller has observed the update
+func updateProject(ctx context.Context, projIf projectpkg.ProjectServiceClient, proj *v1alpha1.AppProject, opts waitOpts) error {
+	return updateProjectWithRequest(ctx, projIf, &projectpkg.ProjectUpdateRequest{Project: proj}, opts)
+}
+
+// updateProjectWithRequest is like updateProject, but sends the given update request
+func updateProjectWithRequest(ctx context.Context, projIf projectpkg.ProjectServiceClient, req *projectpkg.ProjectUpdateRequest, opts waitOpts) error {
+	updated, err := projIf.Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !opts.wait {
+		return nil
+	}
+	return waitForProjectObserved(ctx, projIf, updated.Name, updated.Generation, opts.timeout, projectWaitPollInterval)
+}
+
+// waitForProjectObserved polls the project until the application controller has observed the given generation of it,
+// failing once the timeout elapses
+func waitForProjectObserved(ctx context.Context, projIf projectpkg.ProjectServiceClient, projName string, generation int64, timeout, interval time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil {
+			observed, _ := strconv.ParseInt(proj.Annotations[common.AnnotationKeyObservedGeneration], 10, 64)
+			if observed >= generation {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for the application controller to observe generation %d of project '%s'", timeout, generation, projName)
+		case <-time.After(interval):
+		}
+	}
 }
 
 // NewProjectCreateCommand returns a new instance of an `argocd proj create` command
 func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		opts    cmdutil.ProjectOpts
-		fileURL string
-		upsert  bool
+		opts           cmdutil.ProjectOpts
+		fileURL        string
+		upsert         bool
+		fromTemplate   string
+		allowWildcard  bool
+		allowAdminRole bool
 	)
 	command := &cobra.Command{
 		Use:   "create PROJECT",
@@ -116,32 +241,134 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 
 			# Create a new project with name PROJECT from a file or URL to a Kubernetes manifest
 			argocd proj create PROJECT -f FILE|URL
+
+			# Create a new project with name PROJECT from the template project TEMPLATE, overriding its description
+			argocd proj create PROJECT --from-template TEMPLATE --description "My project"
+
+			# Create a new labeled project with name PROJECT, e.g. to match a global project
+			argocd proj create PROJECT --label opt=me
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			proj, err := cmdutil.ConstructAppProj(fileURL, args, opts, c)
-			errors.CheckError(err)
-
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
-			_, err = projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: upsert})
+
+			var proj *v1alpha1.AppProject
+			var err error
+			if fromTemplate != "" {
+				template, getErr := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: fromTemplate})
+				if getErr != nil {
+					errors.CheckError(fmt.Errorf("failed to get template project '%s': %w", fromTemplate, getErr))
+				}
+				proj, err = cmdutil.ConstructAppProjFromTemplate(template, args, opts, c)
+			} else {
+				proj, err = cmdutil.ConstructAppProj(fileURL, args, opts, c)
+			}
+			errors.CheckError(err)
+
+			_, err = projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: upsert, AllowWildcard: allowWildcard, AllowAdminRole: allowAdminRole})
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override a project with the same name even if supplied project spec is different from existing spec")
+	command.Flags().BoolVar(&allowWildcard, "allow-wildcard", false, "Allow the source repository '*' and destinations permitting every cluster and namespace, if guarded by the projects.wildcardPolicy setting")
+	command.Flags().BoolVar(&allowAdminRole, "allow-admin-role", false, "Allow role policies granting every action on every object of the project, if guarded by the projects.adminRolePolicy setting")
 	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the project")
 	err := command.Flags().SetAnnotation("file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
 	if err != nil {
 		log.Fatal(err)
 	}
+	command.Flags().StringVar(&fromTemplate, "from-template", "", "Name of a project labeled with "+common.LabelKeyProjectTemplate+"=true to use as base spec for the project")
+	command.MarkFlagsMutuallyExclusive("file", "from-template")
 	cmdutil.AddProjFlags(command, &opts)
 	return command
 }
 
+// NewProjectImportCommand returns a new instance of an `argocd proj import` command
+func NewProjectImportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		prune    bool
+		yes      bool
+		selector string
+		retry    retryOpts
+	)
+	command := &cobra.Command{
+		Use:   "import FILE|URL...",
+		Short: "Create or update projects from Kubernetes manifests",
+		Example: templates.Examples(`
+			# Create or update the projects defined in the manifests
+			argocd proj import projects.yaml team-a.yaml
+
+			# Also delete the projects which are not defined in the manifests
+			argocd proj import projects.yaml --prune --yes
+
+			# Only delete projects with a matching label which are not defined in the manifests
+			argocd proj import projects.yaml --prune -l managed-by=platform --yes
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) == 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if prune && !yes {
+				errors.CheckError(stderrors.New("pruning projects requires --yes"))
+			}
+			if selector != "" && !prune {
+				errors.CheckError(stderrors.New("--selector can only be used with --prune"))
+			}
+			projs, err := cmdutil.ReadAppProjs(args)
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			for _, proj := range projs {
+				_, err := projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: true})
+				errors.CheckError(err)
+				fmt.Printf("project '%s' imported\n", proj.Name)
+			}
+			if !prune {
+				return
+			}
+			projects, err := listProjects(ctx, projIf, retry)
+			errors.CheckError(err)
+			names, err := getProjectNamesToPrune(projects.Items, projs, selector)
+			errors.CheckError(err)
+			errors.CheckError(deleteProjects(ctx, projIf, names))
+		},
+	}
+	command.Flags().BoolVar(&prune, "prune", false, "Delete the projects which are not defined in the manifests, except the default project. Requires --yes")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Turn off prompting and confirm the deletion of pruned projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only prune projects with matching label. Supports '=', '==', '!=', in, notin, exists & not exists")
+	addRetryFlags(command, &retry)
+	return command
+}
+
+// getProjectNamesToPrune returns the names of the projects matching the label selector which are not among the
+// imported projects. The default project is never returned since it cannot be deleted.
+func getProjectNamesToPrune(projects []v1alpha1.AppProject, imported []*v1alpha1.AppProject, selector string) ([]string, error) {
+	names, err := getProjectNamesBySelector(projects, selector)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(names, func(name string) bool {
+		return slices.ContainsFunc(imported, func(proj *v1alpha1.AppProject) bool {
+			return proj.Name == name
+		})
+	}), nil
+}
+
 // NewProjectSetCommand returns a new instance of an `argocd proj set` command
 func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var opts cmdutil.ProjectOpts
+	var (
+		opts          cmdutil.ProjectOpts
+		wait          waitOpts
+		force         bool
+		allowWildcard bool
+	)
 	command := &cobra.Command{
 		Use:   "set PROJECT",
 		Short: "Set project parameters",
@@ -151,6 +378,18 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 			# Set project parameters with some denied namespaced resources [RES1,RES2,...] for project with name PROJECT
 			argocd proj set PROJECT ---deny-namespaced-resource [RES1,RES2,...]
+
+			# Replace all permitted destinations of the project with the given one (default)
+			argocd proj set PROJECT --dest https://kubernetes.default.svc,default
+
+			# Add a permitted destination to the project, keeping the existing ones
+			argocd proj set PROJECT --merge --dest https://kubernetes.default.svc,default
+
+			# Label the project, e.g. to match a global project
+			argocd proj set PROJECT --label opt=me
+
+			# Add an annotation to the applications of the project which do not have it yet
+			argocd proj set PROJECT --propagate-annotation team=payments
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -165,23 +404,53 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
+			origProj := proj.DeepCopy()
 
 			if visited := cmdutil.SetProjSpecOptions(c.Flags(), &proj.Spec, &opts); visited == 0 {
 				log.Error("Please set at least one option to update")
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			errors.CheckError(cmdutil.SetProjLabels(c.Flags(), proj, &opts))
+			for _, warning := range orphanedIgnoreConflictWarnings(proj) {
+				log.Warn(warning)
+			}
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			if !force && projectUnchanged(origProj, proj) {
+				fmt.Printf("Project '%s' unchanged\n", projName)
+				return
+			}
+			err = updateProjectWithRequest(ctx, projIf, &projectpkg.ProjectUpdateRequest{Project: proj, AllowWildcard: allowWildcard}, wait)
 			errors.CheckError(err)
 		},
 	}
 	cmdutil.AddProjFlags(command, &opts)
+	cmdutil.AddProjSetFlags(command, &opts)
+	addWaitFlags(command, &wait)
+	command.Flags().BoolVar(&force, "force", false, "Update the project even if the options do not change it")
+	command.Flags().BoolVar(&allowWildcard, "allow-wildcard", false, "Allow the source repository '*' and destinations permitting every cluster and namespace, if guarded by the projects.wildcardPolicy setting")
 	return command
 }
 
+// orphanedIgnoreConflictWarnings returns a warning for each orphaned resources ignore entry of the project which overlaps
+// the cluster or namespace resource blacklist
+func orphanedIgnoreConflictWarnings(proj *v1alpha1.AppProject) []string {
+	var warnings []string
+	for _, key := range proj.OrphanedResourcesIgnoreConflicts() {
+		warnings = append(warnings, fmt.Sprintf("Orphaned resources ignore entry with group '%s', kind '%s' and name '%s' overlaps the resource blacklist of the project", key.Group, key.Kind, key.Name))
+	}
+	return warnings
+}
+
+// projectUnchanged returns whether the spec and labels of the updated project equal those of the original project, in
+// which case updating it would only produce a redundant audit event
+func projectUnchanged(orig, updated *v1alpha1.AppProject) bool {
+	return reflect.DeepEqual(orig.Spec, updated.Spec) && maps.Equal(orig.Labels, updated.Labels)
+}
+
 // NewProjectAddSignatureKeyCommand returns a new instance of an `argocd proj add-signature-key` command
 func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "add-signature-key PROJECT KEY-ID",
 		Short: "Add GnuPG signature key to project",
@@ -215,15 +484,17 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 				}
 			}
 			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, v1alpha1.SignatureKey{KeyID: signatureKey})
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectRemoveSignatureKeyCommand returns a new instance of an `argocd proj remove-signature-key` command
 func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "remove-signature-key PROJECT KEY-ID",
 		Short: "Remove GnuPG signature key from project",
@@ -258,17 +529,21 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 				log.Fatal("Specified signature key is not configured for project")
 			}
 			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys[:index], proj.Spec.SignatureKeys[index+1:]...)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectAddDestinationCommand returns a new instance of an `argocd proj add-destination` command
 func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var nameInsteadServer bool
+	var (
+		nameInsteadServer bool
+		wait              waitOpts
+	)
 
 	buildApplicationDestination := func(destination string, namespace string, nameInsteadServer bool) v1alpha1.ApplicationDestination {
 		if nameInsteadServer {
@@ -278,18 +553,39 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 	}
 
 	command := &cobra.Command{
-		Use:   "add-destination PROJECT SERVER/NAME NAMESPACE",
+		Use:   "add-destination PROJECT SERVER/NAME NAMESPACE | add-destination PROJECT -",
 		Short: "Add project destination",
+		Long:  "Add project destination. With '-', destinations are read from stdin, one 'SERVER,NAMESPACE' or 'NAME,NAMESPACE,--name' per line. Destinations already defined in the project are skipped.",
 		Example: templates.Examples(`
 			# Add project destination using a server URL (SERVER) in the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj add-destination PROJECT SERVER NAMESPACE
 
 			# Add project destination using a server name (NAME) in the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj add-destination PROJECT NAME NAMESPACE --name
+
+			# Add the destinations listed in a file, one per line
+			cat destinations.txt | argocd proj add-destination PROJECT -
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
+			if len(args) == 2 && args[1] == "-" {
+				destinations, err := readDestinations(os.Stdin, nameInsteadServer)
+				errors.CheckError(err)
+				conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+				defer utilio.Close(conn)
+
+				proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: args[0]})
+				errors.CheckError(err)
+
+				added, skipped := addDestinations(proj, destinations)
+				if added > 0 {
+					err = updateProject(ctx, projIf, proj, wait)
+					errors.CheckError(err)
+				}
+				fmt.Printf("Added %d destination(s) to project '%s', skipped %d already defined\n", added, proj.Name, skipped)
+				return
+			}
 			if len(args) != 3 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
@@ -311,16 +607,69 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 				}
 			}
 			proj.Spec.Destinations = append(proj.Spec.Destinations, destination)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().BoolVar(&nameInsteadServer, "name", false, "Use name as destination instead server")
+	addWaitFlags(command, &wait)
 	return command
 }
 
+// readDestinations reads destinations, one 'SERVER,NAMESPACE' or 'NAME,NAMESPACE,--name' per line. Lines with two
+// fields use a name instead of a server if nameInsteadServer is set. Empty lines and lines starting with '#' are ignored.
+func readDestinations(r io.Reader, nameInsteadServer bool) ([]v1alpha1.ApplicationDestination, error) {
+	var destinations []v1alpha1.ApplicationDestination
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.Split(line, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		useName := nameInsteadServer
+		if len(parts) == 3 && parts[2] == "--name" {
+			useName = true
+			parts = parts[:2]
+		}
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("line %d: expected destination of the form SERVER,NAMESPACE or NAME,NAMESPACE,--name, got '%s'", lineNum, line)
+		}
+		if useName {
+			destinations = append(destinations, v1alpha1.ApplicationDestination{Name: parts[0], Namespace: parts[1]})
+		} else {
+			destinations = append(destinations, v1alpha1.ApplicationDestination{Server: parts[0], Namespace: parts[1]})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading destinations: %w", err)
+	}
+	return destinations, nil
+}
+
+// addDestinations adds the destinations to the project, skipping those it already defines, and returns the number of
+// added and skipped destinations
+func addDestinations(proj *v1alpha1.AppProject, destinations []v1alpha1.ApplicationDestination) (added int, skipped int) {
+	for _, destination := range destinations {
+		exists := slices.ContainsFunc(proj.Spec.Destinations, func(dest v1alpha1.ApplicationDestination) bool {
+			return dest.Server == destination.Server && dest.Name == destination.Name && dest.Namespace == destination.Namespace
+		})
+		if exists {
+			skipped++
+			continue
+		}
+		proj.Spec.Destinations = append(proj.Spec.Destinations, destination)
+		added++
+	}
+	return added, skipped
+}
+
 // NewProjectRemoveDestinationCommand returns a new instance of an `argocd proj remove-destination` command
 func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "remove-destination PROJECT SERVER NAMESPACE",
 		Short: "Remove project destination",
@@ -355,17 +704,21 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 				log.Fatal("Specified destination does not exist in project")
 			}
 			proj.Spec.Destinations = append(proj.Spec.Destinations[:index], proj.Spec.Destinations[index+1:]...)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectAddOrphanedIgnoreCommand returns a new instance of an `argocd proj add-orphaned-ignore` command
 func NewProjectAddOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var name string
+	var (
+		name string
+		wait waitOpts
+	)
 	command := &cobra.Command{
 		Use:   "add-orphaned-ignore PROJECT GROUP KIND",
 		Short: "Add a resource to orphaned ignore list",
@@ -405,17 +758,21 @@ func NewProjectAddOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions)
 				}
 				proj.Spec.OrphanedResources.Ignore = append(proj.Spec.OrphanedResources.Ignore, v1alpha1.OrphanedResourceKey{Group: group, Kind: kind, Name: name})
 			}
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().StringVar(&name, "name", "", "Resource name pattern")
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectRemoveOrphanedIgnoreCommand returns a new instance of an `argocd proj remove-orphaned-ignore` command
 func NewProjectRemoveOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var name string
+	var (
+		name string
+		wait waitOpts
+	)
 	command := &cobra.Command{
 		Use:   "remove-orphaned-ignore PROJECT GROUP KIND",
 		Short: "Remove a resource from orphaned ignore list",
@@ -458,22 +815,34 @@ func NewProjectRemoveOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOption
 				log.Fatal("Specified resource does not exist in the orphaned ignore of project")
 			}
 			proj.Spec.OrphanedResources.Ignore = append(proj.Spec.OrphanedResources.Ignore[:index], proj.Spec.OrphanedResources.Ignore[index+1:]...)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().StringVar(&name, "name", "", "Resource name pattern")
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectAddSourceCommand returns a new instance of an `argocd proj add-src` command
 func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		validate     bool
+		normalizeSSH bool
+		wait         waitOpts
+	)
 	command := &cobra.Command{
 		Use:   "add-source PROJECT URL",
 		Short: "Add project source repository",
 		Example: templates.Examples(`
 			# Add a source repository (URL) to the project with name PROJECT
 			argocd proj add-source PROJECT URL
+
+			# Check that the repository is reachable with the configured credentials before adding it
+			argocd proj add-source PROJECT URL --validate
+
+			# Add a scp-like SSH URL in its ssh:// form, i.e. ssh://git@github.com/org/repo.git
+			argocd proj add-source PROJECT git@github.com:org/repo.git --normalize-ssh
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -483,7 +852,8 @@ func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 				os.Exit(1)
 			}
 			projName := args[0]
-			url := args[1]
+			url, err := sourceRepoURL(args[1], normalizeSSH)
+			errors.CheckError(err)
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
@@ -500,16 +870,56 @@ func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 					return
 				}
 			}
+			if validate {
+				repoConn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoClientOrDie()
+				defer utilio.Close(repoConn)
+				errors.CheckError(validateSourceRepo(ctx, repoIf, projName, url))
+			}
 			proj.Spec.SourceRepos = append(proj.Spec.SourceRepos, url)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
+	command.Flags().BoolVar(&validate, "validate", false, "Verify that the source repository is reachable with the configured credentials before adding it")
+	command.Flags().BoolVar(&normalizeSSH, "normalize-ssh", false, "Convert a scp-like SSH URL (e.g. git@github.com:org/repo.git) to the ssh:// form before adding it")
+	addWaitFlags(command, &wait)
 	return command
 }
 
+// sourceRepoURL validates a source repository URL given to add-source, rejecting malformed SSH URLs, and converts a
+// scp-like SSH URL to the ssh:// form if normalizeSSH is true. A leading '!' of a deny pattern is kept.
+func sourceRepoURL(url string, normalizeSSH bool) (string, error) {
+	repo, deny := strings.CutPrefix(url, "!")
+	if err := git.ValidateSSHURL(repo); err != nil {
+		return "", err
+	}
+	if normalizeSSH {
+		repo = git.CanonicalSSHURL(repo)
+	}
+	if deny {
+		return "!" + repo, nil
+	}
+	return repo, nil
+}
+
+// validateSourceRepo asks the repo server to list the refs of the given repository, which fails early if the
+// repository does not exist or the configured credentials are not accepted. Glob patterns cannot be validated and
+// are skipped.
+func validateSourceRepo(ctx context.Context, repoIf repositorypkg.RepositoryServiceClient, projName, url string) error {
+	if strings.ContainsAny(url, "*?[") {
+		log.Warnf("Skipping validation of source repository pattern '%s'", url)
+		return nil
+	}
+	_, err := repoIf.ListRefs(ctx, &repositorypkg.RepoQuery{Repo: url, AppProject: projName})
+	if err != nil {
+		return fmt.Errorf("failed to validate source repository '%s': %w", url, err)
+	}
+	return nil
+}
+
 // NewProjectAddSourceNamespace returns a new instance of an `argocd proj add-source-namespace` command
 func NewProjectAddSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "add-source-namespace PROJECT NAMESPACE",
 		Short: "Add source namespace to the AppProject",
@@ -539,15 +949,17 @@ func NewProjectAddSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra
 				}
 			}
 			proj.Spec.SourceNamespaces = append(proj.Spec.SourceNamespaces, srcNamespace)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectRemoveSourceNamespace returns a new instance of an `argocd proj remove-source-namespace` command
 func NewProjectRemoveSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "remove-source-namespace PROJECT NAMESPACE",
 		Short: "Removes the source namespace from the AppProject",
@@ -581,12 +993,13 @@ func NewProjectRemoveSourceNamespace(clientOpts *argocdclient.ClientOptions) *co
 				fmt.Printf("Source namespace '%s' does not exist in project or cannot be removed\n", srcNamespace)
 			} else {
 				proj.Spec.SourceNamespaces = append(proj.Spec.SourceNamespaces[:index], proj.Spec.SourceNamespaces[index+1:]...)
-				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				err = updateProject(ctx, projIf, proj, wait)
 				errors.CheckError(err)
 			}
 		},
 	}
 
+	addWaitFlags(command, &wait)
 	return command
 }
 
@@ -622,6 +1035,7 @@ func modifyResourceListCmd(cmdUse, cmdDesc, examples string, clientOpts *argocdc
 	var (
 		listType    string
 		defaultList string
+		wait        waitOpts
 	)
 	if namespacedList {
 		defaultList = "deny"
@@ -667,12 +1081,13 @@ func modifyResourceListCmd(cmdUse, cmdDesc, examples string, clientOpts *argocdc
 			}
 
 			if modifyResourcesList(list, add, listAction+" "+listDesc, group, kind) {
-				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				err = updateProject(ctx, projIf, proj, wait)
 				errors.CheckError(err)
 			}
 		},
 	}
 	command.Flags().StringVarP(&listType, "list", "l", defaultList, "Use deny list or allow list. This can only be 'allow' or 'deny'")
+	addWaitFlags(command, &wait)
 	return command
 }
 
@@ -722,6 +1137,7 @@ func NewProjectAllowClusterResourceCommand(clientOpts *argocdclient.ClientOption
 
 // NewProjectRemoveSourceCommand returns a new instance of an `argocd proj remove-src` command
 func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "remove-source PROJECT URL",
 		Short: "Remove project source repository",
@@ -755,36 +1171,71 @@ func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobr
 				fmt.Printf("Source repository '%s' does not exist in project\n", url)
 			} else {
 				proj.Spec.SourceRepos = append(proj.Spec.SourceRepos[:index], proj.Spec.SourceRepos[index+1:]...)
-				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				err = updateProject(ctx, projIf, proj, wait)
 				errors.CheckError(err)
 			}
 		},
 	}
 
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectDeleteCommand returns a new instance of an `argocd proj delete` command
 func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		selector string
+		all      bool
+		yes      bool
+		retry    retryOpts
+	)
 	command := &cobra.Command{
 		Use:   "delete PROJECT",
 		Short: "Delete project",
 		Example: templates.Examples(`
 			# Delete the project with name PROJECT
 			argocd proj delete PROJECT
+
+			# Delete all projects with a matching label
+			argocd proj delete -l team=ephemeral --yes
+
+			# Delete all projects except the default project
+			argocd proj delete --all --yes
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) == 0 {
+			if len(args) == 0 && selector == "" && !all {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-
-			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			if len(args) > 0 && (selector != "" || all) {
+				errors.CheckError(stderrors.New("project names cannot be combined with --selector or --all"))
+			}
+			if selector != "" && all {
+				errors.CheckError(stderrors.New("--selector and --all are mutually exclusive"))
+			}
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
+
+			if selector != "" || all {
+				if !yes {
+					errors.CheckError(stderrors.New("deleting projects by --selector or --all requires --yes"))
+				}
+				projects, err := listProjects(ctx, projIf, retry)
+				errors.CheckError(err)
+				names, err := getProjectNamesBySelector(projects.Items, selector)
+				errors.CheckError(err)
+				if len(names) == 0 {
+					fmt.Println("No matching projects found")
+					return
+				}
+				errors.CheckError(deleteProjects(ctx, projIf, names))
+				return
+			}
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled && !yes)
 			for _, name := range args {
 				canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete %s? [y/n]", name))
 				if canDelete {
@@ -796,9 +1247,44 @@ func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			}
 		},
 	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Delete all projects with matching label. Supports '=', '==', '!=', in, notin, exists & not exists. Requires --yes")
+	command.Flags().BoolVar(&all, "all", false, "Delete all projects except the default project. Requires --yes")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Turn off prompting and confirm the deletion of multiple projects")
+	addRetryFlags(command, &retry)
 	return command
 }
 
+// getProjectNamesBySelector returns the names of the projects matching the label selector, or of all projects if the
+// selector is empty. The default project is never returned since it cannot be deleted.
+func getProjectNamesBySelector(projects []v1alpha1.AppProject, selector string) ([]string, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+	}
+	var names []string
+	for _, p := range projects {
+		if p.Name != v1alpha1.DefaultAppProjectName && sel.Matches(labels.Set(p.Labels)) {
+			names = append(names, p.Name)
+		}
+	}
+	return names, nil
+}
+
+// deleteProjects deletes the projects one by one and prints each deleted project. A project which cannot be deleted,
+// e.g. because applications still reference it, does not stop the deletion of the others; its error is returned.
+func deleteProjects(ctx context.Context, projIf projectpkg.ProjectServiceClient, names []string) error {
+	var errs []error
+	for _, name := range names {
+		if _, err := projIf.Delete(ctx, &projectpkg.ProjectQuery{Name: name}); err != nil {
+			fmt.Printf("project '%s' could not be deleted: %v\n", name, err)
+			errs = append(errs, fmt.Errorf("failed to delete project '%s': %w", name, err))
+			continue
+		}
+		fmt.Printf("project '%s' deleted\n", name)
+	}
+	return stderrors.Join(errs...)
+}
+
 // Print list of project names
 func printProjectNames(projects []v1alpha1.AppProject) {
 	for _, p := range projects {
@@ -806,10 +1292,13 @@ func printProjectNames(projects []v1alpha1.AppProject) {
 	}
 }
 
+// projectTableHeader is the header of the table printed by printProjectLine
+const projectTableHeader = "NAME\tDESCRIPTION\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\tSIGNATURE-KEYS\tORPHANED-RESOURCES\tDESTINATION-SERVICE-ACCOUNTS"
+
 // Print table of project info
 func printProjectTable(projects []v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "NAME\tDESCRIPTION\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\tSIGNATURE-KEYS\tORPHANED-RESOURCES\tDESTINATION-SERVICE-ACCOUNTS\n")
+	fmt.Fprintln(w, projectTableHeader)
 	for _, p := range projects {
 		printProjectLine(w, &p)
 	}
@@ -818,7 +1307,11 @@ func printProjectTable(projects []v1alpha1.AppProject) {
 
 // NewProjectListCommand returns a new instance of an `argocd proj list` command
 func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output string
+		retry  retryOpts
+		watch  bool
+	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List projects",
@@ -828,13 +1321,26 @@ func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 
 			# List all available projects in yaml format
 			argocd proj list -o yaml
+
+			# List only the project names, one per line, e.g. to pipe them into another command
+			argocd proj list -o name | xargs -n 1 argocd proj get
+
+			# Watch the projects, printing a row for every added, modified or deleted project
+			argocd proj list --watch
 		`),
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
-			projects, err := projIf.List(ctx, &projectpkg.ProjectQuery{})
+			if watch {
+				if output != "wide" && output != "" {
+					errors.CheckError(fmt.Errorf("output format %s is not supported with --watch", output))
+				}
+				errors.CheckError(watchProjects(ctx, projIf, os.Stdout))
+				return
+			}
+			projects, err := listProjects(ctx, projIf, retry)
 			errors.CheckError(err)
 			switch output {
 			case "yaml", "json":
@@ -850,14 +1356,55 @@ func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the projects and print a row for every change until interrupted. Only supports the wide output format")
+	addRetryFlags(command, &retry)
 	return command
 }
 
+// watchProjects prints a table row for every event of the project watch stream, starting with the existing projects,
+// until the stream ends or the context is canceled
+func watchProjects(ctx context.Context, projIf projectpkg.ProjectServiceClient, out io.Writer) error {
+	stream, err := projIf.Watch(ctx, &projectpkg.ProjectQuery{})
+	if err != nil {
+		return fmt.Errorf("failed to watch projects: %w", err)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "EVENT\t%s\n", projectTableHeader)
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if stderrors.Is(err, io.EOF) || ctx.Err() != nil || status.Code(err) == codes.Canceled {
+				return w.Flush()
+			}
+			return fmt.Errorf("failed to receive project event: %w", err)
+		}
+		fmt.Fprintf(w, "%s\t", event.Type)
+		printProjectLine(w, &event.AppProject)
+		// flush every event, since the next one may take arbitrarily long to arrive
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func listProjects(ctx context.Context, projIf projectpkg.ProjectServiceClient, retry retryOpts) (*v1alpha1.AppProjectList, error) {
+	var projects *v1alpha1.AppProjectList
+	err := runWithRetry(ctx, retry, func() error {
+		var err error
+		projects, err = projIf.List(ctx, &projectpkg.ProjectQuery{})
+		return err
+	})
+	return projects, err
+}
+
 func formatOrphanedResources(p *v1alpha1.AppProject) string {
 	if p.Spec.OrphanedResources == nil {
 		return "disabled"
 	}
 	details := fmt.Sprintf("warn=%v", p.Spec.OrphanedResources.IsWarn())
+	if p.Spec.OrphanedResources.IsBlock() {
+		details += ", block=true"
+	}
 	if len(p.Spec.OrphanedResources.Ignore) > 0 {
 		details = fmt.Sprintf("%s, ignored %d", details, len(p.Spec.OrphanedResources.Ignore))
 	}
@@ -913,7 +1460,7 @@ func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, destinations, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
 }
 
-func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Repository, scopedClusters []*v1alpha1.Cluster) {
+func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Repository, scopedClusters []*v1alpha1.Cluster, globalProjects []*v1alpha1.AppProject) {
 	const printProjFmtStr = "%-29s%s\n"
 
 	fmt.Printf(printProjFmtStr, "Name:", p.Name)
@@ -1001,11 +1548,193 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
 
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
+
+	// Print the global projects whose label selector matches the project
+	gp0 := "<none>"
+	if len(globalProjects) > 0 {
+		gp0 = globalProjects[0].Name
+	}
+	fmt.Printf(printProjFmtStr, "Global Projects:", gp0)
+	for i := 1; i < len(globalProjects); i++ {
+		fmt.Printf(printProjFmtStr, "", globalProjects[i].Name)
+	}
+}
+
+// getProjectField evaluates a jsonpath expression (e.g. '{.spec.destinations[0].server}') against the project and
+// returns the selected values, one per line. Non-string values are rendered as JSON.
+func getProjectField(p *v1alpha1.AppProject, field string) (string, error) {
+	expr := field
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New("field")
+	if err := jp.Parse(expr); err != nil {
+		return "", fmt.Errorf("invalid field expression '%s': %w", field, err)
+	}
+	obj, err := unstructuredProject(p)
+	if err != nil {
+		return "", err
+	}
+	results, err := jp.FindResults(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to evaluate field '%s': %w", field, err)
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			switch v := value.Interface().(type) {
+			case string:
+				values = append(values, v)
+			default:
+				out, err := json.Marshal(v)
+				if err != nil {
+					return "", fmt.Errorf("error marshaling field value: %w", err)
+				}
+				values = append(values, string(out))
+			}
+		}
+	}
+	if len(values) == 0 {
+		return "", fmt.Errorf("field '%s' did not match any value", field)
+	}
+	return strings.Join(values, "\n"), nil
+}
+
+// unstructuredProject converts the project to its JSON form, so that fields are addressed by their JSON names like in
+// kubectl, e.g. `.spec.destinations`
+func unstructuredProject(p *v1alpha1.AppProject) (any, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling project: %w", err)
+	}
+	var obj any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling project: %w", err)
+	}
+	return obj, nil
+}
+
+// parseProjectTemplate parses a Go template for `argocd proj get --template`
+func parseProjectTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("project").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderProjectTemplate applies the template to the JSON form of the project, like kubectl's `-o go-template`
+func renderProjectTemplate(p *v1alpha1.AppProject, tmpl *template.Template) (string, error) {
+	obj, err := unstructuredProject(p)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, obj); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// projectViolations returns all reasons for which the API server would reject the given project
+func projectViolations(proj *v1alpha1.AppProject) []string {
+	proj.NormalizePolicies()
+	var violations []string
+	for _, err := range proj.ValidateProjectAll() {
+		violations = append(violations, status.Convert(err).Message())
+	}
+	if err := rbac.ValidatePolicy(proj.ProjectPoliciesString()); err != nil {
+		violations = append(violations, "policy syntax error: "+err.Error())
+	}
+	return violations
+}
+
+// kubeconfigResourceScopeWarnings looks up the scopes of the resource list entries of the project on the cluster of the
+// current kubeconfig context, as the API server does on its own cluster if projects.warnResourceScopeMismatch is enabled
+func kubeconfigResourceScopeWarnings(proj *v1alpha1.AppProject) ([]string, error) {
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error while creating client config: %w", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating discovery client: %w", err)
+	}
+	return argo.ResourceScopeWarnings(disco, proj.Spec)
+}
+
+// NewProjectValidateCommand returns a new instance of an `argocd proj validate` command
+func NewProjectValidateCommand() *cobra.Command {
+	var (
+		fileURL             string
+		checkResourceScopes bool
+	)
+	command := &cobra.Command{
+		Use:   "validate -f FILE|URL",
+		Short: "Validate a project manifest offline",
+		Long:  "Validate a project manifest offline using the same checks as the API server, reporting all violations at once. Exits with a non-zero code if any violation is found. Warns about destination service accounts which are not covered by any destination of the project, about sync windows whose schedule never fires, about orphaned resources ignore entries which overlap the resource blacklists, and, with --check-resource-scopes, about resource list entries of the wrong scope.",
+		Example: templates.Examples(`
+			# Validate a project manifest before applying it
+			argocd proj validate -f project.yaml
+
+			# Also look up the scopes of the resource list entries on the cluster of the current kubeconfig context
+			argocd proj validate -f project.yaml --check-resource-scopes
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			proj, err := cmdutil.ConstructAppProj(fileURL, args, cmdutil.ProjectOpts{}, c)
+			errors.CheckError(err)
+
+			for _, dsa := range proj.UncoveredDestinationServiceAccounts() {
+				log.Warnf("Destination service account '%s' for server '%s' and namespace '%s' is not covered by any destination of the project", dsa.DefaultServiceAccount, dsa.Server, dsa.Namespace)
+			}
+			if warning := neverFiringWindowsWarning(proj.Spec.SyncWindows); warning != "" {
+				log.Warn(warning)
+			}
+			for _, warning := range orphanedIgnoreConflictWarnings(proj) {
+				log.Warn(warning)
+			}
+			if checkResourceScopes {
+				warnings, err := kubeconfigResourceScopeWarnings(proj)
+				errors.CheckError(err)
+				for _, warning := range warnings {
+					log.Warn(warning)
+				}
+			}
+			violations := projectViolations(proj)
+			if len(violations) == 0 {
+				fmt.Printf("Project '%s' is valid\n", proj.Name)
+				return
+			}
+			for _, violation := range violations {
+				fmt.Printf("- %s\n", violation)
+			}
+			log.Fatalf("Project '%s' has %d violation(s)", proj.Name, len(violations))
+		},
+	}
+	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the project")
+	command.Flags().BoolVar(&checkResourceScopes, "check-resource-scopes", false, "Warn about cluster resource list entries of namespaced kinds and namespace resource list entries of cluster-scoped kinds, looking up the scopes on the cluster of the current kubeconfig context")
+	errors.CheckError(command.MarkFlagRequired("file"))
+	err := command.Flags().SetAnnotation("file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return command
 }
 
 // NewProjectGetCommand returns a new instance of an `argocd proj get` command
 func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output       string
+		field        string
+		templateText string
+		validate     bool
+		revision     string
+		retry        retryOpts
+	)
 	command := &cobra.Command{
 		Use:   "get PROJECT",
 		Short: "Get project details",
@@ -1016,6 +1745,17 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			# Get details from project PROJECT in yaml format
 			argocd proj get PROJECT -o yaml
 
+			# Print only the server of the first destination of project PROJECT
+			argocd proj get PROJECT --field '{.spec.destinations[0].server}'
+
+			# Print the description and the number of destinations of project PROJECT
+			argocd proj get PROJECT --template '{{.spec.description}}: {{len .spec.destinations}} destinations{{"\n"}}'
+
+			# Warn about destinations of project PROJECT which do not match a registered cluster
+			argocd proj get PROJECT --validate
+
+			# Get project PROJECT as it was at resource version 12345, if it is still recorded in its revision history
+			argocd proj get PROJECT --revision 12345 -o yaml
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -1025,23 +1765,190 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 				os.Exit(1)
 			}
 			projName := args[0]
-			detailedProject := getProject(ctx, c, clientOpts, projName)
+			if field != "" && templateText != "" {
+				errors.CheckError(stderrors.New("--field and --template cannot be combined"))
+			}
+			var tmpl *template.Template
+			if templateText != "" {
+				var err error
+				tmpl, err = parseProjectTemplate(templateText)
+				errors.CheckError(err)
+			}
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := clientset.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			detailedProject, err := getDetailedProject(ctx, projIf, projName, retry)
+			errors.CheckError(err)
+			if revision != "" {
+				detailedProject.Project, err = argo.GetProjectRevision(detailedProject.Project, revision)
+				errors.CheckError(err)
+			}
+			if validate {
+				clusterConn, clusterIf := clientset.NewClusterClientOrDie()
+				defer utilio.Close(clusterConn)
+				clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+				errors.CheckError(err)
+				// warnings are logged to stderr, so that they do not interfere with the output
+				defer func() {
+					for _, warning := range danglingDestinationWarnings(detailedProject.Project.Spec.Destinations, clusters.Items) {
+						log.Warn(warning)
+					}
+				}()
+			}
+
+			if field != "" {
+				value, err := getProjectField(detailedProject.Project, field)
+				errors.CheckError(err)
+				fmt.Println(value)
+				return
+			}
+			if tmpl != nil {
+				out, err := renderProjectTemplate(detailedProject.Project, tmpl)
+				errors.CheckError(err)
+				fmt.Print(out)
+				return
+			}
 
 			switch output {
 			case "yaml", "json":
 				err := PrintResource(detailedProject.Project, output)
 				errors.CheckError(err)
 			case "wide", "":
-				printProject(detailedProject.Project, detailedProject.Repositories, detailedProject.Clusters)
+				printProject(detailedProject.Project, detailedProject.Repositories, detailedProject.Clusters, detailedProject.GlobalProjects)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&field, "field", "", "Print only the value selected by a jsonpath expression, e.g. '{.spec.sourceRepos[*]}'")
+	command.Flags().StringVar(&templateText, "template", "", "Print the project using a Go template applied to its JSON form, e.g. '{{.spec.description}}'")
+	command.Flags().BoolVar(&validate, "validate", false, "Warn about destinations which do not match a registered cluster")
+	command.Flags().StringVar(&revision, "revision", "", "Show the project at a prior resource version recorded in its revision history (see the "+common.AnnotationKeyRevisionHistoryLimit+" annotation)")
+	addRetryFlags(command, &retry)
+	return command
+}
+
+// danglingDestinationWarnings returns a warning for each destination whose server or name does not match a registered
+// cluster, e.g. because the cluster was removed. Destinations with patterns and deny destinations are not checked.
+func danglingDestinationWarnings(destinations []v1alpha1.ApplicationDestination, clusters []v1alpha1.Cluster) []string {
+	var warnings []string
+	for _, dest := range destinations {
+		switch {
+		case dest.Server != "":
+			if strings.ContainsAny(dest.Server, "*?[!") || slices.ContainsFunc(clusters, func(c v1alpha1.Cluster) bool { return c.Server == dest.Server }) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("destination server '%s' (namespace '%s') does not match a registered cluster", dest.Server, dest.Namespace))
+		case dest.Name != "":
+			if strings.ContainsAny(dest.Name, "*?[!") || slices.ContainsFunc(clusters, func(c v1alpha1.Cluster) bool { return c.Name == dest.Name }) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("destination name '%s' (namespace '%s') does not match a registered cluster", dest.Name, dest.Namespace))
+		}
+	}
+	return warnings
+}
+
+// migrateDestinationsToNames rewrites the server-based destinations to reference the registered cluster with the same
+// server by name. It returns a message for each migrated destination and a warning for each server which could not be
+// resolved to a named cluster. Destinations with patterns and deny destinations are left untouched.
+func migrateDestinationsToNames(destinations []v1alpha1.ApplicationDestination, clusters []v1alpha1.Cluster) (migrated []string, warnings []string) {
+	for i := range destinations {
+		dest := &destinations[i]
+		if dest.Server == "" || strings.ContainsAny(dest.Server, "*?[!") {
+			continue
+		}
+		idx := slices.IndexFunc(clusters, func(c v1alpha1.Cluster) bool { return c.Server == dest.Server })
+		if idx == -1 || clusters[idx].Name == "" {
+			warnings = append(warnings, fmt.Sprintf("destination server '%s' (namespace '%s') does not match a named cluster", dest.Server, dest.Namespace))
+			continue
+		}
+		migrated = append(migrated, fmt.Sprintf("Destination server '%s' (namespace '%s') migrated to name '%s'", dest.Server, dest.Namespace, clusters[idx].Name))
+		dest.Name = clusters[idx].Name
+		dest.Server = ""
+	}
+	return migrated, warnings
+}
+
+// NewProjectMigrateDestinationsCommand returns a new instance of an `argocd proj migrate-destinations` command
+func NewProjectMigrateDestinationsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		toNames bool
+		dryRun  bool
+	)
+	command := &cobra.Command{
+		Use:   "migrate-destinations PROJECT",
+		Short: "Migrate the destinations of a project to another form",
+		Long: `Migrate the destinations of a project to another form. With --to-names, destinations referencing a cluster by
+server URL are rewritten to reference the registered cluster by name. Servers which do not match a named cluster are
+reported and left untouched, as are destinations with patterns.`,
+		Example: templates.Examples(`
+			# Show which destinations would be migrated to cluster names
+			argocd proj migrate-destinations PROJECT --to-names
+
+			# Migrate the destinations
+			argocd proj migrate-destinations PROJECT --to-names --dry-run=false
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			if !toNames {
+				log.Fatal("--to-names is required")
+			}
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := clientset.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			clusterConn, clusterIf := clientset.NewClusterClientOrDie()
+			defer utilio.Close(clusterConn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+			errors.CheckError(err)
+
+			migrated, warnings := migrateDestinationsToNames(proj.Spec.Destinations, clusters.Items)
+			for _, warning := range warnings {
+				log.Warn(warning)
+			}
+			if len(migrated) == 0 {
+				fmt.Printf("No destinations of project '%s' to migrate\n", projName)
+				return
+			}
+			suffix := ""
+			if dryRun {
+				suffix = " (dry run)"
+			}
+			for _, message := range migrated {
+				fmt.Printf("%s%s\n", message, suffix)
+			}
+			if dryRun {
+				return
+			}
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().BoolVar(&toNames, "to-names", false, "Rewrite server-based destinations to reference the registered cluster by name")
+	command.Flags().BoolVar(&dryRun, "dry-run", true, "Only print the changes which would be made")
 	return command
 }
 
+func getDetailedProject(ctx context.Context, projIf projectpkg.ProjectServiceClient, projName string, retry retryOpts) (*projectpkg.DetailedProjectsResponse, error) {
+	var detailedProject *projectpkg.DetailedProjectsResponse
+	err := runWithRetry(ctx, retry, func() error {
+		var err error
+		detailedProject, err = projIf.GetDetailedProject(ctx, &projectpkg.ProjectQuery{Name: projName})
+		return err
+	})
+	return detailedProject, err
+}
+
 func getProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.ClientOptions, projName string) *projectpkg.DetailedProjectsResponse {
 	conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 	defer utilio.Close(conn)
@@ -1051,6 +1958,7 @@ func getProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.
 }
 
 func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "edit PROJECT",
 		Short: "Edit project",
@@ -1075,6 +1983,7 @@ func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 			projData, err = yaml.JSONToYAML(projData)
 			errors.CheckError(err)
 
+			var updated *v1alpha1.AppProject
 			cli.InteractiveEdit(projName+"-*-edit.yaml", projData, func(input []byte) error {
 				input, err = yaml.YAMLToJSON(input)
 				if err != nil {
@@ -1090,35 +1999,27 @@ func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 					return fmt.Errorf("could not get project by project name: %w", err)
 				}
 				proj.Spec = updatedSpec
-				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				updated, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 				if err != nil {
 					return fmt.Errorf("failed to update project:\n%w", err)
 				}
 				return nil
 			})
+			if wait.wait && updated != nil {
+				errors.CheckError(waitForProjectObserved(ctx, projIf, updated.Name, updated.Generation, wait.timeout, projectWaitPollInterval))
+			}
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectAddDestinationServiceAccountCommand returns a new instance of an `argocd proj add-destination-service-account` command
 func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var serviceAccountNamespace string
-
-	buildApplicationDestinationServiceAccount := func(destination string, namespace string, serviceAccount string, serviceAccountNamespace string) v1alpha1.ApplicationDestinationServiceAccount {
-		if serviceAccountNamespace != "" {
-			return v1alpha1.ApplicationDestinationServiceAccount{
-				Server:                destination,
-				Namespace:             namespace,
-				DefaultServiceAccount: fmt.Sprintf("%s:%s", serviceAccountNamespace, serviceAccount),
-			}
-		}
-		return v1alpha1.ApplicationDestinationServiceAccount{
-			Server:                destination,
-			Namespace:             namespace,
-			DefaultServiceAccount: serviceAccount,
-		}
-	}
+	var (
+		serviceAccountNamespace string
+		wait                    waitOpts
+	)
 
 	command := &cobra.Command{
 		Use:   "add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
@@ -1129,6 +2030,9 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 
 			# Add project destination service account (SERVICE_ACCOUNT) from a different namespace
 			argocd proj add-destination PROJECT SERVER NAMESPACE SERVICE_ACCOUNT --service-account-namespace <service_account_namespace>
+
+			# Add project destination service account (SERVICE_ACCOUNT) from a different namespace, using the combined form
+			argocd proj add-destination-service-account PROJECT SERVER NAMESPACE <service_account_namespace>:SERVICE_ACCOUNT
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -1150,7 +2054,13 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 				log.Fatal("ServiceAccount for DestinationServiceAccount must not contain wildcards")
 			}
 
-			destinationServiceAccount := buildApplicationDestinationServiceAccount(server, namespace, serviceAccount, serviceAccountNamespace)
+			defaultServiceAccount, err := buildDefaultServiceAccount(serviceAccount, serviceAccountNamespace)
+			errors.CheckError(err)
+			destinationServiceAccount := v1alpha1.ApplicationDestinationServiceAccount{
+				Server:                server,
+				Namespace:             namespace,
+				DefaultServiceAccount: defaultServiceAccount,
+			}
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
@@ -1165,16 +2075,117 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 				}
 			}
 			proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts, destinationServiceAccount)
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().StringVar(&serviceAccountNamespace, "service-account-namespace", "", "Use service-account-namespace as namespace where the service account is present")
+	addWaitFlags(command, &wait)
+	return command
+}
+
+// buildDefaultServiceAccount returns the default service account of a destination, either given as a plain name with an
+// optional namespace or in the combined NAMESPACE:NAME form.
+func buildDefaultServiceAccount(serviceAccount string, serviceAccountNamespace string) (string, error) {
+	if !strings.Contains(serviceAccount, ":") {
+		if serviceAccountNamespace != "" {
+			return fmt.Sprintf("%s:%s", serviceAccountNamespace, serviceAccount), nil
+		}
+		return serviceAccount, nil
+	}
+	if serviceAccountNamespace != "" {
+		return "", fmt.Errorf("service account '%s' already contains a namespace, --service-account-namespace must not be set", serviceAccount)
+	}
+	parts := strings.Split(serviceAccount, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("service account '%s' must be of the form NAMESPACE:NAME", serviceAccount)
+	}
+	return serviceAccount, nil
+}
+
+// NewProjectSetDestinationServiceAccountCommand returns a new instance of an `argocd proj set-destination-service-account` command
+func NewProjectSetDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		serviceAccountNamespace string
+		wait                    waitOpts
+	)
+	command := &cobra.Command{
+		Use:   "set-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT NEW_SERVICE_ACCOUNT",
+		Short: "Change the default service account of a project destination",
+		Example: templates.Examples(`
+			# Replace the destination service account (SERVICE_ACCOUNT) of the specified destination (SERVER and NAMESPACE combination) with NEW_SERVICE_ACCOUNT
+			argocd proj set-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT NEW_SERVICE_ACCOUNT
+
+			# Move the service account to a different namespace
+			argocd proj set-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT SERVICE_ACCOUNT --service-account-namespace <service_account_namespace>
+
+			# Use the service account from the namespace of the destination, removing the namespace of a combined form
+			argocd proj set-destination-service-account PROJECT SERVER NAMESPACE <service_account_namespace>:SERVICE_ACCOUNT SERVICE_ACCOUNT
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 5 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			server := args[1]
+			namespace := args[2]
+			serviceAccount := args[3]
+			newServiceAccount := args[4]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			err = setDestinationServiceAccount(proj, server, namespace, serviceAccount, newServiceAccount, serviceAccountNamespace)
+			errors.CheckError(err)
+			err = updateProject(ctx, projIf, proj, wait)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&serviceAccountNamespace, "service-account-namespace", "", "Use service-account-namespace as namespace where the new service account is present")
+	addWaitFlags(command, &wait)
 	return command
 }
 
+// setDestinationServiceAccount replaces the default service account of the destination service account entry matching
+// the server, namespace and service account with the new service account
+func setDestinationServiceAccount(proj *v1alpha1.AppProject, server string, namespace string, serviceAccount string, newServiceAccount string, serviceAccountNamespace string) error {
+	if strings.Contains(serviceAccountNamespace, "*") {
+		return stderrors.New("service-account-namespace for DestinationServiceAccount must not contain wildcards")
+	}
+	if strings.Contains(newServiceAccount, "*") {
+		return stderrors.New("ServiceAccount for DestinationServiceAccount must not contain wildcards")
+	}
+	defaultServiceAccount, err := buildDefaultServiceAccount(newServiceAccount, serviceAccountNamespace)
+	if err != nil {
+		return err
+	}
+	index := -1
+	for i, dest := range proj.Spec.DestinationServiceAccounts {
+		if dest.Server != server || dest.Namespace != namespace {
+			continue
+		}
+		switch dest.DefaultServiceAccount {
+		case serviceAccount:
+			index = i
+		case defaultServiceAccount:
+			return fmt.Errorf("destination service account '%s' is already defined for destination '%s/%s' in project", defaultServiceAccount, server, namespace)
+		}
+	}
+	if index < 0 {
+		return stderrors.New("specified destination service account does not exist in project")
+	}
+	proj.Spec.DestinationServiceAccounts[index].DefaultServiceAccount = defaultServiceAccount
+	return nil
+}
+
 // NewProjectRemoveDestinationCommand returns a new instance of an `argocd proj remove-destination-service-account` command
 func NewProjectRemoveDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "remove-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
 		Short: "Remove default destination service account from the project",
@@ -1210,10 +2221,72 @@ func NewProjectRemoveDestinationServiceAccountCommand(clientOpts *argocdclient.C
 			if originalLength == len(proj.Spec.DestinationServiceAccounts) {
 				log.Fatal("Specified destination service account does not exist in project")
 			}
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
 
+	addWaitFlags(command, &wait)
+	return command
+}
+
+// NewProjectCanICommand returns a new instance of an `argocd proj can-i` command
+func NewProjectCanICommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "can-i ACTION RESOURCE/NAME --project PROJECT",
+		Short: "Check whether the current login can perform an action in a project",
+		Long:  "Check whether the current login can perform an action on a project scoped resource, printing yes or no along with the RBAC policy which decided it.",
+		Example: templates.Examples(fmt.Sprintf(`
+			# Can I sync the guestbook application of the default project?
+			argocd proj can-i sync applications/guestbook --project default
+
+			# Can I read the logs of any application of the default project?
+			argocd proj can-i get 'logs/*' --project default
+
+			Actions: %v
+			Resources: %v
+		`, rbac.Actions, slices.Sorted(maps.Keys(rbac.ProjectScoped)))),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, accountIf := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			response, err := projectCanI(ctx, accountIf, project, args[0], args[1])
+			errors.CheckError(err)
+			fmt.Println(response.Value)
+			if response.Policy != "" {
+				fmt.Printf("Policy: %s\n", response.Policy)
+			} else {
+				fmt.Println("Policy: <none>")
+			}
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", "Project to check the action in")
+	errors.CheckError(command.MarkFlagRequired("project"))
 	return command
 }
+
+// projectCanI asks the API server whether the current login can perform the action on the object, a project scoped
+// resource and name such as applications/guestbook, in the project
+func projectCanI(ctx context.Context, accountIf accountpkg.AccountServiceClient, project, action, object string) (*accountpkg.CanIResponse, error) {
+	resource, name, ok := strings.Cut(object, "/")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("object '%s' must be of the form RESOURCE/NAME", object)
+	}
+	if !rbac.ProjectScoped[resource] {
+		return nil, fmt.Errorf("resource '%s' is not project scoped", resource)
+	}
+	return accountIf.CanI(ctx, &accountpkg.CanIRequest{
+		Action:      action,
+		Resource:    resource,
+		Subresource: project + "/" + name,
+		Explain:     true,
+	})
+}
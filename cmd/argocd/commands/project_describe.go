@@ -0,0 +1,268 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// projectDescription holds everything rendered by `argocd proj describe`
+type projectDescription struct {
+	project        *v1alpha1.AppProject
+	globalProjects []*v1alpha1.AppProject
+	// repositories are the registered repositories, including their connection state
+	repositories []*v1alpha1.Repository
+	apps         []v1alpha1.Application
+	// windowActive reports whether a sync window is currently open
+	windowActive func(window *v1alpha1.SyncWindow) (bool, error)
+	// canSync reports whether the sync windows matching an application currently allow an automated sync
+	canSync func(windows *v1alpha1.SyncWindows) (bool, error)
+}
+
+// NewProjectDescribeCommand returns a new instance of an `argocd proj describe` command
+func NewProjectDescribeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var retry retryOpts
+	command := &cobra.Command{
+		Use:   "describe PROJECT",
+		Short: "Describe a project",
+		Long:  "Describe a project, showing its effective destinations and sync windows (including those inherited from global projects), token expiry, member applications and validation warnings.",
+		Example: templates.Examples(`
+			# Describe project PROJECT
+			argocd proj describe PROJECT
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := clientset.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			detailedProject, err := getDetailedProject(ctx, projIf, projName, retry)
+			errors.CheckError(err)
+
+			appConn, appIf := clientset.NewApplicationClientOrDie()
+			defer utilio.Close(appConn)
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Projects: []string{projName}})
+			errors.CheckError(err)
+
+			repoConn, repoIf := clientset.NewRepoClientOrDie()
+			defer utilio.Close(repoConn)
+			repos, err := repoIf.ListRepositories(ctx, &repositorypkg.RepoQuery{})
+			errors.CheckError(err)
+
+			description := projectDescription{
+				project:        detailedProject.Project,
+				globalProjects: detailedProject.GlobalProjects,
+				repositories:   repos.Items,
+				apps:           apps.Items,
+				windowActive: func(window *v1alpha1.SyncWindow) (bool, error) {
+					return window.Active()
+				},
+				canSync: func(windows *v1alpha1.SyncWindows) (bool, error) {
+					return windows.CanSync(false)
+				},
+			}
+			printProjectDescription(os.Stdout, description, time.Now())
+		},
+	}
+	addRetryFlags(command, &retry)
+	return command
+}
+
+// printProjectDescription renders a project description in kubectl-describe style
+func printProjectDescription(out io.Writer, d projectDescription, now time.Time) {
+	p := d.project
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Name:\t%s\n", p.Name)
+	_, _ = fmt.Fprintf(w, "Namespace:\t%s\n", p.Namespace)
+	_, _ = fmt.Fprintf(w, "Description:\t%s\n", valueOrNone(p.Spec.Description))
+	_, _ = fmt.Fprintf(w, "Labels:\t%s\n", formatLabels(p.Labels))
+	globalNames := make([]string, 0, len(d.globalProjects))
+	for _, gp := range d.globalProjects {
+		globalNames = append(globalNames, gp.Name)
+	}
+	_, _ = fmt.Fprintf(w, "Global Projects:\t%s\n", valueOrNone(strings.Join(globalNames, ", ")))
+	_, _ = fmt.Fprintf(w, "Applications:\t%d\n", len(d.apps))
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(out, "Destinations:")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	destinationCount := len(p.Spec.Destinations)
+	_, _ = fmt.Fprintln(w, "  SERVER\tNAME\tNAMESPACE\tFROM")
+	for _, dest := range p.Spec.Destinations {
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", valueOrNone(dest.Server), valueOrNone(dest.Name), valueOrNone(dest.Namespace), p.Name)
+	}
+	for _, gp := range d.globalProjects {
+		destinationCount += len(gp.Spec.Destinations)
+		for _, dest := range gp.Spec.Destinations {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", valueOrNone(dest.Server), valueOrNone(dest.Name), valueOrNone(dest.Namespace), gp.Name)
+		}
+	}
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(out, "Sync Windows:")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	var warnings []string
+	windows := make(v1alpha1.SyncWindows, 0, len(p.Spec.SyncWindows))
+	windowSources := make([]string, 0, len(p.Spec.SyncWindows))
+	for _, window := range p.Spec.SyncWindows {
+		windows = append(windows, window)
+		windowSources = append(windowSources, p.Name)
+	}
+	for _, gp := range d.globalProjects {
+		for _, window := range gp.Spec.SyncWindows {
+			windows = append(windows, window)
+			windowSources = append(windowSources, gp.Name)
+		}
+	}
+	if len(windows) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+	} else {
+		_, _ = fmt.Fprintln(w, "  STATUS\tKIND\tSCHEDULE\tDURATION\tMANUALSYNC\tFROM")
+		for i, window := range windows {
+			schedule := window.Schedule
+			if window.ScheduleExpression != "" {
+				schedule = fmt.Sprintf("%s (%s)", schedule, window.ScheduleExpression)
+			}
+			status := "Error"
+			if active, err := d.windowActive(window); err == nil {
+				status = formatBoolOutput(active)
+			} else {
+				warnings = append(warnings, fmt.Sprintf("sync window '%s' of project '%s' cannot be evaluated: %v", window.Schedule, windowSources[i], err))
+			}
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", status, window.Kind, schedule, window.Duration, formatBoolEnabledOutput(window.ManualSync), windowSources[i])
+		}
+	}
+	_ = w.Flush()
+	_, _ = fmt.Fprintf(out, "  Automated sync: %s\n", formatAutomatedSyncState(windows, d.apps, d.canSync))
+
+	_, _ = fmt.Fprintln(out, "Roles:")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if len(p.Spec.Roles) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+	} else {
+		_, _ = fmt.Fprintln(w, "  NAME\tTOKENS\tEXPIRED\tNEXT EXPIRY")
+		for _, role := range p.Spec.Roles {
+			expired := 0
+			var nextExpiry int64
+			for _, token := range role.JWTTokens {
+				switch {
+				case token.ExpiresAt <= 0:
+				case time.Unix(token.ExpiresAt, 0).After(now):
+					if nextExpiry == 0 || token.ExpiresAt < nextExpiry {
+						nextExpiry = token.ExpiresAt
+					}
+				default:
+					expired++
+				}
+			}
+			next, _ := formatTokenTime(nextExpiry, now, tokenTimeFormatRelative, true)
+			_, _ = fmt.Fprintf(w, "  %s\t%d\t%d\t%s\n", role.Name, len(role.JWTTokens), expired, next)
+			if expired > 0 {
+				warnings = append(warnings, fmt.Sprintf("role '%s' has %d expired token(s)", role.Name, expired))
+			}
+		}
+	}
+	_ = w.Flush()
+
+	if destinationCount == 0 {
+		warnings = append(warnings, "no destinations are permitted, applications of this project cannot be deployed")
+	}
+	for _, repo := range projectRepositories(p, d.globalProjects, d.repositories) {
+		if repo.ConnectionState.Status == v1alpha1.ConnectionStatusFailed {
+			warnings = append(warnings, fmt.Sprintf("repository '%s' is unreachable: %s", repo.Repo, repo.ConnectionState.Message))
+		}
+	}
+	if warning := duplicateWindowsWarning(p.Spec.SyncWindows); warning != "" {
+		warnings = append(warnings, warning)
+	}
+	warnings = append(warnings, projectViolations(p.DeepCopy())...)
+
+	_, _ = fmt.Fprintln(out, "Warnings:")
+	if len(warnings) == 0 {
+		_, _ = fmt.Fprintln(out, "  <none>")
+	}
+	for _, warning := range warnings {
+		_, _ = fmt.Fprintf(out, "  - %s\n", warning)
+	}
+}
+
+// projectRepositories returns the registered repositories usable by the project, either because they are scoped to
+// it or because they match one of its effective source repositories
+func projectRepositories(p *v1alpha1.AppProject, globalProjects []*v1alpha1.AppProject, repos []*v1alpha1.Repository) []*v1alpha1.Repository {
+	effective := p.DeepCopy()
+	for _, gp := range globalProjects {
+		effective.Spec.SourceRepos = append(effective.Spec.SourceRepos, gp.Spec.SourceRepos...)
+	}
+	var result []*v1alpha1.Repository
+	for _, repo := range repos {
+		switch {
+		case repo.Project == p.Name:
+			result = append(result, repo)
+		case repo.Project == "" && effective.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: repo.Repo}):
+			result = append(result, repo)
+		}
+	}
+	return result
+}
+
+// formatAutomatedSyncState summarizes for how many applications of the project the sync windows currently allow
+// automated syncs. The windows are scoped to each application and evaluated like the application controller does.
+func formatAutomatedSyncState(windows v1alpha1.SyncWindows, apps []v1alpha1.Application, canSync func(windows *v1alpha1.SyncWindows) (bool, error)) string {
+	if len(apps) == 0 {
+		return "<no applications>"
+	}
+	allowed, failed := 0, 0
+	for i := range apps {
+		ok, err := canSync(windows.Matches(&apps[i]))
+		switch {
+		case err != nil:
+			failed++
+		case ok:
+			allowed++
+		}
+	}
+	state := fmt.Sprintf("allowed for %d of %d applications", allowed, len(apps))
+	if failed > 0 {
+		state += fmt.Sprintf(", %d cannot be evaluated", failed)
+	}
+	return state
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "<none>"
+	}
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
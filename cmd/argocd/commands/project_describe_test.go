@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_printProjectDescription(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	windowActive := func(window *v1alpha1.SyncWindow) (bool, error) {
+		return window.Kind == "allow" || window.Schedule == "0 22 * * *", nil
+	}
+	description := projectDescription{
+		project: &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd", Labels: map[string]string{"team": "a", "env": "prod"}},
+			Spec: v1alpha1.AppProjectSpec{
+				Description:      "Team A production services",
+				SourceRepos:      []string{"https://github.com/team-a/*"},
+				SourceNamespaces: []string{"team-a"},
+				Destinations: []v1alpha1.ApplicationDestination{
+					{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+					{Name: "prod-eu", Namespace: "team-a-*"},
+				},
+				SyncWindows: v1alpha1.SyncWindows{
+					{Kind: "allow", Schedule: "0 8 * * 1-5", Duration: "10h", Applications: []string{"*"}},
+					{Kind: "deny", Schedule: "0 0 1 * *", Duration: "24h", Applications: []string{"*"}, ManualSync: true, ScheduleExpression: "businessDay == 1"},
+				},
+				Roles: []v1alpha1.ProjectRole{
+					{
+						Name:     "ci",
+						Policies: []string{"p, proj:team-a:ci, applications, sync, team-a/*, allow"},
+						JWTTokens: []v1alpha1.JWTToken{
+							{IssuedAt: now.Add(-60 * 24 * time.Hour).Unix(), ExpiresAt: now.Add(-24 * time.Hour).Unix()},
+							{IssuedAt: now.Add(-24 * time.Hour).Unix(), ExpiresAt: now.Add(29 * 24 * time.Hour).Unix()},
+							{IssuedAt: now.Add(-12 * time.Hour).Unix()},
+						},
+					},
+					{Name: "viewer", Policies: []string{"p, proj:team-a:viewer, applications, get, team-a/*, allow"}},
+				},
+			},
+		},
+		globalProjects: []*v1alpha1.AppProject{{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "argocd"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"https://github.com/platform/charts"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "monitoring"}},
+				SyncWindows: v1alpha1.SyncWindows{
+					{Kind: "deny", Schedule: "0 22 * * *", Duration: "2h", Clusters: []string{"prod-*"}},
+				},
+			},
+		}},
+		repositories: []*v1alpha1.Repository{
+			{Repo: "https://github.com/team-a/services", ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusSuccessful}},
+			{Repo: "https://github.com/team-a/legacy", ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusFailed, Message: "Unable to connect to repository: authentication required"}},
+			{Repo: "https://github.com/platform/charts", ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusSuccessful}},
+			{Repo: "https://github.com/team-b/services", ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusFailed, Message: "Unable to connect to repository: not found"}},
+		},
+		apps: []v1alpha1.Application{
+			{ObjectMeta: metav1.ObjectMeta{Name: "api"}, Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "worker"}, Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "edge"}, Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Name: "prod-eu", Namespace: "team-a-edge"}}},
+		},
+		windowActive: windowActive,
+		canSync: func(windows *v1alpha1.SyncWindows) (bool, error) {
+			// the deny window of the platform project only matches the application deployed to prod-eu
+			for _, window := range *windows {
+				if active, _ := windowActive(window); active && window.Kind == "deny" {
+					return false, nil
+				}
+			}
+			return true, nil
+		},
+	}
+
+	var out bytes.Buffer
+	printProjectDescription(&out, description, now)
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "proj_describe.golden"))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), out.String())
+}
+
+func Test_printProjectDescriptionInvalidSyncWindow(t *testing.T) {
+	description := projectDescription{
+		project: &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd"},
+			Spec: v1alpha1.AppProjectSpec{
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SyncWindows: v1alpha1.SyncWindows{
+					{Kind: "allow", Schedule: "0 8 * * *", Duration: "ten hours", Applications: []string{"*"}},
+				},
+			},
+		},
+		apps: []v1alpha1.Application{{ObjectMeta: metav1.ObjectMeta{Name: "api"}}},
+		windowActive: func(window *v1alpha1.SyncWindow) (bool, error) {
+			return window.Active()
+		},
+		canSync: func(windows *v1alpha1.SyncWindows) (bool, error) {
+			return windows.CanSync(false)
+		},
+	}
+
+	var out bytes.Buffer
+	printProjectDescription(&out, description, time.Now())
+
+	assert.Regexp(t, `(?m)^  Error\s+allow\s+0 8 \* \* \*\s+ten hours`, out.String())
+	assert.Contains(t, out.String(), "  Automated sync: allowed for 0 of 1 applications, 1 cannot be evaluated\n")
+	assert.Contains(t, out.String(), "  - sync window '0 8 * * *' of project 'team-a' cannot be evaluated: ")
+}
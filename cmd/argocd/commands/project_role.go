@@ -1,32 +1,170 @@
 package commands
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"os"
+	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	timeutil "github.com/argoproj/pkg/v2/time"
 	jwtgo "github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/config"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/jwt"
+	"github.com/argoproj/argo-cd/v3/util/localconfig"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 )
 
 const (
 	policyTemplate = "p, proj:%s:%s, %s, %s, %s/%s, %s"
+
+	tokenTimeFormatRaw      = "raw"
+	tokenTimeFormatRFC3339  = "rfc3339"
+	tokenTimeFormatRelative = "relative"
 )
 
+// tokenExpiryOpts holds the thresholds used to flag project role tokens which are about to expire
+type tokenExpiryOpts struct {
+	warnBefore     string
+	criticalBefore string
+}
+
+func (o *tokenExpiryOpts) addFlags(command *cobra.Command) {
+	command.Flags().StringVar(&o.warnBefore, "warn-before", "", "Annotate tokens expiring within the given duration, e.g. \"12h\", \"7d\"")
+	command.Flags().StringVar(&o.criticalBefore, "critical-before", "", "Exit with a non-zero code if any token expires within the given duration, e.g. \"12h\", \"7d\"")
+}
+
+func (o *tokenExpiryOpts) enabled() bool {
+	return o.warnBefore != "" || o.criticalBefore != ""
+}
+
+// thresholds parses the configured durations. An unset duration is returned as zero.
+func (o *tokenExpiryOpts) thresholds() (warnBefore time.Duration, criticalBefore time.Duration, err error) {
+	if o.warnBefore != "" {
+		d, err := timeutil.ParseDuration(o.warnBefore)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid --warn-before: %w", err)
+		}
+		warnBefore = *d
+	}
+	if o.criticalBefore != "" {
+		d, err := timeutil.ParseDuration(o.criticalBefore)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid --critical-before: %w", err)
+		}
+		criticalBefore = *d
+	}
+	return warnBefore, criticalBefore, nil
+}
+
+// exitIfCritical exits with a non-zero code if any of the tokens of the role expires within the critical threshold
+func (o *tokenExpiryOpts) exitIfCritical(projName, roleName string, tokens []v1alpha1.JWTToken, now time.Time, criticalBefore time.Duration) {
+	for _, token := range tokens {
+		if _, critical := tokenExpiryWarning(token.ExpiresAt, now, 0, criticalBefore); critical {
+			log.Fatalf("One or more tokens of %s.%s expire within %s", projName, roleName, o.criticalBefore)
+		}
+	}
+}
+
+// tokenExpiryWarning returns an annotation for a token expiring at the given Unix time, and whether the expiry lies
+// within the critical threshold. Tokens without expiry are never flagged.
+func tokenExpiryWarning(expiresAt int64, now time.Time, warnBefore, criticalBefore time.Duration) (string, bool) {
+	if expiresAt <= 0 {
+		return "", false
+	}
+	remaining := time.Unix(expiresAt, 0).Sub(now)
+	critical := criticalBefore > 0 && remaining <= criticalBefore
+	switch {
+	case remaining <= 0:
+		return "EXPIRED", critical
+	case critical:
+		return "CRITICAL: expires in " + remaining.Round(time.Second).String(), true
+	case warnBefore > 0 && remaining <= warnBefore:
+		return "WARNING: expires in " + remaining.Round(time.Second).String(), false
+	}
+	return "", false
+}
+
+// shortDuration renders a duration using its largest whole unit, e.g. "29d", "5h", "4m" or "12s"
+func shortDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
+}
+
+// tokenClaims renders the claims of a project role token as JSON. The tokens themselves are not stored, so the claims
+// are reconstructed from the token metadata stored in the project, the same way the API server sets them when issuing
+// the token. The audience is omitted, since it may be overridden when the token is created and is not stored.
+func tokenClaims(proj *v1alpha1.AppProject, roleName string, token v1alpha1.JWTToken) (string, error) {
+	claims := jwtgo.RegisteredClaims{
+		Issuer:  session.SessionManagerClaimsIssuer,
+		Subject: fmt.Sprintf("proj:%s:%s", proj.Name, roleName),
+		ID:      token.ID,
+	}
+	if token.IssuedAt > 0 {
+		claims.IssuedAt = jwtgo.NewNumericDate(time.Unix(token.IssuedAt, 0))
+	}
+	if token.ExpiresAt > 0 {
+		claims.ExpiresAt = jwtgo.NewNumericDate(time.Unix(token.ExpiresAt, 0))
+	}
+	out, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// formatTokenTime renders a token issued-at or expires-at Unix time in the given format. Expiry times are rendered
+// relative to now as "in 29d" or "expired 3d ago", other times as "3d ago". A zero expiry means the token never expires.
+func formatTokenTime(epoch int64, now time.Time, format string, isExpiry bool) (string, error) {
+	if isExpiry && epoch <= 0 {
+		if format == tokenTimeFormatRaw {
+			return "0", nil
+		}
+		return "<none>", nil
+	}
+	ts := time.Unix(epoch, 0)
+	switch format {
+	case tokenTimeFormatRaw:
+		return strconv.FormatInt(epoch, 10), nil
+	case tokenTimeFormatRFC3339:
+		return ts.Format(time.RFC3339), nil
+	case tokenTimeFormatRelative:
+		if !isExpiry {
+			return shortDuration(now.Sub(ts)) + " ago", nil
+		}
+		if ts.After(now) {
+			return "in " + shortDuration(ts.Sub(now)), nil
+		}
+		return "expired " + shortDuration(now.Sub(ts)) + " ago", nil
+	}
+	return "", fmt.Errorf("unknown time format: %s", format)
+}
+
 // NewProjectRoleCommand returns a new instance of the `argocd proj role` command
 func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	roleCommand := &cobra.Command{
@@ -39,8 +177,10 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	}
 	roleCommand.AddCommand(NewProjectRoleListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleGetCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleEffectiveCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleCreateCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleDeleteCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleRenameCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleCreateTokenCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleListTokensCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleDeleteTokenCommand(clientOpts))
@@ -48,12 +188,17 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleRemovePolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddGroupCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemoveGroupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleSyncFromCommand(clientOpts))
 	return roleCommand
 }
 
 // NewProjectRoleAddPolicyCommand returns a new instance of an `argocd proj role add-policy` command
 func NewProjectRoleAddPolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var opts policyOpts
+	var (
+		opts           policyOpts
+		dryRun         bool
+		allowAdminRole bool
+	)
 	command := &cobra.Command{
 		Use:   "add-policy PROJECT ROLE-NAME",
 		Short: "Add a policy to a project role",
@@ -95,6 +240,10 @@ p, proj:test-project:test-role, logs, get, test-project/project, allow
 JWT Tokens:
 ID          ISSUED-AT                                EXPIRES-AT
 1696759698  2023-10-08T11:08:18+01:00 (3 hours ago)  <none>
+
+# Print the policy which would be added, without adding it
+$ argocd proj role add-policy test-project test-role -a sync -p allow -o '*' --dry-run
+p, proj:test-project:test-role, applications, sync, test-project/*, allow
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -114,17 +263,28 @@ ID          ISSUED-AT                                EXPIRES-AT
 			role, roleIndex, err := proj.GetRoleByName(roleName)
 			errors.CheckError(err)
 
-			policy := fmt.Sprintf(policyTemplate, proj.Name, role.Name, opts.resource, opts.action, proj.Name, opts.object, opts.permission)
+			policy := formatRolePolicy(proj.Name, role.Name, opts)
+			if dryRun {
+				fmt.Println(policy)
+				return
+			}
 			proj.Spec.Roles[roleIndex].Policies = append(role.Policies, policy)
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj, AllowAdminRole: allowAdminRole})
 			errors.CheckError(err)
 		},
 	}
 	addPolicyFlags(command, &opts)
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the policy which would be added to the role without adding it")
+	command.Flags().BoolVar(&allowAdminRole, "allow-admin-role", false, "Allow a policy granting the action '*' on the object '*', if guarded by the projects.adminRolePolicy setting")
 	return command
 }
 
+// formatRolePolicy renders the casbin policy line stored in a project role for the given policy flags
+func formatRolePolicy(projName string, roleName string, opts policyOpts) string {
+	return fmt.Sprintf(policyTemplate, projName, roleName, opts.resource, opts.action, projName, opts.object, opts.permission)
+}
+
 // NewProjectRoleRemovePolicyCommand returns a new instance of an `argocd proj role remove-policy` command
 func NewProjectRoleRemovePolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts policyOpts
@@ -189,7 +349,7 @@ ID          ISSUED-AT                                EXPIRES-AT
 			role, roleIndex, err := proj.GetRoleByName(roleName)
 			errors.CheckError(err)
 
-			policyToRemove := fmt.Sprintf(policyTemplate, proj.Name, role.Name, opts.resource, opts.action, proj.Name, opts.object, opts.permission)
+			policyToRemove := formatRolePolicy(proj.Name, role.Name, opts)
 			duplicateIndex := -1
 			for i, policy := range role.Policies {
 				if policy == policyToRemove {
@@ -219,13 +379,19 @@ ID          ISSUED-AT                                EXPIRES-AT
 
 // NewProjectRoleCreateCommand returns a new instance of an `argocd proj role create` command
 func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var description string
+	var (
+		description string
+		upsert      bool
+	)
 	command := &cobra.Command{
 		Use:   "create PROJECT ROLE-NAME",
 		Short: "Create a project role",
 		Example: templates.Examples(`
   # Create a project role in the "my-project" project with the name "my-role".
   argocd proj role create my-project my-role --description "My project role description"
+
+  # Update the description of the role if it already exists.
+  argocd proj role create my-project my-role --description "My project role description" --upsert
   		`),
 
 		Run: func(c *cobra.Command, args []string) {
@@ -243,28 +409,50 @@ func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			_, _, err = proj.GetRoleByName(roleName)
-			if err == nil {
-				fmt.Printf("Role '%s' already exists\n", roleName)
-				return
-			}
-			proj.Spec.Roles = append(proj.Spec.Roles, v1alpha1.ProjectRole{Name: roleName, Description: description})
+			created, err := createProjectRole(proj, roleName, description, upsert)
+			errors.CheckError(err)
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
-			fmt.Printf("Role '%s' created\n", roleName)
+			if created {
+				fmt.Printf("Role '%s' created\n", roleName)
+			} else {
+				fmt.Printf("Role '%s' updated\n", roleName)
+			}
 		},
 	}
 	command.Flags().StringVarP(&description, "description", "", "", "Project description")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Update the description of the role if it already exists")
 	return command
 }
 
+// createProjectRole adds a role with the given name and description to the project. If a role with the same name
+// already exists, its description is updated when upsert is set, otherwise an error is returned.
+func createProjectRole(proj *v1alpha1.AppProject, roleName string, description string, upsert bool) (bool, error) {
+	role, index, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		proj.Spec.Roles = append(proj.Spec.Roles, v1alpha1.ProjectRole{Name: roleName, Description: description})
+		return true, nil
+	}
+	if !upsert {
+		return false, fmt.Errorf("role '%s' already exists in project '%s', use --upsert to update it", roleName, proj.Name)
+	}
+	role.Description = description
+	proj.Spec.Roles[index] = *role
+	return false, nil
+}
+
 // NewProjectRoleDeleteCommand returns a new instance of an `argocd proj role delete` command
 func NewProjectRoleDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var noPrompt bool
 	command := &cobra.Command{
-		Use:     "delete PROJECT ROLE-NAME",
-		Short:   "Delete a project role",
-		Example: `$ argocd proj role delete test-project test-role`,
+		Use:   "delete PROJECT ROLE-NAME",
+		Short: "Delete a project role",
+		Long:  "Delete a project role. Deleting a role which has outstanding tokens invalidates them and requires --yes.",
+		Example: `$ argocd proj role delete test-project test-role
+
+# Delete a role which still has tokens
+$ argocd proj role delete test-project ci-role --yes`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -287,10 +475,16 @@ func NewProjectRoleDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				fmt.Printf("Role '%s' does not exist in project\n", roleName)
 				return
 			}
+			if tokens := roleTokenCount(proj, roleName); tokens > 0 {
+				log.Warnf("Role '%s' has %d outstanding token(s) which stop working once the role is deleted", roleName, tokens)
+				if !noPrompt {
+					log.Fatalf("Refusing to delete role '%s' with outstanding tokens, use --yes to delete it anyway", roleName)
+				}
+			}
 			proj.Spec.Roles[index] = proj.Spec.Roles[len(proj.Spec.Roles)-1]
 			proj.Spec.Roles = proj.Spec.Roles[:len(proj.Spec.Roles)-1]
 
-			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' role? [y/n]", roleName))
+			canDelete := noPrompt || promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' role? [y/n]", roleName))
 			if canDelete {
 				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 				errors.CheckError(err)
@@ -300,9 +494,117 @@ func NewProjectRoleDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			}
 		},
 	}
+	command.Flags().BoolVarP(&noPrompt, "yes", "y", false, "Delete the role without prompting, even if it has outstanding tokens")
+	return command
+}
+
+// roleTokenCount returns the number of distinct tokens issued for the given role, whether recorded in the spec or the
+// status of the project
+func roleTokenCount(proj *v1alpha1.AppProject, roleName string) int {
+	ids := map[string]bool{}
+	tokenID := func(token v1alpha1.JWTToken) string {
+		if token.ID != "" {
+			return token.ID
+		}
+		return strconv.FormatInt(token.IssuedAt, 10)
+	}
+	if role, _, err := proj.GetRoleByName(roleName); err == nil {
+		for _, token := range role.JWTTokens {
+			ids[tokenID(token)] = true
+		}
+	}
+	for _, token := range proj.Status.JWTTokensByRole[roleName].Items {
+		ids[tokenID(token)] = true
+	}
+	return len(ids)
+}
+
+// NewProjectRoleRenameCommand returns a new instance of an `argocd proj role rename` command
+func NewProjectRoleRenameCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var yes bool
+	command := &cobra.Command{
+		Use:   "rename PROJECT ROLE-NAME NEW-ROLE-NAME",
+		Short: "Rename a project role",
+		Long: `Rename a project role, keeping its policies and groups.
+
+The subjects of the role policies are updated to the new name. Tokens issued before the rename reference the old role
+name, so they are revoked and must be re-issued with "argocd proj role create-token". Renaming a role which has
+outstanding tokens requires --yes.`,
+		Example: `$ argocd proj role rename test-project test-role new-test-role
+
+# Rename a role which still has tokens, revoking them
+$ argocd proj role rename test-project ci-role new-ci-role --yes`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			roleName := args[1]
+			newRoleName := args[2]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			if tokens := roleTokenCount(proj, roleName); tokens > 0 && !yes {
+				log.Fatalf("Refusing to rename role '%s' with %d outstanding token(s), use --yes to revoke them and rename it anyway", roleName, tokens)
+			}
+			revoked, err := renameProjectRole(proj, roleName, newRoleName)
+			errors.CheckError(err)
+
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			fmt.Printf("Role '%s' renamed to '%s'\n", roleName, newRoleName)
+			if len(revoked) > 0 {
+				fmt.Printf("Revoked %d token(s) issued for role '%s': %s\n", len(revoked), roleName, strings.Join(revoked, ", "))
+				fmt.Printf("Issue new tokens with: argocd proj role create-token %s %s\n", projName, newRoleName)
+			}
+		},
+	}
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Rename the role even if it has outstanding tokens, which are revoked")
 	return command
 }
 
+// renameProjectRole renames the role of the project, updating the subject of its policies. Tokens issued for the role
+// carry the old role name in their subject, so they are removed from the project, which revokes them. The IDs of the
+// revoked tokens are returned.
+func renameProjectRole(proj *v1alpha1.AppProject, roleName string, newRoleName string) ([]string, error) {
+	role, index, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := proj.GetRoleByName(newRoleName); err == nil {
+		return nil, fmt.Errorf("role '%s' already exists in project '%s'", newRoleName, proj.Name)
+	}
+
+	subject := fmt.Sprintf("proj:%s:%s", proj.Name, roleName)
+	newSubject := fmt.Sprintf("proj:%s:%s", proj.Name, newRoleName)
+	for i, policy := range role.Policies {
+		policyComponents := strings.Split(policy, ",")
+		if len(policyComponents) > 1 && strings.TrimSpace(policyComponents[1]) == subject {
+			policyComponents[1] = " " + newSubject
+			role.Policies[i] = strings.Join(policyComponents, ",")
+		}
+	}
+
+	var revoked []string
+	for _, token := range append(role.JWTTokens, proj.Status.JWTTokensByRole[roleName].Items...) {
+		if !slices.Contains(revoked, token.ID) {
+			revoked = append(revoked, token.ID)
+		}
+	}
+	role.JWTTokens = nil
+	delete(proj.Status.JWTTokensByRole, roleName)
+
+	role.Name = newRoleName
+	proj.Spec.Roles[index] = *role
+	return revoked, nil
+}
+
 func tokenTimeToString(t int64) string {
 	tokenTimeToString := "Never"
 	if t > 0 {
@@ -316,7 +618,10 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 	var (
 		expiresIn       string
 		outputTokenOnly bool
-		tokenID         string
+		tokenIDs        []string
+		count           int
+		audience        string
+		output          string
 	)
 	command := &cobra.Command{
 		Use:   "create-token PROJECT ROLE-NAME",
@@ -327,6 +632,13 @@ Create token succeeded for proj:test-project:test-role.
   Issued At: 2023-10-08T15:21:40+01:00
   Expires At: Never
   Token: xxx
+
+# Create three tokens with the IDs ci-1, ci-2 and ci-3 at once
+$ argocd proj role create-token test-project test-role --id ci --count 3
+
+# Create a token and write an Argo CD CLI config using it, e.g. for a CI system
+$ argocd proj role create-token test-project test-role -o config > ci-config.yaml
+$ argocd app list --config ci-config.yaml
 `,
 		Aliases: []string{"token-create"},
 		Run: func(c *cobra.Command, args []string) {
@@ -338,56 +650,160 @@ Create token succeeded for proj:test-project:test-role.
 			}
 			projName := args[0]
 			roleName := args[1]
-			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			switch output {
+			case "wide":
+			case "config":
+				if outputTokenOnly {
+					log.Fatal("--token-only cannot be combined with --output config")
+				}
+				if clientOpts.Core {
+					log.Fatal("--output config is not supported with --core")
+				}
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := acdClient.NewProjectClientOrDie()
 			defer utilio.Close(conn)
 			if expiresIn == "" {
 				expiresIn = "0s"
 			}
 			duration, err := timeutil.ParseDuration(expiresIn)
 			errors.CheckError(err)
-			tokenResponse, err := projIf.CreateToken(ctx, &projectpkg.ProjectTokenCreateRequest{
+			ids, err := createTokenIDs(tokenIDs, count)
+			errors.CheckError(err)
+			req := &projectpkg.ProjectTokenCreateRequest{
 				Project:   projName,
 				Role:      roleName,
 				ExpiresIn: int64(duration.Seconds()),
-				Id:        tokenID,
-			})
+				Audience:  audience,
+			}
+			// A single token is requested using the id field, which is also understood by older API servers
+			if len(ids) == 1 {
+				req.Id = ids[0]
+			} else {
+				req.Ids = ids
+			}
+			tokenResponse, err := projIf.CreateToken(ctx, req)
 			errors.CheckError(err)
 
-			token, err := jwtgo.Parse(tokenResponse.Token, nil)
-			if token == nil {
-				err = fmt.Errorf("received malformed token %w", err)
+			tokens := tokenResponse.Tokens
+			if len(tokens) == 0 {
+				tokens = []string{tokenResponse.Token}
+			}
+			if output == "config" {
+				cfg, err := tokenLocalConfig(acdClient.ClientOptions(), projName, roleName, tokens)
+				errors.CheckError(err)
+				out, err := yaml.Marshal(cfg)
 				errors.CheckError(err)
+				fmt.Print(string(out))
 				return
 			}
-
-			claims := token.Claims.(jwtgo.MapClaims)
-
-			issuedAt, _ := jwt.IssuedAt(claims)
-			expiresAt := int64(jwt.Float64Field(claims, "exp"))
-			id := jwt.StringField(claims, "jti")
-			subject := jwt.GetUserIdentifier(claims)
-			if !outputTokenOnly {
-				fmt.Printf("Create token succeeded for %s.\n", subject)
-				fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
-					id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
-				)
-				fmt.Println("  Token: " + tokenResponse.Token)
-			} else {
-				fmt.Println(tokenResponse.Token)
+			for _, token := range tokens {
+				errors.CheckError(printCreatedToken(token, outputTokenOnly))
 			}
 		},
 	}
 	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
 		"Duration before the token will expire, e.g. \"12h\", \"7d\". (Default: No expiration)",
 	)
-	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
+	command.Flags().StringArrayVarP(&tokenIDs, "id", "i", nil, "Token unique identifier. Can be repeated to create several tokens at once. (Default: Random UUID)")
+	command.Flags().IntVar(&count, "count", 1, "Number of tokens to create. Combined with a single --id, the IDs of the tokens are suffixed with -1, -2, ...")
 	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
+	command.Flags().StringVar(&audience, "audience", "", "Audience claim of the token. (Default: The token audience of the project)")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|config. 'config' prints an Argo CD CLI config for the server using the token")
 
 	return command
 }
 
+// tokenLocalConfig returns an Argo CD CLI config with a context for each of the given project role tokens, which
+// connects to the server the tokens were created with. The first context is the current one.
+func tokenLocalConfig(serverOpts argocdclient.ClientOptions, projName, roleName string, tokens []string) (*localconfig.LocalConfig, error) {
+	server := localconfig.Server{
+		Server:          serverOpts.ServerAddr,
+		Insecure:        serverOpts.Insecure,
+		PlainText:       serverOpts.PlainText,
+		GRPCWeb:         serverOpts.GRPCWeb,
+		GRPCWebRootPath: serverOpts.GRPCWebRootPath,
+	}
+	cfg := localconfig.LocalConfig{Servers: []localconfig.Server{server}}
+	for _, token := range tokens {
+		name := fmt.Sprintf("proj:%s:%s", projName, roleName)
+		user := localconfig.User{AuthToken: token}
+		if len(tokens) > 1 {
+			claims, err := user.Claims()
+			if err != nil {
+				return nil, fmt.Errorf("received malformed token %w", err)
+			}
+			name = fmt.Sprintf("%s:%s", name, claims.ID)
+		}
+		user.Name = name
+		cfg.Users = append(cfg.Users, user)
+		cfg.Contexts = append(cfg.Contexts, localconfig.ContextRef{Name: name, Server: server.Server, User: name})
+	}
+	if len(cfg.Contexts) > 0 {
+		cfg.CurrentContext = cfg.Contexts[0].Name
+	}
+	return &cfg, nil
+}
+
+// createTokenIDs returns the IDs of the tokens to create. An empty ID lets the API server generate a random one.
+func createTokenIDs(ids []string, count int) ([]string, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("--count must be at least 1, got %d", count)
+	}
+	switch {
+	case count > 1 && len(ids) > 1:
+		return nil, stderrors.New("--count cannot be combined with several --id values")
+	case count > 1 && len(ids) == 1:
+		prefix := ids[0]
+		ids = make([]string, 0, count)
+		for i := 1; i <= count; i++ {
+			ids = append(ids, fmt.Sprintf("%s-%d", prefix, i))
+		}
+	case count > 1:
+		ids = make([]string, count)
+	case len(ids) == 0:
+		ids = []string{""}
+	}
+	for i, id := range ids {
+		if id != "" && slices.Contains(ids[:i], id) {
+			return nil, fmt.Errorf("token id '%s' is specified more than once", id)
+		}
+	}
+	return ids, nil
+}
+
+// printCreatedToken prints the details of a token returned by the API server
+func printCreatedToken(tokenString string, tokenOnly bool) error {
+	if tokenOnly {
+		fmt.Println(tokenString)
+		return nil
+	}
+	token, err := jwtgo.Parse(tokenString, nil)
+	if token == nil {
+		return fmt.Errorf("received malformed token %w", err)
+	}
+
+	claims := token.Claims.(jwtgo.MapClaims)
+
+	issuedAt, _ := jwt.IssuedAt(claims)
+	expiresAt := int64(jwt.Float64Field(claims, "exp"))
+	id := jwt.StringField(claims, "jti")
+	subject := jwt.GetUserIdentifier(claims)
+	fmt.Printf("Create token succeeded for %s.\n", subject)
+	fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
+		id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
+	)
+	fmt.Println("  Token: " + tokenString)
+	return nil
+}
+
 func NewProjectRoleListTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var useUnixTime bool
+	var (
+		useUnixTime bool
+		expiryOpts  tokenExpiryOpts
+	)
 	command := &cobra.Command{
 		Use:   "list-tokens PROJECT ROLE-NAME",
 		Short: "List tokens for a given role.",
@@ -395,6 +811,9 @@ func NewProjectRoleListTokensCommand(clientOpts *argocdclient.ClientOptions) *co
 ID                                      ISSUED AT                    EXPIRES AT
 f316c466-40bd-4cfd-8a8c-1392e92255d4    2023-10-08T15:21:40+01:00    Never
 fa9d3517-c52d-434c-9bff-215b38508842    2023-10-08T11:08:18+01:00    Never
+
+# Flag tokens expiring within a week and fail if any expires within a day
+$ argocd proj role list-tokens test-project test-role --warn-before 7d --critical-before 1d
 `,
 		Aliases: []string{"list-token", "token-list"},
 		Run: func(c *cobra.Command, args []string) {
@@ -406,6 +825,8 @@ fa9d3517-c52d-434c-9bff-215b38508842    2023-10-08T11:08:18+01:00    Never
 			}
 			projName := args[0]
 			roleName := args[1]
+			warnBefore, criticalBefore, err := expiryOpts.thresholds()
+			errors.CheckError(err)
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
@@ -421,24 +842,36 @@ fa9d3517-c52d-434c-9bff-215b38508842    2023-10-08T11:08:18+01:00    Never
 			}
 
 			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
-			_, err = fmt.Fprintf(writer, "ID\tISSUED AT\tEXPIRES AT\n")
+			if expiryOpts.enabled() {
+				_, err = fmt.Fprintf(writer, "ID\tISSUED AT\tEXPIRES AT\tEXPIRY\n")
+			} else {
+				_, err = fmt.Fprintf(writer, "ID\tISSUED AT\tEXPIRES AT\n")
+			}
 			errors.CheckError(err)
 
-			tokenRowFormat := "%s\t%v\t%v\n"
+			tokenRowFormat := "%s\t%v\t%v"
+			now := time.Now()
 			for _, token := range role.JWTTokens {
 				if useUnixTime {
 					_, _ = fmt.Fprintf(writer, tokenRowFormat, token.ID, token.IssuedAt, token.ExpiresAt)
 				} else {
 					_, _ = fmt.Fprintf(writer, tokenRowFormat, token.ID, tokenTimeToString(token.IssuedAt), tokenTimeToString(token.ExpiresAt))
 				}
+				if expiryOpts.enabled() {
+					warning, _ := tokenExpiryWarning(token.ExpiresAt, now, warnBefore, criticalBefore)
+					_, _ = fmt.Fprintf(writer, "\t%s", warning)
+				}
+				_, _ = fmt.Fprintln(writer)
 			}
 			err = writer.Flush()
 			errors.CheckError(err)
+			expiryOpts.exitIfCritical(projName, roleName, role.JWTTokens, now, criticalBefore)
 		},
 	}
 	command.Flags().BoolVarP(&useUnixTime, "unixtime", "u", false,
 		"Print timestamps as Unix time instead of converting. Useful for piping into delete-token.",
 	)
+	expiryOpts.addFlags(command)
 	return command
 }
 
@@ -469,8 +902,8 @@ Description:
 Policies:
 p, proj:test-project:test-role, projects, get, test-project, allow
 JWT Tokens:
-ID          ISSUED-AT                                  EXPIRES-AT
-1696769937  2023-10-08T13:58:57+01:00 (6 minutes ago)  <none>
+ID          ISSUED-AT  EXPIRES-AT
+1696769937  6m ago     <none>
 
 $ argocd proj role delete-token test-project test-role 1696769937
 `,
@@ -569,6 +1002,12 @@ func NewProjectRoleListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 // NewProjectRoleGetCommand returns a new instance of an `argocd proj roles get` command
 func NewProjectRoleGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		expiryOpts      tokenExpiryOpts
+		timeFormat      string
+		showTokenClaims bool
+		output          string
+	)
 	command := &cobra.Command{
 		Use:   "get PROJECT ROLE-NAME",
 		Short: "Get the details of a specific role",
@@ -578,9 +1017,18 @@ Description:
 Policies:
 p, proj:test-project:test-role, projects, get, test-project, allow
 JWT Tokens:
-ID          ISSUED-AT                                  EXPIRES-AT
-1696774900  2023-10-08T15:21:40+01:00 (4 minutes ago)  <none>
-1696759698  2023-10-08T11:08:18+01:00 (4 hours ago)    <none>
+ID          ISSUED-AT  EXPIRES-AT
+1696774900  4m ago     in 29d
+1696759698  4h ago     <none>
+
+# Print token timestamps as Unix time for use in scripts
+$ argocd proj role get test-project test-role --time-format raw
+
+# Print the claims of each token as reconstructed from the project, without the token itself
+$ argocd proj role get test-project test-role --show-token-claims
+
+# Print the role as JSON for use in scripts
+$ argocd proj role get test-project test-role -o json
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -591,6 +1039,8 @@ ID          ISSUED-AT                                  EXPIRES-AT
 			}
 			projName := args[0]
 			roleName := args[1]
+			warnBefore, criticalBefore, err := expiryOpts.thresholds()
+			errors.CheckError(err)
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
@@ -600,21 +1050,188 @@ ID          ISSUED-AT                                  EXPIRES-AT
 			role, _, err := proj.GetRoleByName(roleName)
 			errors.CheckError(err)
 
+			switch output {
+			case "json", "yaml":
+				err = PrintResource(newProjectRoleDetails(proj, role), output)
+				errors.CheckError(err)
+				expiryOpts.exitIfCritical(projName, roleName, proj.Status.JWTTokensByRole[roleName].Items, time.Now(), criticalBefore)
+				return
+			case "wide", "":
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+
 			printRoleFmtStr := "%-15s%s\n"
 			fmt.Printf(printRoleFmtStr, "Role Name:", roleName)
 			fmt.Printf(printRoleFmtStr, "Description:", role.Description)
 			fmt.Printf("Policies:\n")
 			fmt.Printf("%s\n", proj.ProjectPoliciesString())
+			if conditional := role.ConditionalPolicies(); len(conditional) > 0 {
+				// the policies above are resolved as for an unknown application, i.e. without conditional allow rules
+				fmt.Printf("Conditional Policies:\n")
+				fmt.Printf("%s\n", strings.Join(conditional, "\n"))
+			}
 			fmt.Printf("JWT Tokens:\n")
 			// TODO(jessesuen): print groups
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "ID\tISSUED-AT\tEXPIRES-AT\n")
+			if expiryOpts.enabled() {
+				fmt.Fprintf(w, "ID\tISSUED-AT\tEXPIRES-AT\tEXPIRY\n")
+			} else {
+				fmt.Fprintf(w, "ID\tISSUED-AT\tEXPIRES-AT\n")
+			}
+			now := time.Now()
 			for _, token := range proj.Status.JWTTokensByRole[roleName].Items {
-				expiresAt := "<none>"
-				if token.ExpiresAt > 0 {
-					expiresAt = humanizeTimestamp(token.ExpiresAt)
+				issuedAt, err := formatTokenTime(token.IssuedAt, now, timeFormat, false)
+				errors.CheckError(err)
+				expiresAt, err := formatTokenTime(token.ExpiresAt, now, timeFormat, true)
+				errors.CheckError(err)
+				fmt.Fprintf(w, "%d\t%s\t%s", token.IssuedAt, issuedAt, expiresAt)
+				if expiryOpts.enabled() {
+					warning, _ := tokenExpiryWarning(token.ExpiresAt, now, warnBefore, criticalBefore)
+					fmt.Fprintf(w, "\t%s", warning)
 				}
-				fmt.Fprintf(w, "%d\t%s\t%s\n", token.IssuedAt, humanizeTimestamp(token.IssuedAt), expiresAt)
+				fmt.Fprintln(w)
+			}
+			_ = w.Flush()
+			if showTokenClaims {
+				fmt.Printf("Token Claims (reconstructed from the project, without the audience):\n")
+				w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "ID\tCLAIMS\n")
+				for _, token := range proj.Status.JWTTokensByRole[roleName].Items {
+					claims, err := tokenClaims(proj, roleName, token)
+					errors.CheckError(err)
+					fmt.Fprintf(w, "%d\t%s\n", token.IssuedAt, claims)
+				}
+				_ = w.Flush()
+			}
+			expiryOpts.exitIfCritical(projName, roleName, proj.Status.JWTTokensByRole[roleName].Items, now, criticalBefore)
+		},
+	}
+	command.Flags().StringVar(&timeFormat, "time-format", tokenTimeFormatRelative, "Format of token timestamps. One of: raw|rfc3339|relative")
+	command.Flags().BoolVar(&showTokenClaims, "show-token-claims", false, "Print the claims of each token as reconstructed from the token metadata stored in the project, without the audience which is not stored")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	expiryOpts.addFlags(command)
+	return command
+}
+
+// projectRoleDetails is the structured output of `argocd proj role get`, whose fields are kept stable for scripts
+type projectRoleDetails struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Policies    []string           `json:"policies"`
+	Groups      []string           `json:"groups"`
+	Tokens      []projectRoleToken `json:"tokens"`
+}
+
+// projectRoleToken is the metadata of a token of a project role. ExpiresAt is 0 for tokens which never expire.
+type projectRoleToken struct {
+	ID        string `json:"id"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// newProjectRoleDetails returns the structured details of a role, with the tokens recorded in the project status
+func newProjectRoleDetails(proj *v1alpha1.AppProject, role *v1alpha1.ProjectRole) projectRoleDetails {
+	details := projectRoleDetails{
+		Name:        role.Name,
+		Description: role.Description,
+		Policies:    append([]string{}, role.Policies...),
+		Groups:      append([]string{}, role.Groups...),
+		Tokens:      []projectRoleToken{},
+	}
+	for _, token := range proj.Status.JWTTokensByRole[role.Name].Items {
+		details.Tokens = append(details.Tokens, projectRoleToken{ID: token.ID, IssuedAt: token.IssuedAt, ExpiresAt: token.ExpiresAt})
+	}
+	return details
+}
+
+const (
+	// policySourceImplicit marks the policy every role is granted to get its own project
+	policySourceImplicit = "implicit"
+	// policySourceRole marks the policies configured for the role
+	policySourceRole = "role"
+	// policySourceGroup marks the bindings of the groups of the role
+	policySourceGroup = "group"
+)
+
+// effectivePolicy is a policy of a project role annotated with where it comes from
+type effectivePolicy struct {
+	Source string
+	Policy string
+}
+
+// effectiveRolePolicies returns the policies and group bindings the project grants the role, in the order they are
+// loaded into the RBAC enforcer. Policies are normalized and only the first occurrence of a policy is kept. Policies of
+// argocd-rbac-cm are not part of the project and are not returned.
+func effectiveRolePolicies(proj *v1alpha1.AppProject, roleName string) ([]effectivePolicy, error) {
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return nil, err
+	}
+	var policies []effectivePolicy
+	seen := map[string]bool{}
+	add := func(source string, policy string) {
+		fields := strings.Split(policy, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		policy = strings.Join(fields, ", ")
+		if seen[policy] {
+			return
+		}
+		seen[policy] = true
+		policies = append(policies, effectivePolicy{Source: source, Policy: policy})
+	}
+	add(policySourceImplicit, fmt.Sprintf("p, proj:%s:%s, projects, get, %s, allow", proj.Name, role.Name, proj.Name))
+	for _, policy := range role.Policies {
+		add(policySourceRole, policy)
+	}
+	for _, group := range role.Groups {
+		add(policySourceGroup, fmt.Sprintf("g, %s, proj:%s:%s", group, proj.Name, role.Name))
+	}
+	return policies, nil
+}
+
+// NewProjectRoleEffectiveCommand returns a new instance of an `argocd proj role effective` command
+func NewProjectRoleEffectiveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "effective PROJECT ROLE-NAME",
+		Short: "List the deduplicated policies the project grants a role, annotated by their source",
+		Long: `List the deduplicated policies the project grants a role, annotated by their source.
+
+The sources are the permission every role has to get its own project (implicit), the policies of the role (role) and
+the bindings of its groups (group). Only the project itself is read: policies and group bindings of argocd-rbac-cm,
+including its default role, are not listed, even if they apply to the role's subject. Global projects never
+contribute role policies. Policies with a label condition are listed as they are configured, see
+"argocd proj role get" for how they are applied.`,
+		Example: `$ argocd proj role effective test-project test-role
+SOURCE    POLICY
+implicit  p, proj:test-project:test-role, projects, get, test-project, allow
+role      p, proj:test-project:test-role, applications, sync, test-project/*, allow
+group     g, my-oidc-group, proj:test-project:test-role
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			roleName := args[1]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			policies, err := effectiveRolePolicies(proj, roleName)
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "SOURCE\tPOLICY\n")
+			for _, policy := range policies {
+				fmt.Fprintf(w, "%s\t%s\n", policy.Source, policy.Policy)
 			}
 			_ = w.Flush()
 		},
@@ -692,3 +1309,114 @@ func NewProjectRoleRemoveGroupCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// projectRoleMapping is the desired set of project roles read by `argocd proj role sync-from`
+type projectRoleMapping struct {
+	Roles []v1alpha1.ProjectRole `json:"roles"`
+}
+
+// reconcileProjectRoles updates the roles of the project to match the desired ones: missing roles are created, roles
+// which are not desired are deleted and the remaining roles are updated. The tokens of the remaining roles are kept.
+func reconcileProjectRoles(proj *v1alpha1.AppProject, desired []v1alpha1.ProjectRole) (created []string, updated []string, deleted []string) {
+	roles := make([]v1alpha1.ProjectRole, 0, len(desired))
+	desiredNames := make(map[string]bool, len(desired))
+	for _, desiredRole := range desired {
+		desiredNames[desiredRole.Name] = true
+		role := *desiredRole.DeepCopy()
+		role.JWTTokens = nil
+		existing, _, err := proj.GetRoleByName(desiredRole.Name)
+		if err != nil {
+			created = append(created, role.Name)
+		} else {
+			role.JWTTokens = existing.JWTTokens
+			if !reflect.DeepEqual(*existing, role) {
+				updated = append(updated, role.Name)
+			}
+		}
+		roles = append(roles, role)
+	}
+	for _, role := range proj.Spec.Roles {
+		if !desiredNames[role.Name] {
+			deleted = append(deleted, role.Name)
+		}
+	}
+	proj.Spec.Roles = roles
+	return created, updated, deleted
+}
+
+// NewProjectRoleSyncFromCommand returns a new instance of an `argocd proj role sync-from` command
+func NewProjectRoleSyncFromCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		mappingFile string
+		dryRun      bool
+	)
+	command := &cobra.Command{
+		Use:   "sync-from PROJECT",
+		Short: "Reconcile the roles of a project against a mapping file",
+		Long: `Reconcile the roles of a project against a mapping file: roles missing from the project are created, roles
+not listed in the file are deleted, and the description, policies and groups of the remaining roles are updated.
+Tokens of roles which are kept are preserved. All changes are applied in a single project update.`,
+		Example: `# Show which roles would be created, updated or deleted
+$ argocd proj role sync-from test-project --mapping-file roles.yaml
+
+# Apply the changes
+$ argocd proj role sync-from test-project --mapping-file roles.yaml --dry-run=false
+
+# Example mapping file
+roles:
+- name: developers
+  description: Developers of the team
+  groups:
+  - my-org:developers
+  policies:
+  - p, proj:test-project:developers, applications, sync, test-project/*, allow
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			if mappingFile == "" {
+				log.Fatal("--mapping-file is required")
+			}
+			var mapping projectRoleMapping
+			errors.CheckError(config.UnmarshalLocalFile(mappingFile, &mapping))
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			created, updated, deleted := reconcileProjectRoles(proj, mapping.Roles)
+			if len(created)+len(updated)+len(deleted) == 0 {
+				fmt.Printf("Roles of project '%s' are up to date\n", projName)
+				return
+			}
+			suffix := ""
+			if dryRun {
+				suffix = " (dry run)"
+			}
+			for _, roleName := range created {
+				fmt.Printf("Role '%s' created%s\n", roleName, suffix)
+			}
+			for _, roleName := range updated {
+				fmt.Printf("Role '%s' updated%s\n", roleName, suffix)
+			}
+			for _, roleName := range deleted {
+				fmt.Printf("Role '%s' deleted%s\n", roleName, suffix)
+			}
+			if dryRun {
+				return
+			}
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&mappingFile, "mapping-file", "", "Path to a YAML or JSON file with the desired roles of the project")
+	command.Flags().BoolVar(&dryRun, "dry-run", true, "Only print the changes which would be made")
+	return command
+}
@@ -0,0 +1,477 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_tokenExpiryOpts_thresholds(t *testing.T) {
+	warnBefore, criticalBefore, err := (&tokenExpiryOpts{}).thresholds()
+	require.NoError(t, err)
+	assert.Zero(t, warnBefore)
+	assert.Zero(t, criticalBefore)
+
+	warnBefore, criticalBefore, err = (&tokenExpiryOpts{warnBefore: "7d", criticalBefore: "12h"}).thresholds()
+	require.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, warnBefore)
+	assert.Equal(t, 12*time.Hour, criticalBefore)
+
+	_, _, err = (&tokenExpiryOpts{warnBefore: "soon"}).thresholds()
+	require.ErrorContains(t, err, "invalid --warn-before")
+}
+
+func Test_tokenExpiryWarning(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresIn := func(d time.Duration) int64 {
+		return now.Add(d).Unix()
+	}
+	warnBefore := 7 * 24 * time.Hour
+	criticalBefore := 24 * time.Hour
+
+	testCases := []struct {
+		name             string
+		expiresAt        int64
+		expectedWarning  string
+		expectedCritical bool
+	}{
+		{name: "NoExpiry", expiresAt: 0, expectedWarning: "", expectedCritical: false},
+		{name: "FarAway", expiresAt: expiresIn(30 * 24 * time.Hour), expectedWarning: "", expectedCritical: false},
+		{name: "WithinWarning", expiresAt: expiresIn(72 * time.Hour), expectedWarning: "WARNING: expires in 72h0m0s", expectedCritical: false},
+		{name: "WithinCritical", expiresAt: expiresIn(time.Hour), expectedWarning: "CRITICAL: expires in 1h0m0s", expectedCritical: true},
+		{name: "Expired", expiresAt: expiresIn(-time.Hour), expectedWarning: "EXPIRED", expectedCritical: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			warning, critical := tokenExpiryWarning(tc.expiresAt, now, warnBefore, criticalBefore)
+			assert.Equal(t, tc.expectedWarning, warning)
+			assert.Equal(t, tc.expectedCritical, critical)
+		})
+	}
+
+	t.Run("WithoutCriticalThreshold", func(t *testing.T) {
+		warning, critical := tokenExpiryWarning(expiresIn(time.Hour), now, warnBefore, 0)
+		assert.Equal(t, "WARNING: expires in 1h0m0s", warning)
+		assert.False(t, critical)
+
+		warning, critical = tokenExpiryWarning(expiresIn(-time.Hour), now, warnBefore, 0)
+		assert.Equal(t, "EXPIRED", warning)
+		assert.False(t, critical)
+	})
+}
+
+func Test_reconcileProjectRoles(t *testing.T) {
+	newProject := func() *v1alpha1.AppProject {
+		proj := newTestProject()
+		proj.Spec.Roles = []v1alpha1.ProjectRole{
+			{
+				Name:      "kept",
+				Groups:    []string{"my-org:kept"},
+				Policies:  []string{"p, proj:test-proj:kept, applications, get, test-proj/*, allow"},
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "kept-token"}},
+			},
+			{
+				Name:      "changed",
+				Groups:    []string{"my-org:changed"},
+				Policies:  []string{"p, proj:test-proj:changed, applications, get, test-proj/*, allow"},
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 2, ID: "changed-token"}},
+			},
+			{
+				Name:      "removed",
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 3, ID: "removed-token"}},
+			},
+		}
+		return proj
+	}
+	desired := []v1alpha1.ProjectRole{
+		{
+			Name:     "kept",
+			Groups:   []string{"my-org:kept"},
+			Policies: []string{"p, proj:test-proj:kept, applications, get, test-proj/*, allow"},
+		},
+		{
+			Name:     "changed",
+			Groups:   []string{"my-org:changed", "my-org:admins"},
+			Policies: []string{"p, proj:test-proj:changed, applications, sync, test-proj/*, allow"},
+		},
+		{
+			Name:     "added",
+			Groups:   []string{"my-org:added"},
+			Policies: []string{"p, proj:test-proj:added, applications, get, test-proj/*, allow"},
+			// tokens cannot be created from a mapping file
+			JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 4, ID: "forged-token"}},
+		},
+	}
+
+	t.Run("CreateUpdateDelete", func(t *testing.T) {
+		proj := newProject()
+		created, updated, deleted := reconcileProjectRoles(proj, desired)
+		assert.Equal(t, []string{"added"}, created)
+		assert.Equal(t, []string{"changed"}, updated)
+		assert.Equal(t, []string{"removed"}, deleted)
+
+		require.Len(t, proj.Spec.Roles, 3)
+		kept, _, err := proj.GetRoleByName("kept")
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.JWTToken{{IssuedAt: 1, ID: "kept-token"}}, kept.JWTTokens)
+
+		changed, _, err := proj.GetRoleByName("changed")
+		require.NoError(t, err)
+		assert.Equal(t, desired[1].Groups, changed.Groups)
+		assert.Equal(t, desired[1].Policies, changed.Policies)
+		assert.Equal(t, []v1alpha1.JWTToken{{IssuedAt: 2, ID: "changed-token"}}, changed.JWTTokens)
+
+		added, _, err := proj.GetRoleByName("added")
+		require.NoError(t, err)
+		assert.Empty(t, added.JWTTokens)
+
+		_, _, err = proj.GetRoleByName("removed")
+		require.Error(t, err)
+	})
+
+	t.Run("UpToDate", func(t *testing.T) {
+		proj := newProject()
+		reconcileProjectRoles(proj, desired)
+		created, updated, deleted := reconcileProjectRoles(proj, desired)
+		assert.Empty(t, created)
+		assert.Empty(t, updated)
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("DeleteAll", func(t *testing.T) {
+		proj := newProject()
+		created, updated, deleted := reconcileProjectRoles(proj, nil)
+		assert.Empty(t, created)
+		assert.Empty(t, updated)
+		assert.Equal(t, []string{"kept", "changed", "removed"}, deleted)
+		assert.Empty(t, proj.Spec.Roles)
+	})
+}
+
+func Test_formatTokenTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuedAt := now.Add(-3 * 24 * time.Hour).Unix()
+	expiresAt := now.Add(29*24*time.Hour + time.Hour).Unix()
+	expiredAt := now.Add(-3 * 24 * time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		epoch    int64
+		format   string
+		isExpiry bool
+		expected string
+	}{
+		{"raw issued", issuedAt, tokenTimeFormatRaw, false, "1703808000"},
+		{"raw expires", expiresAt, tokenTimeFormatRaw, true, "1706576400"},
+		{"raw never expires", 0, tokenTimeFormatRaw, true, "0"},
+		{"rfc3339 issued", issuedAt, tokenTimeFormatRFC3339, false, time.Unix(issuedAt, 0).Format(time.RFC3339)},
+		{"rfc3339 expires", expiresAt, tokenTimeFormatRFC3339, true, time.Unix(expiresAt, 0).Format(time.RFC3339)},
+		{"rfc3339 never expires", 0, tokenTimeFormatRFC3339, true, "<none>"},
+		{"relative issued", issuedAt, tokenTimeFormatRelative, false, "3d ago"},
+		{"relative expires", expiresAt, tokenTimeFormatRelative, true, "in 29d"},
+		{"relative expired", expiredAt, tokenTimeFormatRelative, true, "expired 3d ago"},
+		{"relative never expires", 0, tokenTimeFormatRelative, true, "<none>"},
+		{"relative hours", now.Add(-5 * time.Hour).Unix(), tokenTimeFormatRelative, false, "5h ago"},
+		{"relative minutes", now.Add(4 * time.Minute).Unix(), tokenTimeFormatRelative, true, "in 4m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := formatTokenTime(tt.epoch, now, tt.format, tt.isExpiry)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	_, err := formatTokenTime(issuedAt, now, "epoch", false)
+	require.EqualError(t, err, "unknown time format: epoch")
+}
+
+func Test_createProjectRole(t *testing.T) {
+	newProj := func() *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: v1alpha1.AppProjectSpec{
+				Roles: []v1alpha1.ProjectRole{{Name: "existing", Description: "old", Policies: []string{"p"}}},
+			},
+		}
+	}
+
+	proj := newProj()
+	created, err := createProjectRole(proj, "new", "desc", false)
+	require.NoError(t, err)
+	assert.True(t, created)
+	require.Len(t, proj.Spec.Roles, 2)
+	assert.Equal(t, v1alpha1.ProjectRole{Name: "new", Description: "desc"}, proj.Spec.Roles[1])
+
+	proj = newProj()
+	_, err = createProjectRole(proj, "existing", "new", false)
+	require.EqualError(t, err, "role 'existing' already exists in project 'test', use --upsert to update it")
+	assert.Equal(t, newProj().Spec.Roles, proj.Spec.Roles)
+
+	proj = newProj()
+	created, err = createProjectRole(proj, "existing", "new", true)
+	require.NoError(t, err)
+	assert.False(t, created)
+	require.Len(t, proj.Spec.Roles, 1)
+	assert.Equal(t, v1alpha1.ProjectRole{Name: "existing", Description: "new", Policies: []string{"p"}}, proj.Spec.Roles[0])
+}
+
+func Test_renameProjectRole(t *testing.T) {
+	newProj := func() *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: v1alpha1.AppProjectSpec{
+				Roles: []v1alpha1.ProjectRole{
+					{
+						Name:      "old",
+						Policies:  []string{"p, proj:test:old, applications, get, test/*, allow"},
+						Groups:    []string{"group"},
+						JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "spec-token"}},
+					},
+					{Name: "other"},
+				},
+			},
+			Status: v1alpha1.AppProjectStatus{
+				JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+					"old":   {Items: []v1alpha1.JWTToken{{IssuedAt: 2, ID: "status-token"}}},
+					"other": {Items: []v1alpha1.JWTToken{{IssuedAt: 3, ID: "other-token"}}},
+				},
+			},
+		}
+	}
+
+	proj := newProj()
+	revoked, err := renameProjectRole(proj, "old", "new")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"spec-token", "status-token"}, revoked)
+	role, _, err := proj.GetRoleByName("new")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p, proj:test:new, applications, get, test/*, allow"}, role.Policies)
+	assert.Equal(t, []string{"group"}, role.Groups)
+	assert.Empty(t, role.JWTTokens, "tokens issued for the old name are revoked")
+	assert.Equal(t, map[string]v1alpha1.JWTTokens{
+		"other": {Items: []v1alpha1.JWTToken{{IssuedAt: 3, ID: "other-token"}}},
+	}, proj.Status.JWTTokensByRole)
+	proj.NormalizeJWTTokens()
+	assert.Empty(t, proj.Status.JWTTokensByRole["new"].Items, "no token is restored when the server normalizes the project")
+	_, _, err = proj.GetRoleByName("old")
+	require.Error(t, err)
+	require.NoError(t, proj.ValidateProject())
+
+	proj = newProj()
+	_, err = renameProjectRole(proj, "old", "other")
+	require.EqualError(t, err, "role 'other' already exists in project 'test'")
+	assert.Equal(t, newProj(), proj)
+
+	_, err = renameProjectRole(newProj(), "missing", "new")
+	require.EqualError(t, err, "role 'missing' does not exist in project 'test'")
+}
+
+func Test_createTokenIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		ids         []string
+		count       int
+		expected    []string
+		expectedErr string
+	}{
+		{name: "random", count: 1, expected: []string{""}},
+		{name: "single id", ids: []string{"ci"}, count: 1, expected: []string{"ci"}},
+		{name: "several ids", ids: []string{"ci", "cd", "bot"}, count: 1, expected: []string{"ci", "cd", "bot"}},
+		{name: "count with id", ids: []string{"ci"}, count: 3, expected: []string{"ci-1", "ci-2", "ci-3"}},
+		{name: "count with random ids", count: 2, expected: []string{"", ""}},
+		{name: "duplicate ids", ids: []string{"ci", "cd", "ci"}, count: 1, expectedErr: "token id 'ci' is specified more than once"},
+		{name: "count with several ids", ids: []string{"ci", "cd"}, count: 2, expectedErr: "--count cannot be combined with several --id values"},
+		{name: "invalid count", count: 0, expectedErr: "--count must be at least 1, got 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := createTokenIDs(tt.ids, tt.count)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func Test_tokenClaims(t *testing.T) {
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "test-project"}}
+
+	claims, err := tokenClaims(proj, "ci", v1alpha1.JWTToken{IssuedAt: 1696759698, ExpiresAt: 1699351698, ID: "token-1"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iss":"argocd","sub":"proj:test-project:ci","iat":1696759698,"exp":1699351698,"jti":"token-1"}`, claims)
+
+	// tokens without expiry do not have an exp claim, and the audience is never reconstructed since it is not stored
+	proj.Spec.TokenAudience = "argocd-ci"
+	claims, err = tokenClaims(proj, "ci", v1alpha1.JWTToken{IssuedAt: 1696759698, ID: "token-2"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iss":"argocd","sub":"proj:test-project:ci","iat":1696759698,"jti":"token-2"}`, claims)
+}
+
+func Test_formatRolePolicy(t *testing.T) {
+	policy := formatRolePolicy("myproj", "roleTest", policyOpts{action: "sync", permission: "allow", object: "*", resource: "applications"})
+	assert.Equal(t, "p, proj:myproj:roleTest, applications, sync, myproj/*, allow", policy)
+
+	policy = formatRolePolicy("myproj", "roleTest", policyOpts{action: "get", permission: "deny", object: "guestbook", resource: "logs"})
+	assert.Equal(t, "p, proj:myproj:roleTest, logs, get, myproj/guestbook, deny", policy)
+}
+
+func Test_effectiveRolePolicies(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{
+			Name: "my-role",
+			Policies: []string{
+				"p, proj:my-proj:my-role, applications, sync, my-proj/*, allow",
+				// duplicates of the implicit and the previous policy
+				"p,proj:my-proj:my-role,projects,get,my-proj,allow",
+				"p, proj:my-proj:my-role,  applications, sync, my-proj/*, allow",
+			},
+			Groups: []string{"my-group", "my-group"},
+		}}},
+	}
+
+	policies, err := effectiveRolePolicies(proj, "my-role")
+	require.NoError(t, err)
+	assert.Equal(t, []effectivePolicy{
+		{Source: policySourceImplicit, Policy: "p, proj:my-proj:my-role, projects, get, my-proj, allow"},
+		{Source: policySourceRole, Policy: "p, proj:my-proj:my-role, applications, sync, my-proj/*, allow"},
+		{Source: policySourceGroup, Policy: "g, my-group, proj:my-proj:my-role"},
+	}, policies)
+
+	_, err = effectiveRolePolicies(proj, "missing")
+	require.Error(t, err)
+}
+
+func Test_roleTokenCount(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{
+			{Name: "ci", JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "a"}, {IssuedAt: 2}}},
+			{Name: "dev"},
+		}},
+		Status: v1alpha1.AppProjectStatus{JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+			"ci": {Items: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "a"}, {IssuedAt: 3, ID: "b"}}},
+		}},
+	}
+	assert.Equal(t, 3, roleTokenCount(proj, "ci"))
+	assert.Zero(t, roleTokenCount(proj, "dev"))
+	assert.Zero(t, roleTokenCount(proj, "missing"))
+}
+
+func Test_newProjectRoleDetails(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{
+			Name:        "ci",
+			Description: "CI pipelines",
+			Policies:    []string{"p, proj:test:ci, applications, sync, test/*, allow"},
+		}}},
+		Status: v1alpha1.AppProjectStatus{JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+			"ci": {Items: []v1alpha1.JWTToken{{IssuedAt: 1696759698, ID: "a"}, {IssuedAt: 1696774900, ExpiresAt: 1699366900, ID: "b"}}},
+		}},
+	}
+	role, _, err := proj.GetRoleByName("ci")
+	require.NoError(t, err)
+
+	out, err := json.Marshal(newProjectRoleDetails(proj, role))
+	require.NoError(t, err)
+	var details map[string]any
+	require.NoError(t, json.Unmarshal(out, &details))
+	assert.Equal(t, "ci", details["name"])
+	assert.Equal(t, "CI pipelines", details["description"])
+	assert.Equal(t, []any{"p, proj:test:ci, applications, sync, test/*, allow"}, details["policies"])
+	assert.Equal(t, []any{}, details["groups"])
+	assert.Equal(t, []any{
+		map[string]any{"id": "a", "iat": float64(1696759698), "exp": float64(0)},
+		map[string]any{"id": "b", "iat": float64(1696774900), "exp": float64(1699366900)},
+	}, details["tokens"])
+}
+
+func Test_tokenLocalConfig(t *testing.T) {
+	newToken := func(id string) string {
+		token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.RegisteredClaims{ID: id, Subject: "proj:test:ci"}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		return token
+	}
+	serverOpts := argocdclient.ClientOptions{ServerAddr: "argocd.example.com:443", GRPCWeb: true}
+
+	t.Run("single token", func(t *testing.T) {
+		token := newToken("ci")
+		cfg, err := tokenLocalConfig(serverOpts, "test", "ci", []string{token})
+		require.NoError(t, err)
+		out, err := yaml.Marshal(cfg)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), "auth-token: "+token)
+		assert.Contains(t, string(out), "server: argocd.example.com:443")
+
+		ctx, err := cfg.ResolveContext("")
+		require.NoError(t, err)
+		assert.Equal(t, "proj:test:ci", ctx.Name)
+		assert.Equal(t, token, ctx.User.AuthToken)
+		assert.Equal(t, "argocd.example.com:443", ctx.Server.Server)
+		assert.True(t, ctx.Server.GRPCWeb)
+	})
+
+	t.Run("several tokens", func(t *testing.T) {
+		cfg, err := tokenLocalConfig(serverOpts, "test", "ci", []string{newToken("ci-1"), newToken("ci-2")})
+		require.NoError(t, err)
+		require.Len(t, cfg.Contexts, 2)
+		assert.Equal(t, "proj:test:ci:ci-1", cfg.CurrentContext)
+		assert.Equal(t, "proj:test:ci:ci-2", cfg.Contexts[1].Name)
+		assert.Equal(t, "proj:test:ci:ci-2", cfg.Users[1].Name)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, err := tokenLocalConfig(serverOpts, "test", "ci", []string{"a", "b"})
+		require.ErrorContains(t, err, "received malformed token")
+	})
+}
+
+func Test_tokenExpiryOpts_exitIfCritical(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokens := []v1alpha1.JWTToken{
+		{IssuedAt: 1, ExpiresAt: 0},
+		{IssuedAt: 2, ExpiresAt: now.Add(30 * 24 * time.Hour).Unix()},
+		{IssuedAt: 3, ExpiresAt: now.Add(72 * time.Hour).Unix()},
+	}
+	opts := &tokenExpiryOpts{warnBefore: "7d", criticalBefore: "1d"}
+
+	// tokens within the warning threshold only are annotated, but do not fail the command
+	opts.exitIfCritical("my-proj", "ci", tokens, now, 24*time.Hour)
+
+	testCases := []struct {
+		name      string
+		expiresAt int64
+	}{
+		{name: "WithinCritical", expiresAt: now.Add(time.Hour).Unix()},
+		{name: "Expired", expiresAt: now.Add(-time.Hour).Unix()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if os.Getenv("TEST_FATAL") == "1" {
+				opts.exitIfCritical("my-proj", "ci", append(tokens, v1alpha1.JWTToken{IssuedAt: 4, ExpiresAt: tc.expiresAt}), now, 24*time.Hour)
+				return
+			}
+			cmd := exec.Command(os.Args[0], "-test.run=^"+t.Name()+"$")
+			cmd.Env = append(os.Environ(), "TEST_FATAL=1")
+			output, err := cmd.CombinedOutput()
+			var exitErr *exec.ExitError
+			require.ErrorAs(t, err, &exitErr, "expected a non-zero exit code, got output: %s", output)
+			assert.Equal(t, 1, exitErr.ExitCode())
+			assert.Contains(t, string(output), "One or more tokens of my-proj.ci expire within 1d")
+		})
+	}
+}
@@ -0,0 +1,813 @@
+package commands
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/yaml"
+
+	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// flakyProjectClient is a stubbed project client which fails with the configured errors before succeeding
+type flakyProjectClient struct {
+	projectpkg.ProjectServiceClient
+	errs  []error
+	calls int
+}
+
+func (c *flakyProjectClient) nextError() error {
+	c.calls++
+	if c.calls <= len(c.errs) {
+		return c.errs[c.calls-1]
+	}
+	return nil
+}
+
+func (c *flakyProjectClient) List(_ context.Context, _ *projectpkg.ProjectQuery, _ ...grpc.CallOption) (*v1alpha1.AppProjectList, error) {
+	if err := c.nextError(); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.AppProjectList{Items: []v1alpha1.AppProject{*newTestProject()}}, nil
+}
+
+func (c *flakyProjectClient) GetDetailedProject(_ context.Context, q *projectpkg.ProjectQuery, _ ...grpc.CallOption) (*projectpkg.DetailedProjectsResponse, error) {
+	if err := c.nextError(); err != nil {
+		return nil, err
+	}
+	proj := newTestProject()
+	proj.Name = q.Name
+	return &projectpkg.DetailedProjectsResponse{Project: proj}, nil
+}
+
+// fakeRepoClient is a stubbed repository client which only knows about the configured repository URLs
+type fakeRepoClient struct {
+	repositorypkg.RepositoryServiceClient
+	repos   []string
+	queries []*repositorypkg.RepoQuery
+}
+
+func (c *fakeRepoClient) ListRefs(_ context.Context, q *repositorypkg.RepoQuery, _ ...grpc.CallOption) (*apiclient.Refs, error) {
+	c.queries = append(c.queries, q)
+	for _, repo := range c.repos {
+		if repo == q.Repo {
+			return &apiclient.Refs{Branches: []string{"main"}}, nil
+		}
+	}
+	return nil, status.Errorf(codes.Unknown, "repository not found")
+}
+
+// fakeDeleteProjectClient is a stubbed project client which refuses to delete the projects used by applications
+type fakeDeleteProjectClient struct {
+	projectpkg.ProjectServiceClient
+	usedByApps []string
+	deleted    []string
+}
+
+func (c *fakeDeleteProjectClient) Delete(_ context.Context, q *projectpkg.ProjectQuery, _ ...grpc.CallOption) (*projectpkg.EmptyResponse, error) {
+	if slices.Contains(c.usedByApps, q.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "project is referenced by 1 applications")
+	}
+	c.deleted = append(c.deleted, q.Name)
+	return &projectpkg.EmptyResponse{}, nil
+}
+
+// fakeObservingProjectClient is a stubbed project client whose controller catches up with the latest generation of
+// the project after a number of polls
+type fakeObservingProjectClient struct {
+	projectpkg.ProjectServiceClient
+	generation    int64
+	observedAfter int
+	polls         int
+}
+
+func (c *fakeObservingProjectClient) Get(_ context.Context, q *projectpkg.ProjectQuery, _ ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	c.polls++
+	observed := c.generation - 1
+	if c.observedAfter >= 0 && c.polls >= c.observedAfter {
+		observed = c.generation
+	}
+	return &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{
+		Name:        q.Name,
+		Generation:  c.generation,
+		Annotations: map[string]string{common.AnnotationKeyObservedGeneration: strconv.FormatInt(observed, 10)},
+	}}, nil
+}
+
+func newTestProject() *v1alpha1.AppProject {
+	return &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-proj"},
+		Spec: v1alpha1.AppProjectSpec{
+			Description: "test project",
+			SourceRepos: []string{"https://github.com/argoproj/argo-cd", "https://github.com/argoproj/argocd-example-apps"},
+			Destinations: []v1alpha1.ApplicationDestination{
+				{Server: "https://kubernetes.default.svc", Namespace: "default"},
+				{Server: "https://remote-cluster", Namespace: "guestbook"},
+			},
+		},
+	}
+}
+
+func Test_getProjectField(t *testing.T) {
+	proj := newTestProject()
+
+	t.Run("Scalar", func(t *testing.T) {
+		value, err := getProjectField(proj, "{.spec.destinations[0].server}")
+		require.NoError(t, err)
+		assert.Equal(t, "https://kubernetes.default.svc", value)
+	})
+
+	t.Run("ScalarWithoutBraces", func(t *testing.T) {
+		value, err := getProjectField(proj, ".metadata.name")
+		require.NoError(t, err)
+		assert.Equal(t, "test-proj", value)
+	})
+
+	t.Run("ListItems", func(t *testing.T) {
+		value, err := getProjectField(proj, "{.spec.sourceRepos[*]}")
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/argoproj/argo-cd\nhttps://github.com/argoproj/argocd-example-apps", value)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		value, err := getProjectField(proj, "{.spec.destinations[*].namespace}")
+		require.NoError(t, err)
+		assert.Equal(t, "default\nguestbook", value)
+
+		value, err = getProjectField(proj, "{.spec.sourceRepos}")
+		require.NoError(t, err)
+		assert.JSONEq(t, `["https://github.com/argoproj/argo-cd","https://github.com/argoproj/argocd-example-apps"]`, value)
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		_, err := getProjectField(proj, "{.spec.doesNotExist}")
+		require.Error(t, err)
+
+		_, err = getProjectField(proj, `{.spec.destinations[?(@.namespace=="missing")].server}`)
+		require.ErrorContains(t, err, "did not match any value")
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := getProjectField(proj, "{.spec.destinations[}")
+		require.ErrorContains(t, err, "invalid field expression")
+	})
+}
+
+func Test_renderProjectTemplate(t *testing.T) {
+	proj := newTestProject()
+
+	t.Run("Custom", func(t *testing.T) {
+		tmpl, err := parseProjectTemplate(`{{.spec.description}}: {{len .spec.destinations}} destinations{{range .spec.destinations}}
+- {{.server}} ({{.namespace}}){{end}}`)
+		require.NoError(t, err)
+		out, err := renderProjectTemplate(proj, tmpl)
+		require.NoError(t, err)
+		assert.Equal(t, `test project: 2 destinations
+- https://kubernetes.default.svc (default)
+- https://remote-cluster (guestbook)`, out)
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		_, err := parseProjectTemplate("{{.spec.description")
+		require.ErrorContains(t, err, "invalid template")
+	})
+
+	t.Run("ExecutionError", func(t *testing.T) {
+		tmpl, err := parseProjectTemplate("{{index .spec.destinations 5}}")
+		require.NoError(t, err)
+		_, err = renderProjectTemplate(proj, tmpl)
+		require.ErrorContains(t, err, "failed to execute template")
+	})
+}
+
+func Test_isRetryableError(t *testing.T) {
+	assert.False(t, isRetryableError(nil))
+	assert.True(t, isRetryableError(status.Error(codes.Unavailable, "connection refused")))
+	assert.True(t, isRetryableError(status.Error(codes.DeadlineExceeded, "timeout")))
+	assert.False(t, isRetryableError(status.Error(codes.NotFound, "project not found")))
+	assert.False(t, isRetryableError(status.Error(codes.PermissionDenied, "permission denied")))
+}
+
+func Test_listProjectsWithRetry(t *testing.T) {
+	retry := retryOpts{retries: 3, backoff: time.Millisecond}
+
+	t.Run("TransientFailure", func(t *testing.T) {
+		client := &flakyProjectClient{errs: []error{
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.Unavailable, "connection refused"),
+		}}
+		projects, err := listProjects(t.Context(), client, retry)
+		require.NoError(t, err)
+		assert.Len(t, projects.Items, 1)
+		assert.Equal(t, 3, client.calls)
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		client := &flakyProjectClient{errs: []error{
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.Unavailable, "connection refused"),
+			status.Error(codes.Unavailable, "connection refused"),
+		}}
+		_, err := listProjects(t.Context(), client, retry)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 4, client.calls)
+	})
+
+	t.Run("NoRetryByDefault", func(t *testing.T) {
+		client := &flakyProjectClient{errs: []error{status.Error(codes.Unavailable, "connection refused")}}
+		_, err := listProjects(t.Context(), client, retryOpts{})
+		require.Error(t, err)
+		assert.Equal(t, 1, client.calls)
+	})
+}
+
+func Test_getDetailedProjectWithRetry(t *testing.T) {
+	retry := retryOpts{retries: 3, backoff: time.Millisecond}
+
+	t.Run("TransientFailure", func(t *testing.T) {
+		client := &flakyProjectClient{errs: []error{status.Error(codes.Unavailable, "connection refused")}}
+		detailedProject, err := getDetailedProject(t.Context(), client, "my-proj", retry)
+		require.NoError(t, err)
+		assert.Equal(t, "my-proj", detailedProject.Project.Name)
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("TerminalFailure", func(t *testing.T) {
+		client := &flakyProjectClient{errs: []error{status.Error(codes.NotFound, "project not found")}}
+		_, err := getDetailedProject(t.Context(), client, "my-proj", retry)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, 1, client.calls)
+	})
+}
+
+func Test_validateSourceRepo(t *testing.T) {
+	client := &fakeRepoClient{repos: []string{"https://github.com/argoproj/argo-cd"}}
+
+	t.Run("Reachable", func(t *testing.T) {
+		require.NoError(t, validateSourceRepo(t.Context(), client, "my-proj", "https://github.com/argoproj/argo-cd"))
+		assert.Equal(t, "my-proj", client.queries[len(client.queries)-1].AppProject)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		err := validateSourceRepo(t.Context(), client, "my-proj", "https://github.com/argoproj/does-not-exist")
+		require.ErrorContains(t, err, "failed to validate source repository 'https://github.com/argoproj/does-not-exist'")
+		assert.Equal(t, codes.Unknown, status.Code(stderrors.Unwrap(err)))
+	})
+
+	t.Run("Pattern", func(t *testing.T) {
+		queries := len(client.queries)
+		require.NoError(t, validateSourceRepo(t.Context(), client, "my-proj", "https://github.com/argoproj/*"))
+		assert.Len(t, client.queries, queries)
+	})
+}
+
+func Test_sourceRepoURL(t *testing.T) {
+	t.Run("HTTPS", func(t *testing.T) {
+		url, err := sourceRepoURL("https://github.com/argoproj/argo-cd.git", true)
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/argoproj/argo-cd.git", url)
+	})
+
+	t.Run("SSH", func(t *testing.T) {
+		url, err := sourceRepoURL("git@github.com:argoproj/argo-cd.git", false)
+		require.NoError(t, err)
+		assert.Equal(t, "git@github.com:argoproj/argo-cd.git", url)
+	})
+
+	t.Run("NormalizeSSH", func(t *testing.T) {
+		url, err := sourceRepoURL("git@github.com:argoproj/argo-cd.git", true)
+		require.NoError(t, err)
+		assert.Equal(t, "ssh://git@github.com/argoproj/argo-cd.git", url)
+		url, err = sourceRepoURL("!git@github.com:argoproj/*", true)
+		require.NoError(t, err)
+		assert.Equal(t, "!ssh://git@github.com/argoproj/*", url)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := sourceRepoURL("git@github.com/argoproj/argo-cd.git", true)
+		require.ErrorContains(t, err, "must separate the host and the repository path with ':'")
+		_, err = sourceRepoURL("!ssh://git@github.com", false)
+		require.ErrorContains(t, err, "has no repository path")
+	})
+}
+
+func Test_buildDefaultServiceAccount(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		serviceAccount          string
+		serviceAccountNamespace string
+		expected                string
+		expectedErr             string
+	}{
+		{name: "Name", serviceAccount: "test-sa", expected: "test-sa"},
+		{name: "NameWithNamespaceFlag", serviceAccount: "test-sa", serviceAccountNamespace: "default", expected: "default:test-sa"},
+		{name: "Combined", serviceAccount: "default:test-sa", expected: "default:test-sa"},
+		{name: "CombinedWithNamespaceFlag", serviceAccount: "default:test-sa", serviceAccountNamespace: "other", expectedErr: "--service-account-namespace must not be set"},
+		{name: "EmptyName", serviceAccount: "default:", expectedErr: "must be of the form NAMESPACE:NAME"},
+		{name: "EmptyNamespace", serviceAccount: ":test-sa", expectedErr: "must be of the form NAMESPACE:NAME"},
+		{name: "MultipleColons", serviceAccount: "a:b:c", expectedErr: "must be of the form NAMESPACE:NAME"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defaultServiceAccount, err := buildDefaultServiceAccount(tc.serviceAccount, tc.serviceAccountNamespace)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, defaultServiceAccount)
+		})
+	}
+}
+
+func Test_setDestinationServiceAccount(t *testing.T) {
+	newProject := func() *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+			{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "argocd:deployer"},
+			{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "viewer"},
+			{Server: "https://other-cluster", Namespace: "guestbook", DefaultServiceAccount: "argocd:deployer"},
+		}}}
+	}
+
+	t.Run("RemoveNamespace", func(t *testing.T) {
+		proj := newProject()
+		require.NoError(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "guestbook", "argocd:deployer", "deployer", ""))
+		assert.Equal(t, "deployer", proj.Spec.DestinationServiceAccounts[0].DefaultServiceAccount)
+		assert.Equal(t, "viewer", proj.Spec.DestinationServiceAccounts[1].DefaultServiceAccount)
+		assert.Equal(t, "argocd:deployer", proj.Spec.DestinationServiceAccounts[2].DefaultServiceAccount)
+	})
+	t.Run("NamespaceFlag", func(t *testing.T) {
+		proj := newProject()
+		require.NoError(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "guestbook", "viewer", "viewer", "argocd"))
+		assert.Equal(t, "argocd:viewer", proj.Spec.DestinationServiceAccounts[1].DefaultServiceAccount)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		proj := newProject()
+		require.EqualError(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "default", "argocd:deployer", "deployer", ""), "specified destination service account does not exist in project")
+	})
+	t.Run("AlreadyDefined", func(t *testing.T) {
+		proj := newProject()
+		require.EqualError(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "guestbook", "argocd:deployer", "viewer", ""),
+			"destination service account 'viewer' is already defined for destination 'https://kubernetes.default.svc/guestbook' in project")
+	})
+	t.Run("InvalidServiceAccount", func(t *testing.T) {
+		proj := newProject()
+		require.ErrorContains(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "guestbook", "viewer", "view*", ""), "must not contain wildcards")
+		require.ErrorContains(t, setDestinationServiceAccount(proj, "https://kubernetes.default.svc", "guestbook", "viewer", "argocd:", ""), "must be of the form NAMESPACE:NAME")
+		assert.Equal(t, newProject(), proj)
+	})
+}
+
+func Test_projectViolations(t *testing.T) {
+	manifest := `
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: my-project
+spec:
+  sourceRepos:
+  - '!*'
+  - https://github.com/argoproj/argo-cd.git
+  - https://github.com/argoproj/argo-cd.git
+  destinations:
+  - server: https://kubernetes.default.svc
+    namespace: '!*'
+  clusterResourceWhitelist:
+  - group: '*'
+    kind: '!Namespace'
+  namespaceResourceWhitelist:
+  - group: '!apps'
+    kind: Deployment
+  syncWindows:
+  - kind: allow
+    schedule: '* * * * *'
+    duration: 1h
+  roles:
+  - name: ci
+    policies:
+    - p, proj:other-project:ci, applications, get, other-project/*, allow
+    tokenSourceRanges:
+    - 10.0.0.0
+`
+	var proj v1alpha1.AppProject
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &proj))
+
+	violations := projectViolations(&proj)
+	require.Len(t, violations, 8)
+	assert.Equal(t, "namespace has an invalid format, '!*'", violations[0])
+	assert.Equal(t, "source repository has an invalid format, '!*'", violations[1])
+	assert.Equal(t, "source repository 'https://github.com/argoproj/argo-cd.git' already added", violations[2])
+	assert.Equal(t, "cluster resource whitelist entry has an invalid format, '*/!Namespace': negation patterns are not supported", violations[3])
+	assert.Equal(t, "namespace resource whitelist entry has an invalid format, '!apps/Deployment': negation patterns are not supported", violations[4])
+	assert.Contains(t, violations[5], "other-project")
+	assert.Contains(t, violations[6], "token source range '10.0.0.0' of role 'ci' is not a valid CIDR")
+	assert.Equal(t, "window 'allow':'* * * * *':'1h' requires one of application, cluster or namespace", violations[7])
+}
+
+func Test_projectViolationsValid(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Roles: []v1alpha1.ProjectRole{{
+				Name:     "ci",
+				Policies: []string{"p, proj:my-project:ci, applications, get, my-project/*, allow"},
+			}},
+		},
+	}
+	assert.Empty(t, projectViolations(proj))
+}
+
+func Test_printProjectNames(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printProjectNames([]v1alpha1.AppProject{
+			{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Spec: v1alpha1.AppProjectSpec{Description: "Default project"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "default\nteam-a\n", output)
+}
+
+func Test_deleteProjectsBySelector(t *testing.T) {
+	projects := []v1alpha1.AppProject{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ephemeral-a", Labels: map[string]string{"lifecycle": "ephemeral"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ephemeral-b", Labels: map[string]string{"lifecycle": "ephemeral"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"lifecycle": "permanent"}}},
+	}
+
+	names, err := getProjectNamesBySelector(projects, "lifecycle=ephemeral")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ephemeral-a", "ephemeral-b"}, names)
+
+	names, err = getProjectNamesBySelector(projects, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ephemeral-a", "ephemeral-b", "team-a"}, names)
+
+	_, err = getProjectNamesBySelector(projects, "lifecycle in (")
+	require.ErrorContains(t, err, "invalid selector")
+
+	projIf := &fakeDeleteProjectClient{usedByApps: []string{"ephemeral-a"}}
+	var deleteErr error
+	output, err := captureOutput(func() error {
+		deleteErr = deleteProjects(t.Context(), projIf, []string{"ephemeral-a", "ephemeral-b"})
+		return nil
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, deleteErr, "failed to delete project 'ephemeral-a'")
+	assert.Equal(t, []string{"ephemeral-b"}, projIf.deleted)
+	assert.Contains(t, output, "project 'ephemeral-b' deleted\n")
+}
+
+func Test_getProjectNamesToPrune(t *testing.T) {
+	projects := []v1alpha1.AppProject{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"managed-by": "platform"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"managed-by": "platform"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "manual"}},
+	}
+	imported := []*v1alpha1.AppProject{{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}}
+
+	names, err := getProjectNamesToPrune(projects, imported, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team-b", "manual"}, names)
+
+	names, err = getProjectNamesToPrune(projects, imported, "managed-by=platform")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team-b"}, names)
+
+	_, err = getProjectNamesToPrune(projects, imported, "managed-by in (")
+	require.ErrorContains(t, err, "invalid selector")
+}
+
+func Test_danglingDestinationWarnings(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://kubernetes.default.svc", Name: "in-cluster"},
+		{Server: "https://prod.example.com", Name: "prod"},
+	}
+	destinations := []v1alpha1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		{Name: "prod", Namespace: "*"},
+		{Server: "https://*.example.com", Namespace: "*"},
+		{Name: "staging-*", Namespace: "*"},
+		{Server: "!https://team1.example.com", Namespace: "*"},
+		{Server: "https://removed.example.com", Namespace: "apps"},
+		{Name: "removed", Namespace: "apps"},
+	}
+
+	assert.Equal(t, []string{
+		"destination server 'https://removed.example.com' (namespace 'apps') does not match a registered cluster",
+		"destination name 'removed' (namespace 'apps') does not match a registered cluster",
+	}, danglingDestinationWarnings(destinations, clusters))
+	assert.Empty(t, danglingDestinationWarnings(destinations[:5], clusters))
+}
+
+func Test_migrateDestinationsToNames(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://kubernetes.default.svc", Name: "in-cluster"},
+		{Server: "https://prod.example.com", Name: "prod"},
+		{Server: "https://unnamed.example.com"},
+	}
+
+	t.Run("Resolvable", func(t *testing.T) {
+		destinations := []v1alpha1.ApplicationDestination{
+			{Server: "https://kubernetes.default.svc", Namespace: "default"},
+			{Server: "https://prod.example.com", Namespace: "apps"},
+			{Name: "staging", Namespace: "*"},
+			{Server: "https://*.example.com", Namespace: "*"},
+			{Server: "!https://team1.example.com", Namespace: "*"},
+		}
+
+		migrated, warnings := migrateDestinationsToNames(destinations, clusters)
+
+		assert.Equal(t, []string{
+			"Destination server 'https://kubernetes.default.svc' (namespace 'default') migrated to name 'in-cluster'",
+			"Destination server 'https://prod.example.com' (namespace 'apps') migrated to name 'prod'",
+		}, migrated)
+		assert.Empty(t, warnings)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Name: "in-cluster", Namespace: "default"},
+			{Name: "prod", Namespace: "apps"},
+			{Name: "staging", Namespace: "*"},
+			{Server: "https://*.example.com", Namespace: "*"},
+			{Server: "!https://team1.example.com", Namespace: "*"},
+		}, destinations)
+	})
+
+	t.Run("Unresolvable", func(t *testing.T) {
+		destinations := []v1alpha1.ApplicationDestination{
+			{Server: "https://removed.example.com", Namespace: "apps"},
+			{Server: "https://unnamed.example.com", Namespace: "apps"},
+			{Server: "https://prod.example.com", Namespace: "apps"},
+		}
+
+		migrated, warnings := migrateDestinationsToNames(destinations, clusters)
+
+		assert.Equal(t, []string{"Destination server 'https://prod.example.com' (namespace 'apps') migrated to name 'prod'"}, migrated)
+		assert.Equal(t, []string{
+			"destination server 'https://removed.example.com' (namespace 'apps') does not match a named cluster",
+			"destination server 'https://unnamed.example.com' (namespace 'apps') does not match a named cluster",
+		}, warnings)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://removed.example.com", Namespace: "apps"},
+			{Server: "https://unnamed.example.com", Namespace: "apps"},
+			{Name: "prod", Namespace: "apps"},
+		}, destinations)
+	})
+}
+
+func Test_projectUnchanged(t *testing.T) {
+	// set applies the options to a copy of the project, like proj set does, and returns the updated project
+	set := func(t *testing.T, proj *v1alpha1.AppProject, args ...string) *v1alpha1.AppProject {
+		t.Helper()
+		var opts cmdutil.ProjectOpts
+		command := &cobra.Command{}
+		cmdutil.AddProjFlags(command, &opts)
+		require.NoError(t, command.ParseFlags(args))
+		updated := proj.DeepCopy()
+		cmdutil.SetProjSpecOptions(command.Flags(), &updated.Spec, &opts)
+		require.NoError(t, cmdutil.SetProjLabels(command.Flags(), updated, &opts))
+		return updated
+	}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: v1alpha1.AppProjectSpec{
+			Description: "old",
+			SourceRepos: []string{"https://github.com/argoproj/argo-cd"},
+		},
+	}
+	args := []string{"--description", "new", "--src", "https://github.com/argoproj/argo-cd", "--label", "team=a"}
+
+	first := set(t, proj, args...)
+	assert.False(t, projectUnchanged(proj, first))
+
+	// setting the same options again is a no-op
+	second := set(t, first, args...)
+	assert.True(t, projectUnchanged(first, second))
+
+	assert.False(t, projectUnchanged(first, set(t, first, "--label", "team=b")))
+}
+
+func Test_printProjectGlobalProjects(t *testing.T) {
+	proj := newTestProject()
+	output, err := captureOutput(func() error {
+		printProject(proj, nil, nil, []*v1alpha1.AppProject{
+			{ObjectMeta: metav1.ObjectMeta{Name: "global-a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "global-b"}},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "Global Projects:             global-a\n                             global-b\n")
+
+	output, err = captureOutput(func() error {
+		printProject(proj, nil, nil, nil)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "Global Projects:             <none>\n")
+}
+
+func Test_waitForProjectObserved(t *testing.T) {
+	t.Run("returns once the controller observed the update", func(t *testing.T) {
+		projIf := &fakeObservingProjectClient{generation: 5, observedAfter: 3}
+		err := waitForProjectObserved(t.Context(), projIf, "test-proj", 5, time.Minute, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, 3, projIf.polls)
+	})
+	t.Run("times out if the controller never observes the update", func(t *testing.T) {
+		projIf := &fakeObservingProjectClient{generation: 5, observedAfter: -1}
+		err := waitForProjectObserved(t.Context(), projIf, "test-proj", 5, 50*time.Millisecond, time.Millisecond)
+		require.ErrorContains(t, err, "timed out after 50ms waiting for the application controller to observe generation 5 of project 'test-proj'")
+	})
+}
+
+func Test_readDestinations(t *testing.T) {
+	stdin := strings.NewReader(`# destinations of team a
+https://kubernetes.default.svc,team-a
+
+in-cluster, team-a-preview, --name
+https://remote,team-a
+`)
+	destinations, err := readDestinations(stdin, false)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+		{Name: "in-cluster", Namespace: "team-a-preview"},
+		{Server: "https://remote", Namespace: "team-a"},
+	}, destinations)
+
+	destinations, err = readDestinations(strings.NewReader("in-cluster,default\n"), true)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{{Name: "in-cluster", Namespace: "default"}}, destinations)
+
+	_, err = readDestinations(strings.NewReader("https://remote,default\nhttps://remote\n"), false)
+	require.EqualError(t, err, "line 2: expected destination of the form SERVER,NAMESPACE or NAME,NAMESPACE,--name, got 'https://remote'")
+	_, err = readDestinations(strings.NewReader("https://remote,default,extra\n"), false)
+	require.ErrorContains(t, err, "line 1")
+}
+
+func Test_addDestinations(t *testing.T) {
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{Destinations: []v1alpha1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+	}}}
+	destinations, err := readDestinations(strings.NewReader(`https://kubernetes.default.svc,team-a
+https://kubernetes.default.svc,team-b
+in-cluster,team-a,--name
+https://kubernetes.default.svc,team-b
+`), false)
+	require.NoError(t, err)
+
+	added, skipped := addDestinations(proj, destinations)
+	assert.Equal(t, 2, added)
+	assert.Equal(t, 2, skipped)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+		{Server: "https://kubernetes.default.svc", Namespace: "team-b"},
+		{Name: "in-cluster", Namespace: "team-a"},
+	}, proj.Spec.Destinations)
+}
+
+func Test_orphanedIgnoreConflictWarnings(t *testing.T) {
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{
+		NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+		OrphanedResources: &v1alpha1.OrphanedResourcesMonitorSettings{Ignore: []v1alpha1.OrphanedResourceKey{
+			{Group: "", Kind: "ConfigMap"},
+			{Group: "", Kind: "Secret", Name: "token-*"},
+		}},
+	}}
+	assert.Equal(t, []string{
+		"Orphaned resources ignore entry with group '', kind 'Secret' and name 'token-*' overlaps the resource blacklist of the project",
+	}, orphanedIgnoreConflictWarnings(proj))
+
+	proj.Spec.NamespaceResourceBlacklist = nil
+	assert.Empty(t, orphanedIgnoreConflictWarnings(proj))
+}
+
+// fakeCanIAccountClient is a stubbed account client which allows the requests of the given policies
+type fakeCanIAccountClient struct {
+	accountpkg.AccountServiceClient
+	allowed  map[string]string
+	requests []*accountpkg.CanIRequest
+}
+
+func (c *fakeCanIAccountClient) CanI(_ context.Context, in *accountpkg.CanIRequest, _ ...grpc.CallOption) (*accountpkg.CanIResponse, error) {
+	c.requests = append(c.requests, in)
+	if policy, ok := c.allowed[in.Action+" "+in.Resource+" "+in.Subresource]; ok {
+		return &accountpkg.CanIResponse{Value: "yes", Policy: policy}, nil
+	}
+	return &accountpkg.CanIResponse{Value: "no"}, nil
+}
+
+func Test_projectCanI(t *testing.T) {
+	accountIf := &fakeCanIAccountClient{allowed: map[string]string{
+		"sync applications default/guestbook": "p, role:admin, applications, sync, */*, allow",
+	}}
+
+	t.Run("allowed", func(t *testing.T) {
+		response, err := projectCanI(t.Context(), accountIf, "default", "sync", "applications/guestbook")
+		require.NoError(t, err)
+		assert.Equal(t, "yes", response.Value)
+		assert.Equal(t, "p, role:admin, applications, sync, */*, allow", response.Policy)
+		assert.True(t, accountIf.requests[len(accountIf.requests)-1].Explain)
+	})
+	t.Run("denied", func(t *testing.T) {
+		response, err := projectCanI(t.Context(), accountIf, "other", "sync", "applications/guestbook")
+		require.NoError(t, err)
+		assert.Equal(t, "no", response.Value)
+		assert.Empty(t, response.Policy)
+		assert.Equal(t, "other/guestbook", accountIf.requests[len(accountIf.requests)-1].Subresource)
+	})
+	t.Run("invalid object", func(t *testing.T) {
+		_, err := projectCanI(t.Context(), accountIf, "default", "sync", "applications")
+		require.ErrorContains(t, err, "object 'applications' must be of the form RESOURCE/NAME")
+		_, err = projectCanI(t.Context(), accountIf, "default", "update", "projects/default")
+		require.ErrorContains(t, err, "resource 'projects' is not project scoped")
+	})
+}
+
+// fakeWatchProjectClient is a stubbed project client whose watch stream returns the configured events, then closes
+// exhausted and blocks until the context is canceled
+type fakeWatchProjectClient struct {
+	projectpkg.ProjectServiceClient
+	events    []*v1alpha1.AppProjectWatchEvent
+	exhausted chan struct{}
+}
+
+func (c *fakeWatchProjectClient) Watch(ctx context.Context, _ *projectpkg.ProjectQuery, _ ...grpc.CallOption) (projectpkg.ProjectService_WatchClient, error) {
+	return &fakeProjectWatchStream{ctx: ctx, events: c.events, exhausted: c.exhausted}, nil
+}
+
+type fakeProjectWatchStream struct {
+	grpc.ClientStream
+	ctx       context.Context
+	events    []*v1alpha1.AppProjectWatchEvent
+	exhausted chan struct{}
+}
+
+func (s *fakeProjectWatchStream) Recv() (*v1alpha1.AppProjectWatchEvent, error) {
+	if len(s.events) == 0 {
+		close(s.exhausted)
+		<-s.ctx.Done()
+		return nil, status.Error(codes.Canceled, s.ctx.Err().Error())
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func Test_watchProjects(t *testing.T) {
+	proj := newTestProject()
+	modified := newTestProject()
+	modified.Spec.Description = "modified project"
+	other := newTestProject()
+	other.Name = "other-proj"
+	projIf := &fakeWatchProjectClient{events: []*v1alpha1.AppProjectWatchEvent{
+		{Type: watch.Added, AppProject: *proj},
+		{Type: watch.Added, AppProject: *other},
+		{Type: watch.Modified, AppProject: *modified},
+		{Type: watch.Deleted, AppProject: *other},
+	}, exhausted: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var out strings.Builder
+	done := make(chan error)
+	go func() {
+		done <- watchProjects(ctx, projIf, &out)
+	}()
+	// all events were printed once the next one is requested
+	<-projIf.exhausted
+	cancel()
+	require.NoError(t, <-done)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 5)
+	assert.True(t, strings.HasPrefix(lines[0], "EVENT"))
+	assert.Regexp(t, `^ADDED\s+test-proj\s+test project\s`, lines[1])
+	assert.Regexp(t, `^ADDED\s+other-proj\s`, lines[2])
+	assert.Regexp(t, `^MODIFIED\s+test-proj\s+modified project\s`, lines[3])
+	assert.Regexp(t, `^DELETED\s+other-proj\s`, lines[4])
+}
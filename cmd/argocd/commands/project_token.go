@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewProjectTokenCommand returns a new instance of the `argocd proj token` command
+func NewProjectTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "token",
+		Short: "Manage a project's tokens",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewProjectTokenVerifyCommand(clientOpts))
+	return command
+}
+
+// NewProjectTokenVerifyCommand returns a new instance of an `argocd proj token verify` command
+func NewProjectTokenVerifyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projName string
+		token    string
+	)
+	command := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify a project token",
+		Long:  "Verify that a token was issued for a role of the project, has not expired and has not been deleted. Exits with a non-zero code if the token is not valid.",
+		Example: templates.Examples(`
+			# Verify a token of project PROJECT
+			argocd proj token verify --project PROJECT --token TOKEN
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			valid, err := verifyProjectToken(ctx, os.Stdout, projIf, projName, token)
+			errors.CheckError(err)
+			if !valid {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&projName, "project", "", "Project the token was issued for")
+	command.Flags().StringVar(&token, "token", "", "Token to verify")
+	errors.CheckError(command.MarkFlagRequired("project"))
+	errors.CheckError(command.MarkFlagRequired("token"))
+	return command
+}
+
+// verifyProjectToken asks the server to verify the token against the project and prints the outcome. It returns
+// whether the token is valid.
+func verifyProjectToken(ctx context.Context, out io.Writer, projIf projectpkg.ProjectServiceClient, projName, token string) (bool, error) {
+	resp, err := projIf.VerifyToken(ctx, &projectpkg.ProjectTokenVerifyRequest{Project: projName, Token: token})
+	if err != nil {
+		return false, err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Project:\t%s\n", projName)
+	_, _ = fmt.Fprintf(w, "Role:\t%s\n", valueOrNone(resp.Role))
+	_, _ = fmt.Fprintf(w, "ID:\t%s\n", valueOrNone(resp.Id))
+	_, _ = fmt.Fprintf(w, "Issued At:\t%s\n", formatVerifiedTokenTime(resp.IssuedAt))
+	_, _ = fmt.Fprintf(w, "Expires At:\t%s\n", formatVerifiedTokenTime(resp.ExpiresAt))
+	_, _ = fmt.Fprintf(w, "Valid:\t%t\n", resp.Valid)
+	if !resp.Valid {
+		_, _ = fmt.Fprintf(w, "Reason:\t%s\n", resp.Reason)
+	}
+	return resp.Valid, w.Flush()
+}
+
+// formatVerifiedTokenTime renders a Unix time of a verified token as RFC3339, or <none> if it is not set
+func formatVerifiedTokenTime(epoch int64) string {
+	if epoch <= 0 {
+		return "<none>"
+	}
+	return time.Unix(epoch, 0).UTC().Format(time.RFC3339)
+}
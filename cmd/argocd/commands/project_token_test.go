@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+)
+
+// fakeVerifyTokenClient is a stubbed project client which answers token verification with the configured response
+type fakeVerifyTokenClient struct {
+	projectpkg.ProjectServiceClient
+	resp *projectpkg.ProjectTokenVerifyResponse
+	err  error
+	req  *projectpkg.ProjectTokenVerifyRequest
+}
+
+func (c *fakeVerifyTokenClient) VerifyToken(_ context.Context, q *projectpkg.ProjectTokenVerifyRequest, _ ...grpc.CallOption) (*projectpkg.ProjectTokenVerifyResponse, error) {
+	c.req = q
+	return c.resp, c.err
+}
+
+func Test_verifyProjectToken(t *testing.T) {
+	issuedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Valid", func(t *testing.T) {
+		client := &fakeVerifyTokenClient{resp: &projectpkg.ProjectTokenVerifyResponse{
+			Valid: true, Role: "ci", Id: "token-1", IssuedAt: issuedAt.Unix(),
+		}}
+		out := &bytes.Buffer{}
+		valid, err := verifyProjectToken(t.Context(), out, client, "team-a", "jwt")
+		require.NoError(t, err)
+		assert.True(t, valid)
+		assert.Equal(t, &projectpkg.ProjectTokenVerifyRequest{Project: "team-a", Token: "jwt"}, client.req)
+		assert.Equal(t, `Project:     team-a
+Role:        ci
+ID:          token-1
+Issued At:   2024-03-01T12:00:00Z
+Expires At:  <none>
+Valid:       true
+`, out.String())
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		client := &fakeVerifyTokenClient{resp: &projectpkg.ProjectTokenVerifyResponse{
+			Role: "ci", Id: "token-1", IssuedAt: issuedAt.Unix(), ExpiresAt: issuedAt.Add(time.Hour).Unix(),
+			Reason: "token expired at 2024-03-01T13:00:00Z",
+		}}
+		out := &bytes.Buffer{}
+		valid, err := verifyProjectToken(t.Context(), out, client, "team-a", "jwt")
+		require.NoError(t, err)
+		assert.False(t, valid)
+		assert.Contains(t, out.String(), "Expires At:  2024-03-01T13:00:00Z\n")
+		assert.Contains(t, out.String(), "Valid:       false\nReason:      token expired at 2024-03-01T13:00:00Z\n")
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		client := &fakeVerifyTokenClient{resp: &projectpkg.ProjectTokenVerifyResponse{
+			Role: "ci", Id: "token-1", IssuedAt: issuedAt.Unix(), Reason: "token has been deleted from role 'ci'",
+		}}
+		out := &bytes.Buffer{}
+		valid, err := verifyProjectToken(t.Context(), out, client, "team-a", "jwt")
+		require.NoError(t, err)
+		assert.False(t, valid)
+		assert.Contains(t, out.String(), "Reason:      token has been deleted from role 'ci'\n")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		client := &fakeVerifyTokenClient{err: status.Error(codes.PermissionDenied, "permission denied")}
+		_, err := verifyProjectToken(t.Context(), &bytes.Buffer{}, client, "team-a", "jwt")
+		require.Error(t, err)
+	})
+}
@@ -3,15 +3,18 @@ package commands
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"text/tabwriter"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -34,7 +37,13 @@ argocd proj windows add my-project \
 argocd proj windows delete <project-name> <window-id>
 
 #List project sync windows
-argocd proj windows list <project-name>`,
+argocd proj windows list <project-name>
+
+#Remove duplicate sync windows from a project
+argocd proj windows dedup <project-name>
+
+#List the applications of a project currently blocked by sync windows
+argocd proj windows blocked <project-name>`,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -46,11 +55,50 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsDedupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsBlockedCommand(clientOpts))
 	return roleCommand
 }
 
+// manualSyncWarning returns a warning if manual sync is enabled on a window which is not a deny window, since only deny
+// windows are overridden by it while they are active
+func manualSyncWarning(kind string, manualSync bool) string {
+	if !manualSync || kind == "deny" {
+		return ""
+	}
+	return fmt.Sprintf("--manual-sync only overrides active deny windows; on a %s window it only permits manual syncs while the window is inactive", kind)
+}
+
+// windowUpdateOpts holds the changes of an `argocd proj windows update` command to a sync window
+type windowUpdateOpts struct {
+	schedule     string
+	duration     string
+	applications []string
+	namespaces   []string
+	clusters     []string
+	// manualSync is only changed if it is not nil, in which case the other settings are optional
+	manualSync  *bool
+	timeZone    string
+	description string
+}
+
+// updateWindow applies the changes of an `argocd proj windows update` command to the given window
+func updateWindow(window *v1alpha1.SyncWindow, opts windowUpdateOpts) error {
+	if opts.manualSync != nil {
+		window.ManualSync = *opts.manualSync
+		if warning := manualSyncWarning(window.Kind, window.ManualSync); warning != "" {
+			log.Warn(warning)
+		}
+		if opts.schedule == "" && opts.duration == "" && len(opts.applications) == 0 && len(opts.namespaces) == 0 && len(opts.clusters) == 0 && opts.description == "" {
+			return nil
+		}
+	}
+	return window.Update(opts.schedule, opts.duration, opts.applications, opts.namespaces, opts.clusters, opts.timeZone, opts.description)
+}
+
 // NewProjectWindowsDisableManualSyncCommand returns a new instance of an `argocd proj windows disable-manual-sync` command
 func NewProjectWindowsDisableManualSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "disable-manual-sync PROJECT ID",
 		Short: "Disable manual sync for a sync window",
@@ -85,15 +133,17 @@ argocd proj windows disable-manual-sync default 0`,
 				}
 			}
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectWindowsEnableManualSyncCommand returns a new instance of an `argocd proj windows enable-manual-sync` command
 func NewProjectWindowsEnableManualSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "enable-manual-sync PROJECT ID",
 		Short: "Enable manual sync for a sync window",
@@ -131,10 +181,11 @@ argocd proj windows enable-manual-sync my-app-project --message "Manual sync ini
 				}
 			}
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
@@ -151,6 +202,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		timeZone     string
 		andOperator  bool
 		description  string
+		rejectNever  bool
+		wait         waitOpts
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -189,10 +242,14 @@ argocd proj windows add PROJECT \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
+			if warning := manualSyncWarning(kind, manualSync); warning != "" {
+				log.Warn(warning)
+			}
 			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description)
 			errors.CheckError(err)
+			checkNeverFiringWindow(proj.Spec.SyncWindows, len(proj.Spec.SyncWindows)-1, rejectNever)
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
@@ -202,16 +259,19 @@ argocd proj windows add PROJECT \
 	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
-	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
+	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs to override the window while it is active (deny windows), or while it is inactive (allow windows)")
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
+	command.Flags().BoolVar(&rejectNever, "reject-never-firing", false, "Fail instead of warning if the schedule of the added sync window never fires (e.g. --schedule \"0 0 30 2 *\")")
 
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
 	command := &cobra.Command{
 		Use:   "delete PROJECT ID",
 		Short: "Delete a sync window from a project. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
@@ -245,26 +305,24 @@ argocd proj windows delete new-project 1`,
 			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
 			canDelete := promptUtil.Confirm("Are you sure you want to delete sync window? [y/n]")
 			if canDelete {
-				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				err = updateProject(ctx, projIf, proj, wait)
 				errors.CheckError(err)
 			} else {
 				fmt.Printf("The command to delete the sync window was cancelled\n")
 			}
 		},
 	}
+	addWaitFlags(command, &wait)
 	return command
 }
 
 // NewProjectWindowsUpdateCommand returns a new instance of an `argocd proj windows update` command
 func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		schedule     string
-		duration     string
-		applications []string
-		namespaces   []string
-		clusters     []string
-		timeZone     string
-		description  string
+		opts        windowUpdateOpts
+		manualSync  bool
+		rejectNever bool
+		wait        waitOpts
 	)
 	command := &cobra.Command{
 		Use:   "update PROJECT ID",
@@ -273,6 +331,9 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 		Example: `# Change a sync window's schedule
 argocd proj windows update PROJECT ID \
     --schedule "0 20 * * *"
+
+# Allow manual syncs while a deny window is active
+argocd proj windows update PROJECT ID --manual-sync
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -292,29 +353,127 @@ argocd proj windows update PROJECT ID \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
+			if c.Flags().Changed("manual-sync") {
+				opts.manualSync = &manualSync
+			}
 			for i, window := range proj.Spec.SyncWindows {
 				if id == i {
-					err := window.Update(schedule, duration, applications, namespaces, clusters, timeZone, description)
+					err := updateWindow(window, opts)
 					if err != nil {
 						errors.CheckError(err)
 					}
 				}
 			}
+			checkNeverFiringWindow(proj.Spec.SyncWindows, id, rejectNever)
 
-			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			err = updateProject(ctx, projIf, proj, wait)
 			errors.CheckError(err)
 		},
 	}
-	command.Flags().StringVar(&schedule, "schedule", "", "Sync window schedule in cron format. (e.g. --schedule \"0 22 * * *\")")
-	command.Flags().StringVar(&duration, "duration", "", "Sync window duration. (e.g. --duration 1h)")
-	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
-	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
-	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
-	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window. (e.g. --time-zone \"America/New_York\")")
-	command.Flags().StringVar(&description, "description", "", "Sync window description")
+	command.Flags().StringVar(&opts.schedule, "schedule", "", "Sync window schedule in cron format. (e.g. --schedule \"0 22 * * *\")")
+	command.Flags().StringVar(&opts.duration, "duration", "", "Sync window duration. (e.g. --duration 1h)")
+	command.Flags().StringSliceVar(&opts.applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
+	command.Flags().StringSliceVar(&opts.namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
+	command.Flags().StringSliceVar(&opts.clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
+	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs to override the window while it is active (deny windows), or while it is inactive (allow windows). Use --manual-sync=false to disallow them")
+	command.Flags().StringVar(&opts.timeZone, "time-zone", "UTC", "Time zone of the sync window. (e.g. --time-zone \"America/New_York\")")
+	command.Flags().StringVar(&opts.description, "description", "", "Sync window description")
+	command.Flags().BoolVar(&rejectNever, "reject-never-firing", false, "Fail instead of warning if the schedule of the updated sync window never fires (e.g. --schedule \"0 0 30 2 *\")")
+	addWaitFlags(command, &wait)
 	return command
 }
 
+// NewProjectWindowsDedupCommand returns a new instance of an `argocd proj windows dedup` command
+func NewProjectWindowsDedupCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var wait waitOpts
+	command := &cobra.Command{
+		Use:   "dedup PROJECT",
+		Short: "Remove sync windows which are identical to another window of the project",
+		Long:  "Remove sync windows which are identical to another window of the project. The first of the identical windows is kept, windows which differ in any setting are left untouched.",
+		Example: `
+#Remove duplicate sync windows from a project (default)
+argocd proj windows dedup default`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			removed := proj.Spec.DedupWindows()
+			if removed == 0 {
+				fmt.Printf("Project '%s' has no duplicate sync windows\n", projName)
+				return
+			}
+			err = updateProject(ctx, projIf, proj, wait)
+			errors.CheckError(err)
+			fmt.Printf("Removed %d duplicate sync window(s) from project '%s'\n", removed, projName)
+		},
+	}
+	addWaitFlags(command, &wait)
+	return command
+}
+
+// duplicateWindowsWarning returns a warning listing the sync windows which are identical to a previous window
+func duplicateWindowsWarning(windows v1alpha1.SyncWindows) string {
+	duplicates := windows.Duplicates()
+	if len(duplicates) == 0 {
+		return ""
+	}
+	ids := make([]int, 0, len(duplicates))
+	for id := range duplicates {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	descriptions := make([]string, 0, len(ids))
+	for _, id := range ids {
+		descriptions = append(descriptions, fmt.Sprintf("%d (duplicates %d)", id, duplicates[id]))
+	}
+	return fmt.Sprintf("sync windows %s are identical to another window and can be removed with 'argocd proj windows dedup'", strings.Join(descriptions, ", "))
+}
+
+// neverFiringWindowsWarning returns a warning listing the sync windows whose schedule never fires, e.g. on February 30th
+func neverFiringWindowsWarning(windows v1alpha1.SyncWindows) string {
+	ids := windows.NeverFiring()
+	if len(ids) == 0 {
+		return ""
+	}
+	descriptions := make([]string, 0, len(ids))
+	for _, id := range ids {
+		descriptions = append(descriptions, fmt.Sprintf("%d (%s)", id, windows[id].Schedule))
+	}
+	return fmt.Sprintf("the schedule of sync windows %s does not fire within the next five years, so the windows never become active", strings.Join(descriptions, ", "))
+}
+
+// checkNeverFiringWindow warns if the schedule of the sync window with the ID, which is being added or updated, never
+// fires, or fails if reject is set. The other windows of the project are not checked, so that they cannot fail the
+// command.
+func checkNeverFiringWindow(windows v1alpha1.SyncWindows, id int, reject bool) {
+	warning := neverFiringWindowWarning(windows, id)
+	if warning == "" {
+		return
+	}
+	if reject {
+		log.Fatal(warning)
+	}
+	log.Warn(warning)
+}
+
+// neverFiringWindowWarning returns a warning if the schedule of the sync window with the ID never fires
+func neverFiringWindowWarning(windows v1alpha1.SyncWindows, id int) string {
+	if !slices.Contains(windows.NeverFiring(), id) {
+		return ""
+	}
+	return fmt.Sprintf("the schedule of sync window %d (%s) does not fire within the next five years, so the window never becomes active", id, windows[id].Schedule)
+}
+
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
@@ -349,6 +508,9 @@ argocd proj windows list test-project`,
 				errors.CheckError(err)
 			case "wide", "":
 				printSyncWindows(proj)
+				if warning := duplicateWindowsWarning(proj.Spec.SyncWindows); warning != "" {
+					log.Warn(warning)
+				}
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -358,16 +520,130 @@ argocd proj windows list test-project`,
 	return command
 }
 
+// NewProjectWindowsBlockedCommand returns a new instance of an `argocd proj windows blocked` command
+func NewProjectWindowsBlockedCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "blocked PROJECT",
+		Short: "List the applications of a project currently blocked from syncing by sync windows",
+		Long:  "List the applications of a project whose automatic syncs are currently blocked by the sync windows of the project, including those inherited from global projects, together with the windows blocking them.",
+		Example: `
+#List the applications of project my-project blocked by sync windows
+argocd proj windows blocked my-project`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := clientset.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			detailedProject, err := projIf.GetDetailedProject(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			appConn, appIf := clientset.NewApplicationClientOrDie()
+			defer utilio.Close(appConn)
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Projects: []string{projName}})
+			errors.CheckError(err)
+
+			windows, sources := projectSyncWindows(detailedProject.Project, detailedProject.GlobalProjects)
+			blocked, err := blockedApplications(windows, sources, apps.Items)
+			errors.CheckError(err)
+			if len(blocked) == 0 {
+				fmt.Printf("No applications of project '%s' are blocked by sync windows\n", projName)
+				return
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "APPLICATION\tBLOCKSMANUALSYNC\tWINDOWS\n")
+			for _, app := range blocked {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", app.name, formatBoolYesNoOutput(app.blocksManual), strings.Join(app.windows, ", "))
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
+
+// projectSyncWindows returns the sync windows of the project followed by those inherited from global projects, along
+// with the source of each window in the form PROJECT:ID
+func projectSyncWindows(proj *v1alpha1.AppProject, globalProjects []*v1alpha1.AppProject) (v1alpha1.SyncWindows, []string) {
+	var windows v1alpha1.SyncWindows
+	var sources []string
+	for _, p := range append([]*v1alpha1.AppProject{proj}, globalProjects...) {
+		for i, window := range p.Spec.SyncWindows {
+			windows = append(windows, window)
+			sources = append(sources, fmt.Sprintf("%s:%d", p.Name, i))
+		}
+	}
+	return windows, sources
+}
+
+// blockedApplication is an application whose automatic syncs are currently blocked by sync windows
+type blockedApplication struct {
+	name string
+	// blocksManual is true if manual syncs are blocked as well
+	blocksManual bool
+	// windows describes the windows which on their own block automatic syncs of the application
+	windows []string
+}
+
+// blockedApplications returns the applications whose automatic syncs are currently blocked by the sync windows which
+// match them. sources holds the source of each window, as returned by projectSyncWindows.
+func blockedApplications(windows v1alpha1.SyncWindows, sources []string, apps []v1alpha1.Application) ([]blockedApplication, error) {
+	var blocked []blockedApplication
+	for i := range apps {
+		app := &apps[i]
+		matching := windows.Matches(app)
+		canSync, err := matching.CanSync(false)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating sync windows of application '%s': %w", app.QualifiedName(), err)
+		}
+		if canSync {
+			continue
+		}
+		canSyncManually, err := matching.CanSync(true)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating sync windows of application '%s': %w", app.QualifiedName(), err)
+		}
+		// active deny windows take precedence, otherwise the sync is blocked by the inactive allow windows
+		var denies, allows []string
+		for _, window := range *matching {
+			active, err := window.Active()
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating sync windows of application '%s': %w", app.QualifiedName(), err)
+			}
+			if blocksAuto, _ := windowBlocksSync(window, active); !blocksAuto {
+				continue
+			}
+			description := fmt.Sprintf("%s %s (%s)", window.Kind, window.Schedule, sources[slices.Index(windows, window)])
+			if window.Kind == "deny" {
+				denies = append(denies, description)
+			} else {
+				allows = append(allows, description)
+			}
+		}
+		blockedApp := blockedApplication{name: app.QualifiedName(), blocksManual: !canSyncManually, windows: denies}
+		if len(denies) == 0 {
+			blockedApp.windows = allows
+		}
+		blocked = append(blocked, blockedApp)
+	}
+	return blocked, nil
+}
+
 // Print table of sync window data
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "TIMEZONE"}
+	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "TIMEZONE", "USEANDOPERATOR", "BLOCKSAUTOSYNC", "BLOCKSMANUALSYNC"}
 	fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
 			isActive, _ := window.Active()
+			blocksAuto, blocksManual := windowBlocksSync(window, isActive)
 			vals := []any{
 				strconv.Itoa(i),
 				formatBoolOutput(isActive),
@@ -380,6 +656,8 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 				formatBoolEnabledOutput(window.ManualSync),
 				window.TimeZone,
 				formatBoolEnabledOutput(window.UseAndOperator),
+				formatBoolYesNoOutput(blocksAuto),
+				formatBoolYesNoOutput(blocksManual),
 			}
 			fmt.Fprintf(w, fmtStr, vals...)
 		}
@@ -387,6 +665,14 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	_ = w.Flush()
 }
 
+// windowBlocksSync returns whether the window on its own currently blocks automatic and manual syncs of the applications
+// it matches. Active deny windows and inactive allow windows block syncs, manual syncs are permitted by them if the
+// window has manual sync enabled. Other windows of the project can still block or permit syncs, see SyncWindows.CanSync.
+func windowBlocksSync(window *v1alpha1.SyncWindow, active bool) (auto bool, manual bool) {
+	blocks := (window.Kind == "deny" && active) || (window.Kind == "allow" && !active)
+	return blocks, blocks && !window.ManualSync
+}
+
 func formatListOutput(list []string) string {
 	var o string
 	if len(list) == 0 {
@@ -407,6 +693,13 @@ func formatBoolOutput(active bool) string {
 	return o
 }
 
+func formatBoolYesNoOutput(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
+
 func formatBoolEnabledOutput(active bool) string {
 	var o string
 	if active {
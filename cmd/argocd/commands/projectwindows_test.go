@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_manualSyncWarning(t *testing.T) {
+	assert.Empty(t, manualSyncWarning("deny", true))
+	assert.Empty(t, manualSyncWarning("allow", false))
+	assert.Contains(t, manualSyncWarning("allow", true), "only permits manual syncs while the window is inactive")
+}
+
+func Test_updateWindow_manualSync(t *testing.T) {
+	window := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, TimeZone: "UTC"}
+	windows := v1alpha1.SyncWindows{window}
+	canSync, err := windows.CanSync(true)
+	require.NoError(t, err)
+	assert.False(t, canSync)
+
+	manualSync := true
+	require.NoError(t, updateWindow(window, windowUpdateOpts{manualSync: &manualSync, timeZone: "UTC"}))
+	assert.True(t, window.ManualSync)
+	assert.Equal(t, "* * * * *", window.Schedule)
+
+	// manual syncs are now permitted during the active deny window, automatic ones are still blocked
+	canSync, err = windows.CanSync(true)
+	require.NoError(t, err)
+	assert.True(t, canSync)
+	canSync, err = windows.CanSync(false)
+	require.NoError(t, err)
+	assert.False(t, canSync)
+
+	manualSync = false
+	require.NoError(t, updateWindow(window, windowUpdateOpts{duration: "2h", manualSync: &manualSync, timeZone: "UTC"}))
+	assert.False(t, window.ManualSync)
+	assert.Equal(t, "2h", window.Duration)
+}
+
+func Test_updateWindow_requiresChange(t *testing.T) {
+	window := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", ManualSync: true}
+	require.ErrorContains(t, updateWindow(window, windowUpdateOpts{timeZone: "UTC"}), "cannot update")
+	assert.True(t, window.ManualSync)
+}
+
+func Test_duplicateWindowsWarning(t *testing.T) {
+	window := func(duration string) *v1alpha1.SyncWindow {
+		return &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: duration, Applications: []string{"*"}}
+	}
+	assert.Empty(t, duplicateWindowsWarning(nil))
+	assert.Empty(t, duplicateWindowsWarning(v1alpha1.SyncWindows{window("1h"), window("2h")}))
+	assert.Equal(t,
+		"sync windows 2 (duplicates 0), 3 (duplicates 1) are identical to another window and can be removed with 'argocd proj windows dedup'",
+		duplicateWindowsWarning(v1alpha1.SyncWindows{window("1h"), window("2h"), window("1h"), window("2h")}))
+}
+
+func Test_neverFiringWindowsWarning(t *testing.T) {
+	window := func(schedule string) *v1alpha1.SyncWindow {
+		return &v1alpha1.SyncWindow{Kind: "deny", Schedule: schedule, Duration: "1h", Applications: []string{"*"}}
+	}
+	assert.Empty(t, neverFiringWindowsWarning(nil))
+	assert.Empty(t, neverFiringWindowsWarning(v1alpha1.SyncWindows{window("0 22 * * *"), window("0 0 29 2 *")}))
+	assert.Equal(t,
+		"the schedule of sync windows 1 (0 0 30 2 *) does not fire within the next five years, so the windows never become active",
+		neverFiringWindowsWarning(v1alpha1.SyncWindows{window("0 22 * * *"), window("0 0 30 2 *")}))
+}
+
+func Test_neverFiringWindowWarning(t *testing.T) {
+	window := func(schedule string) *v1alpha1.SyncWindow {
+		return &v1alpha1.SyncWindow{Kind: "deny", Schedule: schedule, Duration: "1h", Applications: []string{"*"}}
+	}
+	windows := v1alpha1.SyncWindows{window("0 0 30 2 *"), window("0 22 * * *"), window("0 0 31 4 *")}
+	// only the window being added or updated is checked
+	assert.Empty(t, neverFiringWindowWarning(windows, 1))
+	assert.Equal(t,
+		"the schedule of sync window 2 (0 0 31 4 *) does not fire within the next five years, so the window never becomes active",
+		neverFiringWindowWarning(windows, 2))
+}
+
+func Test_windowBlocksSync(t *testing.T) {
+	tests := []struct {
+		kind         string
+		manualSync   bool
+		active       bool
+		blocksAuto   bool
+		blocksManual bool
+	}{
+		{kind: "deny", active: true, blocksAuto: true, blocksManual: true},
+		{kind: "deny", manualSync: true, active: true, blocksAuto: true},
+		{kind: "deny", active: false},
+		{kind: "deny", manualSync: true, active: false},
+		{kind: "allow", active: true},
+		{kind: "allow", manualSync: true, active: true},
+		{kind: "allow", active: false, blocksAuto: true, blocksManual: true},
+		{kind: "allow", manualSync: true, active: false, blocksAuto: true},
+	}
+	for _, tt := range tests {
+		window := &v1alpha1.SyncWindow{Kind: tt.kind, ManualSync: tt.manualSync}
+		blocksAuto, blocksManual := windowBlocksSync(window, tt.active)
+		assert.Equal(t, tt.blocksAuto, blocksAuto, "kind=%s manualSync=%t active=%t", tt.kind, tt.manualSync, tt.active)
+		assert.Equal(t, tt.blocksManual, blocksManual, "kind=%s manualSync=%t active=%t", tt.kind, tt.manualSync, tt.active)
+	}
+
+	// the applicability matches the evaluation of the windows
+	window := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, ManualSync: true}
+	active, err := window.Active()
+	require.NoError(t, err)
+	blocksAuto, blocksManual := windowBlocksSync(window, active)
+	windows := v1alpha1.SyncWindows{window}
+	canSync, err := windows.CanSync(false)
+	require.NoError(t, err)
+	assert.Equal(t, !canSync, blocksAuto)
+	canSync, err = windows.CanSync(true)
+	require.NoError(t, err)
+	assert.Equal(t, !canSync, blocksManual)
+}
+
+func Test_blockedApplications(t *testing.T) {
+	app := func(name, namespace string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: namespace}},
+		}
+	}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+		Spec: v1alpha1.AppProjectSpec{SyncWindows: v1alpha1.SyncWindows{
+			// always active
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"prod-*"}},
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"prod-web"}, ManualSync: true},
+		}},
+	}
+	globalProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "global"},
+		Spec: v1alpha1.AppProjectSpec{SyncWindows: v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Namespaces: []string{"frozen"}, ManualSync: true},
+		}},
+	}
+	windows, sources := projectSyncWindows(proj, []*v1alpha1.AppProject{globalProj})
+	assert.Equal(t, []string{"my-project:0", "my-project:1", "global:0"}, sources)
+
+	blocked, err := blockedApplications(windows, sources, []v1alpha1.Application{
+		app("prod-web", "web"),
+		app("prod-db", "db"),
+		app("staging-web", "web"),
+		app("legacy", "frozen"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []blockedApplication{
+		{name: "argocd/prod-web", blocksManual: true, windows: []string{"deny * * * * * (my-project:0)", "deny * * * * * (my-project:1)"}},
+		{name: "argocd/prod-db", blocksManual: true, windows: []string{"deny * * * * * (my-project:0)"}},
+		{name: "argocd/legacy", blocksManual: false, windows: []string{"deny * * * * * (global:0)"}},
+	}, blocked)
+}
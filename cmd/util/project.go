@@ -2,54 +2,90 @@ package util
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/config"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/text/label"
 )
 
 type ProjectOpts struct {
 	Description                string
 	destinations               []string
+	destinationNames           []string
 	destinationServiceAccounts []string
 	Sources                    []string
 	SignatureKeys              []string
 	SourceNamespaces           []string
+	TokenAudience              string
+	DeletionProtection         bool
+	RefreshInterval            string
+	labels                     []string
+	defaultDestination         string
+	propagatedAnnotations      []string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
+	orphanedResourcesBlock     bool
 	allowedClusterResources    []string
 	deniedClusterResources     []string
 	allowedNamespacedResources []string
 	deniedNamespacedResources  []string
+
+	mergeLists   bool
+	replaceLists bool
 }
 
 func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringVarP(&opts.Description, "description", "", "", "Project description")
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
+	command.Flags().StringArrayVar(&opts.destinationNames, "dest-name", []string{},
+		"Permitted destination cluster name and namespace (e.g. in-cluster,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
 	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
+	command.Flags().BoolVar(&opts.orphanedResourcesBlock, "orphaned-resources-block", false, "Specifies if the sync of applications should be prevented when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources")
 	command.Flags().StringArrayVar(&opts.deniedClusterResources, "deny-cluster-resource", []string{}, "List of denied cluster level resources")
 	command.Flags().StringArrayVar(&opts.allowedNamespacedResources, "allow-namespaced-resource", []string{}, "List of allowed namespaced resources")
 	command.Flags().StringArrayVar(&opts.deniedNamespacedResources, "deny-namespaced-resource", []string{}, "List of denied namespaced resources")
 	command.Flags().StringSliceVar(&opts.SourceNamespaces, "source-namespaces", []string{}, "List of source namespaces for applications")
+	command.Flags().StringVar(&opts.TokenAudience, "token-audience", "", "Audience claim of the tokens created for the project roles")
+	command.Flags().BoolVar(&opts.DeletionProtection, "deletion-protection", false, "Prevent the project from being deleted until the protection is removed")
+	command.Flags().StringVar(&opts.RefreshInterval, "refresh-interval", "", "Interval at which the project's applications are refreshed, e.g. 10m (overridden by the application's refresh-interval annotation)")
 	command.Flags().StringArrayVar(&opts.destinationServiceAccounts, "dest-service-accounts", []string{},
 		"Destination server, namespace and target service account (e.g. https://192.168.99.100:8443,default,default-sa)")
+	command.Flags().StringArrayVar(&opts.labels, "label", []string{}, "Set a metadata label of the project, e.g. to match global projects (e.g. --label key=value)")
+	command.Flags().StringVar(&opts.defaultDestination, "default-destination", "",
+		"Destination server and namespace of applications which specify no destination server or name (e.g. https://192.168.99.100:8443,default). An empty value removes it")
+	command.Flags().StringArrayVar(&opts.propagatedAnnotations, "propagate-annotation", []string{},
+		"Annotation added to the applications of the project which do not have it yet (e.g. --propagate-annotation team=payments)")
+}
+
+// AddProjSetFlags adds the flags controlling how `proj set` updates list fields of an existing project.
+func AddProjSetFlags(command *cobra.Command, opts *ProjectOpts) {
+	command.Flags().BoolVar(&opts.mergeLists, "merge", false, "Append the given destinations (--dest, --dest-name) and source repositories (--src) to the existing ones, dropping duplicates")
+	command.Flags().BoolVar(&opts.replaceLists, "replace", false, "Replace the existing destinations (--dest, --dest-name) and source repositories (--src) with the given ones (default behavior)")
+	command.MarkFlagsMutuallyExclusive("merge", "replace")
 }
 
 func getGroupKindList(values []string) []metav1.GroupKind {
@@ -80,11 +116,30 @@ func (opts *ProjectOpts) GetDeniedNamespacedResources() []metav1.GroupKind {
 	return getGroupKindList(opts.deniedNamespacedResources)
 }
 
+// GetLabels returns the labels given with --label, validating their keys and values.
+func (opts *ProjectOpts) GetLabels() (map[string]string, error) {
+	labels, err := label.Parse(opts.labels)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key '%s': %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid value '%s' of label '%s': %s", value, key, strings.Join(errs, "; "))
+		}
+	}
+	return labels, nil
+}
+
+// GetDestinations returns the server-based destinations given with --dest followed by the name-based destinations
+// given with --dest-name.
 func (opts *ProjectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 	destinations := make([]v1alpha1.ApplicationDestination, 0)
 	for _, destStr := range opts.destinations {
 		parts := strings.Split(destStr, ",")
-		if len(parts) != 2 {
+		if len(parts) != 2 || parts[0] == "" {
 			log.Fatalf("Expected destination of the form: server,namespace. Received: %s", destStr)
 		}
 		destinations = append(destinations, v1alpha1.ApplicationDestination{
@@ -92,9 +147,50 @@ func (opts *ProjectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 			Namespace: parts[1],
 		})
 	}
+	for _, destStr := range opts.destinationNames {
+		parts := strings.Split(destStr, ",")
+		if len(parts) != 2 || parts[0] == "" {
+			log.Fatalf("Expected destination of the form: name,namespace. Received: %s", destStr)
+		}
+		if strings.Contains(parts[0], "://") {
+			log.Fatalf("Expected destination cluster name, not server URL '%s'. Use --dest for server-based destinations", parts[0])
+		}
+		destinations = append(destinations, v1alpha1.ApplicationDestination{
+			Name:      parts[0],
+			Namespace: parts[1],
+		})
+	}
 	return destinations
 }
 
+// GetDefaultDestination returns the destination given with --default-destination, or nil if it is empty.
+func (opts *ProjectOpts) GetDefaultDestination() *v1alpha1.ApplicationDestination {
+	if opts.defaultDestination == "" {
+		return nil
+	}
+	parts := strings.Split(opts.defaultDestination, ",")
+	if len(parts) != 2 {
+		log.Fatalf("Expected default destination of the form: server,namespace. Received: %s", opts.defaultDestination)
+	}
+	return &v1alpha1.ApplicationDestination{
+		Server:    parts[0],
+		Namespace: parts[1],
+	}
+}
+
+// GetPropagatedAnnotations returns the annotations given with --propagate-annotation.
+func (opts *ProjectOpts) GetPropagatedAnnotations() map[string]string {
+	annotations := make(map[string]string, len(opts.propagatedAnnotations))
+	for _, annotationStr := range opts.propagatedAnnotations {
+		key, value, ok := strings.Cut(annotationStr, "=")
+		if !ok || key == "" {
+			log.Fatalf("Expected propagated annotation of the form: key=value. Received: %s", annotationStr)
+		}
+		annotations[key] = value
+	}
+	return annotations
+}
+
 func (opts *ProjectOpts) GetDestinationServiceAccounts() []v1alpha1.ApplicationDestinationServiceAccount {
 	destinationServiceAccounts := make([]v1alpha1.ApplicationDestinationServiceAccount, 0)
 	for _, destStr := range opts.destinationServiceAccounts {
@@ -129,11 +225,15 @@ func (opts *ProjectOpts) GetSourceNamespaces() []string {
 
 func GetOrphanedResourcesSettings(flagSet *pflag.FlagSet, opts ProjectOpts) *v1alpha1.OrphanedResourcesMonitorSettings {
 	warnChanged := flagSet.Changed("orphaned-resources-warn")
-	if opts.orphanedResourcesEnabled || warnChanged {
+	blockChanged := flagSet.Changed("orphaned-resources-block")
+	if opts.orphanedResourcesEnabled || warnChanged || blockChanged {
 		settings := v1alpha1.OrphanedResourcesMonitorSettings{}
 		if warnChanged {
 			settings.Warn = ptr.To(opts.orphanedResourcesWarn)
 		}
+		if blockChanged {
+			settings.Block = ptr.To(opts.orphanedResourcesBlock)
+		}
 		return &settings
 	}
 	return nil
@@ -161,6 +261,60 @@ func readProjFromURI(fileURL string, proj *v1alpha1.AppProject) error {
 	return nil
 }
 
+// ReadAppProjs reads the projects from the given files or URLs, each of which may contain several YAML documents.
+// Every document must be a project with a name, and a project may only be defined once.
+func ReadAppProjs(fileURLs []string) ([]*v1alpha1.AppProject, error) {
+	var projs []*v1alpha1.AppProject
+	names := map[string]string{}
+	for _, fileURL := range fileURLs {
+		var yml []byte
+		var err error
+		parsedURL, parseErr := url.ParseRequestURI(fileURL)
+		if parseErr != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+			yml, err = os.ReadFile(fileURL)
+		} else {
+			yml, err = config.ReadRemoteFile(fileURL)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading projects from %s: %w", fileURL, err)
+		}
+		fileProjs, err := readProjs(yml)
+		if err != nil {
+			return nil, fmt.Errorf("error reading projects from %s: %w", fileURL, err)
+		}
+		for _, proj := range fileProjs {
+			if previous, ok := names[proj.Name]; ok {
+				return nil, fmt.Errorf("project '%s' is defined in both %s and %s", proj.Name, previous, fileURL)
+			}
+			names[proj.Name] = fileURL
+		}
+		projs = append(projs, fileProjs...)
+	}
+	return projs, nil
+}
+
+func readProjs(yml []byte) ([]*v1alpha1.AppProject, error) {
+	yamls, err := kube.SplitYAMLToString(yml)
+	if err != nil {
+		return nil, err
+	}
+	var projs []*v1alpha1.AppProject
+	for _, doc := range yamls {
+		var proj v1alpha1.AppProject
+		if err := config.Unmarshal([]byte(doc), &proj); err != nil {
+			return nil, err
+		}
+		if proj.Kind != application.AppProjectKind {
+			return nil, fmt.Errorf("expected kind %s, got '%s'", application.AppProjectKind, proj.Kind)
+		}
+		if proj.Name == "" {
+			return nil, errors.New("project metadata.name is required")
+		}
+		projs = append(projs, &proj)
+	}
+	return projs, nil
+}
+
 func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, projOpts *ProjectOpts) int {
 	visited := 0
 	flags.Visit(func(f *pflag.Flag) {
@@ -168,10 +322,14 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 		switch f.Name {
 		case "description":
 			spec.Description = projOpts.Description
-		case "dest":
-			spec.Destinations = projOpts.GetDestinations()
+		case "dest", "dest-name":
+			// both flags update the destinations at once below
 		case "src":
-			spec.SourceRepos = projOpts.Sources
+			if projOpts.mergeLists {
+				spec.SourceRepos = mergeSourceRepos(spec.SourceRepos, projOpts.Sources)
+			} else {
+				spec.SourceRepos = projOpts.Sources
+			}
 		case "signature-keys":
 			spec.SignatureKeys = projOpts.GetSignatureKeys()
 		case "allow-cluster-resource":
@@ -186,15 +344,93 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceNamespaces = projOpts.GetSourceNamespaces()
 		case "dest-service-accounts":
 			spec.DestinationServiceAccounts = projOpts.GetDestinationServiceAccounts()
+		case "token-audience":
+			spec.TokenAudience = projOpts.TokenAudience
+		case "deletion-protection":
+			spec.DeletionProtection = projOpts.DeletionProtection
+		case "refresh-interval":
+			spec.RefreshInterval = projOpts.RefreshInterval
+		case "default-destination":
+			spec.DefaultDestination = projOpts.GetDefaultDestination()
+		case "propagate-annotation":
+			if spec.PropagatedAnnotations == nil {
+				spec.PropagatedAnnotations = make(map[string]string)
+			}
+			maps.Copy(spec.PropagatedAnnotations, projOpts.GetPropagatedAnnotations())
+		case "merge", "replace":
+			// these only control how the list fields above are updated
+			visited--
 		}
 	})
-	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") {
-		spec.OrphanedResources = GetOrphanedResourcesSettings(flags, *projOpts)
+	if flags.Changed("dest") || flags.Changed("dest-name") {
+		if projOpts.mergeLists {
+			spec.Destinations = mergeDestinations(spec.Destinations, projOpts.GetDestinations())
+		} else {
+			spec.Destinations = projOpts.GetDestinations()
+		}
+	}
+	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") || flags.Changed("orphaned-resources-block") {
+		settings := GetOrphanedResourcesSettings(flags, *projOpts)
+		if settings != nil && spec.OrphanedResources != nil {
+			// keep the existing settings which are not changed by the flags
+			settings.Ignore = spec.OrphanedResources.Ignore
+			if !flags.Changed("orphaned-resources-warn") {
+				settings.Warn = spec.OrphanedResources.Warn
+			}
+			if !flags.Changed("orphaned-resources-block") {
+				settings.Block = spec.OrphanedResources.Block
+			}
+		}
+		spec.OrphanedResources = settings
 		visited++
 	}
 	return visited
 }
 
+// SetProjLabels adds the labels given with --label to the labels of the project, overriding existing labels with the
+// same key.
+func SetProjLabels(flags *pflag.FlagSet, proj *v1alpha1.AppProject, projOpts *ProjectOpts) error {
+	if !flags.Changed("label") {
+		return nil
+	}
+	labels, err := projOpts.GetLabels()
+	if err != nil {
+		return err
+	}
+	if proj.Labels == nil {
+		proj.Labels = make(map[string]string, len(labels))
+	}
+	maps.Copy(proj.Labels, labels)
+	return nil
+}
+
+func mergeDestinations(existing, added []v1alpha1.ApplicationDestination) []v1alpha1.ApplicationDestination {
+	merged := existing
+	for _, dest := range added {
+		found := false
+		for _, existingDest := range merged {
+			if existingDest.Server == dest.Server && existingDest.Name == dest.Name && existingDest.Namespace == dest.Namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, dest)
+		}
+	}
+	return merged
+}
+
+func mergeSourceRepos(existing, added []string) []string {
+	merged := existing
+	for _, repo := range added {
+		if !slices.Contains(merged, repo) {
+			merged = append(merged, repo)
+		}
+	}
+	return merged
+}
+
 func ConstructAppProj(fileURL string, args []string, opts ProjectOpts, c *cobra.Command) (*v1alpha1.AppProject, error) {
 	proj := v1alpha1.AppProject{
 		TypeMeta: metav1.TypeMeta{
@@ -228,5 +464,57 @@ func ConstructAppProj(fileURL string, args []string, opts ProjectOpts, c *cobra.
 		proj.Name = args[0]
 	}
 	SetProjSpecOptions(c.Flags(), &proj.Spec, &opts)
+	if err := SetProjLabels(c.Flags(), &proj, &opts); err != nil {
+		return nil, err
+	}
 	return &proj, nil
 }
+
+// ConstructAppProjFromTemplate constructs a project named after the first argument with the spec of the template
+// project, then applies the project options on top of it. The template must be labeled as a project template.
+func ConstructAppProjFromTemplate(template *v1alpha1.AppProject, args []string, opts ProjectOpts, c *cobra.Command) (*v1alpha1.AppProject, error) {
+	if template.Labels[common.LabelKeyProjectTemplate] != "true" {
+		return nil, fmt.Errorf("project '%s' is not a template, it must be labeled with %s=true", template.Name, common.LabelKeyProjectTemplate)
+	}
+	if len(args) == 0 {
+		c.HelpFunc()(c, args)
+		os.Exit(1)
+	}
+	proj := v1alpha1.AppProject{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       application.AppProjectKind,
+			APIVersion: application.Group + "/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: args[0]},
+		Spec:       *template.Spec.DeepCopy(),
+	}
+	// the tokens of the template roles cannot be used with the new project, and the policies of the roles must refer to it
+	for i := range proj.Spec.Roles {
+		proj.Spec.Roles[i].JWTTokens = nil
+		for j, policy := range proj.Spec.Roles[i].Policies {
+			proj.Spec.Roles[i].Policies[j] = renamePolicyProject(policy, template.Name, proj.Name)
+		}
+	}
+	SetProjSpecOptions(c.Flags(), &proj.Spec, &opts)
+	if err := SetProjLabels(c.Flags(), &proj, &opts); err != nil {
+		return nil, err
+	}
+	return &proj, nil
+}
+
+// renamePolicyProject rewrites a role policy of a project to refer to another project, i.e. the subject
+// 'proj:<project>:<role>' and the objects '<project>' and '<project>/...'. Other policies are returned unchanged.
+func renamePolicyProject(policy string, projName string, newProjName string) string {
+	policyComponents := strings.Split(policy, ",")
+	if len(policyComponents) != 6 {
+		return policy
+	}
+	subjectPrefix := fmt.Sprintf("proj:%s:", projName)
+	if subject := strings.TrimSpace(policyComponents[1]); strings.HasPrefix(subject, subjectPrefix) {
+		policyComponents[1] = fmt.Sprintf(" proj:%s:%s", newProjName, strings.TrimPrefix(subject, subjectPrefix))
+	}
+	if object := strings.TrimSpace(policyComponents[4]); object == projName || strings.HasPrefix(object, projName+"/") {
+		policyComponents[4] = " " + newProjName + strings.TrimPrefix(object, projName)
+	}
+	return strings.Join(policyComponents, ",")
+}
@@ -1,11 +1,17 @@
 package util
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -46,3 +52,253 @@ func TestProjectOpts_GetDestinationServiceAccounts(t *testing.T) {
 		}, opts.GetDestinationServiceAccounts(),
 	)
 }
+
+func TestSetProjLabels(t *testing.T) {
+	setLabels := func(t *testing.T, proj *v1alpha1.AppProject, args ...string) error {
+		t.Helper()
+		var opts ProjectOpts
+		command := &cobra.Command{}
+		AddProjFlags(command, &opts)
+		require.NoError(t, command.ParseFlags(args))
+		return SetProjLabels(command.Flags(), proj, &opts)
+	}
+
+	proj := &v1alpha1.AppProject{}
+	require.NoError(t, setLabels(t, proj))
+	assert.Nil(t, proj.Labels)
+
+	require.NoError(t, setLabels(t, proj, "--label", "opt=me", "--label", "team=a"))
+	assert.Equal(t, map[string]string{"opt": "me", "team": "a"}, proj.Labels)
+
+	require.NoError(t, setLabels(t, proj, "--label", "team=b"))
+	assert.Equal(t, map[string]string{"opt": "me", "team": "b"}, proj.Labels)
+
+	require.ErrorContains(t, setLabels(t, proj, "--label", "opt"), "labels should have key=value")
+	require.ErrorContains(t, setLabels(t, proj, "--label", "bad key=value"), "invalid label key 'bad key'")
+	require.ErrorContains(t, setLabels(t, proj, "--label", "key=bad value"), "invalid value 'bad value' of label 'key'")
+}
+
+func TestSetProjSpecOptions_ListSemantics(t *testing.T) {
+	newSpec := func() *v1alpha1.AppProjectSpec {
+		return &v1alpha1.AppProjectSpec{
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "default"}},
+			SourceRepos:  []string{"https://github.com/argoproj/argo-cd"},
+		}
+	}
+	setSpec := func(t *testing.T, spec *v1alpha1.AppProjectSpec, args ...string) int {
+		t.Helper()
+		var opts ProjectOpts
+		command := &cobra.Command{}
+		AddProjFlags(command, &opts)
+		AddProjSetFlags(command, &opts)
+		require.NoError(t, command.ParseFlags(args))
+		require.NoError(t, command.ValidateFlagGroups())
+		return SetProjSpecOptions(command.Flags(), spec, &opts)
+	}
+
+	t.Run("ReplaceByDefault", func(t *testing.T) {
+		spec := newSpec()
+		visited := setSpec(t, spec, "--dest", "https://remote,guestbook", "--src", "https://github.com/argoproj/argocd-example-apps")
+		assert.Equal(t, 2, visited)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://remote", Namespace: "guestbook"}}, spec.Destinations)
+		assert.Equal(t, []string{"https://github.com/argoproj/argocd-example-apps"}, spec.SourceRepos)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		spec := newSpec()
+		visited := setSpec(t, spec, "--replace", "--dest", "https://remote,guestbook")
+		assert.Equal(t, 1, visited)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://remote", Namespace: "guestbook"}}, spec.Destinations)
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		spec := newSpec()
+		visited := setSpec(t, spec, "--merge",
+			"--dest", "https://kubernetes.default.svc,default",
+			"--dest", "https://remote,guestbook",
+			"--src", "https://github.com/argoproj/argo-cd",
+			"--src", "https://github.com/argoproj/argocd-example-apps")
+		assert.Equal(t, 2, visited)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://kubernetes.default.svc", Namespace: "default"},
+			{Server: "https://remote", Namespace: "guestbook"},
+		}, spec.Destinations)
+		assert.Equal(t, []string{"https://github.com/argoproj/argo-cd", "https://github.com/argoproj/argocd-example-apps"}, spec.SourceRepos)
+	})
+
+	t.Run("DestName", func(t *testing.T) {
+		spec := newSpec()
+		visited := setSpec(t, spec, "--dest", "https://remote,guestbook", "--dest-name", "in-cluster,default")
+		assert.Equal(t, 2, visited)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://remote", Namespace: "guestbook"},
+			{Name: "in-cluster", Namespace: "default"},
+		}, spec.Destinations)
+	})
+
+	t.Run("MergeDestName", func(t *testing.T) {
+		spec := newSpec()
+		visited := setSpec(t, spec, "--merge", "--dest-name", "in-cluster,default")
+		assert.Equal(t, 1, visited)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{
+			{Server: "https://kubernetes.default.svc", Namespace: "default"},
+			{Name: "in-cluster", Namespace: "default"},
+		}, spec.Destinations)
+	})
+
+	t.Run("MergeAloneIsNoOption", func(t *testing.T) {
+		assert.Equal(t, 0, setSpec(t, newSpec(), "--merge"))
+	})
+
+	t.Run("RefreshInterval", func(t *testing.T) {
+		spec := newSpec()
+		assert.Equal(t, 1, setSpec(t, spec, "--refresh-interval", "10m"))
+		assert.Equal(t, "10m", spec.RefreshInterval)
+		assert.Equal(t, 1, setSpec(t, spec, "--refresh-interval", ""))
+		assert.Empty(t, spec.RefreshInterval)
+	})
+
+	t.Run("DefaultDestination", func(t *testing.T) {
+		spec := newSpec()
+		assert.Equal(t, 1, setSpec(t, spec, "--default-destination", "https://remote,guestbook"))
+		assert.Equal(t, &v1alpha1.ApplicationDestination{Server: "https://remote", Namespace: "guestbook"}, spec.DefaultDestination)
+		assert.Equal(t, 1, setSpec(t, spec, "--default-destination", ""))
+		assert.Nil(t, spec.DefaultDestination)
+	})
+
+	t.Run("PropagateAnnotation", func(t *testing.T) {
+		spec := newSpec()
+		spec.PropagatedAnnotations = map[string]string{"team": "checkout", "tier": "backend"}
+		assert.Equal(t, 1, setSpec(t, spec, "--propagate-annotation", "team=payments", "--propagate-annotation", "query=a=b"))
+		assert.Equal(t, map[string]string{"team": "payments", "tier": "backend", "query": "a=b"}, spec.PropagatedAnnotations)
+	})
+
+	t.Run("OrphanedResourcesBlock", func(t *testing.T) {
+		spec := newSpec()
+		spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{
+			Warn:   ptr.To(true),
+			Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap"}},
+		}
+		assert.Positive(t, setSpec(t, spec, "--orphaned-resources-block"))
+		assert.Equal(t, &v1alpha1.OrphanedResourcesMonitorSettings{
+			Warn:   ptr.To(true),
+			Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap"}},
+			Block:  ptr.To(true),
+		}, spec.OrphanedResources)
+		assert.True(t, spec.OrphanedResources.IsBlock())
+
+		assert.Positive(t, setSpec(t, spec, "--orphaned-resources-block=false"))
+		assert.False(t, spec.OrphanedResources.IsBlock())
+		assert.True(t, spec.OrphanedResources.IsWarn())
+
+		assert.Positive(t, setSpec(t, spec, "--orphaned-resources=false"))
+		assert.Nil(t, spec.OrphanedResources)
+	})
+
+	t.Run("MutuallyExclusive", func(t *testing.T) {
+		var opts ProjectOpts
+		command := &cobra.Command{}
+		AddProjFlags(command, &opts)
+		AddProjSetFlags(command, &opts)
+		require.NoError(t, command.ParseFlags([]string{"--merge", "--replace"}))
+		require.Error(t, command.ValidateFlagGroups())
+	})
+}
+
+func TestConstructAppProjFromTemplate(t *testing.T) {
+	template := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "golden", Labels: map[string]string{common.LabelKeyProjectTemplate: "true"}},
+		Spec: v1alpha1.AppProjectSpec{
+			Description:  "golden project",
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "*"}},
+			SourceRepos:  []string{"https://github.com/argoproj/argo-cd"},
+			Roles: []v1alpha1.ProjectRole{{
+				Name:      "ci",
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1}},
+				Policies: []string{
+					"p, proj:golden:ci, applications, sync, golden/*, allow",
+					"p, proj:golden:ci, applications, get, golden/*/*;team=payments, allow",
+					"p, proj:golden:ci, projects, get, golden, allow",
+					"p, proj:golden-other:ci, applications, get, golden-other/*, allow",
+				},
+			}},
+		},
+	}
+	var opts ProjectOpts
+	command := &cobra.Command{}
+	AddProjFlags(command, &opts)
+	require.NoError(t, command.ParseFlags([]string{"--description", "team project", "--src", "https://github.com/argoproj/argocd-example-apps"}))
+
+	proj, err := ConstructAppProjFromTemplate(template, []string{"team"}, opts, command)
+	require.NoError(t, err)
+	assert.Equal(t, "team", proj.Name)
+	assert.Empty(t, proj.Labels)
+	assert.Equal(t, "team project", proj.Spec.Description)
+	assert.Equal(t, []string{"https://github.com/argoproj/argocd-example-apps"}, proj.Spec.SourceRepos)
+	assert.Equal(t, template.Spec.Destinations, proj.Spec.Destinations)
+	require.Len(t, proj.Spec.Roles, 1)
+	assert.Equal(t, "ci", proj.Spec.Roles[0].Name)
+	assert.Empty(t, proj.Spec.Roles[0].JWTTokens)
+	assert.Equal(t, []string{
+		"p, proj:team:ci, applications, sync, team/*, allow",
+		"p, proj:team:ci, applications, get, team/*/*;team=payments, allow",
+		"p, proj:team:ci, projects, get, team, allow",
+		"p, proj:golden-other:ci, applications, get, golden-other/*, allow",
+	}, proj.Spec.Roles[0].Policies)
+	// the template is left untouched
+	assert.Equal(t, "golden project", template.Spec.Description)
+	assert.Len(t, template.Spec.Roles[0].JWTTokens, 1)
+	assert.Equal(t, "p, proj:golden:ci, applications, sync, golden/*, allow", template.Spec.Roles[0].Policies[0])
+
+	delete(template.Labels, common.LabelKeyProjectTemplate)
+	_, err = ConstructAppProjFromTemplate(template, []string{"team"}, opts, command)
+	require.ErrorContains(t, err, "project 'golden' is not a template")
+}
+
+func TestReadAppProjs(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+	projects := write("projects.yaml", `apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: team-a
+spec:
+  description: Team A
+---
+apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: team-b
+`)
+	teamC := write("team-c.yaml", `apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: team-c
+`)
+
+	projs, err := ReadAppProjs([]string{projects, teamC})
+	require.NoError(t, err)
+	require.Len(t, projs, 3)
+	assert.Equal(t, "team-a", projs[0].Name)
+	assert.Equal(t, "Team A", projs[0].Spec.Description)
+	assert.Equal(t, "team-b", projs[1].Name)
+	assert.Equal(t, "team-c", projs[2].Name)
+
+	_, err = ReadAppProjs([]string{projects, projects})
+	require.ErrorContains(t, err, "project 'team-a' is defined in both")
+
+	app := write("app.yaml", `apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: app
+`)
+	_, err = ReadAppProjs([]string{app})
+	require.ErrorContains(t, err, "expected kind AppProject")
+
+	_, err = ReadAppProjs([]string{filepath.Join(dir, "missing.yaml")})
+	require.Error(t, err)
+}
@@ -86,6 +86,8 @@ const (
 	DefaultPathTLSConfig = "/app/config/tls"
 	// DefaultPathSSHConfig is the default path where SSH known hosts are stored
 	DefaultPathSSHConfig = "/app/config/ssh"
+	// DefaultPathSCMTokens is the default path where the ApplicationSet controller reads SCM provider token files from
+	DefaultPathSCMTokens = "/app/config/scm-tokens"
 	// DefaultSSHKnownHostsName is the Default name for the SSH known hosts file
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
@@ -178,6 +180,8 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyProjectTemplate marks a project as a template which new projects can be created from, if set to true
+	LabelKeyProjectTemplate = "argocd.argoproj.io/project-template"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -221,6 +225,17 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyRefreshInterval overrides the interval at which the Application is refreshed, e.g. "10m".
+	// It takes precedence over the refresh interval of the Application's project.
+	AnnotationKeyRefreshInterval = "argocd.argoproj.io/refresh-interval"
+	// AnnotationKeyObservedGeneration is set by the application controller on an AppProject to the most recent
+	// generation of the project it has observed.
+	AnnotationKeyObservedGeneration = "argocd.argoproj.io/observed-generation"
+	// AnnotationKeyRevisionHistoryLimit enables recording the prior specs of an AppProject updated through the API
+	// server, and sets the number of recorded revisions.
+	AnnotationKeyRevisionHistoryLimit = "argocd.argoproj.io/revision-history-limit"
+	// AnnotationKeyRevisionHistory holds the prior specs of an AppProject recorded by the API server, most recent first.
+	AnnotationKeyRevisionHistory = "argocd.argoproj.io/revision-history"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -237,6 +252,8 @@ const (
 	EnvVarSSHDataPath = "ARGOCD_SSH_DATA_PATH"
 	// EnvVarTLSDataPath overrides the location where TLS certificate for repo access data is stored
 	EnvVarTLSDataPath = "ARGOCD_TLS_DATA_PATH"
+	// EnvVarSCMTokensDataPath overrides the location where the ApplicationSet controller reads SCM provider token files from
+	EnvVarSCMTokensDataPath = "ARGOCD_SCM_TOKENS_DATA_PATH"
 	// EnvGitAttemptsCount specifies number of git remote operations attempts count
 	EnvGitAttemptsCount = "ARGOCD_GIT_ATTEMPTS_COUNT"
 	// EnvGitRetryMaxDuration specifies max duration of git remote operation retry
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"maps"
 	"math"
 	"math/rand"
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -141,6 +143,10 @@ type ApplicationController struct {
 	applicationNamespaces         []string
 	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
 
+	// refreshIntervalWarnings holds the last warning about an invalid refresh interval logged for each application or
+	// project, so that it is not logged again on every reconciliation
+	refreshIntervalWarnings sync.Map
+
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
 	deploymentInformer                informerv1.DeploymentInformer
@@ -1121,14 +1127,41 @@ func (ctrl *ApplicationController) processProjectQueueItem() (processNext bool)
 		return
 	}
 
-	if origProj.DeletionTimestamp != nil && origProj.HasFinalizer() {
-		if err := ctrl.finalizeProjectDeletion(origProj.DeepCopy()); err != nil {
-			log.Warnf("Failed to finalize project deletion: %v", err)
+	if origProj.DeletionTimestamp != nil {
+		if origProj.HasFinalizer() {
+			if err := ctrl.finalizeProjectDeletion(origProj.DeepCopy()); err != nil {
+				log.Warnf("Failed to finalize project deletion: %v", err)
+			}
 		}
+		return
+	}
+	if err := ctrl.recordProjectObservedGeneration(origProj); err != nil {
+		log.Warnf("Failed to record observed generation of project '%s': %v", origProj.Name, err)
 	}
 	return
 }
 
+// recordProjectObservedGeneration annotates the project with the generation the controller has observed, allowing
+// clients to wait for a project update to be picked up. An annotation is used since the AppProject CRD has no status
+// subresource, so that a status field would bump the generation of the project itself. The project is only patched
+// if its generation is newer than the observed one, so that the events of the patch, or a stale informer cache, do
+// not cause further patches.
+func (ctrl *ApplicationController) recordProjectObservedGeneration(proj *appv1.AppProject) error {
+	if observed, err := strconv.ParseInt(proj.Annotations[common.AnnotationKeyObservedGeneration], 10, 64); err == nil && observed >= proj.Generation {
+		return nil
+	}
+	generation := strconv.FormatInt(proj.Generation, 10)
+	patch, _ := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				common.AnnotationKeyObservedGeneration: generation,
+			},
+		},
+	})
+	_, err := ctrl.applicationClientset.ArgoprojV1alpha1().AppProjects(ctrl.namespace).Patch(context.Background(), proj.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
 func (ctrl *ApplicationController) finalizeProjectDeletion(proj *appv1.AppProject) error {
 	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
 	if err != nil {
@@ -1343,6 +1376,26 @@ func (ctrl *ApplicationController) updateFinalizers(app *appv1.Application) erro
 	return err
 }
 
+// propagateProjectAnnotations adds the propagated annotations of the project which the application does not have yet
+// to the application. Existing annotations of the application are never overridden.
+func (ctrl *ApplicationController) propagateProjectAnnotations(app *appv1.Application, proj *appv1.AppProject) {
+	missing := proj.MissingPropagatedAnnotations(app)
+	if len(missing) == 0 {
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	patch, _ := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": missing,
+		},
+	})
+	if _, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		logCtx.Warnf("Failed to propagate the annotations of project '%s': %v", proj.Name, err)
+		return
+	}
+	logCtx.Infof("Propagated annotations %v of project '%s'", slices.Sorted(maps.Keys(missing)), proj.Name)
+}
+
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	// do nothing if app already has same condition
@@ -1632,7 +1685,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.appRefreshTimeout(origApp), ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
 		return
@@ -1708,6 +1761,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 
+	ctrl.propagateProjectAnnotations(app, project)
+	ts.AddCheckpoint("propagate_project_annotations_ms")
+
 	destCluster, err = argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
 	if err != nil {
 		logCtx.Errorf("Failed to get destination cluster: %v", err)
@@ -1899,6 +1955,48 @@ func currentSourceEqualsSyncedSource(app *appv1.Application) bool {
 	return source.Equals(&app.Status.Sync.ComparedTo.Source)
 }
 
+// appRefreshTimeout returns the refresh timeout of the given application. The refresh interval annotation of the
+// application takes precedence over the refresh interval of its project, which takes precedence over the controller
+// wide status refresh timeout. Invalid values are ignored.
+func (ctrl *ApplicationController) appRefreshTimeout(app *appv1.Application) time.Duration {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	appKey := "application/" + app.QualifiedName()
+	if value, ok := app.GetAnnotations()[common.AnnotationKeyRefreshInterval]; ok {
+		interval, err := time.ParseDuration(value)
+		if err == nil && interval > 0 {
+			ctrl.refreshIntervalWarnings.Delete(appKey)
+			return interval
+		}
+		ctrl.warnRefreshIntervalOnce(logCtx, appKey, fmt.Sprintf("Ignoring invalid %s annotation value '%s'", common.AnnotationKeyRefreshInterval, value))
+	} else {
+		ctrl.refreshIntervalWarnings.Delete(appKey)
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		return ctrl.statusRefreshTimeout
+	}
+	projKey := "project/" + proj.Name
+	interval, err := proj.Spec.GetRefreshInterval()
+	if err != nil {
+		ctrl.warnRefreshIntervalOnce(logCtx, projKey, fmt.Sprintf("Ignoring refresh interval of project '%s': %v", proj.Name, err))
+		return ctrl.statusRefreshTimeout
+	}
+	ctrl.refreshIntervalWarnings.Delete(projKey)
+	if interval > 0 {
+		return interval
+	}
+	return ctrl.statusRefreshTimeout
+}
+
+// warnRefreshIntervalOnce logs the warning about an invalid refresh interval of an application or a project, unless the
+// same warning was already logged for it
+func (ctrl *ApplicationController) warnRefreshIntervalOnce(logCtx *log.Entry, key string, warning string) {
+	if previous, loaded := ctrl.refreshIntervalWarnings.Swap(key, warning); loaded && previous == warning {
+		return
+	}
+	logCtx.Warn(warning)
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally, it returns whether full refresh was requested or not.
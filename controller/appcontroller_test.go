@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
@@ -1438,6 +1440,89 @@ func TestSetOperationStateLogRetries(t *testing.T) {
 	assert.Contains(t, hook.Entries[0].Message, "fake error")
 }
 
+func TestAppRefreshTimeout(t *testing.T) {
+	newProj := func(refreshInterval string) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:     []string{"*"},
+				Destinations:    []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				RefreshInterval: refreshInterval,
+			},
+		}
+	}
+	newApp := func(annotation string) *v1alpha1.Application {
+		app := newFakeApp()
+		if annotation != "" {
+			app.Annotations = map[string]string{common.AnnotationKeyRefreshInterval: annotation}
+		}
+		return app
+	}
+
+	testCases := []struct {
+		name       string
+		annotation string
+		project    string
+		expected   time.Duration
+	}{
+		{name: "controller default", expected: 0},
+		{name: "project interval", project: "10m", expected: 10 * time.Minute},
+		{name: "annotation wins over project", annotation: "5m", project: "10m", expected: 5 * time.Minute},
+		{name: "annotation without project interval", annotation: "30s", expected: 30 * time.Second},
+		{name: "invalid annotation falls back to project", annotation: "soon", project: "10m", expected: 10 * time.Minute},
+		{name: "non-positive annotation falls back to project", annotation: "0s", project: "10m", expected: 10 * time.Minute},
+		{name: "invalid project interval falls back to default", project: "-1m", expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newApp(tc.annotation)
+			ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, newProj(tc.project)}}, nil)
+			expected := tc.expected
+			if expected == 0 {
+				expected = ctrl.statusRefreshTimeout
+			}
+			assert.Equal(t, expected, ctrl.appRefreshTimeout(app))
+		})
+	}
+}
+
+func TestAppRefreshTimeoutWarnsOnce(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationKeyRefreshInterval: "soon"}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+	hook := logrustest.NewGlobal()
+	defer hook.Reset()
+	warnings := func() int {
+		count := 0
+		for _, entry := range hook.AllEntries() {
+			if entry.Level == logrus.WarnLevel && strings.Contains(entry.Message, "annotation value") {
+				count++
+			}
+		}
+		return count
+	}
+
+	ctrl.appRefreshTimeout(app)
+	ctrl.appRefreshTimeout(app)
+	assert.Equal(t, 1, warnings())
+
+	// a different invalid value is logged again
+	app.Annotations[common.AnnotationKeyRefreshInterval] = "later"
+	ctrl.appRefreshTimeout(app)
+	assert.Equal(t, 2, warnings())
+
+	// so is the same value once it was fixed in between
+	app.Annotations[common.AnnotationKeyRefreshInterval] = "5m"
+	ctrl.appRefreshTimeout(app)
+	app.Annotations[common.AnnotationKeyRefreshInterval] = "later"
+	ctrl.appRefreshTimeout(app)
+	assert.Equal(t, 3, warnings())
+}
+
 func TestNeedRefreshAppStatus(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -2035,6 +2120,52 @@ func TestProjectErrorToCondition(t *testing.T) {
 	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, updatedApp.Status.Conditions[0].Type)
 }
 
+func TestPropagateProjectAnnotations(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{"team": "checkout"}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.PropagatedAnnotations = map[string]string{"team": "payments", "cost-center": "1234"}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+	ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+
+	ctrl.processAppRefreshQueueItem()
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	// the annotation the app already had is not overridden
+	assert.Equal(t, "checkout", updatedApp.Annotations["team"])
+	assert.Equal(t, "1234", updatedApp.Annotations["cost-center"])
+}
+
+func TestPropagateProjectAnnotations_NothingMissing(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{"team": "checkout"}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.PropagatedAnnotations = map[string]string{"team": "payments"}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patched := false
+	fakeAppCs.PrependReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patched = true
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.propagateProjectAnnotations(app, proj)
+	assert.False(t, patched)
+}
+
 func TestFinalizeProjectDeletion_HasApplications(t *testing.T) {
 	app := newFakeApp()
 	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace}}
@@ -2074,6 +2205,45 @@ func TestFinalizeProjectDeletion_DoesNotHaveApplications(t *testing.T) {
 	}, receivedPatch)
 }
 
+func TestRecordProjectObservedGeneration(t *testing.T) {
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace, Generation: 3}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{&defaultProj}}, nil)
+
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patches := 0
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patches++
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.AppProject{}, nil
+	})
+
+	require.NoError(t, ctrl.recordProjectObservedGeneration(proj))
+	assert.Equal(t, 1, patches)
+	assert.Equal(t, map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				common.AnnotationKeyObservedGeneration: "3",
+			},
+		},
+	}, receivedPatch)
+
+	proj.Annotations = map[string]string{common.AnnotationKeyObservedGeneration: "3"}
+	require.NoError(t, ctrl.recordProjectObservedGeneration(proj))
+	assert.Equal(t, 1, patches, "the observed generation is unchanged")
+
+	proj.Generation = 2
+	require.NoError(t, ctrl.recordProjectObservedGeneration(proj))
+	assert.Equal(t, 1, patches, "a stale project does not lower the observed generation")
+
+	proj.Generation = 4
+	proj.Annotations = map[string]string{common.AnnotationKeyObservedGeneration: "invalid"}
+	require.NoError(t, ctrl.recordProjectObservedGeneration(proj))
+	assert.Equal(t, 2, patches, "an invalid observed generation is replaced")
+}
+
 func TestProcessRequestedAppOperation_FailedNoRetries(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "default"
@@ -1050,6 +1050,50 @@ func TestSignedResponseNoSignatureRequired(t *testing.T) {
 	}
 }
 
+func TestSignedResponseSignatureRequiredByGlobalProject(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "true")
+
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	proj.Labels = map[string]string{"signing": "required"}
+	orgProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "org-signing", Namespace: test.FakeArgoCDNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, proj, orgProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData: map[string]string{
+			"globalProjects": `
+- projectName: org-signing
+  labelSelector:
+    matchLabels:
+      signing: required
+`,
+		},
+	}
+	ctrl := newFakeController(&data, nil)
+
+	// The global project contributes the only signature key of the project
+	virtualProj, err := ctrl.getAppProj(app)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}, virtualProj.Spec.SignatureKeys)
+
+	compRes, err := ctrl.appStateManager.CompareAppState(app, virtualProj, []string{"abc123"}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+	assert.NotNil(t, compRes)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Contains(t, app.Status.Conditions[0].Message, "is not signed, but a signature is required")
+}
+
 func TestSignedResponseSignatureRequired(t *testing.T) {
 	t.Setenv("ARGOCD_GPG_ENABLED", "true")
 
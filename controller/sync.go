@@ -21,6 +21,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -33,6 +34,7 @@ import (
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
@@ -117,6 +119,23 @@ func newSyncOperationResult(app *v1alpha1.Application, op v1alpha1.SyncOperation
 	return syncRes
 }
 
+// isResourceSyncExcluded returns whether the target or the live state of a resource carries annotations matching one of
+// the sync excluded resource annotation selectors of the project
+func isResourceSyncExcluded(selectors []labels.Selector, target, live *unstructured.Unstructured) bool {
+	for _, obj := range []*unstructured.Unstructured{target, live} {
+		if obj == nil {
+			continue
+		}
+		annotations := labels.Set(obj.GetAnnotations())
+		for _, selector := range selectors {
+			if selector.Matches(annotations) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) {
 	syncId, err := syncid.Generate()
 	if err != nil {
@@ -178,6 +197,20 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		return
 	}
 
+	if project.Spec.OrphanedResources.IsBlock() {
+		orphanedResources, err := m.getOrphanedResources(app)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to get orphaned resources: %v", err)
+			return
+		}
+		if len(orphanedResources) > 0 {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Sync blocked by %d orphaned resources: %s", len(orphanedResources), strings.Join(orphanedResources, ", "))
+			return
+		}
+	}
+
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
 		v1alpha1.ApplicationConditionComparisonError:  true,
@@ -282,6 +315,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		return
 	}
 
+	// the selectors are matched against every resource of the sync, so they are parsed only once
+	syncExcludedSelectors := project.SyncExcludedResourceSelectors()
+
 	impersonationEnabled, err := m.settingsMgr.IsImpersonationEnabled()
 	if err != nil {
 		log.Errorf("could not get impersonation feature flag: %v", err)
@@ -331,7 +367,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			return (len(syncOp.Resources) == 0 ||
 				isPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
-				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID)
+				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID) &&
+				!isResourceSyncExcluded(syncExcludedSelectors, target, live)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
@@ -533,6 +570,26 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 	return false, ""
 }
 
+// getOrphanedResources returns the orphaned resources of the app found by its last refresh, as group/kind/namespace/name.
+// If the resource tree of the app is not cached, e.g. because it was evicted, the orphaned resources are unknown and an
+// error is returned rather than assuming there are none.
+func (m *appStateManager) getOrphanedResources(app *v1alpha1.Application) ([]string, error) {
+	var tree v1alpha1.ApplicationTree
+	err := m.cache.GetAppResourcesTree(app.InstanceName(m.namespace), &tree)
+	if stderrors.Is(err, appstatecache.ErrCacheMiss) {
+		return nil, stderrors.New("the resource tree of the application is not cached, refresh the application and retry")
+	}
+	if err != nil {
+		return nil, err
+	}
+	orphanedResources := make([]string, 0, len(tree.OrphanedNodes))
+	for _, node := range tree.OrphanedNodes {
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		orphanedResources = append(orphanedResources, key.String())
+	}
+	return orphanedResources, nil
+}
+
 // delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
 // between each sync wave. We introduce an artificial delay in order give other controllers a
 // _chance_ to react to the spec change that we just applied. This is important because without
@@ -559,7 +616,7 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
 	if app.Status.OperationState != nil {
-		isManual = !app.Status.OperationState.Operation.InitiatedBy.Automated
+		isManual = app.Status.OperationState.Operation.InitiatedBy.IsInteractive()
 	}
 	canSync, err := window.CanSync(isManual)
 	if err != nil {
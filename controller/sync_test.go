@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/testdata"
@@ -277,6 +279,128 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
 		assert.Contains(t, opState.Message, "ConfigMap/configmap1 is part of applications fake-argocd-ns/my-app and guestbook")
 	})
+
+	t.Run("orphaned resources", func(t *testing.T) {
+		t.Parallel()
+
+		tree := &v1alpha1.ApplicationTree{OrphanedNodes: []v1alpha1.ResourceNode{{
+			ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "orphaned"},
+		}}}
+		newOpState := func() *v1alpha1.OperationState {
+			return &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}},
+			}}
+		}
+
+		t.Run("will fail the sync if blocked", func(t *testing.T) {
+			t.Parallel()
+			f := setup(nil)
+			f.project.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Block: ptr.To(true)}
+			require.NoError(t, f.controller.cache.SetAppResourcesTree(f.application.InstanceName(f.controller.namespace), tree))
+
+			opState := newOpState()
+			f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+			assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+			assert.Equal(t, "Sync blocked by 1 orphaned resources: /ConfigMap/"+test.FakeDestNamespace+"/orphaned", opState.Message)
+		})
+
+		t.Run("will fail the sync if blocked and the resource tree is not cached", func(t *testing.T) {
+			t.Parallel()
+			f := setup(nil)
+			f.project.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Block: ptr.To(true)}
+
+			opState := newOpState()
+			f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+			assert.Equal(t, synccommon.OperationError, opState.Phase)
+			assert.Equal(t, "Failed to get orphaned resources: the resource tree of the application is not cached, refresh the application and retry", opState.Message)
+		})
+
+		t.Run("will only warn by default", func(t *testing.T) {
+			t.Parallel()
+			f := setup(nil)
+			f.project.Spec.SignatureKeys = nil
+			f.project.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{Warn: ptr.To(true)}
+			require.NoError(t, f.controller.cache.SetAppResourcesTree(f.application.InstanceName(f.controller.namespace), tree))
+
+			opState := newOpState()
+			f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+			assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
+		})
+	})
+}
+
+func TestSyncExcludedResourceAnnotations(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations:                    []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}},
+			SyncExcludedResourceAnnotations: []string{"argocd.argoproj.io/manual-only"},
+		},
+	}
+	configMap := func(name string, annotations map[string]string) string {
+		obj := kube.MustToUnstructured(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeDestNamespace, Annotations: annotations},
+		})
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		return string(data)
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{
+				configMap("excluded", map[string]string{"argocd.argoproj.io/manual-only": "true"}),
+				configMap("synced", nil),
+			},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Source: &v1alpha1.ApplicationSource{},
+		},
+	}}
+	ctrl.appStateManager.SyncAppState(app, project, opState)
+
+	// the fake cluster cannot apply resources, but only the unannotated resource is attempted to be synced
+	require.Len(t, opState.SyncResult.Resources, 1)
+	assert.Equal(t, "synced", opState.SyncResult.Resources[0].Name)
+}
+
+func TestIsResourceSyncExcluded(t *testing.T) {
+	project := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{
+		SyncExcludedResourceAnnotations: []string{"argocd.argoproj.io/manual-only", "team=ops"},
+	}}
+	selectors := project.SyncExcludedResourceSelectors()
+	withAnnotations := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+
+	assert.True(t, isResourceSyncExcluded(selectors, withAnnotations(map[string]string{"argocd.argoproj.io/manual-only": "true"}), nil))
+	assert.True(t, isResourceSyncExcluded(selectors, withAnnotations(map[string]string{"team": "ops"}), nil))
+	assert.False(t, isResourceSyncExcluded(selectors, withAnnotations(map[string]string{"team": "dev"}), nil))
+	assert.False(t, isResourceSyncExcluded(selectors, withAnnotations(nil), withAnnotations(nil)))
+	// resources to prune only have a live state
+	assert.True(t, isResourceSyncExcluded(selectors, nil, withAnnotations(map[string]string{"team": "ops"})))
+	// the annotation of the live state excludes the resource even if the target state does not carry it
+	assert.True(t, isResourceSyncExcluded(selectors, withAnnotations(nil), withAnnotations(map[string]string{"team": "ops"})))
 }
 
 func TestSyncWindowDeniesSync(t *testing.T) {
@@ -351,6 +475,43 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 	})
 }
 
+func TestSyncWindowPreventsSyncManualSync(t *testing.T) {
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{
+			SyncWindows: v1alpha1.SyncWindows{{
+				Kind:         "deny",
+				Schedule:     "* * * * *",
+				Duration:     "1h",
+				Applications: []string{"*"},
+				ManualSync:   true,
+			}},
+		},
+	}
+	withInitiator := func(initiator v1alpha1.OperationInitiator) *v1alpha1.Application {
+		app := app.DeepCopy()
+		app.Status.OperationState = &v1alpha1.OperationState{Operation: v1alpha1.Operation{InitiatedBy: initiator}}
+		return app
+	}
+
+	// a user sync is permitted by the manual sync window
+	prevented, err := syncWindowPreventsSync(withInitiator(v1alpha1.OperationInitiator{Username: "admin"}), proj)
+	require.NoError(t, err)
+	assert.False(t, prevented)
+
+	// syncs with a project role token or an API key are not
+	prevented, err = syncWindowPreventsSync(withInitiator(v1alpha1.OperationInitiator{Username: "proj:default:ci"}), proj)
+	require.NoError(t, err)
+	assert.True(t, prevented)
+	prevented, err = syncWindowPreventsSync(withInitiator(v1alpha1.OperationInitiator{Username: "ci-bot:apiKey"}), proj)
+	require.NoError(t, err)
+	assert.True(t, prevented)
+
+	prevented, err = syncWindowPreventsSync(withInitiator(v1alpha1.OperationInitiator{Automated: true}), proj)
+	require.NoError(t, err)
+	assert.True(t, prevented)
+}
+
 func TestNormalizeTargetResources(t *testing.T) {
 	type fixture struct {
 		comparisonResult *comparisonResult
@@ -130,9 +130,11 @@ func (m *UpdatePasswordResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdatePasswordResponse proto.InternalMessageInfo
 
 type CanIRequest struct {
-	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Subresource          string   `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	Resource    string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Subresource string `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	// explain returns the policy which decided the permission in the response
+	Explain              bool     `protobuf:"varint,4,opt,name=explain,proto3" json:"explain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -192,8 +194,18 @@ func (m *CanIRequest) GetSubresource() string {
 	return ""
 }
 
+func (m *CanIRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
 type CanIResponse struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// policy is the policy which decided the permission, e.g. "p, role:admin, applications, sync, */*, allow", if
+	// explain was requested and a policy matched
+	Policy               string   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -239,6 +251,13 @@ func (m *CanIResponse) GetValue() string {
 	return ""
 }
 
+func (m *CanIResponse) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
 type GetAccountRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -778,54 +797,55 @@ func init() {
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0xa6, 0x3f, 0x27, 0xb9, 0xe9, 0xed, 0xdc, 0x36, 0xd7, 0x32, 0x21, 0xa4, 0xd3,
-	0xaa, 0x0d, 0x41, 0xad, 0x45, 0x8b, 0x10, 0xaa, 0x60, 0xd1, 0x16, 0x84, 0x2a, 0xb1, 0x80, 0xf0,
-	0xb3, 0x28, 0xab, 0x89, 0x33, 0x0a, 0x43, 0x13, 0xdb, 0xf5, 0x8c, 0x13, 0x50, 0x94, 0x0d, 0x3c,
-	0x02, 0x5b, 0x1e, 0x88, 0x25, 0x12, 0x2f, 0x80, 0x2a, 0x1e, 0x04, 0x79, 0x3c, 0xe3, 0x38, 0x4e,
-	0x8a, 0x58, 0xd9, 0xe7, 0x67, 0xce, 0xf7, 0x9d, 0x6f, 0xce, 0xb1, 0xa1, 0xca, 0x69, 0x30, 0xa0,
-	0x81, 0x4d, 0x1c, 0xc7, 0x0b, 0x5d, 0xa1, 0x9f, 0xfb, 0x7e, 0xe0, 0x09, 0x0f, 0x2d, 0x29, 0xd3,
-	0xaa, 0x76, 0x3d, 0xaf, 0xdb, 0xa3, 0x36, 0xf1, 0x99, 0x4d, 0x5c, 0xd7, 0x13, 0x44, 0x30, 0xcf,
-	0xe5, 0x71, 0x1a, 0x1e, 0xc2, 0xc6, 0x6b, 0xbf, 0x43, 0x04, 0x7d, 0x4e, 0x38, 0x1f, 0x7a, 0x41,
-	0xa7, 0x45, 0x2f, 0x43, 0xca, 0x05, 0xaa, 0x43, 0xd1, 0xa5, 0x43, 0xed, 0x35, 0x8d, 0xba, 0xd1,
-	0x58, 0x69, 0xa5, 0x5d, 0xa8, 0x01, 0xab, 0x4e, 0x18, 0x04, 0xd4, 0x15, 0x49, 0x56, 0x4e, 0x66,
-	0x65, 0xdd, 0x08, 0xc1, 0x82, 0x4b, 0xfa, 0xd4, 0xcc, 0xcb, 0xb0, 0x7c, 0xc7, 0x26, 0x54, 0xb2,
-	0xc0, 0xdc, 0xf7, 0x5c, 0x4e, 0xb1, 0x03, 0xc5, 0x53, 0xe2, 0x9e, 0x69, 0x22, 0x16, 0x2c, 0x07,
-	0x94, 0x7b, 0x61, 0xe0, 0x50, 0xc5, 0x22, 0xb1, 0x51, 0x05, 0x16, 0x89, 0x13, 0xb5, 0xa3, 0x90,
-	0x95, 0x15, 0x91, 0xe7, 0x61, 0x3b, 0x39, 0x16, 0xe3, 0xa6, 0x5d, 0x78, 0x1b, 0x4a, 0x31, 0x48,
-	0x0c, 0x8a, 0xd6, 0xa1, 0x30, 0x20, 0xbd, 0x50, 0x43, 0xc4, 0x06, 0xde, 0x85, 0xb5, 0xa7, 0x54,
-	0x1c, 0xc7, 0x4a, 0x6a, 0x42, 0xba, 0x1b, 0x23, 0xd5, 0xcd, 0x67, 0x03, 0x96, 0x54, 0xda, 0xbc,
-	0x38, 0x32, 0x61, 0x89, 0xba, 0xa4, 0xdd, 0xa3, 0xb1, 0x46, 0xcb, 0x2d, 0x6d, 0x22, 0x0c, 0x25,
-	0x87, 0xf8, 0xa4, 0xcd, 0x7a, 0x4c, 0x30, 0xca, 0xcd, 0x7c, 0x3d, 0xdf, 0x58, 0x69, 0x4d, 0xf9,
-	0xd0, 0x0e, 0x2c, 0x0a, 0xef, 0x82, 0xba, 0xdc, 0x5c, 0xa8, 0xe7, 0x1b, 0xc5, 0x83, 0xf2, 0xbe,
-	0xbe, 0xeb, 0x57, 0x91, 0xbb, 0xa5, 0xa2, 0xf8, 0x3e, 0x94, 0x14, 0x09, 0xfe, 0x8c, 0x71, 0x81,
-	0x76, 0xa0, 0xc0, 0x04, 0xed, 0x73, 0xd3, 0x90, 0xc7, 0xfe, 0x4d, 0x8e, 0xe9, 0x8e, 0xe2, 0x30,
-	0x7e, 0x01, 0x05, 0x59, 0x08, 0x95, 0x21, 0xc7, 0xf4, 0x5d, 0xe7, 0x58, 0x27, 0xd2, 0x9e, 0x71,
-	0x1e, 0xd2, 0xce, 0xb1, 0x90, 0xbc, 0xf3, 0xad, 0xc4, 0x46, 0x55, 0x58, 0xa1, 0x1f, 0x7c, 0x16,
-	0x50, 0x7e, 0x2c, 0xa4, 0xc2, 0xf9, 0xd6, 0xc4, 0x81, 0x0f, 0x00, 0x64, 0xc9, 0x98, 0xc8, 0xf6,
-	0x34, 0x91, 0x2c, 0x7f, 0x45, 0xe3, 0x0d, 0xa0, 0xd3, 0x80, 0x12, 0x41, 0x63, 0xef, 0xf5, 0x72,
-	0xa7, 0xb0, 0xcf, 0x5c, 0x45, 0x6c, 0xe2, 0x50, 0x5d, 0xe4, 0x75, 0x17, 0xf8, 0x0e, 0xfc, 0x37,
-	0x55, 0x77, 0x72, 0xe5, 0x52, 0x37, 0x7d, 0xe5, 0xd2, 0xc0, 0x0f, 0x00, 0x3d, 0xa6, 0x3d, 0xfa,
-	0x17, 0x24, 0x62, 0x98, 0x5c, 0x02, 0xb3, 0x0e, 0x28, 0x6a, 0x76, 0x7a, 0x5a, 0xf0, 0x2a, 0xfc,
-	0xf3, 0xa4, 0xef, 0x8b, 0x8f, 0x1a, 0xf6, 0xe0, 0x6b, 0x01, 0xca, 0x2a, 0xe7, 0x25, 0x0d, 0x06,
-	0xcc, 0xa1, 0x68, 0x08, 0x0b, 0xd1, 0x30, 0xa2, 0xf5, 0x44, 0x97, 0xd4, 0x02, 0x58, 0x1b, 0x19,
-	0xaf, 0x5a, 0x93, 0x93, 0x4f, 0x3f, 0x7e, 0x7d, 0xc9, 0x3d, 0x44, 0x47, 0x72, 0xb3, 0x07, 0x77,
-	0x93, 0xef, 0x80, 0x43, 0xdc, 0x3d, 0x66, 0x8f, 0xf4, 0xa8, 0x8f, 0xed, 0x51, 0xbc, 0x15, 0x63,
-	0x7b, 0x94, 0xda, 0x80, 0x47, 0xcd, 0xe6, 0x18, 0x0d, 0xa0, 0x3c, 0xbd, 0x84, 0xa8, 0x96, 0x80,
-	0xcd, 0xfd, 0x2c, 0x58, 0xb7, 0xae, 0x8d, 0x2b, 0x5a, 0x5b, 0x92, 0xd6, 0x4d, 0xcb, 0xcc, 0xd2,
-	0xf2, 0x55, 0xe6, 0x91, 0xd1, 0x44, 0x6f, 0xa1, 0x94, 0x92, 0x8a, 0xa3, 0x1b, 0x49, 0xd5, 0x59,
-	0x05, 0x53, 0xfd, 0xa7, 0x87, 0x1b, 0xff, 0x2f, 0x81, 0xd6, 0xd0, 0x6a, 0x06, 0x08, 0x9d, 0x03,
-	0x4c, 0x96, 0x16, 0x59, 0xc9, 0xe9, 0x99, 0x4d, 0xb6, 0x66, 0x16, 0x02, 0xd7, 0x64, 0x51, 0x13,
-	0x55, 0xb2, 0xec, 0x47, 0xd1, 0x95, 0x8f, 0xd1, 0x25, 0x14, 0x53, 0xa3, 0x94, 0xe2, 0x3d, 0x3b,
-	0xb8, 0x56, 0x75, 0x7e, 0x50, 0xe9, 0xb4, 0x2b, 0x91, 0x36, 0x71, 0x75, 0x3e, 0x92, 0x2d, 0xa7,
-	0x31, 0xd2, 0xaa, 0x0f, 0xc5, 0xd4, 0x40, 0xa6, 0x20, 0x67, 0xc7, 0xd4, 0xaa, 0x24, 0xc1, 0xa9,
-	0x99, 0xc3, 0xb7, 0x25, 0xd8, 0x56, 0x73, 0xf3, 0x4f, 0x60, 0xf6, 0x88, 0x75, 0xc6, 0x27, 0x27,
-	0xdf, 0xae, 0x6a, 0xc6, 0xf7, 0xab, 0x9a, 0xf1, 0xf3, 0xaa, 0x66, 0x9c, 0xdf, 0xeb, 0x32, 0xf1,
-	0x2e, 0x6c, 0xef, 0x3b, 0x5e, 0xdf, 0x26, 0x41, 0xd7, 0xf3, 0x03, 0xef, 0xbd, 0x7c, 0xd9, 0x73,
-	0x3a, 0xf6, 0xe0, 0xd0, 0xf6, 0x2f, 0xba, 0x51, 0x49, 0xa7, 0xc7, 0xe8, 0xe4, 0x0f, 0xd4, 0x5e,
-	0x94, 0xff, 0x96, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x18, 0x8a, 0x2b, 0x33, 0xa2, 0x06,
-	0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x96, 0x73, 0xe9, 0xe5, 0x24, 0x7f, 0xfa, 0x77, 0xfe, 0x36, 0xbf, 0x65, 0x42, 0x48, 0xa7,
+	0xa8, 0x0d, 0x41, 0xad, 0x45, 0x8b, 0x10, 0xaa, 0xca, 0xa2, 0x2d, 0x08, 0x55, 0x62, 0x01, 0xe6,
+	0xb2, 0x28, 0xab, 0x89, 0x33, 0x0a, 0x43, 0x1d, 0xdb, 0xf5, 0x8c, 0x93, 0x56, 0x51, 0x36, 0xf0,
+	0x08, 0x6c, 0x79, 0x20, 0x96, 0x48, 0xbc, 0x00, 0xaa, 0x78, 0x10, 0xe4, 0xb1, 0xc7, 0x71, 0x2e,
+	0x45, 0xac, 0x92, 0x73, 0x99, 0xf3, 0x7d, 0xe7, 0xcc, 0x77, 0xc6, 0x50, 0xe3, 0x34, 0xe8, 0xd3,
+	0xc0, 0x24, 0xb6, 0xed, 0x85, 0xae, 0x50, 0xbf, 0xbb, 0x7e, 0xe0, 0x09, 0x0f, 0x2d, 0x26, 0xa6,
+	0x51, 0xeb, 0x7a, 0x5e, 0xd7, 0xa1, 0x26, 0xf1, 0x99, 0x49, 0x5c, 0xd7, 0x13, 0x44, 0x30, 0xcf,
+	0xe5, 0x71, 0x1a, 0x1e, 0xc0, 0xfa, 0x5b, 0xbf, 0x43, 0x04, 0x7d, 0x49, 0x38, 0x1f, 0x78, 0x41,
+	0xc7, 0xa2, 0x17, 0x21, 0xe5, 0x02, 0x35, 0xa0, 0xe4, 0xd2, 0x81, 0xf2, 0xea, 0x5a, 0x43, 0x6b,
+	0x2e, 0x5b, 0x59, 0x17, 0x6a, 0xc2, 0x8a, 0x1d, 0x06, 0x01, 0x75, 0x45, 0x9a, 0x95, 0x93, 0x59,
+	0xd3, 0x6e, 0x84, 0xa0, 0xe0, 0x92, 0x1e, 0xd5, 0xf3, 0x32, 0x2c, 0xff, 0x63, 0x1d, 0xaa, 0xd3,
+	0xc0, 0xdc, 0xf7, 0x5c, 0x4e, 0xf1, 0x08, 0x4a, 0x27, 0xc4, 0x3d, 0x55, 0x44, 0x0c, 0x58, 0x0a,
+	0x28, 0xf7, 0xc2, 0xc0, 0xa6, 0x09, 0x8b, 0xd4, 0x46, 0x55, 0x58, 0x20, 0x76, 0xd4, 0x4e, 0x82,
+	0x9c, 0x58, 0x11, 0x79, 0x1e, 0xb6, 0xd3, 0x63, 0x31, 0x6e, 0xd6, 0x85, 0x74, 0x58, 0xa4, 0x97,
+	0xbe, 0x43, 0x98, 0xab, 0x17, 0x1a, 0x5a, 0x73, 0xc9, 0x52, 0x26, 0x3e, 0x84, 0x72, 0x0c, 0x1f,
+	0xd3, 0x41, 0x6b, 0x50, 0xec, 0x13, 0x27, 0x54, 0xe0, 0xb1, 0x11, 0x21, 0xfb, 0x9e, 0xc3, 0xec,
+	0x2b, 0x85, 0x1c, 0x5b, 0x78, 0x1b, 0x56, 0x9f, 0x53, 0x71, 0x14, 0xcf, 0x5e, 0xb5, 0xa0, 0xfa,
+	0xd7, 0x32, 0xfd, 0x7f, 0xd6, 0x60, 0x31, 0x49, 0x9b, 0x17, 0x97, 0x04, 0x5d, 0xd2, 0x76, 0x68,
+	0x3c, 0xd5, 0x25, 0x4b, 0x99, 0x08, 0x43, 0xd9, 0x26, 0x3e, 0x69, 0x33, 0x87, 0x09, 0x46, 0xb9,
+	0x9e, 0x6f, 0xe4, 0x9b, 0xcb, 0xd6, 0x84, 0x0f, 0x6d, 0xc1, 0x82, 0xf0, 0xce, 0xa9, 0xcb, 0xf5,
+	0x42, 0x23, 0xdf, 0x2c, 0xed, 0x55, 0x76, 0x95, 0x3a, 0xde, 0x44, 0x6e, 0x2b, 0x89, 0xe2, 0x47,
+	0x50, 0x4e, 0x48, 0xf0, 0x17, 0x8c, 0x0b, 0xb4, 0x05, 0x45, 0x26, 0x68, 0x8f, 0xeb, 0x9a, 0x3c,
+	0xf6, 0x6f, 0x7a, 0x4c, 0x75, 0x14, 0x87, 0xf1, 0x2b, 0x28, 0xca, 0x42, 0xa8, 0x02, 0x39, 0xa6,
+	0xd4, 0x91, 0x63, 0x9d, 0xe8, 0xb6, 0x18, 0xe7, 0x21, 0xed, 0x1c, 0x09, 0xc9, 0x3b, 0x6f, 0xa5,
+	0x36, 0xaa, 0xc1, 0x32, 0xbd, 0xf4, 0x59, 0x40, 0xf9, 0x91, 0x90, 0x77, 0x92, 0xb7, 0xc6, 0x0e,
+	0xbc, 0x07, 0x20, 0x4b, 0xc6, 0x44, 0xee, 0x4e, 0x12, 0x99, 0xe6, 0x9f, 0xd0, 0x78, 0x07, 0xe8,
+	0x24, 0xa0, 0x44, 0xd0, 0xd8, 0x7b, 0xf3, 0xb8, 0x33, 0xd8, 0xa7, 0x6e, 0x42, 0x6c, 0xec, 0x48,
+	0xba, 0xc8, 0xab, 0x2e, 0xf0, 0x7d, 0xf8, 0x6f, 0xa2, 0xee, 0x58, 0x0a, 0x72, 0x6e, 0x4a, 0x0a,
+	0xd2, 0xc0, 0x8f, 0x01, 0x3d, 0xa5, 0x0e, 0xfd, 0x0b, 0x12, 0x31, 0x4c, 0x2e, 0x85, 0x59, 0x03,
+	0x14, 0x35, 0x3b, 0xa9, 0x16, 0xbc, 0x02, 0xff, 0x3c, 0xeb, 0xf9, 0xe2, 0x4a, 0xc1, 0xee, 0x7d,
+	0x2d, 0x42, 0x25, 0xc9, 0x79, 0x4d, 0x83, 0x3e, 0xb3, 0x29, 0x1a, 0x40, 0x21, 0x12, 0x29, 0x5a,
+	0x4b, 0xe7, 0x92, 0x59, 0x19, 0x63, 0x7d, 0xca, 0x9b, 0x2c, 0xd6, 0xf1, 0xa7, 0x1f, 0xbf, 0xbe,
+	0xe4, 0x0e, 0xd1, 0x81, 0x7c, 0x0b, 0xfa, 0x0f, 0xd2, 0x97, 0xc3, 0x26, 0xee, 0x0e, 0x33, 0x87,
+	0x6a, 0x39, 0x46, 0xe6, 0x30, 0xde, 0xa3, 0x91, 0x39, 0xcc, 0xec, 0xcc, 0x93, 0x56, 0x6b, 0x84,
+	0xfa, 0x50, 0x99, 0x5c, 0x5b, 0x54, 0x4f, 0xc1, 0xe6, 0x3e, 0x24, 0xc6, 0x9d, 0x1b, 0xe3, 0x09,
+	0xad, 0x4d, 0x49, 0xeb, 0xb6, 0xa1, 0x4f, 0xd3, 0xf2, 0x93, 0xcc, 0x03, 0xad, 0x85, 0xde, 0x43,
+	0x39, 0x33, 0x2a, 0x8e, 0x6e, 0xa5, 0x55, 0x67, 0x27, 0x98, 0xe9, 0x3f, 0x2b, 0x6e, 0xfc, 0xbf,
+	0x04, 0x5a, 0x45, 0x2b, 0x53, 0x40, 0xe8, 0x0c, 0x60, 0xbc, 0xb4, 0xc8, 0x48, 0x4f, 0xcf, 0x6c,
+	0xb2, 0x31, 0xb3, 0x10, 0xb8, 0x2e, 0x8b, 0xea, 0xa8, 0x3a, 0xcd, 0x7e, 0x18, 0x5d, 0xf9, 0x08,
+	0x5d, 0x40, 0x29, 0x23, 0xa5, 0x0c, 0xef, 0x59, 0xe1, 0x1a, 0xb5, 0xf9, 0xc1, 0x64, 0x4e, 0xdb,
+	0x12, 0x69, 0x03, 0xd7, 0xe6, 0x23, 0x99, 0x52, 0x8d, 0xd1, 0xac, 0x7a, 0x50, 0xca, 0x08, 0x32,
+	0x03, 0x39, 0x2b, 0x53, 0xa3, 0x9a, 0x06, 0x27, 0x34, 0x87, 0xef, 0x49, 0xb0, 0xcd, 0xd6, 0xc6,
+	0x9f, 0xc0, 0xcc, 0x21, 0xeb, 0x8c, 0x8e, 0x8f, 0xbf, 0x5d, 0xd7, 0xb5, 0xef, 0xd7, 0x75, 0xed,
+	0xe7, 0x75, 0x5d, 0x3b, 0x7b, 0xd8, 0x65, 0xe2, 0x43, 0xd8, 0xde, 0xb5, 0xbd, 0x9e, 0x49, 0x82,
+	0xae, 0xe7, 0x07, 0xde, 0x47, 0xf9, 0x67, 0xc7, 0xee, 0x98, 0xfd, 0x7d, 0xd3, 0x3f, 0xef, 0x46,
+	0x25, 0x6d, 0x87, 0xd1, 0xf1, 0x37, 0xab, 0xbd, 0x20, 0xbf, 0x46, 0xfb, 0xbf, 0x07, 0x00, 0xc7,
+	0xa4, 0x12, 0x7a, 0xd4, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1199,6 +1219,16 @@ func (m *CanIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Explain {
+		i--
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Subresource) > 0 {
 		i -= len(m.Subresource)
 		copy(dAtA[i:], m.Subresource)
@@ -1247,6 +1277,13 @@ func (m *CanIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -1724,6 +1761,9 @@ func (m *CanIRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.Explain {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1740,6 +1780,10 @@ func (m *CanIResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2263,6 +2307,26 @@ func (m *CanIRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Subresource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -2346,6 +2410,38 @@ func (m *CanIResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
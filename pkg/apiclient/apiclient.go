@@ -576,10 +576,12 @@ func (c *client) tlsConfig() (*tls.Config, error) {
 
 func (c *client) ClientOptions() ClientOptions {
 	return ClientOptions{
-		ServerAddr: c.ServerAddr,
-		PlainText:  c.PlainText,
-		Insecure:   c.Insecure,
-		AuthToken:  c.AuthToken,
+		ServerAddr:      c.ServerAddr,
+		PlainText:       c.PlainText,
+		Insecure:        c.Insecure,
+		AuthToken:       c.AuthToken,
+		GRPCWeb:         c.GRPCWeb,
+		GRPCWebRootPath: c.GRPCWebRootPath,
 	}
 }
 
@@ -1,10 +1,17 @@
 package apiclient
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func Test_parseHeaders(t *testing.T) {
@@ -39,3 +46,34 @@ func Test_parseGRPCHeaders(t *testing.T) {
 		assert.ErrorContains(t, err, "additional headers must be colon(:)-separated: foo")
 	})
 }
+
+func Test_executeRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/project.ProjectService/Get":
+			w.Header().Set("Grpc-Status", strconv.Itoa(int(codes.NotFound)))
+			w.Header().Set("Grpc-Message", "project not found")
+		case "/project.ProjectService/List":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	c := &client{ServerAddr: strings.TrimPrefix(server.URL, "http://"), PlainText: true, httpClient: server.Client()}
+
+	_, err := c.executeRequest("/project.ProjectService/Get", nil, metadata.MD{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// an unavailable proxy in front of the API server is reported as a transient error
+	_, err = c.executeRequest("/project.ProjectService/List", nil, metadata.MD{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, err, "failed with status code 503")
+
+	_, err = c.executeRequest("/project.ProjectService/Delete", nil, metadata.MD{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	server.Close()
+	_, err = c.executeRequest("/project.ProjectService/List", nil, metadata.MD{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -81,10 +81,12 @@ func (c *client) executeRequest(fullMethodName string, msg []byte, md metadata.M
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		// the API server could not be reached, which a gRPC client reports as unavailable too
+		return nil, status.Error(codes.Unavailable, err.Error())
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s %s failed with status code %d", req.Method, req.URL, resp.StatusCode)
+		utilio.Close(resp.Body)
+		return nil, status.Errorf(httpStatusToCode(resp.StatusCode), "%s %s failed with status code %d", req.Method, req.URL, resp.StatusCode)
 	}
 	var code codes.Code
 	if statusStr := resp.Header.Get("Grpc-Status"); statusStr != "" {
@@ -101,6 +103,25 @@ func (c *client) executeRequest(fullMethodName string, msg []byte, md metadata.M
 	return resp, nil
 }
 
+// httpStatusToCode maps the status of an HTTP response without a gRPC status, e.g. an error page of a proxy in front of
+// the API server, to a gRPC code as gRPC clients do, so that callers can tell transient from terminal errors
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
 func (c *client) startGRPCProxy() (*grpc.Server, net.Listener, error) {
 	randSuffix, err := rand.String(16)
 	if err != nil {
@@ -36,11 +36,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ProjectCreateRequest defines project creation parameters.
 type ProjectCreateRequest struct {
-	Project              *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Upsert               bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Project *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Upsert  bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// allowWildcard acknowledges a wildcard source repository or destination which the projects.wildcardPolicy setting
+	// would otherwise warn about or reject
+	AllowWildcard bool `protobuf:"varint,3,opt,name=allowWildcard,proto3" json:"allowWildcard,omitempty"`
+	// allowAdminRole acknowledges a role policy allowing every action on every object which the projects.adminRolePolicy
+	// setting would otherwise warn about or reject
+	AllowAdminRole       bool     `protobuf:"varint,4,opt,name=allowAdminRole,proto3" json:"allowAdminRole,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProjectCreateRequest) Reset()         { *m = ProjectCreateRequest{} }
@@ -90,6 +96,20 @@ func (m *ProjectCreateRequest) GetUpsert() bool {
 	return false
 }
 
+func (m *ProjectCreateRequest) GetAllowWildcard() bool {
+	if m != nil {
+		return m.AllowWildcard
+	}
+	return false
+}
+
+func (m *ProjectCreateRequest) GetAllowAdminRole() bool {
+	if m != nil {
+		return m.AllowAdminRole
+	}
+	return false
+}
+
 // ProjectTokenCreateRequest defines project token deletion parameters.
 type ProjectTokenDeleteRequest struct {
 	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -168,8 +188,13 @@ type ProjectTokenCreateRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Role        string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresIn int64  `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// audience overrides the token audience of the project
+	Audience string `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`
+	// ids creates one token per id in a single project update, using a random id for empty entries. It cannot be
+	// combined with id.
+	Ids                  []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -243,9 +268,25 @@ func (m *ProjectTokenCreateRequest) GetId() string {
 	return ""
 }
 
+func (m *ProjectTokenCreateRequest) GetAudience() string {
+	if m != nil {
+		return m.Audience
+	}
+	return ""
+}
+
+func (m *ProjectTokenCreateRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 // ProjectTokenResponse wraps the created token or returns an empty string if deleted.
 type ProjectTokenResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// tokens are all the created tokens, in the order of the requested ids
+	Tokens               []string `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -291,6 +332,160 @@ func (m *ProjectTokenResponse) GetToken() string {
 	return ""
 }
 
+func (m *ProjectTokenResponse) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// ProjectTokenVerifyRequest defines the token to verify against a project.
+type ProjectTokenVerifyRequest struct {
+	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenVerifyRequest) Reset()         { *m = ProjectTokenVerifyRequest{} }
+func (m *ProjectTokenVerifyRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenVerifyRequest) ProtoMessage()    {}
+func (*ProjectTokenVerifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{4}
+}
+func (m *ProjectTokenVerifyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenVerifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenVerifyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTokenVerifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenVerifyRequest.Merge(m, src)
+}
+func (m *ProjectTokenVerifyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenVerifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenVerifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenVerifyRequest proto.InternalMessageInfo
+
+func (m *ProjectTokenVerifyRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectTokenVerifyRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// ProjectTokenVerifyResponse describes the role a token was issued for and whether it is valid.
+type ProjectTokenVerifyResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// reason explains why the token is not valid
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// role is the role the token was issued for. It is only set if the signature of the token is valid.
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Id       string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt int64  `protobuf:"varint,5,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	// expiresAt is zero for tokens which do not expire
+	ExpiresAt            int64    `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenVerifyResponse) Reset()         { *m = ProjectTokenVerifyResponse{} }
+func (m *ProjectTokenVerifyResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenVerifyResponse) ProtoMessage()    {}
+func (*ProjectTokenVerifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{5}
+}
+func (m *ProjectTokenVerifyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenVerifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenVerifyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTokenVerifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenVerifyResponse.Merge(m, src)
+}
+func (m *ProjectTokenVerifyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenVerifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenVerifyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenVerifyResponse proto.InternalMessageInfo
+
+func (m *ProjectTokenVerifyResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ProjectTokenVerifyResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProjectTokenVerifyResponse) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ProjectTokenVerifyResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectTokenVerifyResponse) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *ProjectTokenVerifyResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // ProjectQuery is a query for Project resources
 type ProjectQuery struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -303,7 +498,7 @@ func (m *ProjectQuery) Reset()         { *m = ProjectQuery{} }
 func (m *ProjectQuery) String() string { return proto.CompactTextString(m) }
 func (*ProjectQuery) ProtoMessage()    {}
 func (*ProjectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{4}
+	return fileDescriptor_5f0a51496972c9e2, []int{6}
 }
 func (m *ProjectQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,17 +535,23 @@ func (m *ProjectQuery) GetName() string {
 }
 
 type ProjectUpdateRequest struct {
-	Project              *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Project *v1alpha1.AppProject `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// allowWildcard acknowledges a wildcard source repository or destination which the projects.wildcardPolicy
+	// setting would otherwise warn about or reject
+	AllowWildcard bool `protobuf:"varint,2,opt,name=allowWildcard,proto3" json:"allowWildcard,omitempty"`
+	// allowAdminRole acknowledges a role policy allowing every action on every object which the
+	// projects.adminRolePolicy setting would otherwise warn about or reject
+	AllowAdminRole       bool     `protobuf:"varint,3,opt,name=allowAdminRole,proto3" json:"allowAdminRole,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProjectUpdateRequest) Reset()         { *m = ProjectUpdateRequest{} }
 func (m *ProjectUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateRequest) ProtoMessage()    {}
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{5}
+	return fileDescriptor_5f0a51496972c9e2, []int{7}
 }
 func (m *ProjectUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,6 +587,20 @@ func (m *ProjectUpdateRequest) GetProject() *v1alpha1.AppProject {
 	return nil
 }
 
+func (m *ProjectUpdateRequest) GetAllowWildcard() bool {
+	if m != nil {
+		return m.AllowWildcard
+	}
+	return false
+}
+
+func (m *ProjectUpdateRequest) GetAllowAdminRole() bool {
+	if m != nil {
+		return m.AllowAdminRole
+	}
+	return false
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -396,7 +611,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{6}
+	return fileDescriptor_5f0a51496972c9e2, []int{8}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +651,7 @@ func (m *SyncWindowsQuery) Reset()         { *m = SyncWindowsQuery{} }
 func (m *SyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsQuery) ProtoMessage()    {}
 func (*SyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{7}
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
 }
 func (m *SyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +698,7 @@ func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
 func (m *SyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsResponse) ProtoMessage()    {}
 func (*SyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{8}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *SyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +745,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +795,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +863,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,6 +904,8 @@ func init() {
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
 	proto.RegisterType((*ProjectTokenCreateRequest)(nil), "project.ProjectTokenCreateRequest")
 	proto.RegisterType((*ProjectTokenResponse)(nil), "project.ProjectTokenResponse")
+	proto.RegisterType((*ProjectTokenVerifyRequest)(nil), "project.ProjectTokenVerifyRequest")
+	proto.RegisterType((*ProjectTokenVerifyResponse)(nil), "project.ProjectTokenVerifyResponse")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
@@ -702,70 +919,83 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0xda, 0x80, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xc7, 0x6f, 0x5b, 0x13, 0x3e, 0x2b, 0xe3, 0x53, 0x83, 0x4f, 0x3d, 0xdf,
-	0x1a, 0xb6, 0xac, 0xb8, 0xd7, 0xb5, 0xa4, 0x7a, 0x6b, 0x9a, 0x25, 0x53, 0x6f, 0xbd, 0x15, 0xc7,
-	0x9a, 0xc7, 0xc9, 0x80, 0xc9, 0x26, 0xd4, 0x06, 0x31, 0xc7, 0x44, 0x98, 0x95, 0x86, 0xd1, 0xbc,
-	0xed, 0xe8, 0x27, 0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x7e, 0xd4, 0xc3, 0xf0, 0x31, 0x06, 0x38, 0x11,
-	0x66, 0x16, 0x85, 0xad, 0x4c, 0xe0, 0x08, 0x2c, 0x25, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x63,
-	0xb2, 0x01, 0x55, 0xe6, 0x0a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x47, 0x0e, 0xc9, 0x3a, 0x54, 0x98,
-	0x6f, 0x2e, 0xa9, 0x98, 0x0a, 0xf3, 0xe9, 0x0f, 0x46, 0x91, 0xad, 0x58, 0x86, 0x72, 0xb6, 0x06,
-	0xac, 0xfa, 0xc8, 0xbd, 0x84, 0xc5, 0x32, 0x51, 0x4d, 0x3a, 0x3d, 0x95, 0xeb, 0xa9, 0x4e, 0xe9,
-	0xd9, 0x86, 0x15, 0xbc, 0x88, 0x59, 0x82, 0xfc, 0x9d, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x09, 0xad,
-	0x6d, 0x39, 0xd7, 0xf6, 0x6a, 0xbe, 0x38, 0x4a, 0x9a, 0x83, 0x3c, 0x8e, 0x42, 0x8e, 0xe4, 0x2e,
-	0x2c, 0x0b, 0x39, 0xa1, 0x35, 0xa5, 0x0f, 0x94, 0xc2, 0x9a, 0x8e, 0x7e, 0x6f, 0x80, 0xc9, 0x48,
-	0xf2, 0x87, 0x6e, 0x1f, 0x75, 0x90, 0x1a, 0xd3, 0xcf, 0x73, 0xc4, 0x0f, 0x62, 0xff, 0xff, 0x5d,
-	0x6e, 0xfa, 0x3c, 0x3c, 0x77, 0xda, 0x8f, 0xc5, 0x28, 0x4b, 0x83, 0xee, 0xc3, 0xc6, 0xf9, 0x28,
-	0xf4, 0x3e, 0x64, 0xa1, 0x1f, 0x3d, 0xe3, 0xe5, 0xa2, 0x47, 0x70, 0x67, 0x2a, 0x2e, 0xaf, 0x42,
-	0x07, 0x6e, 0x3d, 0x4b, 0xa7, 0x4c, 0xa3, 0x51, 0xbd, 0xb9, 0xe6, 0x09, 0x87, 0x93, 0x01, 0xd3,
-	0x0b, 0xd8, 0x6c, 0x07, 0x51, 0xc7, 0x0d, 0x74, 0x36, 0x13, 0xf6, 0x4f, 0x60, 0x99, 0x09, 0xec,
-	0x2f, 0x88, 0x7b, 0xaa, 0x5e, 0x29, 0x2c, 0xfd, 0xb9, 0x0a, 0xe6, 0x63, 0x14, 0x2e, 0x0b, 0xd0,
-	0x9f, 0x23, 0x8f, 0x61, 0xbd, 0x5b, 0x90, 0xb5, 0x70, 0x15, 0x33, 0xf8, 0xd3, 0x0d, 0x52, 0xf9,
-	0xaf, 0xfc, 0x20, 0x80, 0xb5, 0x04, 0xe3, 0x88, 0x33, 0x11, 0x25, 0x0c, 0xb9, 0x59, 0x5d, 0x44,
-	0x4e, 0x4e, 0x86, 0x38, 0x72, 0x0a, 0xe8, 0xc4, 0x85, 0xdb, 0x5e, 0x30, 0xe0, 0x02, 0x13, 0x6e,
-	0x2e, 0x29, 0xa6, 0xd3, 0x9b, 0x31, 0x9d, 0xa4, 0x68, 0x4e, 0x0e, 0x4b, 0x0f, 0xe1, 0xfe, 0x19,
-	0xe3, 0x42, 0x27, 0x7a, 0xc6, 0xc2, 0x1e, 0xcf, 0x36, 0xdc, 0x35, 0x7d, 0x7e, 0xfc, 0xe3, 0x1a,
-	0xac, 0xeb, 0xd8, 0x73, 0x4c, 0x86, 0xcc, 0x43, 0xf2, 0x8d, 0x01, 0xab, 0xa9, 0x23, 0x29, 0x07,
-	0x20, 0xd4, 0xca, 0x4e, 0xa7, 0x52, 0xcf, 0xaa, 0xef, 0x5c, 0x1b, 0x93, 0xef, 0xba, 0x87, 0x5f,
-	0xfc, 0xf6, 0xc7, 0xf7, 0x95, 0x63, 0x7a, 0xa8, 0xce, 0xaa, 0xe1, 0x51, 0x76, 0xde, 0x71, 0x7b,
-	0xac, 0x47, 0x97, 0xb6, 0xf4, 0x2a, 0x6e, 0x8f, 0xe5, 0xcf, 0xa5, 0xad, 0xdc, 0xe5, 0x0d, 0xe3,
-	0x80, 0x7c, 0x65, 0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x25, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6,
-	0xb8, 0xf7, 0xdf, 0x54, 0x2a, 0x5e, 0x3f, 0x68, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xe2, 0x92,
-	0x7c, 0x6b, 0x40, 0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0xba, 0x94, 0xbe, 0xa8,
-	0x04, 0xdf, 0xa3, 0x1b, 0xb3, 0x82, 0x65, 0x65, 0xbe, 0x34, 0x60, 0x49, 0xae, 0x34, 0xb9, 0x37,
-	0x2b, 0x47, 0xb9, 0x5a, 0xfd, 0x6c, 0x51, 0x32, 0x24, 0x09, 0x35, 0x95, 0x14, 0x42, 0xe6, 0xa4,
-	0x90, 0x0b, 0x20, 0x6d, 0x14, 0x33, 0xb6, 0x51, 0x26, 0xea, 0xa5, 0x7c, 0xba, 0xcc, 0x67, 0x68,
-	0x53, 0x31, 0x51, 0xd2, 0x98, 0x5f, 0x25, 0xd9, 0xb1, 0x97, 0xb6, 0xaf, 0xbf, 0x24, 0x5f, 0x1b,
-	0x50, 0x6d, 0x63, 0x29, 0xd7, 0xe2, 0xd6, 0x61, 0x4f, 0x49, 0xda, 0x22, 0xf7, 0x4b, 0x24, 0x91,
-	0x31, 0xbc, 0xd0, 0x46, 0x51, 0x74, 0xed, 0x32, 0x59, 0x7b, 0xf9, 0xf4, 0xf5, 0x2e, 0x4f, 0x2d,
-	0xc5, 0xd6, 0x24, 0xfb, 0x65, 0x05, 0x48, 0x6d, 0x32, 0x5f, 0x80, 0x9f, 0x0c, 0xa8, 0xa5, 0x27,
-	0xeb, 0x7c, 0x67, 0x16, 0x4e, 0xdc, 0x05, 0x56, 0xa4, 0xa5, 0x34, 0x1e, 0xd6, 0x9b, 0xa5, 0x5b,
-	0xc9, 0xea, 0xa3, 0x70, 0x7d, 0x57, 0xb8, 0x96, 0x12, 0x2d, 0x3b, 0xf6, 0x23, 0xa8, 0xa5, 0x1b,
-	0xb5, 0xac, 0x34, 0x65, 0x1b, 0x57, 0xd7, 0xff, 0xa0, 0xb4, 0xfe, 0x4f, 0x01, 0x64, 0x97, 0x9e,
-	0x0e, 0x31, 0x2c, 0x2f, 0xfc, 0x8e, 0x95, 0xde, 0x97, 0x65, 0x86, 0x96, 0xbc, 0x2f, 0x5b, 0xc3,
-	0x23, 0x4b, 0x7d, 0xa2, 0x3a, 0x7c, 0x5f, 0x91, 0x34, 0xc8, 0x6e, 0x59, 0xd9, 0x31, 0x45, 0x1f,
-	0xc3, 0x9d, 0x36, 0x8a, 0xa9, 0xcb, 0xc1, 0xb9, 0x90, 0xa5, 0xdf, 0xca, 0x49, 0x67, 0xef, 0x17,
-	0xf5, 0xed, 0xeb, 0x5e, 0xe5, 0xc9, 0xbd, 0xa2, 0x78, 0x1f, 0x90, 0x97, 0xcb, 0x78, 0xf9, 0x28,
-	0xf4, 0xf4, 0xdd, 0x80, 0xc4, 0xb0, 0x22, 0xc5, 0x2a, 0x5b, 0x27, 0x8d, 0x1c, 0xb7, 0xc4, 0xf1,
-	0xeb, 0xf5, 0xc2, 0x42, 0xea, 0x57, 0x9a, 0xf7, 0x81, 0xe2, 0xdd, 0x23, 0x3b, 0x65, 0xbc, 0x81,
-	0x0c, 0x7f, 0xf4, 0xe8, 0x97, 0xab, 0x5d, 0xe3, 0xd7, 0xab, 0x5d, 0xe3, 0xf7, 0xab, 0x5d, 0xe3,
-	0xe3, 0xd7, 0xfe, 0xd9, 0xdf, 0x09, 0x2f, 0x60, 0x18, 0xe6, 0xff, 0x6a, 0x3a, 0x35, 0x75, 0xf1,
-	0x6f, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc9, 0xe8, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe4, 0xb4,
+	0x1b, 0x56, 0x26, 0xed, 0x6c, 0xeb, 0xee, 0xf6, 0xb7, 0x3f, 0xef, 0xbf, 0x34, 0xf4, 0xcf, 0xe0,
+	0x65, 0xcb, 0xa8, 0xd0, 0x84, 0xb6, 0x20, 0xad, 0xe0, 0xd4, 0x6d, 0xab, 0x82, 0xe8, 0x01, 0x52,
+	0xa0, 0x88, 0x03, 0xc8, 0x4d, 0x5e, 0xa6, 0xde, 0xc9, 0x24, 0x21, 0xf6, 0x4c, 0x3b, 0xaa, 0x7a,
+	0x41, 0x02, 0x24, 0x0e, 0x5c, 0x10, 0x5f, 0x01, 0xf1, 0x35, 0x10, 0x07, 0xf6, 0x88, 0xc4, 0x17,
+	0x58, 0x55, 0x7c, 0x10, 0x64, 0xe7, 0xcf, 0x24, 0x33, 0x93, 0xee, 0xa2, 0x1d, 0x38, 0xc5, 0x7e,
+	0xe3, 0x3c, 0xcf, 0xf3, 0x3e, 0xf6, 0x6b, 0x3b, 0x68, 0x91, 0x43, 0xdc, 0x83, 0xd8, 0x8e, 0xe2,
+	0xf0, 0x31, 0xb8, 0x22, 0x7b, 0x5a, 0x51, 0x1c, 0x8a, 0x10, 0x5f, 0x4b, 0xbb, 0xe6, 0x62, 0x2b,
+	0x0c, 0x5b, 0x3e, 0xd8, 0x34, 0x62, 0x36, 0x0d, 0x82, 0x50, 0x50, 0xc1, 0xc2, 0x80, 0x27, 0xc3,
+	0x4c, 0xd2, 0x7e, 0xc8, 0x2d, 0x16, 0xaa, 0xb7, 0x6e, 0x18, 0x83, 0xdd, 0xdb, 0xb0, 0x5b, 0x10,
+	0x40, 0x4c, 0x05, 0x78, 0xe9, 0x98, 0x83, 0x16, 0x13, 0x27, 0xdd, 0x63, 0xcb, 0x0d, 0x3b, 0x36,
+	0x8d, 0x5b, 0xa1, 0x44, 0x56, 0x8d, 0x75, 0xd7, 0xb3, 0x7b, 0x5b, 0x76, 0xd4, 0x6e, 0xc9, 0xef,
+	0xb9, 0x4d, 0xa3, 0xc8, 0x67, 0xae, 0xc2, 0xb7, 0x7b, 0x1b, 0xd4, 0x8f, 0x4e, 0xe8, 0x28, 0xda,
+	0xce, 0x33, 0xd0, 0xd2, 0xac, 0x8a, 0x58, 0x85, 0x76, 0x02, 0x42, 0x9e, 0x6a, 0xe8, 0xf6, 0x07,
+	0x49, 0x82, 0x3b, 0x31, 0x50, 0x01, 0x0e, 0x7c, 0xd5, 0x05, 0x2e, 0xf0, 0x31, 0xca, 0x12, 0x37,
+	0xb4, 0x86, 0xd6, 0x9c, 0xdb, 0x7c, 0xd7, 0x1a, 0xf0, 0x59, 0x19, 0x9f, 0x6a, 0x7c, 0xe1, 0x7a,
+	0x56, 0x6f, 0xcb, 0x8a, 0xda, 0x2d, 0x4b, 0xaa, 0xb7, 0x8a, 0x2c, 0x99, 0x7a, 0x6b, 0x3b, 0x8a,
+	0x52, 0x1e, 0x27, 0x03, 0xc6, 0x77, 0x51, 0xbd, 0x1b, 0x71, 0x88, 0x85, 0x51, 0x6b, 0x68, 0xcd,
+	0x19, 0x27, 0xed, 0xe1, 0x57, 0xd0, 0x0d, 0xea, 0xfb, 0xe1, 0xe9, 0x11, 0xf3, 0x3d, 0x97, 0xc6,
+	0x9e, 0xa1, 0xab, 0xd7, 0xe5, 0x20, 0x5e, 0x45, 0xf3, 0x2a, 0xb0, 0xed, 0x75, 0x58, 0xe0, 0x84,
+	0x3e, 0x18, 0x53, 0x6a, 0xd8, 0x50, 0x94, 0xb4, 0xd1, 0x42, 0xca, 0xfc, 0x51, 0xd8, 0x86, 0x60,
+	0x17, 0x7c, 0x18, 0xa4, 0x69, 0x94, 0xd3, 0x9c, 0x1d, 0x88, 0xc3, 0x68, 0x2a, 0x96, 0xa0, 0x35,
+	0x15, 0x56, 0x6d, 0x7c, 0x13, 0xe9, 0x8c, 0x0a, 0x25, 0x47, 0x77, 0x64, 0x13, 0xcf, 0xa3, 0x1a,
+	0xf3, 0x14, 0xf1, 0xac, 0x53, 0x63, 0x1e, 0xf9, 0x5d, 0x2b, 0xb3, 0x95, 0x4d, 0xad, 0x66, 0x6b,
+	0xa0, 0x39, 0x0f, 0xb8, 0x1b, 0xb3, 0x48, 0xda, 0x96, 0x92, 0x16, 0x43, 0xb9, 0x1e, 0xbd, 0xa0,
+	0x67, 0x11, 0xcd, 0xc2, 0x59, 0xc4, 0x62, 0xe0, 0xef, 0x05, 0x4a, 0x84, 0xee, 0x0c, 0x02, 0xa9,
+	0xb6, 0xe9, 0x4c, 0x1b, 0x36, 0xd1, 0x0c, 0xed, 0x7a, 0x0c, 0x02, 0x17, 0x8c, 0xba, 0x8a, 0xe6,
+	0x7d, 0x95, 0x99, 0xc7, 0x8d, 0x6b, 0x0d, 0xbd, 0x39, 0xeb, 0xc8, 0x26, 0xd9, 0x45, 0xb7, 0x8b,
+	0x89, 0x38, 0xc0, 0xa3, 0x30, 0xe0, 0x80, 0x6f, 0xa3, 0x69, 0x21, 0x03, 0x69, 0x06, 0x49, 0x47,
+	0x4e, 0xa5, 0x6a, 0x70, 0xa3, 0xa6, 0x20, 0xd2, 0x1e, 0x79, 0xbf, 0x6c, 0xc7, 0x27, 0x10, 0xb3,
+	0x2f, 0xfb, 0xcf, 0xb6, 0x23, 0x27, 0xa9, 0x15, 0x48, 0xc8, 0x2f, 0x1a, 0x32, 0xc7, 0xa1, 0x0d,
+	0x94, 0xf5, 0xa8, 0xcf, 0x3c, 0x05, 0x36, 0xe3, 0x24, 0x1d, 0xa9, 0x2c, 0x06, 0xca, 0x73, 0x53,
+	0xd3, 0xde, 0x58, 0x3f, 0x87, 0x66, 0x53, 0x3a, 0xc6, 0x38, 0xef, 0x82, 0xb7, 0x2d, 0x94, 0x8f,
+	0xba, 0x93, 0xf7, 0x0b, 0xde, 0x6f, 0x0b, 0xa3, 0x5e, 0xf2, 0x7e, 0x5b, 0x10, 0x82, 0xae, 0xa7,
+	0x4a, 0x3f, 0xec, 0x42, 0xdc, 0x97, 0x6c, 0x01, 0xed, 0x40, 0x9a, 0xa7, 0x6a, 0x93, 0x27, 0x83,
+	0xda, 0xfb, 0x38, 0xf2, 0xfe, 0xe3, 0xda, 0x1b, 0xa9, 0xb1, 0xda, 0xf3, 0xd5, 0x98, 0x3e, 0xb6,
+	0xc6, 0xfe, 0x87, 0x6e, 0xec, 0x75, 0x22, 0x91, 0xcf, 0x05, 0x59, 0x45, 0x37, 0x0f, 0xfb, 0x81,
+	0x7b, 0xc4, 0x02, 0x2f, 0x3c, 0xe5, 0xd5, 0x1e, 0xf4, 0xd1, 0xad, 0xc2, 0xb8, 0x7c, 0x2a, 0x8f,
+	0xd1, 0xb5, 0xd3, 0x24, 0x64, 0x68, 0x0d, 0xfd, 0xc5, 0x1d, 0x18, 0x70, 0x38, 0x19, 0x30, 0x39,
+	0x43, 0x77, 0xf7, 0xfd, 0xf0, 0x98, 0xfa, 0xa9, 0x37, 0x03, 0xf6, 0xcf, 0xd1, 0x34, 0x13, 0xd0,
+	0x99, 0x10, 0x77, 0xc1, 0xfd, 0x04, 0x96, 0xfc, 0xaa, 0x23, 0x63, 0x17, 0x04, 0x65, 0x3e, 0x78,
+	0x23, 0xe4, 0x11, 0x9a, 0x6f, 0x95, 0x64, 0x4d, 0x5c, 0xc5, 0x10, 0x7e, 0x71, 0xb9, 0xd5, 0xfe,
+	0xad, 0xe5, 0xe6, 0xa3, 0xeb, 0x31, 0x44, 0x21, 0x67, 0x22, 0x8c, 0x19, 0x70, 0x43, 0x9f, 0x44,
+	0x4e, 0x4e, 0x86, 0xd8, 0x77, 0x4a, 0xe8, 0x98, 0xa2, 0x19, 0xd7, 0xef, 0x72, 0x01, 0x31, 0x37,
+	0xa6, 0x14, 0xd3, 0xde, 0x8b, 0x31, 0xed, 0x24, 0x68, 0x4e, 0x0e, 0x4b, 0xd6, 0xd1, 0xbd, 0x03,
+	0xc6, 0x45, 0x9a, 0xe8, 0x01, 0x0b, 0xda, 0x3c, 0x2b, 0xdf, 0x31, 0xeb, 0x7c, 0xf3, 0xb7, 0x79,
+	0x34, 0x9f, 0x8e, 0x3d, 0x84, 0xb8, 0xc7, 0x5c, 0xc0, 0xdf, 0x6b, 0x68, 0x2e, 0x39, 0x1e, 0xd4,
+	0x66, 0x86, 0x89, 0x95, 0x5d, 0x3c, 0x2a, 0x0f, 0x10, 0x73, 0x69, 0xec, 0x98, 0xbc, 0xea, 0x1e,
+	0x7e, 0xfd, 0xe7, 0x5f, 0x3f, 0xd6, 0x36, 0xc9, 0xba, 0xba, 0x86, 0xf4, 0x36, 0xb2, 0xab, 0x0c,
+	0xb7, 0xcf, 0xd3, 0xd6, 0x85, 0x2d, 0x37, 0x3a, 0x6e, 0x9f, 0xcb, 0xc7, 0x85, 0xad, 0xf6, 0xd5,
+	0xb7, 0xb5, 0x35, 0xfc, 0xad, 0x86, 0xe6, 0x92, 0x93, 0xf1, 0x2a, 0x31, 0xa5, 0xb3, 0xd3, 0xbc,
+	0x9b, 0x8f, 0x29, 0xd7, 0xfe, 0x3b, 0x4a, 0xc5, 0x5b, 0x6b, 0x5b, 0xff, 0x48, 0x85, 0x7d, 0xce,
+	0xa8, 0xb8, 0x50, 0xae, 0x24, 0xfb, 0xfa, 0x55, 0x42, 0x4a, 0xe7, 0x88, 0x79, 0xff, 0xca, 0x31,
+	0xa9, 0xaa, 0x4d, 0xa5, 0xea, 0x75, 0xf2, 0xea, 0x15, 0xaa, 0x12, 0x21, 0x3d, 0xf5, 0xa1, 0x74,
+	0xe5, 0x07, 0x0d, 0xd5, 0x93, 0x09, 0xc0, 0x23, 0xce, 0x97, 0x27, 0x66, 0x62, 0x25, 0x43, 0x5e,
+	0x52, 0x3a, 0xef, 0x90, 0x9b, 0xc3, 0x3a, 0xa5, 0xa0, 0x6f, 0x34, 0x34, 0x25, 0x97, 0x1d, 0xbe,
+	0x33, 0x2c, 0x47, 0x6d, 0xb1, 0xe6, 0xc1, 0xa4, 0x64, 0x48, 0x12, 0x62, 0x28, 0x29, 0x18, 0x8f,
+	0x48, 0xc1, 0x3f, 0x69, 0x68, 0xfa, 0x88, 0x0a, 0xf7, 0xa4, 0x4a, 0x88, 0x33, 0x29, 0x21, 0x8a,
+	0x65, 0xaf, 0x07, 0x81, 0x20, 0x2b, 0x4a, 0xce, 0x02, 0xbe, 0x97, 0xc9, 0xe1, 0x22, 0x06, 0xda,
+	0xc9, 0x55, 0xbd, 0xa1, 0xe1, 0x33, 0x84, 0xf7, 0x41, 0x0c, 0xed, 0xad, 0x55, 0x1a, 0x5f, 0xce,
+	0xc3, 0x55, 0x9b, 0x31, 0x69, 0x2a, 0x4a, 0x82, 0x1b, 0xa3, 0x8b, 0x46, 0x96, 0xf5, 0x85, 0xed,
+	0xa5, 0x5f, 0xe2, 0xef, 0x34, 0xa4, 0xef, 0x43, 0x25, 0xd7, 0xe4, 0xd6, 0xc7, 0x88, 0x0b, 0x43,
+	0x92, 0xf0, 0x39, 0xfa, 0xff, 0x3e, 0x88, 0xf2, 0xd1, 0x56, 0x25, 0x6b, 0x25, 0x0f, 0x8f, 0x3f,
+	0x0a, 0x89, 0xa5, 0xd8, 0x9a, 0x78, 0xb5, 0xca, 0x80, 0xe4, 0x2c, 0xc9, 0x17, 0xc6, 0xcf, 0x1a,
+	0xaa, 0x27, 0x97, 0x99, 0xd1, 0x8a, 0x29, 0x5d, 0x72, 0x26, 0xe8, 0xc8, 0x96, 0xd2, 0xb8, 0x6e,
+	0x36, 0x2b, 0x2b, 0xdb, 0xea, 0x80, 0xa0, 0x1e, 0x15, 0xd4, 0x52, 0xa2, 0x65, 0x25, 0x7d, 0x8a,
+	0xea, 0xc9, 0x6e, 0x56, 0x65, 0x4d, 0xd5, 0xee, 0x96, 0xfa, 0xbf, 0x56, 0xe9, 0xff, 0x63, 0x84,
+	0x64, 0xf5, 0xa8, 0x35, 0x5b, 0x69, 0xfc, 0x92, 0x95, 0xfc, 0x2f, 0xca, 0x0c, 0x2d, 0x37, 0x8c,
+	0xc1, 0xea, 0x6d, 0x58, 0xea, 0x13, 0x55, 0x79, 0xab, 0x8a, 0xa4, 0x81, 0x97, 0xab, 0x6c, 0x87,
+	0x04, 0xfd, 0x1c, 0xdd, 0xda, 0x07, 0x51, 0xb8, 0x41, 0x1d, 0x0a, 0x69, 0xfd, 0x42, 0x4e, 0x3a,
+	0x7c, 0x09, 0x33, 0x17, 0xc7, 0xbd, 0xca, 0x93, 0x7b, 0x4d, 0xf1, 0x3e, 0xc0, 0xf7, 0xab, 0x78,
+	0x79, 0x3f, 0x70, 0xd3, 0x0b, 0x14, 0x8e, 0xd0, 0xac, 0x14, 0xab, 0xce, 0x3e, 0xdc, 0xc8, 0x71,
+	0x2b, 0x8e, 0x45, 0xd3, 0x2c, 0x4d, 0x64, 0xfa, 0x2a, 0xe5, 0x7d, 0xa0, 0x78, 0x57, 0xf0, 0x52,
+	0x15, 0xaf, 0x2f, 0x87, 0x3f, 0x7a, 0xf4, 0xe4, 0x72, 0x59, 0xfb, 0xe3, 0x72, 0x59, 0x7b, 0x7a,
+	0xb9, 0xac, 0x7d, 0xf6, 0xe6, 0xf3, 0xfd, 0x4e, 0xbb, 0x3e, 0x83, 0x20, 0xff, 0xab, 0x3f, 0xae,
+	0xab, 0x1f, 0xdf, 0xad, 0xbf, 0x07, 0x00, 0xde, 0x30, 0x7f, 0x0c, 0xf6, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -784,10 +1014,14 @@ type ProjectServiceClient interface {
 	CreateToken(ctx context.Context, in *ProjectTokenCreateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(ctx context.Context, in *ProjectTokenDeleteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// VerifyToken verifies the signature and expiry of a project token and that it has not been deleted
+	VerifyToken(ctx context.Context, in *ProjectTokenVerifyRequest, opts ...grpc.CallOption) (*ProjectTokenVerifyResponse, error)
 	// Create a new project
 	Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// List returns list of projects
 	List(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1alpha1.AppProjectList, error)
+	// Watch returns stream of project change events
+	Watch(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (ProjectService_WatchClient, error)
 	// GetDetailedProject returns a project that include project, global project and scoped resources by name
 	GetDetailedProject(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*DetailedProjectsResponse, error)
 	// Get returns a project by name
@@ -832,6 +1066,15 @@ func (c *projectServiceClient) DeleteToken(ctx context.Context, in *ProjectToken
 	return out, nil
 }
 
+func (c *projectServiceClient) VerifyToken(ctx context.Context, in *ProjectTokenVerifyRequest, opts ...grpc.CallOption) (*ProjectTokenVerifyResponse, error) {
+	out := new(ProjectTokenVerifyResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/VerifyToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	out := new(v1alpha1.AppProject)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/Create", in, out, opts...)
@@ -850,6 +1093,38 @@ func (c *projectServiceClient) List(ctx context.Context, in *ProjectQuery, opts
 	return out, nil
 }
 
+func (c *projectServiceClient) Watch(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (ProjectService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProjectService_serviceDesc.Streams[0], "/project.ProjectService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &projectServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProjectService_WatchClient interface {
+	Recv() (*v1alpha1.AppProjectWatchEvent, error)
+	grpc.ClientStream
+}
+
+type projectServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *projectServiceWatchClient) Recv() (*v1alpha1.AppProjectWatchEvent, error) {
+	m := new(v1alpha1.AppProjectWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *projectServiceClient) GetDetailedProject(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*DetailedProjectsResponse, error) {
 	out := new(DetailedProjectsResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/GetDetailedProject", in, out, opts...)
@@ -928,10 +1203,14 @@ type ProjectServiceServer interface {
 	CreateToken(context.Context, *ProjectTokenCreateRequest) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(context.Context, *ProjectTokenDeleteRequest) (*EmptyResponse, error)
+	// VerifyToken verifies the signature and expiry of a project token and that it has not been deleted
+	VerifyToken(context.Context, *ProjectTokenVerifyRequest) (*ProjectTokenVerifyResponse, error)
 	// Create a new project
 	Create(context.Context, *ProjectCreateRequest) (*v1alpha1.AppProject, error)
 	// List returns list of projects
 	List(context.Context, *ProjectQuery) (*v1alpha1.AppProjectList, error)
+	// Watch returns stream of project change events
+	Watch(*ProjectQuery, ProjectService_WatchServer) error
 	// GetDetailedProject returns a project that include project, global project and scoped resources by name
 	GetDetailedProject(context.Context, *ProjectQuery) (*DetailedProjectsResponse, error)
 	// Get returns a project by name
@@ -960,12 +1239,18 @@ func (*UnimplementedProjectServiceServer) CreateToken(ctx context.Context, req *
 func (*UnimplementedProjectServiceServer) DeleteToken(ctx context.Context, req *ProjectTokenDeleteRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedProjectServiceServer) VerifyToken(ctx context.Context, req *ProjectTokenVerifyRequest) (*ProjectTokenVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyToken not implemented")
+}
 func (*UnimplementedProjectServiceServer) Create(ctx context.Context, req *ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
 func (*UnimplementedProjectServiceServer) List(ctx context.Context, req *ProjectQuery) (*v1alpha1.AppProjectList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (*UnimplementedProjectServiceServer) Watch(req *ProjectQuery, srv ProjectService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (*UnimplementedProjectServiceServer) GetDetailedProject(ctx context.Context, req *ProjectQuery) (*DetailedProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDetailedProject not implemented")
 }
@@ -1031,6 +1316,24 @@ func _ProjectService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectTokenVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).VerifyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/VerifyToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).VerifyToken(ctx, req.(*ProjectTokenVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectCreateRequest)
 	if err := dec(in); err != nil {
@@ -1067,6 +1370,27 @@ func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProjectQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProjectServiceServer).Watch(m, &projectServiceWatchServer{stream})
+}
+
+type ProjectService_WatchServer interface {
+	Send(*v1alpha1.AppProjectWatchEvent) error
+	grpc.ServerStream
+}
+
+type projectServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *projectServiceWatchServer) Send(m *v1alpha1.AppProjectWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ProjectService_GetDetailedProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectQuery)
 	if err := dec(in); err != nil {
@@ -1223,6 +1547,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _ProjectService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "VerifyToken",
+			Handler:    _ProjectService_VerifyToken_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ProjectService_Create_Handler,
@@ -1264,7 +1592,13 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ProjectService_ListLinks_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ProjectService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "server/project/project.proto",
 }
 
@@ -1292,6 +1626,26 @@ func (m *ProjectCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AllowAdminRole {
+		i--
+		if m.AllowAdminRole {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AllowWildcard {
+		i--
+		if m.AllowWildcard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Upsert {
 		i--
 		if m.Upsert {
@@ -1394,6 +1748,22 @@ func (m *ProjectTokenCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Audience) > 0 {
+		i -= len(m.Audience)
+		copy(dAtA[i:], m.Audience)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Audience)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1454,6 +1824,15 @@ func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Token) > 0 {
 		i -= len(m.Token)
 		copy(dAtA[i:], m.Token)
@@ -1464,7 +1843,7 @@ func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectQuery) Marshal() (dAtA []byte, err error) {
+func (m *ProjectTokenVerifyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1474,12 +1853,12 @@ func (m *ProjectQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectTokenVerifyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectTokenVerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1488,17 +1867,24 @@ func (m *ProjectQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectUpdateRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProjectTokenVerifyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1508,12 +1894,12 @@ func (m *ProjectUpdateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectTokenVerifyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectTokenVerifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1522,15 +1908,137 @@ func (m *ProjectUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		{
-			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProject(dAtA, i, uint64(size))
-		}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AllowAdminRole {
+		i--
+		if m.AllowAdminRole {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllowWildcard {
+		i--
+		if m.AllowWildcard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1819,6 +2327,12 @@ func (m *ProjectCreateRequest) Size() (n int) {
 	if m.Upsert {
 		n += 2
 	}
+	if m.AllowWildcard {
+		n += 2
+	}
+	if m.AllowAdminRole {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1877,6 +2391,16 @@ func (m *ProjectTokenCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	l = len(m.Audience)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1893,6 +2417,65 @@ func (m *ProjectTokenResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenVerifyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenVerifyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovProject(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovProject(uint64(m.ExpiresAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1925,6 +2508,12 @@ func (m *ProjectUpdateRequest) Size() (n int) {
 		l = m.Project.Size()
 		n += 1 + l + sovProject(uint64(l))
 	}
+	if m.AllowWildcard {
+		n += 2
+	}
+	if m.AllowAdminRole {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2136,6 +2725,46 @@ func (m *ProjectCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowWildcard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowWildcard = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAdminRole", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAdminRole = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -2500,32 +3129,96 @@ func (m *ProjectTokenCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProject(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProject
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			m.Audience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2583,6 +3276,358 @@ func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTokenVerifyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTokenVerifyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTokenVerifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTokenVerifyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTokenVerifyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTokenVerifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -2753,6 +3798,46 @@ func (m *ProjectUpdateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowWildcard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowWildcard = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAdminRole", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAdminRole = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -2,9 +2,15 @@ package v1alpha1
 
 import (
 	"fmt"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	globutil "github.com/gobwas/glob"
 	"github.com/google/go-cmp/cmp"
@@ -12,7 +18,10 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
@@ -50,6 +59,16 @@ type AppProjectList struct {
 	Items           []AppProject `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// AppProjectWatchEvent contains information about project change.
+type AppProjectWatchEvent struct {
+	Type watch.EventType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=k8s.io/apimachinery/pkg/watch.EventType"`
+
+	// AppProject is:
+	//  * If Type is Added or Modified: the new state of the object.
+	//  * If Type is Deleted: the state of the object immediately before deletion.
+	AppProject AppProject `json:"appProject" protobuf:"bytes,2,opt,name=appProject"`
+}
+
 // AppProject provides a logical grouping of applications, providing controls for:
 // * where the apps may deploy to (cluster whitelist)
 // * what may be deployed (repository whitelist, resource whitelist/blacklist)
@@ -174,19 +193,30 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 	return nil
 }
 
+// ValidateProject validates the project spec and returns the first violation found, if any
 func (proj *AppProject) ValidateProject() error {
+	if errs := proj.ValidateProjectAll(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// ValidateProjectAll validates the project spec and returns all violations found, in the order ValidateProject
+// checks them
+func (proj *AppProject) ValidateProjectAll() []error {
+	var errs []error
 	destKeys := make(map[string]bool)
 	for _, dest := range proj.Spec.Destinations {
 		if dest.Name == "!*" {
-			return status.Errorf(codes.InvalidArgument, "name has an invalid format, '!*'")
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "name has an invalid format, '!*'"))
 		}
 
 		if dest.Server == "!*" {
-			return status.Errorf(codes.InvalidArgument, "server has an invalid format, '!*'")
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "server has an invalid format, '!*'"))
 		}
 
 		if dest.Namespace == "!*" {
-			return status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '!*'")
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '!*'"))
 		}
 
 		key := fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)
@@ -195,15 +225,42 @@ func (proj *AppProject) ValidateProject() error {
 			key = fmt.Sprintf("%s/%s", dest.Name, dest.Namespace)
 		}
 		if _, ok := destKeys[key]; ok {
-			return status.Errorf(codes.InvalidArgument, "destination '%s' already added", key)
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "destination '%s' already added", key))
 		}
 		destKeys[key] = true
 	}
 
+	regexDestKeys := make(map[string]bool)
+	for _, dest := range proj.Spec.NamespaceRegexDestinations {
+		if dest.Server == "" && dest.Name == "" {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace regex destination '%s' must set a server or a name", dest.NamespaceRegex))
+		}
+		if isDenyPattern(dest.Server) || isDenyPattern(dest.Name) {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace regex destination '%s' has an invalid format: negation patterns are not supported", dest.NamespaceRegex))
+		}
+		if dest.NamespaceRegex == "" {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace regex destination must set a namespace regex"))
+		} else if _, err := regexp.Compile(dest.NamespaceRegex); err != nil {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace regex '%s' is invalid: %v", dest.NamespaceRegex, err))
+		}
+
+		key := fmt.Sprintf("%s/%s", dest.Server, dest.NamespaceRegex)
+		if dest.Server == "" && dest.Name != "" {
+			key = fmt.Sprintf("%s/%s", dest.Name, dest.NamespaceRegex)
+		}
+		if _, ok := regexDestKeys[key]; ok {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace regex destination '%s' already added", key))
+		}
+		regexDestKeys[key] = true
+	}
+
 	srcNamespaces := make(map[string]bool)
 	for _, ns := range proj.Spec.SourceNamespaces {
+		if isDenyPattern(ns) {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "source namespace has an invalid format, '%s': negation patterns are not supported", ns))
+		}
 		if _, ok := srcNamespaces[ns]; ok {
-			return status.Errorf(codes.InvalidArgument, "source namespace '%s' already added", ns)
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "source namespace '%s' already added", ns))
 		}
 		srcNamespaces[ns] = true
 	}
@@ -211,43 +268,117 @@ func (proj *AppProject) ValidateProject() error {
 	srcRepos := make(map[string]bool)
 	for _, src := range proj.Spec.SourceRepos {
 		if src == "!*" {
-			return status.Errorf(codes.InvalidArgument, "source repository has an invalid format, '!*'")
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "source repository has an invalid format, '!*'"))
 		}
 
 		if _, ok := srcRepos[src]; ok {
-			return status.Errorf(codes.InvalidArgument, "source repository '%s' already added", src)
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "source repository '%s' already added", src))
 		}
 		srcRepos[src] = true
 	}
 
+	for _, gk := range proj.Spec.ClusterResourceWhitelist {
+		if isDenyPattern(gk.Group) || isDenyPattern(gk.Kind) {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "cluster resource whitelist entry has an invalid format, '%s/%s': negation patterns are not supported", gk.Group, gk.Kind))
+		}
+	}
+	for _, gk := range proj.Spec.NamespaceResourceWhitelist {
+		if isDenyPattern(gk.Group) || isDenyPattern(gk.Kind) {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace resource whitelist entry has an invalid format, '%s/%s': negation patterns are not supported", gk.Group, gk.Kind))
+		}
+	}
+
+	if proj.Spec.TokenAudience != "" && strings.TrimSpace(proj.Spec.TokenAudience) == "" {
+		errs = append(errs, status.Errorf(codes.InvalidArgument, "token audience must not be blank"))
+	}
+
+	if _, err := proj.Spec.GetRefreshInterval(); err != nil {
+		errs = append(errs, status.Errorf(codes.InvalidArgument, "%v", err))
+	}
+
+	for _, selector := range proj.Spec.SyncExcludedResourceAnnotations {
+		parsed, err := labels.Parse(selector)
+		switch {
+		case err != nil:
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid sync excluded resource annotation selector '%s': %v", selector, err))
+		case parsed.Empty():
+			// an empty selector would match and exclude every resource
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "sync excluded resource annotation selector must not be empty"))
+		case parsed.Matches(labels.Set{}):
+			// e.g. '!key' or 'key!=value' would exclude almost every resource, since most have neither annotation
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "sync excluded resource annotation selector '%s' matches resources without annotations, select resources by an annotation they have", selector))
+		}
+	}
+
+	if dst := proj.Spec.DefaultDestination; dst != nil {
+		switch {
+		case dst.Server == "" && dst.Name == "":
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "default destination must have a server or a name"))
+		case dst.Server != "" && dst.Name != "":
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "default destination can't have both name and server defined: %s %s", dst.Name, dst.Server))
+		case !proj.isDestinationMatched(*dst):
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "default destination server '%s', name '%s' and namespace '%s' do not match any of the allowed destinations of the project", dst.Server, dst.Name, dst.Namespace))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(proj.Spec.PropagatedAnnotations)) {
+		if fieldErrs := validation.IsQualifiedName(key); len(fieldErrs) > 0 {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid propagated annotation key '%s': %s", key, strings.Join(fieldErrs, "; ")))
+		} else if isReservedAnnotationKey(key) {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid propagated annotation key '%s': annotations of the argoproj.io domain are reserved", key))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(proj.Spec.RequiredClusterLabels)) {
+		if fieldErrs := validation.IsQualifiedName(key); len(fieldErrs) > 0 {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid required cluster label key '%s': %s", key, strings.Join(fieldErrs, "; ")))
+		}
+		if fieldErrs := validation.IsValidLabelValue(proj.Spec.RequiredClusterLabels[key]); len(fieldErrs) > 0 {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "invalid value '%s' of required cluster label '%s': %s", proj.Spec.RequiredClusterLabels[key], key, strings.Join(fieldErrs, "; ")))
+		}
+	}
+
 	roleNames := make(map[string]bool)
 	for _, role := range proj.Spec.Roles {
 		if _, ok := roleNames[role.Name]; ok {
-			return status.Errorf(codes.AlreadyExists, "role '%s' already exists", role.Name)
+			errs = append(errs, status.Errorf(codes.AlreadyExists, "role '%s' already exists", role.Name))
+			continue
 		}
 		if err := validateRoleName(role.Name); err != nil {
-			return err
+			errs = append(errs, err)
 		}
 		existingPolicies := make(map[string]bool)
 		for _, policy := range role.Policies {
 			if _, ok := existingPolicies[policy]; ok {
-				return status.Errorf(codes.AlreadyExists, "policy '%s' already exists for role '%s'", policy, role.Name)
+				errs = append(errs, status.Errorf(codes.AlreadyExists, "policy '%s' already exists for role '%s'", policy, role.Name))
+				continue
 			}
 			if err := validatePolicy(proj.Name, role.Name, policy); err != nil {
-				return err
+				errs = append(errs, err)
 			}
 			existingPolicies[policy] = true
 		}
 		existingGroups := make(map[string]bool)
 		for _, group := range role.Groups {
 			if _, ok := existingGroups[group]; ok {
-				return status.Errorf(codes.AlreadyExists, "group '%s' already exists for role '%s'", group, role.Name)
+				errs = append(errs, status.Errorf(codes.AlreadyExists, "group '%s' already exists for role '%s'", group, role.Name))
+				continue
 			}
 			if err := validateGroupName(group); err != nil {
-				return err
+				errs = append(errs, err)
 			}
 			existingGroups[group] = true
 		}
+		for _, sourceRange := range role.TokenSourceRanges {
+			if _, _, err := net.ParseCIDR(sourceRange); err != nil {
+				errs = append(errs, status.Errorf(codes.InvalidArgument, "token source range '%s' of role '%s' is not a valid CIDR: %v", sourceRange, role.Name, err))
+			}
+		}
+		for _, action := range role.DeniedSyncActions {
+			if action != SyncActionPrune && action != SyncActionForce {
+				errs = append(errs, status.Errorf(codes.InvalidArgument, "denied sync action '%s' of role '%s' is invalid: must be '%s' or '%s'", action, role.Name, SyncActionPrune, SyncActionForce))
+			}
+		}
 		roleNames[role.Name] = true
 	}
 
@@ -258,14 +389,14 @@ func (proj *AppProject) ValidateProject() error {
 				continue
 			}
 			if _, ok := existingWindows[window.Kind+window.Schedule+window.Duration]; ok {
-				return status.Errorf(codes.AlreadyExists, "window '%s':'%s':'%s' already exists, update or edit", window.Kind, window.Schedule, window.Duration)
+				errs = append(errs, status.Errorf(codes.AlreadyExists, "window '%s':'%s':'%s' already exists, update or edit", window.Kind, window.Schedule, window.Duration))
+				continue
 			}
-			err := window.Validate()
-			if err != nil {
-				return err
+			if err := window.Validate(); err != nil {
+				errs = append(errs, err)
 			}
 			if len(window.Applications) == 0 && len(window.Namespaces) == 0 && len(window.Clusters) == 0 {
-				return status.Errorf(codes.OutOfRange, "window '%s':'%s':'%s' requires one of application, cluster or namespace", window.Kind, window.Schedule, window.Duration)
+				errs = append(errs, status.Errorf(codes.OutOfRange, "window '%s':'%s':'%s' requires one of application, cluster or namespace", window.Kind, window.Schedule, window.Duration))
 			}
 			existingWindows[window.Kind+window.Schedule+window.Duration] = true
 		}
@@ -273,37 +404,37 @@ func (proj *AppProject) ValidateProject() error {
 
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range proj.Spec.DestinationServiceAccounts {
-		if strings.Contains(destServiceAcct.Server, "!") {
-			return status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAcct.Server)
+		invalidServer := strings.Contains(destServiceAcct.Server, "!")
+		if invalidServer {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAcct.Server))
 		}
 
-		if strings.Contains(destServiceAcct.Namespace, "!") {
-			return status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAcct.Namespace)
+		invalidNamespace := strings.Contains(destServiceAcct.Namespace, "!")
+		if invalidNamespace {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAcct.Namespace))
 		}
 
 		if strings.Trim(destServiceAcct.DefaultServiceAccount, " ") == "" ||
 			strings.ContainsAny(destServiceAcct.DefaultServiceAccount, serviceAccountDisallowedCharSet) {
-			return status.Errorf(codes.InvalidArgument, "defaultServiceAccount has an invalid format, '%s'", destServiceAcct.DefaultServiceAccount)
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "defaultServiceAccount has an invalid format, '%s'", destServiceAcct.DefaultServiceAccount))
 		}
 
-		_, err := globutil.Compile(destServiceAcct.Server)
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAcct.Server)
+		if _, err := globutil.Compile(destServiceAcct.Server); err != nil && !invalidServer {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAcct.Server))
 		}
 
-		_, err = globutil.Compile(destServiceAcct.Namespace)
-		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAcct.Namespace)
+		if _, err := globutil.Compile(destServiceAcct.Namespace); err != nil && !invalidNamespace {
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAcct.Namespace))
 		}
 
 		key := fmt.Sprintf("%s/%s", destServiceAcct.Server, destServiceAcct.Namespace)
 		if _, ok := destServiceAccts[key]; ok {
-			return status.Errorf(codes.InvalidArgument, "destinationServiceAccount '%s' already added", key)
+			errs = append(errs, status.Errorf(codes.InvalidArgument, "destinationServiceAccount '%s' already added", key))
 		}
 		destServiceAccts[key] = true
 	}
 
-	return nil
+	return errs
 }
 
 // AddGroupToRole adds an OIDC group to a role
@@ -362,13 +493,101 @@ func (proj *AppProject) normalizePolicy(policy string) string {
 	return normalizedPolicy
 }
 
-// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role
+// ProjectPoliciesString returns a Casbin formatted string of a project's policies for each role. The label conditions
+// of role policies cannot be evaluated without the target application, so they fail closed: conditional deny rules
+// apply to every application and conditional allow rules to none.
 func (proj *AppProject) ProjectPoliciesString() string {
+	return proj.projectPoliciesString(func(policy string) (string, bool) {
+		return resolvePolicyCondition(policy, nil, false)
+	})
+}
+
+// ApplicationPoliciesString returns the project policies like ProjectPoliciesString, with the label conditions of the
+// role policies evaluated against the labels of the target application. Policies whose condition matches are kept
+// without the condition, the others are dropped.
+func (proj *AppProject) ApplicationPoliciesString(appLabels map[string]string) string {
+	return proj.projectPoliciesString(func(policy string) (string, bool) {
+		return resolvePolicyCondition(policy, appLabels, true)
+	})
+}
+
+// resolvePolicyCondition strips the label condition from a role policy and returns whether the policy applies to the
+// target application. If the application is unknown, or the condition cannot be evaluated, the policy only applies if
+// it is a deny rule.
+func resolvePolicyCondition(policy string, appLabels map[string]string, appKnown bool) (string, bool) {
+	components := strings.Split(policy, ",")
+	if len(components) != 6 {
+		return policy, true
+	}
+	object, condition, hasCondition := splitPolicyObject(strings.Trim(components[4], " "))
+	if !hasCondition {
+		return policy, true
+	}
+	components[4] = " " + object
+	resolved := strings.Join(components, ",")
+	selector, err := parsePolicyCondition(condition)
+	if !appKnown || err != nil || selector.Empty() {
+		return resolved, strings.Trim(components[5], " ") == "deny"
+	}
+	return resolved, selector.Matches(labels.Set(appLabels))
+}
+
+// HasConditionalPolicies returns whether any role policy of the project has a label condition
+func (proj *AppProject) HasConditionalPolicies() bool {
+	for _, role := range proj.Spec.Roles {
+		if len(role.ConditionalPolicies()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ConditionalPolicies returns the policies of the role which have a label condition
+func (role ProjectRole) ConditionalPolicies() []string {
+	var policies []string
+	for _, policy := range role.Policies {
+		if components := strings.Split(policy, ","); len(components) == 6 {
+			if _, _, hasCondition := splitPolicyObject(strings.Trim(components[4], " ")); hasCondition {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return policies
+}
+
+// AdminRolePolicies returns the role policies of the project which allow every action on every object of the project,
+// i.e. the action '*' on the object '<PROJECT>/*' or '<PROJECT>/*/*' without a label condition. The policies are
+// normalized to fields separated by ', ', so that policies differing in spacing only are equal.
+func (proj *AppProject) AdminRolePolicies() []string {
+	var policies []string
+	for _, role := range proj.Spec.Roles {
+		for _, policy := range role.Policies {
+			components := strings.Split(policy, ",")
+			if len(components) != 6 {
+				continue
+			}
+			for i := range components {
+				components[i] = strings.TrimSpace(components[i])
+			}
+			action, object, effect := components[3], components[4], components[5]
+			if action == "*" && effect == "allow" && (object == proj.Name+"/*" || object == proj.Name+"/*/*") {
+				policies = append(policies, strings.Join(components, ", "))
+			}
+		}
+	}
+	return policies
+}
+
+func (proj *AppProject) projectPoliciesString(rolePolicy func(policy string) (string, bool)) string {
 	var policies []string
 	for _, role := range proj.Spec.Roles {
 		projectPolicy := fmt.Sprintf("p, proj:%s:%s, projects, get, %s, allow", proj.Name, role.Name, proj.Name)
 		policies = append(policies, projectPolicy)
-		policies = append(policies, role.Policies...)
+		for _, policy := range role.Policies {
+			if policy, ok := rolePolicy(policy); ok {
+				policies = append(policies, policy)
+			}
+		}
 		for _, groupName := range role.Groups {
 			policies = append(policies, fmt.Sprintf("g, %s, proj:%s:%s", groupName, proj.Name, role.Name))
 		}
@@ -376,6 +595,61 @@ func (proj *AppProject) ProjectPoliciesString() string {
 	return strings.Join(policies, "\n")
 }
 
+// policyConditionSeparator separates the object of a role policy from the label requirements the target application
+// must satisfy, and the requirements from each other, e.g. 'my-proj/*;team=payments;env!=prod'.
+const policyConditionSeparator = ";"
+
+// splitPolicyObject splits the object of a role policy into the application pattern and its label condition
+func splitPolicyObject(object string) (string, string, bool) {
+	return strings.Cut(object, policyConditionSeparator)
+}
+
+// parsePolicyCondition parses the label condition of a role policy into a label selector
+func parsePolicyCondition(condition string) (labels.Selector, error) {
+	return labels.Parse(strings.ReplaceAll(condition, policyConditionSeparator, ","))
+}
+
+// GetRefreshInterval returns the refresh interval of the applications of the project, or zero if it is not set
+func (spec AppProjectSpec) GetRefreshInterval() (time.Duration, error) {
+	if spec.RefreshInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(spec.RefreshInterval)
+	if err != nil {
+		return 0, fmt.Errorf("refresh interval '%s' is invalid: %w", spec.RefreshInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("refresh interval '%s' must be a positive duration", spec.RefreshInterval)
+	}
+	return interval, nil
+}
+
+// SyncExcludedResourceSelectors returns the parsed sync excluded resource annotation selectors of the project, to match
+// the annotations of many resources without parsing the selectors again. Selectors rejected by the project validation
+// are left out, so they never match.
+func (proj AppProject) SyncExcludedResourceSelectors() []labels.Selector {
+	var selectors []labels.Selector
+	for _, selector := range proj.Spec.SyncExcludedResourceAnnotations {
+		parsed, err := labels.Parse(selector)
+		if err != nil || parsed.Empty() || parsed.Matches(labels.Set{}) {
+			continue
+		}
+		selectors = append(selectors, parsed)
+	}
+	return selectors
+}
+
+// IsResourceSyncExcluded returns whether the annotations of a resource match one of the sync excluded resource
+// annotation selectors of the project. Selectors rejected by the project validation never match.
+func (proj AppProject) IsResourceSyncExcluded(annotations map[string]string) bool {
+	for _, selector := range proj.SyncExcludedResourceSelectors() {
+		if selector.Matches(labels.Set(annotations)) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsGroupKindPermitted validates if the given resource group/kind is permitted to be deployed in the project
 func (proj AppProject) IsGroupKindPermitted(gk schema.GroupKind, namespaced bool) bool {
 	var isWhiteListed, isBlackListed bool
@@ -386,7 +660,7 @@ func (proj AppProject) IsGroupKindPermitted(gk schema.GroupKind, namespaced bool
 		namespaceBlacklist := proj.Spec.NamespaceResourceBlacklist
 
 		isWhiteListed = namespaceWhitelist == nil || len(namespaceWhitelist) != 0 && isResourceInList(res, namespaceWhitelist)
-		isBlackListed = len(namespaceBlacklist) != 0 && isResourceInList(res, namespaceBlacklist)
+		isBlackListed = len(namespaceBlacklist) != 0 && isResourceInBlacklist(res, namespaceBlacklist)
 		return isWhiteListed && !isBlackListed
 	}
 
@@ -394,7 +668,7 @@ func (proj AppProject) IsGroupKindPermitted(gk schema.GroupKind, namespaced bool
 	clusterBlacklist := proj.Spec.ClusterResourceBlacklist
 
 	isWhiteListed = len(clusterWhitelist) != 0 && isResourceInList(res, clusterWhitelist)
-	isBlackListed = len(clusterBlacklist) != 0 && isResourceInList(res, clusterBlacklist)
+	isBlackListed = len(clusterBlacklist) != 0 && isResourceInBlacklist(res, clusterBlacklist)
 	return isWhiteListed && !isBlackListed
 }
 
@@ -502,8 +776,271 @@ func (proj AppProject) isDestinationMatched(dst ApplicationDestination) bool {
 			return false
 		}
 	}
+	if anyDestinationMatched {
+		return true
+	}
+
+	for _, item := range proj.Spec.NamespaceRegexDestinations {
+		dstNameMatched := dst.Name != "" && globMatch(item.Name, dst.Name, true)
+		dstServerMatched := dst.Server != "" && globMatch(item.Server, dst.Server, true)
+		if (dstServerMatched || dstNameMatched) && item.namespaceMatched(dst.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyDefaultDestination sets the destination of the application spec to the default destination of the project if
+// the spec specifies neither a server nor a name. A namespace set in the spec is kept. It returns true if the
+// destination was changed. It is only called by the API server, so the application controller never resolves the
+// default destination itself.
+func (proj AppProject) ApplyDefaultDestination(spec *ApplicationSpec) bool {
+	dst := proj.Spec.DefaultDestination
+	if dst == nil || spec.Destination.Server != "" || spec.Destination.Name != "" {
+		return false
+	}
+	spec.Destination.Server = dst.Server
+	spec.Destination.Name = dst.Name
+	if spec.Destination.Namespace == "" {
+		spec.Destination.Namespace = dst.Namespace
+	}
+	return true
+}
+
+// WildcardEntries returns a description of each source repository and destination of the project which permits
+// everything, i.e. source repositories consisting only of '*' wildcards, such as '*' or '**', and destinations whose
+// server or name consists only of '*' wildcards and whose namespace, or namespace regex, permits every namespace.
+func (proj AppProject) WildcardEntries() []string {
+	var entries []string
+	for _, repo := range proj.Spec.SourceRepos {
+		if isMatchAllGlob(repo) {
+			entries = append(entries, fmt.Sprintf("source repository '%s'", repo))
+		}
+	}
+	for _, dest := range proj.Spec.Destinations {
+		if (isMatchAllGlob(dest.Server) || isMatchAllGlob(dest.Name)) && isMatchAllGlob(dest.Namespace) {
+			entries = append(entries, fmt.Sprintf("destination server '%s', name '%s' and namespace '%s'", dest.Server, dest.Name, dest.Namespace))
+		}
+	}
+	for _, dest := range proj.Spec.NamespaceRegexDestinations {
+		if (isMatchAllGlob(dest.Server) || isMatchAllGlob(dest.Name)) && dest.permitsAnyNamespace() {
+			entries = append(entries, fmt.Sprintf("destination server '%s', name '%s' and namespace regex '%s'", dest.Server, dest.Name, dest.NamespaceRegex))
+		}
+	}
+	return entries
+}
+
+// isMatchAllGlob returns whether the glob pattern consists only of '*' wildcards, so that it matches any value
+func isMatchAllGlob(pattern string) bool {
+	return pattern != "" && strings.Trim(pattern, "*") == ""
+}
+
+// matchAllNamespaceProbes are namespaces of various shapes which a namespace regex has to match to be considered to
+// permit every namespace
+var matchAllNamespaceProbes = []string{"default", "kube-system", "a", "0", "z-9", strings.Repeat("x0-", 20) + "end"}
+
+// permitsAnyNamespace returns whether the namespace regex of the project destination permits every namespace. Regexes
+// are considered to do so if they match namespaces of various shapes, e.g. '.*' or '[a-z0-9-]+'.
+func (dst NamespaceRegexDestination) permitsAnyNamespace() bool {
+	for _, namespace := range matchAllNamespaceProbes {
+		if !dst.namespaceMatched(namespace) {
+			return false
+		}
+	}
+	return true
+}
+
+// MissingRequiredClusterLabels returns the required cluster labels of the project, as sorted key=value pairs, which
+// the cluster does not have or has with a different value.
+func (proj AppProject) MissingRequiredClusterLabels(cluster *Cluster) []string {
+	var missing []string
+	for _, key := range slices.Sorted(maps.Keys(proj.Spec.RequiredClusterLabels)) {
+		value := proj.Spec.RequiredClusterLabels[key]
+		if actual, ok := cluster.Labels[key]; !ok || actual != value {
+			missing = append(missing, key+"="+value)
+		}
+	}
+	return missing
+}
 
-	return anyDestinationMatched
+// MissingPropagatedAnnotations returns the propagated annotations of the project which the application does not have
+// yet. Annotations the application already has are left out, even if their values differ, and so are reserved
+// annotations, which a project could otherwise use to e.g. refresh or hydrate its applications.
+func (proj AppProject) MissingPropagatedAnnotations(app *Application) map[string]string {
+	var missing map[string]string
+	for key, value := range proj.Spec.PropagatedAnnotations {
+		if _, ok := app.Annotations[key]; ok || isReservedAnnotationKey(key) {
+			continue
+		}
+		if missing == nil {
+			missing = make(map[string]string)
+		}
+		missing[key] = value
+	}
+	return missing
+}
+
+// isReservedAnnotationKey returns whether the annotation key belongs to the argoproj.io domain or one of its
+// subdomains, e.g. argocd.argoproj.io/refresh or notifications.argoproj.io/subscribe.on-sync-failed.slack
+func isReservedAnnotationKey(key string) bool {
+	prefix, _, ok := strings.Cut(key, "/")
+	return ok && (prefix == "argoproj.io" || strings.HasSuffix(prefix, ".argoproj.io"))
+}
+
+// UncoveredDestinationServiceAccounts returns the destination service accounts whose server and namespace are not
+// permitted by any destination of the project, so that they can never be used. Entries with glob patterns are skipped,
+// since they may match permitted destinations only partially.
+func (proj AppProject) UncoveredDestinationServiceAccounts() []ApplicationDestinationServiceAccount {
+	var uncovered []ApplicationDestinationServiceAccount
+	for _, dsa := range proj.Spec.DestinationServiceAccounts {
+		if strings.ContainsAny(dsa.Server, "*?[!") || strings.ContainsAny(dsa.Namespace, "*?[!") {
+			continue
+		}
+		if !proj.isDestinationMatched(ApplicationDestination{Server: dsa.Server, Namespace: dsa.Namespace}) {
+			uncovered = append(uncovered, dsa)
+		}
+	}
+	return uncovered
+}
+
+// OrphanedResourcesIgnoreConflicts returns the orphaned resources ignore entries whose group and kind overlap an entry
+// of the cluster or namespace resource blacklist. Ignoring resources as orphaned which the project may not deploy is
+// advisory only, but usually indicates a misconfiguration.
+func (proj AppProject) OrphanedResourcesIgnoreConflicts() []OrphanedResourceKey {
+	if proj.Spec.OrphanedResources == nil {
+		return nil
+	}
+	var conflicts []OrphanedResourceKey
+	for _, key := range proj.Spec.OrphanedResources.Ignore {
+		kind := key.Kind
+		if kind == "" {
+			// an empty kind ignores resources of any kind
+			kind = "*"
+		}
+		blacklisted := slices.ContainsFunc(slices.Concat(proj.Spec.ClusterResourceBlacklist, proj.Spec.NamespaceResourceBlacklist), func(gk metav1.GroupKind) bool {
+			// an entry with an empty group and the kind '*' denies resources of any group, see isResourceInBlacklist
+			if isDenyAllEntry(gk) {
+				return true
+			}
+			return patternsOverlap(gk.Group, key.Group) && patternsOverlap(gk.Kind, kind)
+		})
+		if blacklisted {
+			conflicts = append(conflicts, key)
+		}
+	}
+	return conflicts
+}
+
+// AmbiguousDestinationServiceAccounts returns the pairs of destination service accounts which match a common server
+// and namespace with different service accounts, and whose patterns are equally specific. The first matching entry is
+// used, so the outcome for such destinations depends on an order which does not reflect any intent. Patterns using glob
+// syntax other than '*' and '?' are not considered.
+func (proj AppProject) AmbiguousDestinationServiceAccounts() [][2]ApplicationDestinationServiceAccount {
+	var ambiguous [][2]ApplicationDestinationServiceAccount
+	dsas := proj.Spec.DestinationServiceAccounts
+	for i, a := range dsas {
+		for _, b := range dsas[i+1:] {
+			if a.DefaultServiceAccount == b.DefaultServiceAccount ||
+				(a.Server == b.Server && a.Namespace == b.Namespace) {
+				continue
+			}
+			if globSpecificity(a.Server) != globSpecificity(b.Server) || globSpecificity(a.Namespace) != globSpecificity(b.Namespace) {
+				continue
+			}
+			if globsIntersect(a.Server, b.Server) && globsIntersect(a.Namespace, b.Namespace) {
+				ambiguous = append(ambiguous, [2]ApplicationDestinationServiceAccount{a, b})
+			}
+		}
+	}
+	return ambiguous
+}
+
+// globSpecificity returns the number of characters of a glob pattern which are not wildcards
+func globSpecificity(pattern string) int {
+	return len(pattern) - strings.Count(pattern, "*") - strings.Count(pattern, "?")
+}
+
+// globsIntersect returns whether some value is matched by both glob patterns. Only the '*' and '?' wildcards are
+// supported, patterns using any other glob syntax are reported as not intersecting.
+func globsIntersect(a, b string) bool {
+	if strings.ContainsAny(a, "[]{}\\!") || strings.ContainsAny(b, "[]{}\\!") {
+		return false
+	}
+	// memo[i][j] caches whether a[i:] and b[j:] intersect, 0 meaning unknown, 1 intersecting and 2 disjoint
+	memo := make([][]int8, len(a)+1)
+	for i := range memo {
+		memo[i] = make([]int8, len(b)+1)
+	}
+	var intersect func(i, j int) bool
+	intersect = func(i, j int) bool {
+		if memo[i][j] != 0 {
+			return memo[i][j] == 1
+		}
+		var result bool
+		switch {
+		case i == len(a) && j == len(b):
+			result = true
+		case i < len(a) && a[i] == '*':
+			// the star matches nothing more, or absorbs the next element of the other pattern
+			result = intersect(i+1, j) || (j < len(b) && intersect(i, j+1))
+		case j < len(b) && b[j] == '*':
+			result = intersect(i, j+1) || (i < len(a) && intersect(i+1, j))
+		case i < len(a) && j < len(b):
+			result = (a[i] == b[j] || a[i] == '?' || b[j] == '?') && intersect(i+1, j+1)
+		}
+		memo[i][j] = 2
+		if result {
+			memo[i][j] = 1
+		}
+		return result
+	}
+	return intersect(0, 0)
+}
+
+// patternsOverlap returns whether two glob patterns may match the same value, approximated by either pattern matching
+// the other one literally
+func patternsOverlap(a, b string) bool {
+	return a == b || glob.Match(a, b) || glob.Match(b, a)
+}
+
+// namespaceMatched returns whether the namespace is permitted by the namespace regex of the project destination
+func (dst NamespaceRegexDestination) namespaceMatched(namespace string) bool {
+	re, err := compileNamespaceRegex(dst.NamespaceRegex)
+	if err != nil {
+		// invalid regexes are rejected when the project is saved, and never permit a namespace
+		return false
+	}
+	return re.MatchString(namespace)
+}
+
+// maxNamespaceRegexes bounds the number of compiled namespace regexes kept in memory. The cache is emptied once it is
+// reached.
+const maxNamespaceRegexes = 1000
+
+// namespaceRegexes caches the compiled namespace regexes of project destinations by their pattern, since they are
+// matched against the destination of every application of the project
+var namespaceRegexes = struct {
+	sync.Mutex
+	regexes map[string]*regexp.Regexp
+}{regexes: map[string]*regexp.Regexp{}}
+
+// compileNamespaceRegex returns the compiled namespace regex anchored to match the whole namespace, compiling it on its
+// first use
+func compileNamespaceRegex(pattern string) (*regexp.Regexp, error) {
+	namespaceRegexes.Lock()
+	defer namespaceRegexes.Unlock()
+	if re, ok := namespaceRegexes.regexes[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	if len(namespaceRegexes.regexes) >= maxNamespaceRegexes {
+		clear(namespaceRegexes.regexes)
+	}
+	namespaceRegexes.regexes[pattern] = re
+	return re, nil
 }
 
 func isDenyPattern(pattern string) bool {
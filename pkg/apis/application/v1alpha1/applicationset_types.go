@@ -618,6 +618,26 @@ type PullRequestGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
 	// ContinueOnRepoNotFoundError is a flag to continue the ApplicationSet Pull Request generator parameters generation even if the repository is not found.
 	ContinueOnRepoNotFoundError bool `json:"continueOnRepoNotFoundError,omitempty" protobuf:"varint,11,opt,name=continueOnRepoNotFoundError"`
+	// SortBy is the key used to order the generated pull requests. One of "number" (default) or "updatedAt".
+	// +kubebuilder:validation:Enum=number;updatedAt
+	SortBy string `json:"sortBy,omitempty" protobuf:"bytes,12,opt,name=sortBy"`
+	// MissingHeadBranch defines how to handle pull requests whose head branch cannot be resolved, e.g. because it was
+	// deleted. One of "skip" (default), which skips them, or "useHeadSHA", which uses the head SHA as branch.
+	// +kubebuilder:validation:Enum=skip;useHeadSHA
+	MissingHeadBranch string `json:"missingHeadBranch,omitempty" protobuf:"bytes,13,opt,name=missingHeadBranch"`
+	// ResolveHeadCommitAuthor resolves the author of the head commit of each pull request, which costs one additional
+	// API call per pull request. Only supported by the GitHub and GitLab providers.
+	ResolveHeadCommitAuthor bool `json:"resolveHeadCommitAuthor,omitempty" protobuf:"varint,14,opt,name=resolveHeadCommitAuthor"`
+	// ResolveApprovals resolves the number of approvals of each pull request, which costs one additional API call per
+	// pull request for the GitHub and GitLab providers. Azure DevOps reports approvals without additional calls, other
+	// providers report none.
+	ResolveApprovals bool `json:"resolveApprovals,omitempty" protobuf:"varint,15,opt,name=resolveApprovals"`
+	// CombineProviders lists the pull requests of all the configured providers instead of only the first one, e.g. of
+	// a repository which is mirrored on several providers. Pull requests with the same branches and head SHA are
+	// generated once. One of "all", which fails if any provider fails, or "available", which ignores failing providers
+	// as long as one of them succeeds. Disabled if empty.
+	// +kubebuilder:validation:Enum=all;available
+	CombineProviders string `json:"combineProviders,omitempty" protobuf:"bytes,16,opt,name=combineProviders"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
@@ -657,6 +677,9 @@ type PullRequestGeneratorGitea struct {
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
 	// Labels is used to filter the PRs that you want to target
 	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,7,opt,name=tokenFile"`
 }
 
 // PullRequestGeneratorAzureDevOps defines connection info specific to AzureDevOps.
@@ -665,14 +688,24 @@ type PullRequestGeneratorAzureDevOps struct {
 	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
 	// Azure DevOps project name to scan. Required.
 	Project string `json:"project" protobuf:"bytes,2,opt,name=project"`
-	// Azure DevOps repo name to scan. Required.
-	Repo string `json:"repo" protobuf:"bytes,3,opt,name=repo"`
+	// Azure DevOps repo name to scan. Required unless repos is set.
+	Repo string `json:"repo,omitempty" protobuf:"bytes,3,opt,name=repo"`
 	// The Azure DevOps API URL to talk to. If blank, use https://dev.azure.com/.
 	API string `json:"api,omitempty" protobuf:"bytes,4,opt,name=api"`
 	// Authentication token reference.
 	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,5,opt,name=tokenRef"`
 	// Labels is used to filter the PRs that you want to target
 	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+	// MaxPRAge excludes pull requests whose last update is older than the given duration (e.g. "72h"). The last
+	// update is the most recent of the creation date and the date of the last pushed iteration. Disabled if empty.
+	MaxPRAge string `json:"maxPRAge,omitempty" protobuf:"bytes,7,opt,name=maxPRAge"`
+	// Repos is a list of Azure DevOps repo names or IDs to scan, in addition to repo.
+	Repos []string `json:"repos,omitempty" protobuf:"bytes,8,rep,name=repos"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,9,opt,name=tokenFile"`
+	// RequireSucceededStatuses only includes pull requests whose latest status of every status context succeeded.
+	RequireSucceededStatuses bool `json:"requireSucceededStatuses,omitempty" protobuf:"varint,10,opt,name=requireSucceededStatuses"`
 }
 
 // PullRequestGenerator defines connection info specific to GitHub.
@@ -689,6 +722,11 @@ type PullRequestGeneratorGithub struct {
 	AppSecretName string `json:"appSecretName,omitempty" protobuf:"bytes,5,opt,name=appSecretName"`
 	// Labels is used to filter the PRs that you want to target
 	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+	// RequireApproval only includes pull requests with at least one approving review and no reviewer requesting changes.
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,7,opt,name=requireApproval"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,8,opt,name=tokenFile"`
 }
 
 // PullRequestGeneratorGitLab defines connection info specific to GitLab.
@@ -708,6 +746,9 @@ type PullRequestGeneratorGitLab struct {
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
 	// ConfigMap key holding the trusted certificates
 	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,7,opt,name=caRef"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,8,opt,name=tokenFile"`
 }
 
 // PullRequestGeneratorBitbucketServer defines connection info specific to BitbucketServer.
@@ -745,13 +786,19 @@ type PullRequestGeneratorBitbucket struct {
 // BearerTokenBitbucket defines the Bearer token for BitBucket AppToken auth.
 type BearerTokenBitbucket struct {
 	// Password (or personal access token) reference.
-	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,1,opt,name=tokenRef"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,1,opt,name=tokenRef"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,2,opt,name=tokenFile"`
 }
 
 // BearerTokenBitbucketCloud defines the Bearer token for BitBucket AppToken auth.
 type BearerTokenBitbucketCloud struct {
 	// Password (or personal access token) reference.
-	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,1,opt,name=tokenRef"`
+	TokenRef *SecretRef `json:"tokenRef,omitempty" protobuf:"bytes,1,opt,name=tokenRef"`
+	// TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the
+	// ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.
+	TokenFile string `json:"tokenFile,omitempty" protobuf:"bytes,2,opt,name=tokenFile"`
 }
 
 // BasicAuthBitbucketServer defines the username/(password or personal access token) for Basic auth.
@@ -759,7 +806,10 @@ type BasicAuthBitbucketServer struct {
 	// Username for Basic auth
 	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
 	// Password (or personal access token) reference.
-	PasswordRef *SecretRef `json:"passwordRef" protobuf:"bytes,2,opt,name=passwordRef"`
+	PasswordRef *SecretRef `json:"passwordRef,omitempty" protobuf:"bytes,2,opt,name=passwordRef"`
+	// PasswordFile is the path of a file holding the password (or personal access token), relative to the SCM tokens
+	// directory of the ApplicationSet controller, e.g. a mounted Secret. PasswordRef takes precedence.
+	PasswordFile string `json:"passwordFile,omitempty" protobuf:"bytes,3,opt,name=passwordFile"`
 }
 
 // PullRequestGeneratorFilter is a single pull request filter.
@@ -769,6 +819,19 @@ type PullRequestGeneratorFilter struct {
 	BranchMatch       *string `json:"branchMatch,omitempty" protobuf:"bytes,1,opt,name=branchMatch"`
 	TargetBranchMatch *string `json:"targetBranchMatch,omitempty" protobuf:"bytes,2,opt,name=targetBranchMatch"`
 	TitleMatch        *string `json:"titleMatch,omitempty" protobuf:"bytes,3,op,name=titleMatch"`
+	// PathsChanged is a list of globs, e.g. "apps/**", at least one of which must match a file changed by the pull
+	// request. Only supported by providers which can list the changed files of a pull request.
+	PathsChanged []string `json:"pathsChanged,omitempty" protobuf:"bytes,4,rep,name=pathsChanged"`
+	// LabelsAll is a list of labels, all of which the pull request must have.
+	LabelsAll []string `json:"labelsAll,omitempty" protobuf:"bytes,5,rep,name=labelsAll"`
+	// LabelsAny is a list of labels, at least one of which the pull request must have.
+	LabelsAny []string `json:"labelsAny,omitempty" protobuf:"bytes,6,rep,name=labelsAny"`
+	// ExcludeLabels is a list of labels, none of which the pull request may have. It takes precedence over the other
+	// criteria of the filter.
+	ExcludeLabels []string `json:"excludeLabels,omitempty" protobuf:"bytes,7,rep,name=excludeLabels"`
+	// TargetBranchProtected includes only pull requests whose target branch is protected if true, or not protected if
+	// false. Only supported by the GitHub and GitLab providers.
+	TargetBranchProtected *bool `json:"targetBranchProtected,omitempty" protobuf:"varint,8,opt,name=targetBranchProtected"`
 }
 
 type PluginConfigMapRef struct {
@@ -207,10 +207,38 @@ func (m *AppProjectStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AppProjectStatus proto.InternalMessageInfo
 
+func (m *AppProjectWatchEvent) Reset()      { *m = AppProjectWatchEvent{} }
+func (*AppProjectWatchEvent) ProtoMessage() {}
+func (*AppProjectWatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{6}
+}
+func (m *AppProjectWatchEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppProjectWatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AppProjectWatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppProjectWatchEvent.Merge(m, src)
+}
+func (m *AppProjectWatchEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppProjectWatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppProjectWatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppProjectWatchEvent proto.InternalMessageInfo
+
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{6}
+	return fileDescriptor_c078c3c476799f44, []int{7}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +266,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{7}
+	return fileDescriptor_c078c3c476799f44, []int{8}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +294,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +632,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{21}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +688,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +772,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +940,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1248,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1276,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1304,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1332,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1360,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1388,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1416,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1444,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1472,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1500,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1528,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1556,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1584,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1612,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1640,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1668,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1696,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1724,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1752,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1780,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1808,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1836,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1864,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1892,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1920,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +1948,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +1976,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2004,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2032,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2060,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2088,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2116,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2144,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2172,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2200,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2228,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2256,7 @@ var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2284,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2312,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2340,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2368,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2396,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2424,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2452,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2480,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2508,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2536,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2564,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2592,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2620,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2648,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2676,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2704,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,10 +2729,38 @@ func (m *MergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 
+func (m *NamespaceRegexDestination) Reset()      { *m = NamespaceRegexDestination{} }
+func (*NamespaceRegexDestination) ProtoMessage() {}
+func (*NamespaceRegexDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{96}
+}
+func (m *NamespaceRegexDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceRegexDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceRegexDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRegexDestination.Merge(m, src)
+}
+func (m *NamespaceRegexDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceRegexDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRegexDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceRegexDestination proto.InternalMessageInfo
+
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2788,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2816,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2844,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2872,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +2900,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +2928,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +2956,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +2984,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3012,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3040,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3068,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3096,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3124,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3152,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3180,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3208,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3236,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3264,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3292,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3432,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3460,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3488,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3516,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3544,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3572,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3600,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3628,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3656,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3684,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3712,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3740,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3768,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3796,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3824,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +3852,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +3880,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +3908,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +3936,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +3964,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +3992,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4020,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4048,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4076,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4104,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4132,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4160,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4328,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4356,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4384,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4412,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4440,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4468,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4496,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4524,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4552,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4580,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4608,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4636,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +4664,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +4692,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4664,7 +4720,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +4748,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +4776,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4751,8 +4807,11 @@ func init() {
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProject")
 	proto.RegisterType((*AppProjectList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectList")
 	proto.RegisterType((*AppProjectSpec)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectSpec")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectSpec.PropagatedAnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectSpec.RequiredClusterLabelsEntry")
 	proto.RegisterType((*AppProjectStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus")
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
+	proto.RegisterType((*AppProjectWatchEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectWatchEvent")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
@@ -4856,6 +4915,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
 	proto.RegisterType((*MatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MatrixGenerator")
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGenerator")
+	proto.RegisterType((*NamespaceRegexDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NamespaceRegexDestination")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
@@ -4943,783 +5003,819 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0x49,
-	0x56, 0xd8, 0x55, 0xb7, 0x5a, 0xea, 0x7e, 0xfa, 0x1a, 0xe5, 0xcc, 0xec, 0x6a, 0x66, 0x3f, 0x34,
-	0xd4, 0x1e, 0x7b, 0x87, 0x6f, 0x4f, 0x62, 0x67, 0x77, 0x8f, 0x35, 0x0b, 0x07, 0xfa, 0x98, 0x0f,
-	0xcd, 0x48, 0x23, 0x6d, 0xb6, 0x66, 0x86, 0xdb, 0x63, 0x6f, 0xaf, 0x54, 0x9d, 0x92, 0x6a, 0x54,
-	0x5d, 0xd5, 0x5b, 0x55, 0xad, 0x51, 0x2f, 0xcb, 0x71, 0xc7, 0xdd, 0xc1, 0xc1, 0x7d, 0xad, 0xc1,
-	0x61, 0x16, 0xdb, 0xe0, 0xc3, 0xe0, 0xaf, 0x70, 0x5c, 0x80, 0xcd, 0x0f, 0x13, 0x01, 0x04, 0xc1,
-	0x47, 0x10, 0xe0, 0x2f, 0x30, 0x81, 0x01, 0x1b, 0x90, 0xef, 0xc6, 0x76, 0x40, 0x38, 0xc2, 0x44,
-	0x80, 0xfd, 0xc3, 0x31, 0x76, 0x10, 0x8e, 0xfc, 0xce, 0xaa, 0xae, 0x96, 0x5a, 0xa3, 0xd2, 0xcc,
-	0x1c, 0xec, 0xbf, 0xee, 0x7c, 0xaf, 0xde, 0xcb, 0xca, 0xca, 0x7c, 0xef, 0xe5, 0xcb, 0xf7, 0x5e,
-	0xc2, 0xd2, 0xa6, 0x97, 0x6c, 0xb5, 0xd7, 0xa7, 0xdd, 0xb0, 0x39, 0xe3, 0x44, 0x9b, 0x61, 0x2b,
-	0x0a, 0x6f, 0xb1, 0x1f, 0xef, 0x77, 0x1b, 0x33, 0x3b, 0xcf, 0xcd, 0xb4, 0xb6, 0x37, 0x67, 0x9c,
-	0x96, 0x17, 0xcf, 0x38, 0xad, 0x96, 0xef, 0xb9, 0x4e, 0xe2, 0x85, 0xc1, 0xcc, 0xce, 0xb3, 0x8e,
-	0xdf, 0xda, 0x72, 0x9e, 0x9d, 0xd9, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0xa6, 0x5b, 0x51, 0x98,
-	0x84, 0xe8, 0x5b, 0x34, 0xb5, 0x69, 0x49, 0x8d, 0xfd, 0x78, 0xcd, 0x6d, 0x4c, 0xef, 0x3c, 0x37,
-	0xdd, 0xda, 0xde, 0x9c, 0xa6, 0xd4, 0xa6, 0x0d, 0x6a, 0xd3, 0x92, 0xda, 0xd9, 0xf7, 0x1b, 0x7d,
-	0xd9, 0x0c, 0x37, 0xc3, 0x19, 0x46, 0x74, 0xbd, 0xbd, 0xc1, 0xfe, 0xb1, 0x3f, 0xec, 0x17, 0x67,
-	0x76, 0xd6, 0xde, 0x7e, 0x31, 0x9e, 0xf6, 0x42, 0xda, 0xbd, 0x19, 0x37, 0x8c, 0xc8, 0xcc, 0x4e,
-	0x57, 0x87, 0xce, 0x5e, 0xd6, 0x38, 0x64, 0x37, 0x21, 0x41, 0xec, 0x85, 0x41, 0xfc, 0x7e, 0xda,
-	0x05, 0x12, 0xed, 0x90, 0xc8, 0x7c, 0x3d, 0x03, 0x21, 0x8f, 0xd2, 0xf3, 0x9a, 0x52, 0xd3, 0x71,
-	0xb7, 0xbc, 0x80, 0x44, 0x1d, 0xfd, 0x78, 0x93, 0x24, 0x4e, 0xde, 0x53, 0x33, 0xbd, 0x9e, 0x8a,
-	0xda, 0x41, 0xe2, 0x35, 0x49, 0xd7, 0x03, 0x1f, 0x38, 0xe8, 0x81, 0xd8, 0xdd, 0x22, 0x4d, 0xa7,
-	0xeb, 0xb9, 0xe7, 0x7a, 0x3d, 0xd7, 0x4e, 0x3c, 0x7f, 0xc6, 0x0b, 0x92, 0x38, 0x89, 0xb2, 0x0f,
-	0xd9, 0x7f, 0xdf, 0x82, 0xd1, 0xd9, 0x9b, 0xf5, 0xd9, 0x76, 0xb2, 0x35, 0x1f, 0x06, 0x1b, 0xde,
-	0x26, 0x7a, 0x01, 0x86, 0x5d, 0xbf, 0x1d, 0x27, 0x24, 0xba, 0xe6, 0x34, 0xc9, 0xa4, 0x75, 0xce,
-	0x7a, 0x6f, 0x6d, 0xee, 0xe4, 0x6f, 0xec, 0x4d, 0xbd, 0xeb, 0xce, 0xde, 0xd4, 0xf0, 0xbc, 0x06,
-	0x61, 0x13, 0x0f, 0x7d, 0x03, 0x0c, 0x45, 0xa1, 0x4f, 0x66, 0xf1, 0xb5, 0xc9, 0x12, 0x7b, 0x64,
-	0x5c, 0x3c, 0x32, 0x84, 0x79, 0x33, 0x96, 0x70, 0x8a, 0xda, 0x8a, 0xc2, 0x0d, 0xcf, 0x27, 0x93,
-	0xe5, 0x34, 0xea, 0x2a, 0x6f, 0xc6, 0x12, 0x6e, 0xff, 0x68, 0x09, 0xc6, 0x67, 0x5b, 0xad, 0xcb,
-	0xc4, 0xf1, 0x93, 0xad, 0x7a, 0xe2, 0x24, 0xed, 0x18, 0x6d, 0xc2, 0x60, 0xcc, 0x7e, 0x89, 0xbe,
-	0xad, 0x88, 0xa7, 0x07, 0x39, 0xfc, 0xee, 0xde, 0xd4, 0xb7, 0xe6, 0xcd, 0xe8, 0x4d, 0x2f, 0x09,
-	0x5b, 0xf1, 0xfb, 0x49, 0xb0, 0xe9, 0x05, 0x84, 0x8d, 0xcb, 0x16, 0xa3, 0x3a, 0x6d, 0x12, 0x9f,
-	0x0f, 0x1b, 0x04, 0x0b, 0xf2, 0xb4, 0x9f, 0x4d, 0x12, 0xc7, 0xce, 0x26, 0xc9, 0xbe, 0xd2, 0x32,
-	0x6f, 0xc6, 0x12, 0x8e, 0x22, 0x40, 0xbe, 0x13, 0x27, 0x6b, 0x91, 0x13, 0xc4, 0x1e, 0x9d, 0xd2,
-	0x6b, 0x5e, 0x93, 0xbf, 0xdd, 0xf0, 0xf9, 0xbf, 0x31, 0xcd, 0x3f, 0xcc, 0xb4, 0xf9, 0x61, 0xf4,
-	0x3a, 0xa0, 0xf3, 0x66, 0x7a, 0xe7, 0xd9, 0x69, 0xfa, 0xc4, 0xdc, 0x23, 0x77, 0xf6, 0xa6, 0xd0,
-	0x52, 0x17, 0x25, 0x9c, 0x43, 0xdd, 0xfe, 0xbd, 0x12, 0xc0, 0x6c, 0xab, 0xb5, 0x1a, 0x85, 0xb7,
-	0x88, 0x9b, 0xa0, 0x8f, 0x42, 0x95, 0x92, 0x6a, 0x38, 0x89, 0xc3, 0x06, 0x66, 0xf8, 0xfc, 0x37,
-	0xf6, 0xc7, 0x78, 0x65, 0x9d, 0x3e, 0xbf, 0x4c, 0x12, 0x67, 0x0e, 0x89, 0x17, 0x04, 0xdd, 0x86,
-	0x15, 0x55, 0x14, 0xc0, 0x40, 0xdc, 0x22, 0x2e, 0x1b, 0x8c, 0xe1, 0xf3, 0x4b, 0xd3, 0x47, 0x59,
-	0xe9, 0xd3, 0xba, 0xe7, 0xf5, 0x16, 0x71, 0xe7, 0x46, 0x04, 0xe7, 0x01, 0xfa, 0x0f, 0x33, 0x3e,
-	0x68, 0x47, 0x7d, 0x68, 0x3e, 0x90, 0xd7, 0x0a, 0xe3, 0xc8, 0xa8, 0xce, 0x8d, 0xa5, 0x27, 0x8e,
-	0xfc, 0xee, 0xf6, 0x1f, 0x5b, 0x30, 0xa6, 0x91, 0x97, 0xbc, 0x38, 0x41, 0xdf, 0xd9, 0x35, 0xb8,
-	0xd3, 0xfd, 0x0d, 0x2e, 0x7d, 0x9a, 0x0d, 0xed, 0x09, 0xc1, 0xac, 0x2a, 0x5b, 0x8c, 0x81, 0x6d,
-	0x42, 0xc5, 0x4b, 0x48, 0x33, 0x9e, 0x2c, 0x9d, 0x2b, 0xbf, 0x77, 0xf8, 0xfc, 0xe5, 0xa2, 0xde,
-	0x73, 0x6e, 0x54, 0x30, 0xad, 0x2c, 0x52, 0xf2, 0x98, 0x73, 0xb1, 0xff, 0x62, 0xd4, 0x7c, 0x3f,
-	0x3a, 0xe0, 0xe8, 0x59, 0x18, 0x8e, 0xc3, 0x76, 0xe4, 0x12, 0x4c, 0x5a, 0x21, 0x5d, 0x58, 0x65,
-	0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xeb, 0x66, 0x6c, 0xe2, 0xa0, 0x2f, 0x58, 0x30, 0xd2, 0x20, 0x71,
-	0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xda, 0x91, 0x3b, 0x2f, 0x1b, 0x17, 0x34, 0xf1, 0xb9, 0x53,
-	0xe2, 0x45, 0x46, 0x8c, 0xc6, 0x18, 0xa7, 0xf8, 0x53, 0xc1, 0xd5, 0x20, 0xb1, 0x1b, 0x79, 0x2d,
-	0xfa, 0x5f, 0x88, 0x16, 0x25, 0xb8, 0x16, 0x34, 0x08, 0x9b, 0x78, 0x28, 0x80, 0x0a, 0x15, 0x4c,
-	0xf1, 0xe4, 0x00, 0xeb, 0xff, 0xe2, 0xd1, 0xfa, 0x2f, 0x06, 0x95, 0xca, 0x3c, 0x3d, 0xfa, 0xf4,
-	0x5f, 0x8c, 0x39, 0x1b, 0xf4, 0x79, 0x0b, 0x26, 0x85, 0xe0, 0xc4, 0x84, 0x0f, 0xe8, 0xcd, 0x2d,
-	0x2f, 0x21, 0xbe, 0x17, 0x27, 0x93, 0x15, 0xd6, 0x87, 0x99, 0xfe, 0xe6, 0xd6, 0xa5, 0x28, 0x6c,
-	0xb7, 0xae, 0x7a, 0x41, 0x63, 0xee, 0x9c, 0xe0, 0x34, 0x39, 0xdf, 0x83, 0x30, 0xee, 0xc9, 0x12,
-	0xfd, 0xb0, 0x05, 0x67, 0x03, 0xa7, 0x49, 0xe2, 0x96, 0x43, 0x3f, 0x2d, 0x07, 0xcf, 0xf9, 0x8e,
-	0xbb, 0xcd, 0x7a, 0x34, 0x78, 0x6f, 0x3d, 0xb2, 0x45, 0x8f, 0xce, 0x5e, 0xeb, 0x49, 0x1a, 0xef,
-	0xc3, 0x16, 0xfd, 0xa4, 0x05, 0x13, 0x61, 0xd4, 0xda, 0x72, 0x02, 0xd2, 0x90, 0xd0, 0x78, 0x72,
-	0x88, 0x2d, 0xbd, 0x8f, 0x1c, 0xed, 0x13, 0xad, 0x64, 0xc9, 0x2e, 0x87, 0x81, 0x97, 0x84, 0x51,
-	0x9d, 0x24, 0x89, 0x17, 0x6c, 0xc6, 0x73, 0xa7, 0xef, 0xec, 0x4d, 0x4d, 0x74, 0x61, 0xe1, 0xee,
-	0xfe, 0xa0, 0xef, 0x82, 0xe1, 0xb8, 0x13, 0xb8, 0x37, 0xbd, 0xa0, 0x11, 0xde, 0x8e, 0x27, 0xab,
-	0x45, 0x2c, 0xdf, 0xba, 0x22, 0x28, 0x16, 0xa0, 0x66, 0x80, 0x4d, 0x6e, 0xf9, 0x1f, 0x4e, 0x4f,
-	0xa5, 0x5a, 0xd1, 0x1f, 0x4e, 0x4f, 0xa6, 0x7d, 0xd8, 0xa2, 0xef, 0xb7, 0x60, 0x34, 0xf6, 0x36,
-	0x03, 0x27, 0x69, 0x47, 0xe4, 0x2a, 0xe9, 0xc4, 0x93, 0xc0, 0x3a, 0x72, 0xe5, 0x88, 0xa3, 0x62,
-	0x90, 0x9c, 0x3b, 0x2d, 0xfa, 0x38, 0x6a, 0xb6, 0xc6, 0x38, 0xcd, 0x37, 0x6f, 0xa1, 0xe9, 0x69,
-	0x3d, 0x5c, 0xec, 0x42, 0xd3, 0x93, 0xba, 0x27, 0x4b, 0xf4, 0xed, 0x70, 0x82, 0x37, 0xa9, 0x91,
-	0x8d, 0x27, 0x47, 0x98, 0xa0, 0x3d, 0x75, 0x67, 0x6f, 0xea, 0x44, 0x3d, 0x03, 0xc3, 0x5d, 0xd8,
-	0xe8, 0x75, 0x98, 0x6a, 0x91, 0xa8, 0xe9, 0x25, 0x2b, 0x81, 0xdf, 0x91, 0xe2, 0xdb, 0x0d, 0x5b,
-	0xa4, 0x21, 0xba, 0x13, 0x4f, 0x8e, 0x9e, 0xb3, 0xde, 0x5b, 0x9d, 0x7b, 0x8f, 0xe8, 0xe6, 0xd4,
-	0xea, 0xfe, 0xe8, 0xf8, 0x20, 0x7a, 0xe8, 0xd7, 0x2d, 0x38, 0x6b, 0x48, 0xd9, 0x3a, 0x89, 0x76,
-	0x3c, 0x97, 0xcc, 0xba, 0x6e, 0xd8, 0x0e, 0x92, 0x78, 0x72, 0x8c, 0x0d, 0xe3, 0xfa, 0x71, 0xc8,
-	0xfc, 0x34, 0x2b, 0x3d, 0x2f, 0x7b, 0xa2, 0xc4, 0x78, 0x9f, 0x9e, 0xda, 0xbf, 0x59, 0x82, 0x13,
-	0x59, 0x0b, 0x00, 0xfd, 0x63, 0x0b, 0xc6, 0x6f, 0xdd, 0x4e, 0xd6, 0xc2, 0x6d, 0x12, 0xc4, 0x73,
-	0x1d, 0x2a, 0xa7, 0x99, 0xee, 0x1b, 0x3e, 0xef, 0x16, 0x6b, 0x6b, 0x4c, 0x5f, 0x49, 0x73, 0xb9,
-	0x10, 0x24, 0x51, 0x67, 0xee, 0x51, 0xf1, 0x4e, 0xe3, 0x57, 0x6e, 0xae, 0x99, 0x50, 0x9c, 0xed,
-	0xd4, 0xd9, 0xcf, 0x5a, 0x70, 0x2a, 0x8f, 0x04, 0x3a, 0x01, 0xe5, 0x6d, 0xd2, 0xe1, 0x96, 0x30,
-	0xa6, 0x3f, 0xd1, 0xab, 0x50, 0xd9, 0x71, 0xfc, 0x36, 0x11, 0x66, 0xda, 0xa5, 0xa3, 0xbd, 0x88,
-	0xea, 0x19, 0xe6, 0x54, 0xbf, 0xb9, 0xf4, 0xa2, 0x65, 0xff, 0x56, 0x19, 0x86, 0x8d, 0x8f, 0x76,
-	0x1f, 0x4c, 0xcf, 0x30, 0x65, 0x7a, 0x2e, 0x17, 0x36, 0xdf, 0x7a, 0xda, 0x9e, 0xb7, 0x33, 0xb6,
-	0xe7, 0x4a, 0x71, 0x2c, 0xf7, 0x35, 0x3e, 0x51, 0x02, 0xb5, 0xb0, 0x45, 0xb7, 0x68, 0xd4, 0x86,
-	0x19, 0x28, 0xe2, 0x13, 0xae, 0x48, 0x72, 0x73, 0xa3, 0x77, 0xf6, 0xa6, 0x6a, 0xea, 0x2f, 0xd6,
-	0x8c, 0xec, 0xdf, 0xb7, 0xe0, 0x94, 0xd1, 0xc7, 0xf9, 0x30, 0x68, 0xb0, 0x8d, 0x06, 0x3a, 0x07,
-	0x03, 0x49, 0xa7, 0x25, 0xb7, 0x81, 0x6a, 0xa4, 0xd6, 0x3a, 0x2d, 0x82, 0x19, 0xe4, 0x61, 0xdf,
-	0x25, 0xfd, 0xb0, 0x05, 0x8f, 0xe4, 0x0b, 0x18, 0xf4, 0x34, 0x0c, 0x72, 0x1f, 0x80, 0x78, 0x3b,
-	0xfd, 0x49, 0x58, 0x2b, 0x16, 0x50, 0x34, 0x03, 0x35, 0xa5, 0xf0, 0xc4, 0x3b, 0x4e, 0x08, 0xd4,
-	0x9a, 0xd6, 0x92, 0x1a, 0x87, 0x0e, 0x1a, 0xfd, 0x23, 0x4c, 0x50, 0x35, 0x68, 0x6c, 0xd3, 0xcc,
-	0x20, 0xf6, 0xef, 0x5a, 0xf0, 0xee, 0x7e, 0xc4, 0xde, 0xf1, 0xf5, 0xb1, 0x0e, 0xa7, 0x1b, 0x64,
-	0xc3, 0x69, 0xfb, 0x49, 0x9a, 0xa3, 0xe8, 0xf4, 0x13, 0xe2, 0xe1, 0xd3, 0x0b, 0x79, 0x48, 0x38,
-	0xff, 0x59, 0xfb, 0xbf, 0x58, 0x6c, 0xbb, 0x2e, 0x5f, 0xeb, 0x3e, 0x6c, 0x9d, 0x82, 0xf4, 0xd6,
-	0x69, 0xb1, 0xb0, 0x65, 0xda, 0x63, 0xef, 0xf4, 0x79, 0x0b, 0xce, 0x1a, 0x58, 0xcb, 0x4e, 0xe2,
-	0x6e, 0x5d, 0xd8, 0x6d, 0x45, 0x24, 0x8e, 0xe9, 0x94, 0x7a, 0xc2, 0x10, 0xc7, 0x73, 0xc3, 0x82,
-	0x42, 0xf9, 0x2a, 0xe9, 0x70, 0xd9, 0xfc, 0x0c, 0x54, 0xf9, 0x9a, 0x0b, 0x23, 0xf1, 0x91, 0xd4,
-	0xbb, 0xad, 0x88, 0x76, 0xac, 0x30, 0x90, 0x0d, 0x83, 0x4c, 0xe6, 0x52, 0x19, 0x44, 0xcd, 0x04,
-	0xa0, 0xdf, 0xfd, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0xac, 0x46, 0x84, 0xcd, 0x87,
-	0xc6, 0x45, 0x8f, 0xf8, 0x8d, 0x98, 0x6e, 0xeb, 0x9c, 0x20, 0x08, 0x13, 0xb1, 0x43, 0x33, 0xb6,
-	0x75, 0xb3, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xef, 0xac, 0x13, 0x9f, 0x8f, 0xa8, 0x60, 0xba,
-	0xc4, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x03, 0xa9, 0x24, 0x1a, 0xb9, 0x1f, 0xde, 0x87,
-	0x28, 0xa5, 0x02, 0x56, 0x8b, 0x93, 0xc7, 0xa4, 0xb7, 0x07, 0xe2, 0x8d, 0x8c, 0x16, 0xc0, 0x85,
-	0x72, 0xdd, 0xdf, 0x0b, 0xf1, 0xf1, 0x32, 0x4c, 0xa5, 0x1f, 0xe8, 0x52, 0x22, 0x74, 0xcb, 0x6b,
-	0x30, 0xca, 0xfa, 0xea, 0x0c, 0x7c, 0x6c, 0xe2, 0xf5, 0x90, 0xc3, 0xa5, 0xe3, 0x94, 0xc3, 0xa6,
-	0x9a, 0x28, 0x1f, 0xa0, 0x26, 0x9e, 0x56, 0xa3, 0x3e, 0x90, 0x91, 0x79, 0x69, 0x55, 0x79, 0x0e,
-	0x06, 0xe2, 0x84, 0xb4, 0x26, 0x2b, 0x69, 0x31, 0x5b, 0x4f, 0x48, 0x0b, 0x33, 0x08, 0xfa, 0x56,
-	0x18, 0x4f, 0x9c, 0x68, 0x93, 0x24, 0x11, 0xd9, 0xf1, 0x98, 0x5f, 0x97, 0xed, 0x67, 0x6b, 0x73,
-	0x27, 0xa9, 0xd5, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70, 0x16, 0xd7, 0xfe, 0x1f, 0x25, 0x78, 0x34,
-	0xfd, 0x09, 0xb4, 0x62, 0xfc, 0xb6, 0x94, 0x62, 0x7c, 0x9f, 0xa9, 0x18, 0xef, 0xee, 0x4d, 0x3d,
-	0xd6, 0xe3, 0xb1, 0xaf, 0x19, 0xbd, 0x89, 0x2e, 0x65, 0x3e, 0xc2, 0x4c, 0x97, 0x97, 0xf5, 0x89,
-	0x1e, 0xef, 0x98, 0xf9, 0x4a, 0x4f, 0xc3, 0x60, 0x44, 0x9c, 0x38, 0x0c, 0xc4, 0x77, 0x52, 0x5f,
-	0x13, 0xb3, 0x56, 0x2c, 0xa0, 0xf6, 0xef, 0xd4, 0xb2, 0x83, 0x7d, 0x89, 0xfb, 0xaa, 0xc3, 0x08,
-	0x79, 0x30, 0xc0, 0x76, 0x6d, 0x5c, 0xb2, 0x5c, 0x3d, 0xda, 0x2a, 0xa4, 0x5a, 0x44, 0x91, 0x9e,
-	0xab, 0xd2, 0xaf, 0x46, 0x9b, 0x30, 0x63, 0x81, 0x76, 0xa1, 0xea, 0xca, 0xcd, 0x54, 0xa9, 0x08,
-	0xb7, 0xa3, 0xd8, 0x4a, 0x69, 0x8e, 0x23, 0x54, 0xdc, 0xab, 0x1d, 0x98, 0xe2, 0x86, 0x08, 0x94,
-	0x37, 0xbd, 0x44, 0x7c, 0xd6, 0x23, 0x6e, 0x97, 0x2f, 0x79, 0xc6, 0x2b, 0x0e, 0x51, 0x1d, 0x74,
-	0xc9, 0x4b, 0x30, 0xa5, 0x8f, 0x3e, 0x6d, 0xc1, 0x70, 0xec, 0x36, 0x57, 0xa3, 0x70, 0xc7, 0x6b,
-	0x90, 0x48, 0xd8, 0x98, 0x47, 0x94, 0x6c, 0xf5, 0xf9, 0x65, 0x49, 0x50, 0xf3, 0xe5, 0xee, 0x0b,
-	0x0d, 0xc1, 0x26, 0x5f, 0xba, 0xf7, 0x7a, 0x54, 0xbc, 0xfb, 0x02, 0x71, 0xd9, 0x8a, 0x93, 0x7b,
-	0x66, 0x36, 0x53, 0x8e, 0x6c, 0x73, 0x2f, 0xb4, 0xdd, 0x6d, 0xba, 0xde, 0x74, 0x87, 0x1e, 0xbb,
-	0xb3, 0x37, 0xf5, 0xe8, 0x7c, 0x3e, 0x4f, 0xdc, 0xab, 0x33, 0x6c, 0xc0, 0x5a, 0x6d, 0xdf, 0xc7,
-	0xe4, 0xf5, 0x36, 0x61, 0x1e, 0xb1, 0x02, 0x06, 0x6c, 0x55, 0x13, 0xcc, 0x0c, 0x98, 0x01, 0xc1,
-	0x26, 0x5f, 0xf4, 0x3a, 0x0c, 0x36, 0x9d, 0x24, 0xf2, 0x76, 0x85, 0x1b, 0xec, 0x88, 0xbb, 0xa0,
-	0x65, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0x35, 0xa1, 0xd2, 0x24, 0xd1,
-	0x26, 0x99, 0xac, 0x16, 0xe1, 0xf2, 0x5f, 0xa6, 0xa4, 0x34, 0xc3, 0x1a, 0x35, 0xae, 0x58, 0x1b,
-	0xe6, 0x5c, 0xd0, 0xab, 0x50, 0x8d, 0x89, 0x4f, 0x5c, 0x6a, 0x1e, 0xd5, 0x18, 0xc7, 0xe7, 0xfa,
-	0x34, 0x15, 0xa9, 0x5d, 0x52, 0x17, 0x8f, 0xf2, 0x05, 0x26, 0xff, 0x61, 0x45, 0x92, 0x0e, 0x60,
-	0xcb, 0x6f, 0x6f, 0x7a, 0xc1, 0x24, 0x14, 0x31, 0x80, 0xab, 0x8c, 0x56, 0x66, 0x00, 0x79, 0x23,
-	0x16, 0x8c, 0xec, 0xff, 0x6e, 0x01, 0x4a, 0x0b, 0xb5, 0xfb, 0x60, 0x13, 0xbf, 0x9e, 0xb6, 0x89,
-	0x97, 0x8a, 0x34, 0x5a, 0x7a, 0x98, 0xc5, 0x3f, 0x5f, 0x83, 0x8c, 0x3a, 0xb8, 0x46, 0xe2, 0x84,
-	0x34, 0xde, 0x11, 0xe1, 0xef, 0x88, 0xf0, 0x77, 0x44, 0xb8, 0x12, 0xe1, 0xeb, 0x19, 0x11, 0xfe,
-	0x41, 0x63, 0xd5, 0xeb, 0xd8, 0x83, 0xd7, 0x54, 0x70, 0x82, 0xd9, 0x03, 0x03, 0x81, 0x4a, 0x82,
-	0x2b, 0xf5, 0x95, 0x6b, 0xb9, 0x32, 0xfb, 0xb5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xbf, 0x0e, 0x52,
-	0xfa, 0xd7, 0x2d, 0x78, 0x4f, 0x5a, 0x7a, 0xc9, 0x99, 0xb3, 0xb8, 0x19, 0x84, 0x11, 0x59, 0xf0,
-	0x36, 0x36, 0x48, 0x44, 0x02, 0x97, 0xc4, 0xca, 0xb7, 0x63, 0xf5, 0xf2, 0xed, 0xa0, 0xe7, 0x61,
-	0xe4, 0x56, 0x1c, 0x06, 0xab, 0xa1, 0x17, 0x08, 0x11, 0x44, 0x77, 0x1c, 0x27, 0xee, 0xec, 0x4d,
-	0x8d, 0xd0, 0x11, 0x95, 0xed, 0x38, 0x85, 0x85, 0xe6, 0x61, 0xe2, 0xd6, 0xeb, 0xab, 0x4e, 0x62,
-	0x78, 0x13, 0xe4, 0xbe, 0x9f, 0x9d, 0x47, 0x5d, 0x79, 0x39, 0x03, 0xc4, 0xdd, 0xf8, 0xf6, 0xdf,
-	0x2b, 0xc1, 0x99, 0xcc, 0x8b, 0x84, 0xbe, 0x1f, 0xb6, 0x13, 0xba, 0x27, 0x42, 0x3f, 0x6e, 0xc1,
-	0x89, 0x66, 0xda, 0x61, 0x11, 0x0b, 0x77, 0xf7, 0x77, 0x14, 0xa6, 0x23, 0x32, 0x1e, 0x91, 0xb9,
-	0x49, 0x31, 0x42, 0x27, 0x32, 0x80, 0x18, 0x77, 0xf5, 0x05, 0xbd, 0x0a, 0xb5, 0xa6, 0xb3, 0x7b,
-	0xbd, 0xd5, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xed, 0x45, 0x68, 0x27, 0x9e, 0x3f, 0xcd, 0xa3, 0x5a,
-	0xa6, 0x17, 0x83, 0x64, 0x25, 0xaa, 0x27, 0x91, 0x17, 0x6c, 0x72, 0x27, 0xe7, 0xb2, 0x24, 0x83,
-	0x35, 0x45, 0xfb, 0xc7, 0xac, 0xac, 0x92, 0x52, 0xa3, 0x13, 0x39, 0x09, 0xd9, 0xec, 0xa0, 0x37,
-	0xa1, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95, 0x28, 0xfd,
-	0x17, 0x63, 0xce, 0xd4, 0xfe, 0xf1, 0x5a, 0xd6, 0x58, 0x60, 0x67, 0xf3, 0xe7, 0x01, 0x36, 0xc3,
-	0x35, 0xd2, 0x6c, 0xf9, 0x74, 0x58, 0x2c, 0x76, 0xc0, 0xa3, 0x5c, 0x25, 0x97, 0x14, 0x04, 0x1b,
-	0x58, 0xe8, 0x07, 0x2c, 0x80, 0x4d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0xbd, 0xc8, 0xd7, 0xd1, 0x2b,
-	0x4a, 0xf7, 0x45, 0x31, 0xc4, 0x06, 0x73, 0xf4, 0xbd, 0x16, 0x54, 0x13, 0xd9, 0x7d, 0xae, 0x1a,
-	0xd7, 0x8a, 0xec, 0x89, 0x7c, 0x69, 0x6d, 0x13, 0xa9, 0x21, 0x51, 0x7c, 0xd1, 0xf7, 0x59, 0x00,
-	0x71, 0x27, 0x70, 0x57, 0x43, 0xdf, 0x73, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d, 0xa3, 0xa8,
-	0xcf, 0x8d, 0xd1, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98, 0x6e, 0x42,
-	0x47, 0xae, 0x15, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89, 0x7e, 0xc4,
-	0x82, 0xf1, 0x56, 0xda, 0x4d, 0x28, 0xd4, 0x61, 0x71, 0x32, 0x20, 0xe3, 0x86, 0xe4, 0xde, 0x96,
-	0x4c, 0x23, 0xce, 0xf6, 0x82, 0x4a, 0x40, 0x3d, 0x83, 0x57, 0x5a, 0xdc, 0x65, 0x39, 0xa4, 0x25,
-	0xe0, 0xa5, 0x2c, 0x10, 0x77, 0xe3, 0xa3, 0x55, 0x38, 0x45, 0x7b, 0xd7, 0xe1, 0xe6, 0xa7, 0x54,
-	0x2f, 0x31, 0x53, 0x86, 0xd5, 0xb9, 0xc7, 0xc5, 0x0c, 0x61, 0x67, 0x1d, 0x59, 0x1c, 0x9c, 0xfb,
-	0x24, 0xfa, 0x2d, 0x0b, 0x1e, 0xf7, 0x98, 0x1a, 0x30, 0x1d, 0xf6, 0x5a, 0x23, 0x88, 0x83, 0x76,
-	0x52, 0xa8, 0xac, 0xe8, 0xa5, 0x7e, 0xe6, 0xde, 0x2d, 0xde, 0xe0, 0xf1, 0xc5, 0x7d, 0xba, 0x84,
-	0xf7, 0xed, 0x30, 0xfa, 0x26, 0x18, 0x95, 0xeb, 0x62, 0x95, 0x8a, 0x60, 0xa6, 0x68, 0x6b, 0x73,
-	0x13, 0x77, 0xf6, 0xa6, 0x46, 0xd7, 0x4c, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xba, 0x9c, 0x3a, 0x25,
-	0x52, 0x3e, 0x4c, 0x26, 0x6e, 0x5c, 0xe9, 0xff, 0x91, 0xd2, 0xb3, 0x50, 0x71, 0xa3, 0xbc, 0x4b,
-	0x5a, 0xdc, 0xa8, 0xa6, 0x18, 0x1b, 0xcc, 0xa9, 0x51, 0x3a, 0xe1, 0x64, 0x3d, 0xa5, 0x42, 0x02,
-	0xbe, 0x5a, 0x64, 0x97, 0xba, 0xcf, 0xf4, 0xce, 0x88, 0xae, 0x4d, 0x74, 0x81, 0x70, 0x77, 0x97,
-	0xd0, 0x77, 0x43, 0x2d, 0x52, 0x91, 0x2d, 0xe5, 0x22, 0xb6, 0x6a, 0x72, 0xda, 0x88, 0xee, 0xa8,
-	0x03, 0x20, 0x1d, 0xc3, 0xa2, 0x39, 0xda, 0x9f, 0x29, 0xa5, 0x0e, 0xc6, 0x0c, 0xd9, 0xd1, 0xc7,
-	0xa1, 0xdf, 0x17, 0x2c, 0x18, 0x8e, 0x42, 0xdf, 0xf7, 0x82, 0x4d, 0x2a, 0xe7, 0x84, 0xb2, 0xfe,
-	0xf0, 0xb1, 0xe8, 0x4b, 0x21, 0xd0, 0x98, 0x65, 0x8d, 0x35, 0x4f, 0x6c, 0x76, 0x00, 0xbd, 0x04,
-	0xa3, 0x0d, 0xe2, 0x13, 0xfa, 0xec, 0x4a, 0x44, 0xf7, 0x44, 0xdc, 0xc9, 0xac, 0x22, 0x45, 0x16,
-	0x4c, 0x20, 0x4e, 0xe3, 0xda, 0x7f, 0x6c, 0xc1, 0x64, 0x2f, 0x61, 0x8e, 0x08, 0x3c, 0x26, 0x25,
-	0x95, 0x1a, 0xc7, 0x95, 0x40, 0xd2, 0x13, 0xfa, 0xf8, 0x29, 0xc1, 0xe7, 0xb1, 0xd5, 0xde, 0xa8,
-	0x78, 0x3f, 0x3a, 0xe8, 0x15, 0x38, 0x61, 0x0c, 0x4a, 0xac, 0x46, 0xb5, 0x36, 0x37, 0x4d, 0xad,
-	0xa7, 0xd9, 0x0c, 0xec, 0xee, 0xde, 0xd4, 0x23, 0xd9, 0x36, 0xa1, 0x6d, 0xba, 0xe8, 0xd8, 0x3f,
-	0xd5, 0xf5, 0xa9, 0x95, 0xa1, 0xf0, 0xb6, 0xd5, 0xe5, 0x8a, 0xf8, 0x8e, 0xe3, 0x50, 0xce, 0xcc,
-	0x69, 0xa1, 0x62, 0x38, 0x7a, 0xe3, 0x3c, 0xc0, 0x33, 0x7f, 0xfb, 0xdf, 0x0e, 0xc0, 0x3e, 0x3d,
-	0xeb, 0xc3, 0xf2, 0x3f, 0xf4, 0x21, 0xec, 0xe7, 0x2c, 0x75, 0xda, 0xc6, 0x05, 0x40, 0xe3, 0xb8,
-	0xc6, 0x9e, 0x6f, 0xbe, 0x62, 0x1e, 0x77, 0xa2, 0x5c, 0xf0, 0xe9, 0x73, 0x3d, 0xf4, 0x25, 0x2b,
-	0x7d, 0x5e, 0xc8, 0x23, 0x22, 0xbd, 0x63, 0xeb, 0x93, 0x71, 0x08, 0xc9, 0x3b, 0xa6, 0x8f, 0xae,
-	0x7a, 0x1d, 0x4f, 0x4e, 0x03, 0x6c, 0x78, 0x81, 0xe3, 0x7b, 0x6f, 0xd0, 0xad, 0x55, 0x85, 0x59,
-	0x07, 0xcc, 0xdc, 0xba, 0xa8, 0x5a, 0xb1, 0x81, 0x71, 0xf6, 0x6f, 0xc2, 0xb0, 0xf1, 0xe6, 0x39,
-	0xe1, 0x32, 0xa7, 0xcc, 0x70, 0x99, 0x9a, 0x11, 0xe5, 0x72, 0xf6, 0x83, 0x70, 0x22, 0xdb, 0xc1,
-	0xc3, 0x3c, 0x6f, 0xff, 0x9f, 0xa1, 0xec, 0x01, 0xde, 0x1a, 0x89, 0x9a, 0xb4, 0x6b, 0xef, 0x78,
-	0xc5, 0xde, 0xf1, 0x8a, 0xbd, 0xe3, 0x15, 0x33, 0x0f, 0x36, 0x84, 0xc7, 0x67, 0xe8, 0x3e, 0x79,
-	0x7c, 0x52, 0x3e, 0xac, 0x6a, 0xe1, 0x3e, 0x2c, 0xfb, 0xd3, 0x5d, 0x6e, 0xff, 0xb5, 0x88, 0x10,
-	0x14, 0x42, 0x25, 0x08, 0x1b, 0x44, 0x1a, 0xc8, 0x57, 0x8a, 0xb1, 0xf6, 0xae, 0x85, 0x0d, 0x23,
-	0xd6, 0x9c, 0xfe, 0x8b, 0x31, 0xe7, 0x63, 0x7f, 0x6a, 0x10, 0x52, 0xb6, 0x28, 0xff, 0xee, 0xdf,
-	0x00, 0x43, 0x11, 0x69, 0x85, 0xd7, 0xf1, 0x92, 0xd0, 0x65, 0x3a, 0x55, 0x87, 0x37, 0x63, 0x09,
-	0xa7, 0x3a, 0xaf, 0xe5, 0x24, 0x5b, 0x42, 0x99, 0x29, 0x9d, 0xb7, 0xea, 0x24, 0x5b, 0x98, 0x41,
-	0xd0, 0x07, 0x61, 0x2c, 0x49, 0x9d, 0xa3, 0x8b, 0xf3, 0xe2, 0x47, 0x04, 0xee, 0x58, 0xfa, 0x94,
-	0x1d, 0x67, 0xb0, 0xd1, 0xeb, 0x30, 0xb0, 0x45, 0xfc, 0xa6, 0xf8, 0xf4, 0xf5, 0xe2, 0x74, 0x0d,
-	0x7b, 0xd7, 0xcb, 0xc4, 0x6f, 0x72, 0x49, 0x48, 0x7f, 0x61, 0xc6, 0x8a, 0xce, 0xfb, 0xda, 0x76,
-	0x3b, 0x4e, 0xc2, 0xa6, 0xf7, 0x86, 0x74, 0x93, 0x7e, 0x47, 0xc1, 0x8c, 0xaf, 0x4a, 0xfa, 0xdc,
-	0x1f, 0xa5, 0xfe, 0x62, 0xcd, 0x99, 0xf5, 0xa3, 0xe1, 0x45, 0x6c, 0xca, 0x74, 0x84, 0xb7, 0xb3,
-	0xe8, 0x7e, 0x2c, 0x48, 0xfa, 0xbc, 0x1f, 0xea, 0x2f, 0xd6, 0x9c, 0x51, 0x47, 0xad, 0xbf, 0x61,
-	0xd6, 0x87, 0xeb, 0x05, 0xf7, 0x81, 0xaf, 0xbd, 0xdc, 0x75, 0xf8, 0x14, 0x54, 0xdc, 0x2d, 0x27,
-	0x4a, 0x26, 0x47, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x9e, 0x36, 0x62, 0x0e, 0x43, 0x4f, 0x40, 0x39,
-	0x22, 0x1b, 0x2c, 0xb4, 0xd9, 0x08, 0xaa, 0xc2, 0x64, 0x03, 0xd3, 0x76, 0x65, 0x97, 0x8d, 0xf5,
-	0x8c, 0xb6, 0xfb, 0x89, 0x52, 0xda, 0xb0, 0x4b, 0x8f, 0x0c, 0x5f, 0x0f, 0x6e, 0x3b, 0x8a, 0xa5,
-	0x77, 0xcd, 0x58, 0x0f, 0xac, 0x19, 0x4b, 0x38, 0xfa, 0x84, 0x05, 0x43, 0xb7, 0xe2, 0x30, 0x08,
-	0x48, 0x22, 0x94, 0xe8, 0x8d, 0x82, 0x07, 0xeb, 0x0a, 0xa7, 0xae, 0xfb, 0x20, 0x1a, 0xb0, 0xe4,
-	0x4b, 0xbb, 0x4b, 0x76, 0x5d, 0xbf, 0xdd, 0xe8, 0x8a, 0xa4, 0xb9, 0xc0, 0x9b, 0xb1, 0x84, 0x53,
-	0x54, 0x2f, 0xe0, 0xa8, 0x03, 0x69, 0xd4, 0xc5, 0x40, 0xa0, 0x0a, 0xb8, 0xfd, 0xb3, 0x55, 0x38,
-	0x9d, 0xbb, 0x7c, 0xa8, 0xc9, 0xc5, 0x8c, 0x9a, 0x8b, 0x9e, 0x4f, 0x64, 0x0c, 0x19, 0x33, 0xb9,
-	0x6e, 0xa8, 0x56, 0x6c, 0x60, 0xa0, 0xef, 0x01, 0x68, 0x39, 0x91, 0xd3, 0x24, 0xca, 0xfb, 0x7d,
-	0x64, 0xcb, 0x86, 0xf6, 0x63, 0x55, 0xd2, 0xd4, 0x1e, 0x00, 0xd5, 0x14, 0x63, 0x83, 0x25, 0x7a,
-	0x01, 0x86, 0x23, 0xe2, 0x13, 0x27, 0x66, 0xb1, 0xf3, 0xd9, 0x44, 0x20, 0xac, 0x41, 0xd8, 0xc4,
-	0x43, 0x4f, 0xab, 0x70, 0xbb, 0x4c, 0xd8, 0x51, 0x3a, 0xe4, 0x0e, 0x7d, 0xd1, 0x82, 0xb1, 0x0d,
-	0xcf, 0x27, 0x9a, 0xbb, 0x48, 0xdb, 0x59, 0x39, 0xfa, 0x4b, 0x5e, 0x34, 0xe9, 0x6a, 0x19, 0x9a,
-	0x6a, 0x8e, 0x71, 0x86, 0x3d, 0xfd, 0xcc, 0x3b, 0x24, 0x62, 0xc2, 0x77, 0x30, 0xfd, 0x99, 0x6f,
-	0xf0, 0x66, 0x2c, 0xe1, 0x68, 0x16, 0xc6, 0x5b, 0x4e, 0x1c, 0xcf, 0x47, 0xa4, 0x41, 0x82, 0xc4,
-	0x73, 0x7c, 0x9e, 0x54, 0x53, 0xd5, 0xb1, 0xe8, 0xab, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0x0f, 0xc1,
-	0xa3, 0xdc, 0xbd, 0xb4, 0xec, 0xc5, 0xb1, 0x17, 0x6c, 0xea, 0x69, 0x20, 0xbc, 0x6c, 0x53, 0x82,
-	0xd4, 0xa3, 0x8b, 0xf9, 0x68, 0xb8, 0xd7, 0xf3, 0xe8, 0x19, 0xa8, 0xc6, 0xdb, 0x5e, 0x6b, 0x3e,
-	0x6a, 0xc4, 0xec, 0x68, 0xa9, 0xaa, 0x7d, 0xba, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0x72, 0x61, 0x84,
-	0x7f, 0x12, 0x1e, 0x2f, 0x28, 0x24, 0xe8, 0xfb, 0x7b, 0x2a, 0x72, 0x91, 0x3f, 0x3b, 0x8d, 0x9d,
-	0xdb, 0x17, 0xe4, 0x41, 0x17, 0x3f, 0x97, 0xb9, 0x61, 0x90, 0xc1, 0x29, 0xa2, 0xe9, 0x3d, 0xdd,
-	0x70, 0x1f, 0x7b, 0xba, 0x17, 0x60, 0x78, 0xbb, 0xbd, 0x4e, 0xc4, 0xc8, 0x0b, 0xc1, 0xa6, 0x66,
-	0xdf, 0x55, 0x0d, 0xc2, 0x26, 0x1e, 0x0b, 0xd5, 0x6c, 0x79, 0xe2, 0x5f, 0x3c, 0x39, 0x6a, 0x84,
-	0x6a, 0xae, 0x2e, 0xca, 0x66, 0x6c, 0xe2, 0xd0, 0xae, 0xd1, 0xb1, 0x58, 0x23, 0x31, 0xcb, 0xc4,
-	0xa0, 0xc3, 0xa5, 0xba, 0x56, 0x97, 0x00, 0xac, 0x71, 0xd0, 0x2a, 0x9c, 0xa2, 0x7f, 0xea, 0x2c,
-	0x7f, 0xf8, 0x86, 0xe3, 0x7b, 0x0d, 0x1e, 0x37, 0x38, 0x9e, 0x76, 0x8e, 0xd6, 0x73, 0x70, 0x70,
-	0xee, 0x93, 0xf6, 0x8f, 0x96, 0xd2, 0x9e, 0x13, 0x53, 0x84, 0xa1, 0x98, 0x0a, 0xaa, 0xe4, 0x86,
-	0x13, 0x49, 0x83, 0xe7, 0x88, 0x99, 0x51, 0x82, 0xee, 0x0d, 0x27, 0x32, 0x45, 0x1e, 0x63, 0x80,
-	0x25, 0x27, 0x74, 0x0b, 0x06, 0x12, 0xdf, 0x29, 0x28, 0x95, 0xd2, 0xe0, 0xa8, 0xbd, 0x60, 0x4b,
-	0xb3, 0x31, 0x66, 0x3c, 0xd0, 0xe3, 0x74, 0xf7, 0xb6, 0x2e, 0x8f, 0xe9, 0xc4, 0x86, 0x6b, 0x3d,
-	0xc6, 0xac, 0xd5, 0xfe, 0xdb, 0xa3, 0x39, 0x5a, 0x47, 0x19, 0x02, 0xe8, 0x3c, 0x00, 0x9d, 0x34,
-	0xab, 0x11, 0xd9, 0xf0, 0x76, 0x85, 0x21, 0xa6, 0x24, 0xdb, 0x35, 0x05, 0xc1, 0x06, 0x96, 0x7c,
-	0xa6, 0xde, 0xde, 0xa0, 0xcf, 0x94, 0xba, 0x9f, 0xe1, 0x10, 0x6c, 0x60, 0xa1, 0xe7, 0x61, 0xd0,
-	0x6b, 0x3a, 0x9b, 0x2a, 0x8a, 0xf8, 0x71, 0x2a, 0xd2, 0x16, 0x59, 0xcb, 0xdd, 0xbd, 0xa9, 0x31,
-	0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x29, 0x0b, 0x46, 0xdc, 0xb0, 0xd9, 0x0c, 0x03, 0xbe,
-	0x7d, 0x16, 0xbe, 0x80, 0x5b, 0xc7, 0x65, 0x26, 0x4d, 0xcf, 0x1b, 0xcc, 0xb8, 0x33, 0x40, 0xe5,
-	0x7c, 0x9a, 0x20, 0x9c, 0xea, 0x95, 0x29, 0xf9, 0x2a, 0x07, 0x48, 0xbe, 0x9f, 0xb3, 0x60, 0x82,
-	0x3f, 0x6b, 0xec, 0xea, 0x45, 0x7a, 0x63, 0x78, 0xcc, 0xaf, 0xd5, 0xe5, 0xe8, 0x50, 0x9e, 0xe2,
-	0x2e, 0x38, 0xee, 0xee, 0x24, 0xba, 0x04, 0x13, 0x1b, 0x61, 0xe4, 0x12, 0x73, 0x20, 0x84, 0xd8,
-	0x56, 0x84, 0x2e, 0x66, 0x11, 0x70, 0xf7, 0x33, 0xe8, 0x06, 0x3c, 0x62, 0x34, 0x9a, 0xe3, 0xc0,
-	0x25, 0xf7, 0x93, 0x82, 0xda, 0x23, 0x17, 0x73, 0xb1, 0x70, 0x8f, 0xa7, 0xd3, 0x42, 0xb2, 0xd6,
-	0x87, 0x90, 0x7c, 0x0d, 0xce, 0xb8, 0xdd, 0x23, 0xb3, 0x13, 0xb7, 0xd7, 0x63, 0x2e, 0xc7, 0xab,
-	0x73, 0x5f, 0x27, 0x08, 0x9c, 0x99, 0xef, 0x85, 0x88, 0x7b, 0xd3, 0x40, 0x6f, 0x42, 0x35, 0x22,
-	0xec, 0xab, 0xc4, 0x22, 0xd7, 0xef, 0x88, 0xde, 0x0e, 0x6d, 0xc1, 0x73, 0xb2, 0x5a, 0x33, 0x89,
-	0x86, 0x18, 0x2b, 0x8e, 0xe8, 0x36, 0x0c, 0xb5, 0x9c, 0xc4, 0xdd, 0x12, 0x19, 0x7e, 0x47, 0x76,
-	0xec, 0x2b, 0xe6, 0xec, 0x1c, 0xc6, 0xa8, 0x97, 0xc0, 0x99, 0x60, 0xc9, 0x8d, 0xda, 0x6a, 0x6e,
-	0xd8, 0x6c, 0x85, 0x01, 0x09, 0x12, 0xa9, 0x44, 0xc6, 0xf8, 0x61, 0x89, 0x6c, 0xc5, 0x06, 0x46,
-	0x97, 0x2e, 0xd7, 0x68, 0x93, 0x13, 0xfb, 0xe8, 0x72, 0x83, 0x5a, 0xaf, 0xe7, 0xa9, 0xb2, 0x61,
-	0x6e, 0xc5, 0x9b, 0x5e, 0xb2, 0x15, 0xb6, 0x13, 0xb9, 0x4b, 0x16, 0x8a, 0x4a, 0x29, 0x9b, 0xa5,
-	0x1c, 0x1c, 0x9c, 0xfb, 0x64, 0x56, 0xb3, 0x8e, 0xdf, 0x9b, 0x66, 0x3d, 0xd1, 0x87, 0x66, 0xad,
-	0xc3, 0x69, 0xd6, 0x03, 0x61, 0x25, 0x4b, 0xa7, 0x65, 0x3c, 0x89, 0x58, 0xe7, 0x55, 0x72, 0xcc,
-	0x52, 0x1e, 0x12, 0xce, 0x7f, 0xf6, 0xec, 0xb7, 0xc1, 0x44, 0x97, 0x90, 0x3b, 0x94, 0x43, 0x72,
-	0x01, 0x1e, 0xc9, 0x17, 0x27, 0x87, 0x72, 0x4b, 0xfe, 0x6c, 0x26, 0xa8, 0xdd, 0xd8, 0xa2, 0xf5,
-	0xe1, 0xe2, 0x76, 0xa0, 0x4c, 0x82, 0x1d, 0xa1, 0x5d, 0x2f, 0x1e, 0x6d, 0x56, 0x5f, 0x08, 0x76,
-	0xb8, 0x34, 0x64, 0x7e, 0xbc, 0x0b, 0xc1, 0x0e, 0xa6, 0xb4, 0xd1, 0x0f, 0x59, 0xa9, 0x0d, 0x04,
-	0x77, 0x8c, 0x7f, 0xe4, 0x58, 0xf6, 0xa4, 0x7d, 0xef, 0x29, 0xec, 0x7f, 0x57, 0x82, 0x73, 0x07,
-	0x11, 0xe9, 0x63, 0xf8, 0x9e, 0x82, 0xc1, 0x98, 0x85, 0xa9, 0x08, 0x75, 0x35, 0x4c, 0x57, 0x31,
-	0x0f, 0x5c, 0x79, 0x0d, 0x0b, 0x10, 0xf2, 0xa1, 0xdc, 0x74, 0x5a, 0xc2, 0x5f, 0xba, 0x78, 0xd4,
-	0xe4, 0x3f, 0xfa, 0xdf, 0xf1, 0x97, 0x9d, 0x16, 0x9f, 0xf3, 0x46, 0x03, 0xa6, 0x6c, 0x50, 0x02,
-	0x15, 0x27, 0x8a, 0x1c, 0x19, 0x13, 0x71, 0xb5, 0x18, 0x7e, 0xb3, 0x94, 0x24, 0x3f, 0x52, 0x4e,
-	0x35, 0x61, 0xce, 0xcc, 0xfe, 0x91, 0x6a, 0x2a, 0x53, 0x8c, 0x05, 0xba, 0xc4, 0x30, 0x28, 0xdc,
-	0xa4, 0x56, 0xd1, 0x39, 0x97, 0x3c, 0x15, 0x9b, 0x79, 0x20, 0x44, 0x41, 0x0b, 0xc1, 0x0a, 0x7d,
-	0xd6, 0x62, 0x65, 0x23, 0x64, 0xfa, 0x9d, 0xd8, 0xd5, 0x1f, 0x4f, 0x15, 0x0b, 0xb3, 0x18, 0x85,
-	0x6c, 0xc4, 0x26, 0x77, 0x51, 0x1a, 0x87, 0xed, 0x66, 0xba, 0x4b, 0xe3, 0xb0, 0xdd, 0x89, 0x84,
-	0xa3, 0xdd, 0x9c, 0x80, 0x96, 0x02, 0x4a, 0x0f, 0xf4, 0x11, 0xc2, 0xf2, 0x25, 0x0b, 0x26, 0xbc,
-	0x6c, 0x64, 0x82, 0xd8, 0x03, 0xdf, 0x2c, 0xc6, 0xa7, 0xd9, 0x1d, 0xf8, 0xa0, 0x0c, 0x9d, 0x2e,
-	0x10, 0xee, 0xee, 0x0c, 0x6a, 0xc0, 0x80, 0x17, 0x6c, 0x84, 0xc2, 0xbc, 0x9b, 0x3b, 0x5a, 0xa7,
-	0x16, 0x83, 0x8d, 0x50, 0xaf, 0x66, 0xfa, 0x0f, 0x33, 0xea, 0x68, 0x09, 0x4e, 0xc9, 0x64, 0xa1,
-	0xcb, 0x5e, 0x9c, 0x84, 0x51, 0x67, 0xc9, 0x6b, 0x7a, 0x09, 0x33, 0xcd, 0xca, 0x73, 0x93, 0x54,
-	0xbd, 0xe1, 0x1c, 0x38, 0xce, 0x7d, 0x0a, 0xbd, 0x01, 0x43, 0x32, 0x1a, 0xa0, 0x5a, 0x84, 0x3f,
-	0xa1, 0x7b, 0xfe, 0xab, 0xc9, 0x54, 0x17, 0xe1, 0x00, 0x92, 0x21, 0xfa, 0x8c, 0x05, 0x63, 0xfc,
-	0xf7, 0xe5, 0x4e, 0x83, 0xe7, 0x27, 0xd6, 0x8a, 0x08, 0xf9, 0xaf, 0xa7, 0x68, 0xce, 0xa1, 0x3b,
-	0x7b, 0x53, 0x63, 0xe9, 0x36, 0x9c, 0xe1, 0x6b, 0xff, 0x93, 0x11, 0xe8, 0x8e, 0x9f, 0x48, 0x07,
-	0x4b, 0x58, 0xf7, 0x3b, 0x58, 0x82, 0xee, 0x2a, 0x63, 0x1d, 0xe7, 0x50, 0xc0, 0x32, 0x13, 0x5c,
-	0xf5, 0x31, 0x74, 0x27, 0x70, 0x31, 0xe3, 0x81, 0xda, 0x30, 0xc8, 0x2b, 0x53, 0x09, 0x0d, 0x70,
-	0xf4, 0x93, 0x6f, 0xb3, 0xc2, 0x95, 0x76, 0x6b, 0xf1, 0x56, 0x2c, 0x98, 0xa1, 0x5d, 0x18, 0xda,
-	0xe2, 0xd3, 0x51, 0xec, 0xf5, 0x96, 0x8f, 0x3a, 0xbe, 0xa9, 0x39, 0xae, 0x27, 0x9f, 0x68, 0xc0,
-	0x92, 0x1d, 0x8b, 0xcd, 0x33, 0xa2, 0x87, 0xb8, 0x20, 0x29, 0x2e, 0xd5, 0xb2, 0xff, 0xd0, 0xa1,
-	0x8f, 0xc2, 0x48, 0x44, 0xdc, 0x30, 0x70, 0x3d, 0x9f, 0x34, 0x66, 0xe5, 0x81, 0xd8, 0x61, 0x32,
-	0xec, 0x98, 0x37, 0x09, 0x1b, 0x34, 0x70, 0x8a, 0x22, 0x5b, 0x67, 0x2a, 0xeb, 0x9e, 0x7e, 0x10,
-	0x22, 0x0e, 0x3e, 0x96, 0x0a, 0xca, 0xf1, 0x67, 0x34, 0xf9, 0x3a, 0x4b, 0xb7, 0xe1, 0x0c, 0x5f,
-	0xf4, 0x0a, 0x40, 0xb8, 0xce, 0x03, 0xf0, 0x66, 0x13, 0x71, 0x0a, 0x72, 0x98, 0x57, 0x1d, 0xe3,
-	0x99, 0xba, 0x92, 0x02, 0x36, 0xa8, 0xa1, 0xab, 0x00, 0x7c, 0xe5, 0xac, 0x75, 0x5a, 0x72, 0x43,
-	0x28, 0x53, 0x24, 0xa1, 0xae, 0x20, 0x77, 0xf7, 0xa6, 0xba, 0x7d, 0xce, 0x2c, 0xca, 0xc8, 0x78,
-	0x1c, 0x7d, 0x17, 0x0c, 0xc5, 0xed, 0x66, 0xd3, 0x51, 0x67, 0x24, 0x05, 0xe6, 0xfe, 0x72, 0xba,
-	0x86, 0x60, 0xe4, 0x0d, 0x58, 0x72, 0x44, 0xb7, 0xa8, 0x88, 0x17, 0x12, 0x8a, 0xaf, 0x22, 0x6e,
-	0xa1, 0x70, 0x4f, 0xe0, 0x07, 0xe4, 0x2e, 0x06, 0xe7, 0xe0, 0xdc, 0xdd, 0x9b, 0x7a, 0x24, 0xdd,
-	0xbe, 0x14, 0x8a, 0x6c, 0xdc, 0x5c, 0x9a, 0xe8, 0x8a, 0x2c, 0xc2, 0x45, 0x5f, 0x5b, 0xd6, 0x86,
-	0x79, 0xaf, 0x2e, 0xc2, 0xc5, 0x9a, 0x7b, 0x8f, 0x99, 0xf9, 0x30, 0x5a, 0x86, 0x93, 0x6e, 0x18,
-	0x24, 0x51, 0xe8, 0xfb, 0xbc, 0x40, 0x1f, 0xdf, 0x9b, 0xf3, 0x33, 0x94, 0xc7, 0x44, 0xb7, 0x4f,
-	0xce, 0x77, 0xa3, 0xe0, 0xbc, 0xe7, 0xa8, 0x4d, 0x9e, 0xd5, 0x0f, 0x63, 0x85, 0x1c, 0xaf, 0xa7,
-	0x68, 0x0a, 0x09, 0xa5, 0xdc, 0xde, 0x07, 0x68, 0x8a, 0x20, 0x7d, 0xc8, 0x2a, 0xbe, 0xd8, 0xf3,
-	0x30, 0x42, 0x76, 0x13, 0x12, 0x05, 0x8e, 0x7f, 0x1d, 0x2f, 0xc9, 0x03, 0x0b, 0xb6, 0x30, 0x2f,
-	0x18, 0xed, 0x38, 0x85, 0x85, 0x6c, 0xe5, 0x25, 0x33, 0xd2, 0xde, 0xb9, 0x97, 0x4c, 0xfa, 0xc4,
-	0xec, 0x9f, 0x29, 0xa7, 0x6c, 0xd6, 0x07, 0x72, 0xa4, 0xcb, 0xea, 0x2b, 0xc9, 0x42, 0x54, 0x0c,
-	0x20, 0xf6, 0x62, 0x45, 0x72, 0x56, 0x51, 0x73, 0x2b, 0x26, 0x23, 0x9c, 0xe6, 0x8b, 0xb6, 0xa1,
-	0xb2, 0x15, 0xc6, 0x89, 0xdc, 0xa1, 0x1d, 0x71, 0x33, 0x78, 0x39, 0x8c, 0x13, 0x66, 0x68, 0xa9,
-	0xd7, 0xa6, 0x2d, 0x31, 0xe6, 0x3c, 0xe8, 0xde, 0x3f, 0xde, 0x72, 0xa2, 0x46, 0x3c, 0xcf, 0x8a,
-	0x54, 0x0c, 0x30, 0x0b, 0x4b, 0xd9, 0xd3, 0x75, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x27, 0x56, 0xea,
-	0x54, 0xeb, 0x26, 0xcb, 0x38, 0xd8, 0x21, 0x01, 0x15, 0x51, 0x66, 0x8c, 0xe3, 0x37, 0x65, 0xf2,
-	0xb7, 0xdf, 0xd3, 0xab, 0x96, 0xe6, 0x6d, 0x4a, 0x61, 0x9a, 0x91, 0x30, 0xc2, 0x21, 0x3f, 0x6e,
-	0xa5, 0x13, 0xf1, 0x4b, 0x45, 0x6c, 0xdd, 0xcc, 0x62, 0x14, 0x07, 0xe6, 0xf4, 0xdb, 0x3f, 0x64,
-	0xc1, 0xd0, 0x9c, 0xe3, 0x6e, 0x87, 0x1b, 0x1b, 0xe8, 0x19, 0xa8, 0x36, 0xda, 0x91, 0x59, 0x13,
-	0x40, 0x39, 0xab, 0x16, 0x44, 0x3b, 0x56, 0x18, 0x74, 0xea, 0x6f, 0x38, 0xae, 0x2c, 0x49, 0x51,
-	0xe6, 0x53, 0xff, 0x22, 0x6b, 0xc1, 0x02, 0x42, 0x87, 0xbf, 0xe9, 0xec, 0xca, 0x87, 0xb3, 0x47,
-	0x6a, 0xcb, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xd7, 0x2c, 0x98, 0x9c, 0x73, 0x62, 0xcf, 0x9d, 0x6d,
-	0x27, 0x5b, 0x73, 0x5e, 0xb2, 0xde, 0x76, 0xb7, 0x49, 0xc2, 0x4b, 0x97, 0xd0, 0x5e, 0xb6, 0x63,
-	0xba, 0x02, 0xd5, 0x8e, 0x59, 0xf5, 0xf2, 0xba, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x01, 0xc3, 0x2d,
-	0x27, 0x8e, 0x6f, 0x87, 0x51, 0x03, 0x93, 0x8d, 0x62, 0x8a, 0x1b, 0xd5, 0x89, 0x1b, 0x91, 0x04,
-	0x93, 0x0d, 0x11, 0xa0, 0xa2, 0xe9, 0x63, 0x93, 0x99, 0xfd, 0x03, 0x16, 0x9c, 0x9a, 0x23, 0x4e,
-	0x44, 0x22, 0x56, 0x0b, 0x49, 0xbd, 0x08, 0x7a, 0x1d, 0xaa, 0x09, 0x6d, 0xa1, 0x3d, 0xb2, 0x8a,
-	0xed, 0x11, 0x0b, 0x2d, 0x59, 0x13, 0xc4, 0xb1, 0x62, 0x63, 0x7f, 0xc1, 0x82, 0x33, 0x79, 0x7d,
-	0x99, 0xf7, 0xc3, 0x76, 0xe3, 0x41, 0x74, 0xe8, 0xef, 0x5a, 0x30, 0xc2, 0x8e, 0xeb, 0x17, 0x48,
-	0xe2, 0x78, 0x7e, 0x57, 0x1d, 0x46, 0xab, 0xcf, 0x3a, 0x8c, 0xe7, 0x60, 0x60, 0x2b, 0x6c, 0x92,
-	0x6c, 0xa8, 0xc9, 0xe5, 0xb0, 0x49, 0x30, 0x83, 0xa0, 0x67, 0xe9, 0x24, 0xf4, 0x82, 0xc4, 0xa1,
-	0xcb, 0x51, 0x1e, 0x67, 0x8c, 0xf3, 0x09, 0xa8, 0x9a, 0xb1, 0x89, 0x63, 0xff, 0x72, 0x0d, 0x86,
-	0x44, 0x5c, 0x54, 0xdf, 0xa5, 0x74, 0xa4, 0x17, 0xa7, 0xd4, 0xd3, 0x8b, 0x13, 0xc3, 0xa0, 0xcb,
-	0x8a, 0xe5, 0x0a, 0x0b, 0xfd, 0x6a, 0x21, 0x81, 0x74, 0xbc, 0xfe, 0xae, 0xee, 0x16, 0xff, 0x8f,
-	0x05, 0x2b, 0xf4, 0x96, 0x05, 0xe3, 0x6e, 0x18, 0x04, 0xc4, 0xd5, 0xb6, 0xe3, 0x40, 0x11, 0x1b,
-	0x84, 0xf9, 0x34, 0x51, 0x7d, 0x12, 0x9c, 0x01, 0xe0, 0x2c, 0x7b, 0xf4, 0x12, 0x8c, 0xf2, 0x31,
-	0xbb, 0x91, 0x3a, 0x83, 0xd1, 0xe5, 0xf9, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x69, 0x7e, 0x96, 0x25,
-	0x0a, 0xe1, 0x0d, 0x6a, 0x57, 0xb5, 0x51, 0x02, 0xcf, 0xc0, 0x40, 0x11, 0xa0, 0x88, 0x6c, 0x44,
-	0x24, 0xde, 0x12, 0x71, 0x63, 0xcc, 0x6e, 0x1d, 0xba, 0xb7, 0x22, 0x18, 0xb8, 0x8b, 0x12, 0xce,
-	0xa1, 0x8e, 0xb6, 0x85, 0x1b, 0xa1, 0x5a, 0x84, 0x3c, 0x17, 0x9f, 0xb9, 0xa7, 0x37, 0x61, 0x0a,
-	0x2a, 0x4c, 0x75, 0x31, 0x7b, 0xb9, 0xcc, 0x13, 0x2f, 0x99, 0x62, 0xc3, 0xbc, 0x1d, 0x2d, 0xc0,
-	0x89, 0x4c, 0x71, 0xc1, 0x58, 0x9c, 0x95, 0xa8, 0x24, 0xbb, 0x4c, 0x59, 0xc2, 0x18, 0x77, 0x3d,
-	0x61, 0xba, 0x98, 0x86, 0x0f, 0x70, 0x31, 0x75, 0x54, 0x74, 0x32, 0x3f, 0xc5, 0x78, 0xb9, 0x90,
-	0x01, 0xe8, 0x2b, 0x14, 0xf9, 0xf3, 0x99, 0x50, 0xe4, 0x51, 0xd6, 0x81, 0x1b, 0xc5, 0x74, 0xe0,
-	0xf0, 0x71, 0xc7, 0x0f, 0x32, 0x8e, 0xf8, 0x7f, 0x5b, 0x20, 0xbf, 0xeb, 0xbc, 0xe3, 0x6e, 0x11,
-	0x3a, 0x65, 0xd0, 0x07, 0x61, 0x4c, 0x79, 0x27, 0xb8, 0x49, 0x64, 0xb1, 0x59, 0xa3, 0x6c, 0x67,
-	0x9c, 0x82, 0xe2, 0x0c, 0x36, 0x9a, 0x81, 0x1a, 0x1d, 0x27, 0xfe, 0x28, 0xd7, 0xfb, 0xca, 0x03,
-	0x32, 0xbb, 0xba, 0x28, 0x9e, 0xd2, 0x38, 0x28, 0x84, 0x09, 0xdf, 0x89, 0x13, 0xd6, 0x83, 0x7a,
-	0x27, 0x70, 0xef, 0xb1, 0x04, 0x0d, 0xcb, 0xe4, 0x5a, 0xca, 0x12, 0xc2, 0xdd, 0xb4, 0xed, 0xff,
-	0x50, 0x81, 0xd1, 0x94, 0x64, 0x3c, 0xa4, 0xc1, 0xf0, 0x0c, 0x54, 0xa5, 0x0e, 0xcf, 0xd6, 0xda,
-	0x52, 0x8a, 0x5e, 0x61, 0x50, 0xa5, 0xb5, 0xae, 0xb5, 0x6a, 0xd6, 0xc0, 0x31, 0x14, 0x2e, 0x36,
-	0xf1, 0x98, 0x50, 0x4e, 0xfc, 0x78, 0xde, 0xf7, 0x48, 0x90, 0xf0, 0x6e, 0x16, 0x23, 0x94, 0xd7,
-	0x96, 0xea, 0x26, 0x51, 0x2d, 0x94, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x3e, 0x65, 0xc1, 0xa8, 0x73,
-	0x3b, 0xd6, 0x15, 0xdd, 0x45, 0xd0, 0xf1, 0x11, 0x95, 0x54, 0xaa, 0x48, 0x3c, 0x77, 0xec, 0xa7,
-	0x9a, 0x70, 0x9a, 0x29, 0x7a, 0xdb, 0x02, 0x44, 0x76, 0x89, 0x2b, 0xc3, 0xa2, 0x45, 0x5f, 0x06,
-	0x8b, 0xd8, 0xc1, 0x5f, 0xe8, 0xa2, 0xcb, 0xa5, 0x7a, 0x77, 0x3b, 0xce, 0xe9, 0x03, 0xba, 0x02,
-	0xa8, 0xe1, 0xc5, 0xce, 0xba, 0x4f, 0xe6, 0xc3, 0xa6, 0xcc, 0x3e, 0x16, 0xe7, 0xe9, 0x67, 0xc5,
-	0x38, 0xa3, 0x85, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9b, 0x65, 0x51, 0xb8, 0xdb, 0xb9, 0x1e, 0xf9,
-	0x4c, 0x4b, 0x98, 0xb3, 0x4c, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x69, 0x59, 0x2d, 0x65, 0x9d, 0x03,
-	0xe0, 0x18, 0xb1, 0xc8, 0xd6, 0xbd, 0xc7, 0x22, 0xeb, 0x48, 0xa9, 0xee, 0x9c, 0xfa, 0x54, 0x0a,
-	0x6e, 0xe9, 0x01, 0xa5, 0xe0, 0x7e, 0xaf, 0x95, 0xaa, 0x67, 0x37, 0x7c, 0xfe, 0x95, 0x62, 0xf3,
-	0x0f, 0xa6, 0x79, 0x14, 0x57, 0x46, 0xaf, 0x64, 0x82, 0xf7, 0x9e, 0x81, 0xea, 0x86, 0xef, 0xb0,
-	0x2a, 0x2c, 0x6c, 0xa1, 0x1a, 0x11, 0x66, 0x17, 0x45, 0x3b, 0x56, 0x18, 0x54, 0xea, 0x1b, 0x44,
-	0x0f, 0x25, 0xb5, 0xff, 0x73, 0x19, 0x86, 0x0d, 0x8d, 0x9f, 0x6b, 0xbe, 0x59, 0x0f, 0x99, 0xf9,
-	0x56, 0x3a, 0x84, 0xf9, 0xf6, 0x3d, 0x50, 0x73, 0xa5, 0x36, 0x2a, 0xa6, 0x3e, 0x7f, 0x56, 0xc7,
-	0x69, 0x85, 0xa4, 0x9a, 0xb0, 0xe6, 0x89, 0x2e, 0xa5, 0xd2, 0x3c, 0x53, 0x7e, 0x81, 0xbc, 0x3c,
-	0x4c, 0xa1, 0xd1, 0xba, 0x9f, 0xc9, 0xc6, 0x07, 0x54, 0x0e, 0x8e, 0x0f, 0xb0, 0x7f, 0xdf, 0x52,
-	0x1f, 0xf7, 0x3e, 0xd4, 0xf3, 0xb9, 0x95, 0xae, 0xe7, 0x73, 0xa1, 0x90, 0x61, 0xee, 0x51, 0xc8,
-	0xe7, 0x1a, 0x0c, 0xcd, 0x87, 0xcd, 0xa6, 0x13, 0x34, 0xd0, 0xd7, 0xc3, 0x90, 0xcb, 0x7f, 0x0a,
-	0x1f, 0x1a, 0x3b, 0xac, 0x16, 0x50, 0x2c, 0x61, 0xe8, 0x71, 0x18, 0x70, 0xa2, 0x4d, 0xe9, 0x37,
-	0x63, 0x41, 0x70, 0xb3, 0xd1, 0x66, 0x8c, 0x59, 0xab, 0xfd, 0xe7, 0x16, 0x8c, 0xd1, 0x47, 0x3c,
-	0xf6, 0x52, 0xec, 0x75, 0x9e, 0x86, 0x41, 0xa7, 0x9d, 0x6c, 0x85, 0x5d, 0xfb, 0xb0, 0x59, 0xd6,
-	0x8a, 0x05, 0x94, 0xee, 0xc3, 0x54, 0x21, 0x08, 0x63, 0x1f, 0xb6, 0x40, 0xe7, 0x32, 0x83, 0x50,
-	0x53, 0x36, 0x6e, 0xaf, 0xe7, 0x9d, 0x96, 0xd6, 0x79, 0x33, 0x96, 0x70, 0x4a, 0x6c, 0x3d, 0x6c,
-	0x74, 0x44, 0x68, 0xaf, 0x22, 0x36, 0x17, 0x36, 0x3a, 0x98, 0x41, 0xd0, 0x13, 0x50, 0x8e, 0xb7,
-	0x1c, 0x79, 0x2e, 0x2f, 0xa3, 0xcc, 0xeb, 0x97, 0x67, 0x31, 0x6d, 0x57, 0x49, 0x13, 0x91, 0x9f,
-	0x8d, 0xb1, 0x4d, 0x27, 0x4d, 0x44, 0xbe, 0xfd, 0x2f, 0x07, 0x80, 0xc5, 0xdb, 0x38, 0x11, 0x69,
-	0xac, 0x85, 0xac, 0x94, 0xf0, 0xb1, 0x1e, 0x6b, 0xeb, 0x8d, 0xec, 0xc3, 0x7c, 0xb4, 0x6d, 0x1c,
-	0x6f, 0x96, 0xef, 0xf7, 0xf1, 0x66, 0xfe, 0x89, 0xf5, 0xc0, 0x43, 0x74, 0x62, 0x6d, 0x7f, 0xce,
-	0x02, 0xa4, 0xa2, 0xa7, 0x74, 0x48, 0xc9, 0x0c, 0xd4, 0x54, 0xb8, 0x96, 0x58, 0x2f, 0x5a, 0x2c,
-	0x4a, 0x00, 0xd6, 0x38, 0x7d, 0x78, 0x2f, 0x9e, 0x92, 0x3a, 0xab, 0x9c, 0xce, 0xb9, 0x60, 0x9a,
-	0x4e, 0xa8, 0x30, 0xfb, 0x57, 0x4a, 0xf0, 0x08, 0x37, 0x97, 0x96, 0x9d, 0xc0, 0xd9, 0x24, 0x4d,
-	0xda, 0xab, 0x7e, 0x83, 0x84, 0x5c, 0xba, 0x6d, 0xf6, 0x64, 0x86, 0xc4, 0x51, 0xe5, 0x15, 0x97,
-	0x33, 0x5c, 0xb2, 0x2c, 0x06, 0x5e, 0x82, 0x19, 0x71, 0x14, 0x43, 0x55, 0x5e, 0x66, 0x24, 0xf4,
-	0x4f, 0x41, 0x8c, 0x94, 0x28, 0x16, 0x96, 0x05, 0xc1, 0x8a, 0x11, 0x35, 0x1f, 0xfc, 0xd0, 0xdd,
-	0xa6, 0x4b, 0x3e, 0x6b, 0x3e, 0x2c, 0x89, 0x76, 0xac, 0x30, 0xec, 0x26, 0x8c, 0xcb, 0x31, 0x6c,
-	0x5d, 0x25, 0x1d, 0x4c, 0x36, 0xa8, 0xce, 0x75, 0x65, 0x93, 0x71, 0xbf, 0x92, 0xd2, 0xb9, 0xf3,
-	0x26, 0x10, 0xa7, 0x71, 0x65, 0x75, 0xe1, 0x52, 0x7e, 0x75, 0x61, 0xfb, 0x57, 0x2c, 0xc8, 0x2a,
-	0x7d, 0xa3, 0x96, 0xaa, 0xb5, 0x6f, 0x2d, 0xd5, 0x43, 0x54, 0x23, 0xfd, 0x4e, 0x18, 0x76, 0x12,
-	0x6a, 0xd5, 0x71, 0x0f, 0x4c, 0xf9, 0xde, 0x4e, 0x0e, 0x97, 0xc3, 0x86, 0xb7, 0xe1, 0x31, 0xcf,
-	0x8b, 0x49, 0xce, 0x7e, 0xdb, 0x82, 0xda, 0x42, 0xd4, 0x39, 0x7c, 0xaa, 0x5a, 0x77, 0x22, 0x5a,
-	0xe9, 0x50, 0x89, 0x68, 0x32, 0xd5, 0xad, 0xdc, 0x2b, 0xd5, 0xcd, 0xfe, 0x8b, 0x01, 0x98, 0xe8,
-	0xca, 0xbd, 0x44, 0x2f, 0xc2, 0x88, 0xfa, 0x4a, 0xd2, 0xed, 0x5a, 0x33, 0x83, 0x97, 0x35, 0x0c,
-	0xa7, 0x30, 0xfb, 0x58, 0xaa, 0x8b, 0x70, 0x32, 0x22, 0xaf, 0xb7, 0x49, 0x9b, 0xcc, 0x6e, 0x24,
-	0x24, 0xaa, 0x13, 0x37, 0x0c, 0x1a, 0xbc, 0x18, 0x71, 0x79, 0xee, 0xd1, 0x3b, 0x7b, 0x53, 0x27,
-	0x71, 0x37, 0x18, 0xe7, 0x3d, 0x83, 0x5a, 0x30, 0xea, 0x9b, 0xfb, 0x05, 0xb1, 0x4d, 0xbd, 0xa7,
-	0xad, 0x86, 0x9a, 0xad, 0xa9, 0x66, 0x9c, 0x66, 0x90, 0xde, 0x74, 0x54, 0x1e, 0xd0, 0xa6, 0xe3,
-	0x93, 0x7a, 0xd3, 0xc1, 0x63, 0x81, 0x3e, 0x5c, 0x70, 0xee, 0x6d, 0x3f, 0xbb, 0x8e, 0xa3, 0xec,
-	0x23, 0x5e, 0x86, 0xaa, 0x8c, 0x93, 0xec, 0x2b, 0xbe, 0xd0, 0xa4, 0xd3, 0x43, 0xb6, 0x3f, 0x0d,
-	0xef, 0xbe, 0x10, 0x45, 0xc6, 0x60, 0x5e, 0x0b, 0x93, 0x59, 0xdf, 0x0f, 0x6f, 0x53, 0x73, 0xe5,
-	0x7a, 0x4c, 0x84, 0x1f, 0xd0, 0xbe, 0x5b, 0x82, 0x9c, 0x2d, 0x35, 0x5d, 0x93, 0xda, 0x2e, 0x4c,
-	0xad, 0xc9, 0xc3, 0xd9, 0x86, 0x68, 0x97, 0xc7, 0x92, 0x72, 0x6b, 0xe0, 0x43, 0x45, 0xbb, 0x04,
-	0x74, 0x78, 0xa9, 0x92, 0x94, 0x2a, 0xc4, 0xf4, 0x3c, 0x80, 0x36, 0xe7, 0x85, 0x4d, 0xa8, 0x82,
-	0x43, 0xb4, 0xd5, 0x8f, 0x0d, 0x2c, 0xf4, 0x02, 0x0c, 0x7b, 0x41, 0x9c, 0x38, 0xbe, 0x7f, 0xd9,
-	0x0b, 0x12, 0x61, 0x27, 0x2a, 0xb3, 0x67, 0x51, 0x83, 0xb0, 0x89, 0x77, 0xf6, 0x03, 0xc6, 0xf7,
-	0x3b, 0xcc, 0x77, 0xdf, 0x82, 0x33, 0x97, 0xbc, 0x44, 0x25, 0x29, 0xaa, 0xf9, 0x46, 0xad, 0x75,
-	0x25, 0xab, 0xac, 0x9e, 0x69, 0xb9, 0x46, 0x92, 0x60, 0x29, 0x9d, 0xd3, 0x98, 0x4d, 0x12, 0xb4,
-	0x5d, 0x38, 0x75, 0xc9, 0x4b, 0x2e, 0x7a, 0x3e, 0x39, 0x46, 0x26, 0xbf, 0x34, 0x08, 0x23, 0x66,
-	0xee, 0xfe, 0x61, 0x24, 0xfb, 0x17, 0xa8, 0x1d, 0x2b, 0x06, 0xc2, 0x53, 0x07, 0xde, 0x37, 0x8f,
-	0x5c, 0x48, 0x20, 0x7f, 0x70, 0x0d, 0x53, 0x56, 0xf3, 0xc4, 0x66, 0x07, 0xd0, 0x6d, 0xa8, 0x6c,
-	0xb0, 0x7c, 0xb7, 0x72, 0x11, 0xa1, 0x4a, 0x79, 0x83, 0xaf, 0x57, 0x2e, 0xcf, 0x98, 0xe3, 0xfc,
-	0xa8, 0xf9, 0x11, 0xa5, 0xd3, 0xac, 0x8d, 0x2c, 0x04, 0xa1, 0xd7, 0x14, 0x46, 0x2f, 0xed, 0x51,
-	0xb9, 0x07, 0xed, 0x91, 0x92, 0xe5, 0x83, 0x0f, 0x48, 0x96, 0xb3, 0xdc, 0xc5, 0x64, 0x8b, 0x19,
-	0xc7, 0x22, 0x6d, 0x6a, 0x88, 0x0d, 0x82, 0x91, 0xbb, 0x98, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x98,
-	0xd2, 0x06, 0xd5, 0x22, 0x0e, 0x14, 0xcc, 0x19, 0x7d, 0xdc, 0x8a, 0xe0, 0x73, 0x25, 0x18, 0xbb,
-	0x14, 0xb4, 0x57, 0x2f, 0xad, 0xb6, 0xd7, 0x7d, 0xcf, 0xbd, 0x4a, 0x3a, 0x54, 0xda, 0x6f, 0x93,
-	0xce, 0xe2, 0x82, 0x58, 0x41, 0x6a, 0xce, 0x5c, 0xa5, 0x8d, 0x98, 0xc3, 0xa8, 0xdc, 0xda, 0xf0,
-	0x82, 0x4d, 0x12, 0xb5, 0x22, 0x4f, 0xf8, 0xfa, 0x0d, 0xb9, 0x75, 0x51, 0x83, 0xb0, 0x89, 0x47,
-	0x69, 0x87, 0xb7, 0x03, 0x55, 0x48, 0x49, 0xd1, 0x5e, 0xa1, 0x8d, 0x98, 0xc3, 0x28, 0x52, 0x12,
-	0xb5, 0x85, 0x2b, 0xcd, 0x40, 0x5a, 0xa3, 0x8d, 0x98, 0xc3, 0xc4, 0x2e, 0x9d, 0x45, 0x82, 0x55,
-	0xba, 0x76, 0xe9, 0x2c, 0x88, 0x42, 0xc2, 0x29, 0xea, 0x36, 0xe9, 0x2c, 0x38, 0x89, 0x93, 0xdd,
-	0x64, 0x5f, 0xe5, 0xcd, 0x58, 0xc2, 0x59, 0x65, 0xe5, 0xf4, 0x70, 0x7c, 0xcd, 0x55, 0x56, 0x4e,
-	0x77, 0xbf, 0x87, 0x43, 0xe6, 0xef, 0x94, 0x60, 0xe4, 0x9d, 0xeb, 0x4f, 0x73, 0x2e, 0xf6, 0xb9,
-	0x09, 0x13, 0x5d, 0x19, 0xd3, 0x7d, 0x58, 0x48, 0x07, 0x56, 0xb4, 0xb0, 0x31, 0x0c, 0x53, 0xc2,
-	0xb2, 0xa2, 0xe0, 0x3c, 0x4c, 0xf0, 0xc5, 0x4b, 0x39, 0xb1, 0x04, 0x58, 0x95, 0x05, 0xcf, 0x0e,
-	0xb3, 0x6e, 0x64, 0x81, 0xb8, 0x1b, 0xdf, 0xfe, 0xbc, 0x05, 0xa3, 0xa9, 0x24, 0xf6, 0x82, 0x6c,
-	0x39, 0xb6, 0xba, 0x43, 0x16, 0xc5, 0xcc, 0xb2, 0x4a, 0xca, 0x4c, 0x0d, 0xeb, 0xd5, 0xad, 0x41,
-	0xd8, 0xc4, 0xb3, 0x7f, 0xb3, 0x0c, 0x55, 0x19, 0x71, 0xd5, 0x47, 0x57, 0x3e, 0x6b, 0xc1, 0xa8,
-	0x3a, 0x40, 0x64, 0x1e, 0xdf, 0x52, 0x11, 0x39, 0x75, 0xb4, 0x07, 0xca, 0x7f, 0x12, 0x6c, 0x84,
-	0x7a, 0x63, 0x81, 0x4d, 0x66, 0x38, 0xcd, 0x1b, 0xdd, 0x00, 0x88, 0x3b, 0x71, 0x42, 0x9a, 0x86,
-	0xef, 0xd9, 0x36, 0x66, 0xd9, 0xb4, 0x1b, 0x46, 0x84, 0xce, 0xa9, 0x6b, 0x61, 0x83, 0xd4, 0x15,
-	0xa6, 0xb6, 0xf0, 0x74, 0x1b, 0x36, 0x28, 0xa1, 0x37, 0xd4, 0x71, 0xf7, 0x40, 0x11, 0x7a, 0x5d,
-	0x8e, 0x6f, 0x3f, 0xe7, 0xdd, 0x47, 0x38, 0x5f, 0xb6, 0x7f, 0xba, 0x04, 0x27, 0xb2, 0x23, 0x89,
-	0x3e, 0x0c, 0x23, 0x72, 0xd0, 0x0c, 0x37, 0x83, 0x0c, 0x73, 0x1b, 0xc1, 0x06, 0xec, 0xee, 0xde,
-	0xd4, 0x54, 0xf7, 0x3d, 0xda, 0xd3, 0x26, 0x0a, 0x4e, 0x11, 0xe3, 0x87, 0xcf, 0x22, 0x4a, 0x62,
-	0xae, 0x33, 0xdb, 0x6a, 0x89, 0x13, 0x64, 0xe3, 0xf0, 0xd9, 0x84, 0xe2, 0x0c, 0x36, 0x5a, 0x85,
-	0x53, 0x46, 0xcb, 0x35, 0xe2, 0x6d, 0x6e, 0xad, 0x87, 0x91, 0xdc, 0xd7, 0x3e, 0xae, 0x83, 0x6a,
-	0xbb, 0x71, 0x70, 0xee, 0x93, 0xd4, 0x30, 0x72, 0x9d, 0x96, 0xe3, 0x7a, 0x49, 0x47, 0x9c, 0x01,
-	0x28, 0x31, 0x3e, 0x2f, 0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xe1, 0x00, 0x9c, 0xe0, 0x51, 0xa4, 0x44,
-	0x05, 0x49, 0xa3, 0x0f, 0x43, 0x2d, 0x4e, 0x9c, 0x88, 0x3b, 0x35, 0xac, 0x43, 0x8b, 0x2e, 0x9d,
-	0x79, 0x2f, 0x89, 0x60, 0x4d, 0x0f, 0xbd, 0xc2, 0xca, 0x96, 0x79, 0xf1, 0x16, 0xa3, 0x5e, 0xba,
-	0x37, 0x97, 0xc9, 0x45, 0x45, 0x01, 0x1b, 0xd4, 0xd0, 0xb7, 0x40, 0xa5, 0xb5, 0xe5, 0xc4, 0xd2,
-	0x9f, 0xf7, 0xb4, 0x94, 0x13, 0xab, 0xb4, 0xf1, 0xee, 0xde, 0xd4, 0xe9, 0xec, 0xab, 0x32, 0x00,
-	0xe6, 0x0f, 0x99, 0x52, 0x7e, 0xe0, 0xe0, 0x7b, 0x79, 0x1a, 0x51, 0xa7, 0x7e, 0x79, 0x36, 0x7b,
-	0x93, 0xcb, 0x02, 0x6b, 0xc5, 0x02, 0x4a, 0x65, 0xd2, 0x16, 0x67, 0xd9, 0xa0, 0xc8, 0x83, 0x69,
-	0x8b, 0xe3, 0xb2, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0xae, 0x3b, 0xc6, 0x78, 0xe8, 0x18, 0x72, 0x50,
-	0xfa, 0x8d, 0x2e, 0xbe, 0x00, 0x35, 0xd1, 0xd5, 0xb5, 0x10, 0xbd, 0x08, 0x23, 0xdc, 0x5d, 0x34,
-	0x17, 0x39, 0x81, 0xbb, 0x95, 0x75, 0xf2, 0xac, 0x19, 0x30, 0x9c, 0xc2, 0xb4, 0x97, 0x61, 0xa0,
-	0x4f, 0x21, 0xdb, 0xd7, 0xde, 0xfd, 0x65, 0xa8, 0x52, 0x72, 0x72, 0x83, 0x56, 0x04, 0xc9, 0x10,
-	0xaa, 0xf2, 0x96, 0x47, 0x64, 0x43, 0xd9, 0x73, 0x64, 0x2c, 0x89, 0x5a, 0x42, 0x8b, 0x71, 0xdc,
-	0x66, 0xd3, 0x8e, 0x02, 0xd1, 0x53, 0x50, 0x26, 0xbb, 0xad, 0x6c, 0xd0, 0xc8, 0x85, 0xdd, 0x96,
-	0x17, 0x91, 0x98, 0x22, 0x91, 0xdd, 0x16, 0x3a, 0x0b, 0x25, 0xaf, 0x21, 0x66, 0x24, 0x08, 0x9c,
-	0xd2, 0xe2, 0x02, 0x2e, 0x79, 0x0d, 0x7b, 0x17, 0x6a, 0xea, 0x5a, 0x49, 0xb4, 0x2d, 0x4d, 0x2a,
-	0xab, 0x88, 0x28, 0x62, 0x49, 0xb7, 0x87, 0x31, 0xd5, 0x06, 0xd0, 0x25, 0x1d, 0x8a, 0x52, 0xc1,
-	0xe7, 0x60, 0xc0, 0x0d, 0x45, 0x31, 0x9e, 0xaa, 0x26, 0xc3, 0x6c, 0x29, 0x06, 0xb1, 0x6f, 0xc2,
-	0xd8, 0xd5, 0x20, 0xbc, 0xcd, 0x6e, 0x7f, 0x62, 0xc5, 0x8e, 0x29, 0xe1, 0x0d, 0xfa, 0x23, 0x6b,
-	0xb9, 0x33, 0x28, 0xe6, 0x30, 0x55, 0x86, 0xb5, 0xd4, 0xab, 0x0c, 0xab, 0xfd, 0x71, 0x0b, 0x46,
-	0x54, 0x6e, 0xf8, 0xa5, 0x9d, 0x6d, 0x4a, 0x77, 0x33, 0x0a, 0xdb, 0xad, 0x2c, 0x5d, 0x76, 0x83,
-	0x2d, 0xe6, 0x30, 0xb3, 0x68, 0x42, 0xe9, 0x80, 0xa2, 0x09, 0xe7, 0x60, 0x60, 0xdb, 0x0b, 0x1a,
-	0x59, 0xa7, 0xe8, 0x55, 0x2f, 0x68, 0x60, 0x06, 0xb1, 0xff, 0xd2, 0x82, 0x13, 0xaa, 0x0b, 0xd2,
-	0x66, 0x7a, 0x11, 0x46, 0xd6, 0xdb, 0x9e, 0xdf, 0x90, 0x55, 0x9c, 0x33, 0xcb, 0x65, 0xce, 0x80,
-	0xe1, 0x14, 0x26, 0x3a, 0x0f, 0xb0, 0xee, 0x05, 0x4e, 0xd4, 0x59, 0xd5, 0x46, 0x9a, 0xd2, 0xdb,
-	0x73, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x26, 0x54, 0x77, 0xe4, 0xe9, 0x6d, 0xb9, 0xd0, 0x5c, 0x7f,
-	0x31, 0x1e, 0x7a, 0x25, 0xa8, 0xe3, 0x60, 0xc5, 0xd1, 0xfe, 0x62, 0x19, 0xc6, 0xd2, 0xf9, 0xf9,
-	0x7d, 0x78, 0x4e, 0x9e, 0x82, 0x0a, 0x4b, 0xd9, 0xcf, 0x4e, 0x2c, 0x5e, 0x76, 0x99, 0xc3, 0x50,
-	0x0c, 0x83, 0x5c, 0x94, 0x14, 0x73, 0x07, 0xa9, 0xea, 0xa4, 0xf2, 0xe3, 0xb2, 0x48, 0x6f, 0xe1,
-	0x16, 0x17, 0xac, 0xd0, 0xa7, 0x2c, 0x18, 0x0a, 0x5b, 0x66, 0xfd, 0xcf, 0x0f, 0x15, 0x59, 0xbb,
-	0x40, 0x24, 0x08, 0x0b, 0x6b, 0x48, 0x4d, 0x3c, 0x39, 0x19, 0x24, 0xeb, 0xb3, 0xdf, 0x0c, 0x23,
-	0x26, 0xe6, 0x41, 0x06, 0x51, 0xd5, 0x34, 0x88, 0x3e, 0x6b, 0x4e, 0x49, 0x51, 0x9d, 0xa1, 0x8f,
-	0xc5, 0x7e, 0x1d, 0x2a, 0xae, 0x0a, 0x87, 0xbb, 0xa7, 0x9b, 0x07, 0x54, 0xf5, 0x32, 0x16, 0x6a,
-	0xc0, 0xa9, 0xd9, 0xbf, 0x6f, 0x19, 0xf3, 0x03, 0x93, 0x78, 0xb1, 0x81, 0x22, 0x28, 0x6f, 0xee,
-	0x6c, 0x0b, 0x23, 0xe3, 0x4a, 0x41, 0xc3, 0x7b, 0x69, 0x67, 0x5b, 0xaf, 0x30, 0xb3, 0x15, 0x53,
-	0x66, 0x7d, 0x1c, 0x36, 0xa4, 0x8a, 0x78, 0x94, 0x0f, 0x2e, 0xe2, 0x61, 0xbf, 0x5d, 0x82, 0x89,
-	0xae, 0x49, 0x85, 0xde, 0x80, 0x4a, 0x44, 0xdf, 0x52, 0xbc, 0xde, 0x52, 0x61, 0x65, 0x37, 0xe2,
-	0xc5, 0x86, 0x56, 0xde, 0xe9, 0x76, 0xcc, 0x59, 0xa2, 0x2b, 0x80, 0x74, 0xd0, 0xa6, 0x3a, 0xe9,
-	0xe0, 0xaf, 0xac, 0x22, 0xbb, 0x66, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xe8, 0xa5, 0xec, 0x81, 0x49,
-	0xa6, 0xa2, 0xf4, 0x7e, 0x67, 0x1f, 0xf6, 0x5b, 0xe6, 0x14, 0xbc, 0xa1, 0x85, 0xe9, 0x51, 0x37,
-	0xa7, 0x5d, 0x92, 0xb5, 0xdc, 0xaf, 0x64, 0xb5, 0x7f, 0xa1, 0x04, 0xa3, 0xa9, 0x0a, 0xb1, 0xc8,
-	0x87, 0x2a, 0xf1, 0xd9, 0xc9, 0xae, 0xd4, 0xbe, 0x47, 0xbd, 0x2c, 0x46, 0xc9, 0xc9, 0x0b, 0x82,
-	0x2e, 0x56, 0x1c, 0x1e, 0x8e, 0x18, 0xb4, 0x17, 0x61, 0x44, 0x76, 0xe8, 0x43, 0x4e, 0xd3, 0xcf,
-	0x0e, 0xdf, 0x05, 0x03, 0x86, 0x53, 0x98, 0xf6, 0xaf, 0x96, 0x61, 0x92, 0x1f, 0x85, 0x37, 0xd4,
-	0x62, 0x50, 0x21, 0x2d, 0x3f, 0xa8, 0xeb, 0x38, 0x5b, 0x45, 0xdc, 0x88, 0xde, 0x8b, 0x51, 0x5f,
-	0xa1, 0xd3, 0x3f, 0x9e, 0x09, 0x9d, 0xe6, 0x5b, 0xf5, 0xcd, 0x63, 0xea, 0xd1, 0xd7, 0x56, 0x2c,
-	0xf5, 0x3f, 0x2d, 0xc1, 0x78, 0xe6, 0xe2, 0x3b, 0xf4, 0xc5, 0xf4, 0x5d, 0x29, 0x56, 0x11, 0xc7,
-	0x84, 0xfb, 0xde, 0x85, 0x76, 0xb8, 0x1b, 0x53, 0x1e, 0xd0, 0x52, 0xb1, 0x7f, 0xb7, 0x04, 0x63,
-	0xe9, 0x1b, 0xfb, 0x1e, 0xc2, 0x91, 0x7a, 0x1f, 0xd4, 0xd8, 0xa5, 0x54, 0x57, 0x49, 0x47, 0x9e,
-	0x32, 0xf2, 0xfb, 0x7f, 0x64, 0x23, 0xd6, 0xf0, 0x87, 0xe2, 0x22, 0x1a, 0xfb, 0x9f, 0x5b, 0x70,
-	0x9a, 0xbf, 0x65, 0x76, 0x1e, 0xfe, 0xad, 0xbc, 0xd1, 0x7d, 0xb5, 0xd8, 0x0e, 0x66, 0xea, 0x8f,
-	0x1f, 0x34, 0xbe, 0xec, 0x5e, 0x78, 0xd1, 0xdb, 0xf4, 0x54, 0x78, 0x08, 0x3b, 0x7b, 0xa8, 0xc9,
-	0x60, 0xff, 0xc7, 0x12, 0x0c, 0xaf, 0xcc, 0x2f, 0x2a, 0x11, 0x3e, 0x03, 0x35, 0x37, 0x22, 0x8e,
-	0x76, 0xff, 0x98, 0x81, 0x56, 0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0x40, 0xc5, 0x38, 0xbb,
-	0x8b, 0xe2, 0x71, 0x8c, 0x31, 0x96, 0x70, 0xf4, 0x0c, 0x54, 0x59, 0x0a, 0xf1, 0xf5, 0x48, 0x6a,
-	0x1c, 0xbd, 0xb5, 0x66, 0xed, 0x78, 0x09, 0x2b, 0x0c, 0x4a, 0xb8, 0x11, 0xba, 0x31, 0x45, 0xce,
-	0x78, 0x64, 0x16, 0x68, 0x33, 0x5e, 0xc2, 0x12, 0xce, 0x2a, 0x40, 0x32, 0xaf, 0x05, 0x45, 0xae,
-	0xa4, 0x3b, 0xcd, 0xdd, 0x1b, 0x14, 0x5d, 0xe3, 0x1c, 0xa6, 0x52, 0x68, 0x26, 0x8d, 0x6f, 0xa8,
-	0xbf, 0x34, 0x3e, 0xfb, 0x77, 0xcb, 0x50, 0xd3, 0x4e, 0x35, 0x4f, 0xd4, 0xcd, 0x28, 0xa4, 0xbe,
-	0x7d, 0xbd, 0x13, 0xb8, 0x8a, 0x34, 0x8f, 0x26, 0x30, 0xca, 0x66, 0x7c, 0xbf, 0x05, 0xc3, 0x5e,
-	0xe0, 0x25, 0x9e, 0xc3, 0x7c, 0x83, 0xc5, 0xdc, 0x13, 0xae, 0xd8, 0x2d, 0x72, 0xca, 0x61, 0x64,
-	0x1e, 0xf9, 0x2b, 0x66, 0xd8, 0xe4, 0x8c, 0x3e, 0x2a, 0xb2, 0xc6, 0xca, 0x85, 0x15, 0x9f, 0xa9,
-	0x66, 0x52, 0xc5, 0x5a, 0xd4, 0xc6, 0x4e, 0xa2, 0x82, 0x6a, 0x36, 0x61, 0x4a, 0x4a, 0xdd, 0xb3,
-	0xa2, 0x76, 0x31, 0xac, 0x19, 0x73, 0x46, 0x76, 0x0c, 0xa8, 0x7b, 0x2c, 0x0e, 0x99, 0x91, 0x33,
-	0x03, 0x35, 0xa7, 0x9d, 0x84, 0x4d, 0x3a, 0x4c, 0x22, 0x60, 0x40, 0xe7, 0x1c, 0x49, 0x00, 0xd6,
-	0x38, 0xf6, 0x17, 0x2b, 0x90, 0xa9, 0x62, 0x81, 0x76, 0xa1, 0xa6, 0xea, 0x58, 0x14, 0x93, 0xe1,
-	0xaa, 0x67, 0x94, 0xea, 0x8c, 0x6a, 0xc2, 0x9a, 0x19, 0xda, 0x94, 0x6e, 0x56, 0xbe, 0xda, 0x5f,
-	0xce, 0xba, 0x59, 0xbf, 0xbd, 0xbf, 0x53, 0x37, 0x3a, 0x57, 0x67, 0x78, 0xdd, 0xc2, 0xe9, 0x03,
-	0x3d, 0xb2, 0x07, 0xdd, 0x94, 0xfe, 0x09, 0x71, 0xab, 0x19, 0x26, 0x71, 0xdb, 0x4f, 0xc4, 0x6c,
-	0x78, 0xb9, 0xc0, 0x55, 0xc6, 0x09, 0xeb, 0x6a, 0x50, 0xfc, 0x3f, 0x36, 0x98, 0xa6, 0xfd, 0xe6,
-	0x83, 0xc7, 0xea, 0x37, 0x1f, 0x2a, 0xd4, 0x6f, 0x7e, 0x1e, 0x80, 0xcd, 0x6d, 0x9e, 0x39, 0x50,
-	0x65, 0xee, 0x4c, 0xa5, 0x62, 0xb0, 0x82, 0x60, 0x03, 0xcb, 0xfe, 0x46, 0x48, 0x97, 0x33, 0x43,
-	0x53, 0xb2, 0x7a, 0x1a, 0x3f, 0x11, 0x64, 0x49, 0x9b, 0xa9, 0x42, 0x67, 0x3f, 0x67, 0x81, 0x59,
-	0x73, 0x0d, 0xbd, 0xce, 0x8b, 0xbb, 0x59, 0x45, 0x9c, 0x30, 0x19, 0x74, 0xa7, 0x97, 0x9d, 0x56,
-	0x26, 0xda, 0x49, 0x56, 0x78, 0x3b, 0xfb, 0x01, 0xa8, 0x4a, 0xe8, 0xa1, 0x8c, 0xe5, 0x8f, 0xc1,
-	0x49, 0x59, 0x00, 0x42, 0x1e, 0x06, 0x89, 0xa8, 0x83, 0x83, 0x7d, 0x8c, 0xd2, 0x71, 0x58, 0xea,
-	0xe5, 0x38, 0x54, 0xbb, 0xe1, 0x72, 0xcf, 0xb2, 0xed, 0x3f, 0x6f, 0xc1, 0xb9, 0x6c, 0x07, 0xe2,
-	0xe5, 0x30, 0xf0, 0x92, 0x30, 0xaa, 0x93, 0x24, 0xf1, 0x82, 0x4d, 0x56, 0x83, 0xf7, 0xb6, 0x13,
-	0xc9, 0x7b, 0x98, 0x98, 0xa0, 0xbc, 0xe9, 0x44, 0x01, 0x66, 0xad, 0xa8, 0x03, 0x83, 0x3c, 0xd4,
-	0x5a, 0xec, 0x82, 0x8e, 0xb8, 0x36, 0x72, 0x86, 0x43, 0x6f, 0xc3, 0x78, 0x98, 0x37, 0x16, 0x0c,
-	0xed, 0xaf, 0x58, 0x80, 0x56, 0x76, 0x48, 0x14, 0x79, 0x0d, 0x23, 0x38, 0x9c, 0xdd, 0x0e, 0x6a,
-	0xdc, 0x02, 0x6a, 0x96, 0x27, 0xc9, 0xdc, 0x0e, 0x6a, 0xfc, 0xcb, 0xbf, 0x1d, 0xb4, 0x74, 0xb8,
-	0xdb, 0x41, 0xd1, 0x0a, 0x9c, 0x6e, 0xf2, 0x6d, 0x1c, 0xbf, 0x71, 0x8f, 0xef, 0xe9, 0x54, 0x26,
-	0xfd, 0x99, 0x3b, 0x7b, 0x53, 0xa7, 0x97, 0xf3, 0x10, 0x70, 0xfe, 0x73, 0xf6, 0x07, 0x00, 0xf1,
-	0x98, 0xf0, 0xf9, 0xbc, 0xb0, 0xd6, 0x9e, 0x6e, 0x0e, 0xfb, 0xc7, 0x2a, 0x30, 0x9e, 0xb9, 0xa5,
-	0x83, 0x6e, 0xa1, 0xbb, 0xe3, 0x68, 0x8f, 0xac, 0xbf, 0xbb, 0xbb, 0xd7, 0x57, 0x64, 0x6e, 0x00,
-	0x15, 0x2f, 0x68, 0xb5, 0x93, 0x62, 0x0a, 0x79, 0xf0, 0x4e, 0x2c, 0x52, 0x82, 0xc6, 0xb9, 0x04,
-	0xfd, 0x8b, 0x39, 0x9b, 0x22, 0xe3, 0x7c, 0x53, 0x9b, 0x9c, 0x81, 0x07, 0xe4, 0x66, 0xf9, 0x84,
-	0x8e, 0xba, 0xad, 0x14, 0xe1, 0x43, 0xce, 0x4c, 0x96, 0xe3, 0x0e, 0xb5, 0xfa, 0x99, 0x12, 0x0c,
-	0x1b, 0x1f, 0x0d, 0xfd, 0x44, 0xba, 0x22, 0xa9, 0x55, 0xdc, 0x2b, 0x31, 0xfa, 0xd3, 0xba, 0xe6,
-	0x28, 0x7f, 0xa5, 0xa7, 0xbb, 0x8b, 0x91, 0xde, 0xdd, 0x9b, 0x3a, 0x91, 0x29, 0x37, 0x9a, 0x2a,
-	0x50, 0x7a, 0xf6, 0xbb, 0x61, 0x3c, 0x43, 0x26, 0xe7, 0x95, 0xd7, 0xcc, 0x57, 0x3e, 0xb2, 0xbb,
-	0xcf, 0x1c, 0xb2, 0x2f, 0xd3, 0x21, 0x13, 0xf5, 0x03, 0x42, 0x9f, 0xf4, 0xe1, 0xeb, 0xcc, 0xec,
-	0x2f, 0x4a, 0x7d, 0x96, 0x09, 0x79, 0x2f, 0x54, 0x5b, 0xa1, 0xef, 0xb9, 0x9e, 0x2a, 0x68, 0xce,
-	0x0a, 0x93, 0xac, 0x8a, 0x36, 0xac, 0xa0, 0xe8, 0x36, 0xd4, 0x6e, 0xdd, 0x4e, 0xf8, 0x31, 0xa3,
-	0x38, 0xca, 0x28, 0xea, 0x74, 0x51, 0x19, 0x2d, 0xea, 0x1c, 0x13, 0x6b, 0x5e, 0xc8, 0x86, 0x41,
-	0xa6, 0x04, 0x65, 0x2e, 0x21, 0x3b, 0x66, 0x61, 0xda, 0x31, 0xc6, 0x02, 0x62, 0xff, 0xfb, 0x61,
-	0x38, 0x95, 0x77, 0x55, 0x12, 0x7a, 0x13, 0x06, 0x79, 0x1f, 0x8b, 0xb9, 0x8d, 0x2f, 0x8f, 0xc7,
-	0x25, 0x46, 0x50, 0x74, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee, 0x3b, 0xeb, 0x62, 0x86, 0x1c,
-	0x0f, 0xf7, 0x25, 0x47, 0x73, 0x5f, 0x72, 0x38, 0x77, 0xdf, 0x59, 0x47, 0xbb, 0x50, 0xd9, 0xf4,
-	0x12, 0xe2, 0x08, 0xe7, 0xcc, 0xcd, 0x63, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x33,
-	0x44, 0x5f, 0xb2, 0x60, 0x7c, 0x3d, 0x5d, 0x9f, 0x48, 0x08, 0x4f, 0xe7, 0x18, 0xae, 0xc3, 0x4a,
-	0x33, 0xe2, 0xd7, 0xe3, 0x66, 0x1a, 0x71, 0xb6, 0x3b, 0xe8, 0x93, 0x16, 0x0c, 0x6d, 0x78, 0xbe,
-	0x71, 0xdf, 0xc8, 0x31, 0x7c, 0x9c, 0x8b, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x31, 0x96, 0x9c,
-	0x7b, 0x69, 0xaa, 0xc1, 0xa3, 0x6a, 0xaa, 0xa1, 0x07, 0xa4, 0xa9, 0x3e, 0x63, 0x41, 0x4d, 0x8d,
-	0xb4, 0xa8, 0xf3, 0xf2, 0xe1, 0x63, 0xfc, 0xe4, 0xdc, 0x23, 0xa5, 0xfe, 0x62, 0xcd, 0x1c, 0xbd,
-	0x65, 0xc1, 0xb0, 0xf3, 0x46, 0x3b, 0x22, 0x0d, 0xb2, 0x13, 0xb6, 0x62, 0x51, 0x80, 0xf5, 0xd5,
-	0xe2, 0x3b, 0x33, 0x4b, 0x99, 0x2c, 0x90, 0x9d, 0x95, 0x56, 0x2c, 0xf2, 0x9c, 0x75, 0x03, 0x36,
-	0xbb, 0x80, 0xbe, 0x4f, 0xeb, 0x71, 0x28, 0xa2, 0x0c, 0x77, 0x5e, 0x6f, 0xfa, 0x4a, 0xdb, 0x27,
-	0xf0, 0x98, 0x1b, 0x06, 0x89, 0x17, 0xb4, 0xc9, 0x4a, 0x80, 0x49, 0x2b, 0xbc, 0x16, 0x26, 0x17,
-	0xc3, 0x76, 0xd0, 0xb8, 0x10, 0x45, 0x61, 0xc4, 0x0a, 0xd9, 0x18, 0x97, 0xb0, 0xce, 0xf7, 0x46,
-	0xc5, 0xfb, 0xd1, 0x39, 0x8a, 0xcd, 0xb0, 0x57, 0x82, 0xa9, 0x03, 0x06, 0x1b, 0xbd, 0x08, 0x23,
-	0x61, 0xb4, 0xe9, 0x04, 0xde, 0x1b, 0x66, 0x6d, 0x36, 0x65, 0x90, 0xae, 0x18, 0x30, 0x9c, 0xc2,
-	0x34, 0x8b, 0xf6, 0x94, 0x0e, 0x28, 0xda, 0x73, 0x0e, 0x06, 0x22, 0xd2, 0x0a, 0xb3, 0xfb, 0x2a,
-	0x96, 0x9a, 0xc8, 0x20, 0xe8, 0x09, 0x28, 0x3b, 0x2d, 0x4f, 0x38, 0x17, 0xd5, 0x76, 0x71, 0x76,
-	0x75, 0x11, 0xd3, 0xf6, 0x54, 0x0d, 0xb1, 0xca, 0x7d, 0xa9, 0x21, 0x46, 0x35, 0xa6, 0x38, 0x3e,
-	0x1b, 0xd4, 0x1a, 0x33, 0x7d, 0xac, 0x65, 0xbf, 0x5d, 0x86, 0x27, 0xf6, 0x5d, 0x5a, 0x3a, 0x64,
-	0xdd, 0xda, 0x27, 0x64, 0x5d, 0x0e, 0x4f, 0xe9, 0xa0, 0xe1, 0x29, 0xf7, 0x18, 0x9e, 0x4f, 0x52,
-	0x89, 0x21, 0x6b, 0xda, 0x15, 0x73, 0x91, 0x7c, 0xaf, 0x12, 0x79, 0x42, 0x58, 0x48, 0x28, 0xd6,
-	0x7c, 0xe9, 0x76, 0x29, 0x55, 0xb0, 0xa6, 0x52, 0x84, 0xc6, 0xec, 0x59, 0x57, 0x8e, 0x8b, 0x89,
-	0x5e, 0x55, 0x70, 0xec, 0x5f, 0x1c, 0x80, 0xa7, 0xfa, 0x50, 0x74, 0xe6, 0x2c, 0xb6, 0xfa, 0x9c,
-	0xc5, 0x5f, 0xe3, 0x9f, 0xe9, 0xd3, 0xb9, 0x9f, 0x09, 0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec,
-	0x04, 0x22, 0x88, 0x89, 0xdb, 0x8e, 0x78, 0xfa, 0x8e, 0x91, 0xb7, 0xbc, 0x28, 0xda, 0xb1, 0xc2,
-	0xa0, 0xdb, 0x5f, 0xd7, 0xa1, 0xcb, 0x7f, 0xa8, 0xa0, 0x02, 0x25, 0x66, 0x0a, 0x34, 0xb7, 0xbe,
-	0xe6, 0x67, 0xa9, 0x04, 0xe0, 0x6c, 0xec, 0x5f, 0xb3, 0xe0, 0x6c, 0x6f, 0x6b, 0x04, 0x3d, 0x0b,
-	0xc3, 0xeb, 0x2c, 0x98, 0x72, 0x99, 0x85, 0x4c, 0x89, 0xa9, 0xc3, 0xde, 0x57, 0x37, 0x63, 0x13,
-	0x07, 0xcd, 0xc3, 0x84, 0x19, 0x85, 0xb9, 0x6c, 0xc4, 0x5a, 0x31, 0x7f, 0xc9, 0x5a, 0x16, 0x88,
-	0xbb, 0xf1, 0xd1, 0x34, 0x40, 0xe2, 0x25, 0x3e, 0xe1, 0x4f, 0xf3, 0x89, 0xc6, 0x1c, 0x8a, 0x6b,
-	0xaa, 0x15, 0x1b, 0x18, 0xf6, 0x57, 0xcb, 0xf9, 0xaf, 0xc1, 0xad, 0xdc, 0xc3, 0xcc, 0x7e, 0x31,
-	0xb7, 0x4b, 0x7d, 0x48, 0xe8, 0xf2, 0xfd, 0x96, 0xd0, 0x03, 0xbd, 0x24, 0x34, 0x5a, 0x80, 0x13,
-	0xc6, 0xb5, 0xae, 0xbc, 0xc4, 0x0d, 0x3f, 0x94, 0x52, 0xf5, 0xe9, 0x56, 0x33, 0x70, 0xdc, 0xf5,
-	0xc4, 0x43, 0x3e, 0x55, 0x7f, 0xbd, 0x04, 0x67, 0x7a, 0x6e, 0x2c, 0xee, 0x93, 0x06, 0x32, 0x3f,
-	0xff, 0xc0, 0xfd, 0xf9, 0xfc, 0xe6, 0x47, 0xa9, 0x1c, 0xf8, 0x51, 0xfa, 0x51, 0xe7, 0xbf, 0x57,
-	0xea, 0xb9, 0x58, 0xe8, 0x46, 0xf4, 0xaf, 0xec, 0x48, 0xbe, 0x04, 0xa3, 0x4e, 0xab, 0xc5, 0xf1,
-	0x58, 0x66, 0x46, 0xa6, 0x66, 0xe6, 0xac, 0x09, 0xc4, 0x69, 0xdc, 0xbe, 0x06, 0xf6, 0x8f, 0x2c,
-	0xa8, 0x61, 0xb2, 0xc1, 0x25, 0x1c, 0xba, 0x25, 0x86, 0xc8, 0x2a, 0xe2, 0xe2, 0x02, 0x3a, 0xb0,
-	0xb1, 0xc7, 0xaa, 0xf9, 0xe7, 0x0d, 0xf6, 0x51, 0x2b, 0x30, 0xa8, 0xcb, 0x60, 0xcb, 0xbd, 0x2f,
-	0x83, 0xb5, 0x7f, 0xa9, 0x46, 0x5f, 0xaf, 0x15, 0xce, 0x47, 0xa4, 0x11, 0xd3, 0xef, 0xdb, 0x8e,
-	0x7c, 0x31, 0x49, 0xd4, 0xf7, 0xbd, 0x8e, 0x97, 0x30, 0x6d, 0x4f, 0x9d, 0x4f, 0x96, 0x0e, 0x55,
-	0x31, 0xb0, 0x7c, 0x60, 0xc5, 0xc0, 0x97, 0x60, 0x34, 0x8e, 0xb7, 0x56, 0x23, 0x6f, 0xc7, 0x49,
-	0xc8, 0x55, 0x22, 0x4b, 0x0b, 0xe9, 0xea, 0x59, 0xf5, 0xcb, 0x1a, 0x88, 0xd3, 0xb8, 0xe8, 0x12,
-	0x4c, 0xe8, 0xba, 0x7d, 0x24, 0x4a, 0x58, 0xca, 0x23, 0x9f, 0x09, 0xaa, 0x6c, 0x8c, 0xae, 0xf4,
-	0x27, 0x10, 0x70, 0xf7, 0x33, 0x54, 0xe6, 0xa6, 0x1a, 0x69, 0x47, 0x06, 0xd3, 0x32, 0x37, 0x45,
-	0x87, 0xf6, 0xa5, 0xeb, 0x09, 0xb4, 0x0c, 0x27, 0xf9, 0xc4, 0x98, 0x6d, 0xb5, 0x8c, 0x37, 0x1a,
-	0x4a, 0x57, 0x8b, 0xbf, 0xd4, 0x8d, 0x82, 0xf3, 0x9e, 0x43, 0x2f, 0xc0, 0xb0, 0x6a, 0x5e, 0x5c,
-	0x10, 0x47, 0x6b, 0xca, 0xb5, 0xa7, 0xc8, 0x2c, 0x36, 0xb0, 0x89, 0x87, 0x3e, 0x04, 0x8f, 0xea,
-	0xbf, 0x3c, 0x85, 0x9e, 0x9f, 0x37, 0x2f, 0x88, 0x92, 0xa8, 0xea, 0x32, 0xb2, 0x4b, 0xb9, 0x68,
-	0x0d, 0xdc, 0xeb, 0x79, 0xb4, 0x0e, 0x67, 0x15, 0xe8, 0x42, 0x90, 0xb0, 0x24, 0xd7, 0x98, 0xcc,
-	0x39, 0x31, 0x8b, 0x9c, 0x00, 0xf6, 0x9e, 0xb6, 0xa0, 0x7e, 0xf6, 0x92, 0x97, 0x5c, 0xce, 0xc3,
-	0xc4, 0x4b, 0x78, 0x1f, 0x2a, 0x68, 0x06, 0x6a, 0x24, 0x70, 0xd6, 0x7d, 0xb2, 0x32, 0xbf, 0x28,
-	0x76, 0xa4, 0x3a, 0x3b, 0x42, 0x02, 0xb0, 0xc6, 0x51, 0xf1, 0xfd, 0x23, 0xbd, 0xe2, 0xfb, 0xd1,
-	0x2a, 0x9c, 0xda, 0x74, 0x5b, 0xd4, 0xca, 0xf4, 0x5c, 0x32, 0xeb, 0xb2, 0x80, 0x62, 0xfa, 0x61,
-	0x78, 0x19, 0x7f, 0x95, 0x28, 0x75, 0x69, 0x7e, 0xb5, 0x0b, 0x07, 0xe7, 0x3e, 0xc9, 0x02, 0xcf,
-	0xa3, 0x70, 0xb7, 0x33, 0x79, 0x32, 0x13, 0x78, 0x4e, 0x1b, 0x31, 0x87, 0xa1, 0x2b, 0x80, 0x58,
-	0xb2, 0xe0, 0xe5, 0x24, 0x69, 0x29, 0xb3, 0x76, 0xf2, 0x54, 0xba, 0x40, 0xe2, 0xc5, 0x2e, 0x0c,
-	0x9c, 0xf3, 0x14, 0xb5, 0x7a, 0x82, 0x90, 0x51, 0x9f, 0x7c, 0x34, 0x6d, 0xf5, 0x5c, 0xe3, 0xcd,
-	0x58, 0xc2, 0xd1, 0x77, 0xc2, 0x64, 0x3b, 0x26, 0x6c, 0xc3, 0x7c, 0x33, 0x8c, 0xb6, 0xfd, 0xd0,
-	0x69, 0x2c, 0xb2, 0x5b, 0x67, 0x93, 0xce, 0xe4, 0x24, 0x63, 0x7e, 0x4e, 0x3c, 0x3b, 0x79, 0xbd,
-	0x07, 0x1e, 0xee, 0x49, 0x21, 0x5b, 0xe1, 0xf3, 0x4c, 0x9f, 0x15, 0x3e, 0x57, 0xe1, 0x94, 0xd4,
-	0x6b, 0x2b, 0xf3, 0x8b, 0xea, 0xa5, 0x27, 0xcf, 0xa6, 0xaf, 0xb1, 0x5b, 0xcc, 0xc1, 0xc1, 0xb9,
-	0x4f, 0xda, 0x7f, 0x68, 0xc1, 0xa8, 0x92, 0x60, 0xf7, 0x21, 0x69, 0xd9, 0x4f, 0x27, 0x2d, 0x5f,
-	0x3a, 0xba, 0x0e, 0x60, 0x3d, 0xef, 0x91, 0x62, 0xf3, 0x23, 0xa3, 0x00, 0x5a, 0x4f, 0x28, 0x15,
-	0x6d, 0xf5, 0x54, 0xd1, 0x0f, 0xad, 0x8c, 0xce, 0xab, 0xd8, 0x58, 0x79, 0xb0, 0x15, 0x1b, 0xeb,
-	0x70, 0x5a, 0x4e, 0x29, 0x7e, 0xa4, 0x7c, 0x39, 0x8c, 0x95, 0xc8, 0x37, 0xee, 0x25, 0x5c, 0xcc,
-	0x43, 0xc2, 0xf9, 0xcf, 0xa6, 0x6c, 0xbb, 0xa1, 0x03, 0x6d, 0x3b, 0x25, 0xe5, 0x96, 0x36, 0xe4,
-	0xad, 0xa1, 0x19, 0x29, 0xb7, 0x74, 0xb1, 0x8e, 0x35, 0x4e, 0xbe, 0xaa, 0xab, 0x15, 0xa4, 0xea,
-	0xe0, 0xd0, 0xaa, 0x4e, 0x0a, 0xdd, 0xe1, 0x9e, 0x42, 0x57, 0x1e, 0x5d, 0x8d, 0xf4, 0x3c, 0xba,
-	0xfa, 0x20, 0x8c, 0x79, 0xc1, 0x16, 0x89, 0xbc, 0x84, 0x34, 0xd8, 0x5a, 0x60, 0x02, 0xb9, 0xaa,
-	0x0d, 0x9d, 0xc5, 0x14, 0x14, 0x67, 0xb0, 0xd3, 0x9a, 0x62, 0xac, 0x0f, 0x4d, 0xd1, 0x43, 0x3f,
-	0x8f, 0x17, 0xa3, 0x9f, 0x4f, 0x1c, 0x5d, 0x3f, 0x4f, 0x1c, 0xab, 0x7e, 0x46, 0x85, 0xe8, 0xe7,
-	0xbe, 0x54, 0x9f, 0xb1, 0x49, 0x3f, 0x75, 0xc0, 0x26, 0xbd, 0x97, 0x72, 0x3e, 0x7d, 0xcf, 0xca,
-	0x39, 0x5f, 0xef, 0x3e, 0xf2, 0x8e, 0xde, 0x2d, 0x44, 0xef, 0x7e, 0xa6, 0x04, 0xa7, 0xb5, 0x66,
-	0xa2, 0xf2, 0xc0, 0xdb, 0xa0, 0xb2, 0x99, 0x5d, 0xc5, 0xcd, 0x0f, 0xbc, 0x8d, 0x54, 0x79, 0x5d,
-	0x2c, 0x40, 0x41, 0xb0, 0x81, 0xc5, 0x32, 0xce, 0x49, 0xc4, 0x2e, 0x81, 0xc9, 0xaa, 0xad, 0x79,
-	0xd1, 0x8e, 0x15, 0x06, 0x1d, 0x04, 0xfa, 0x5b, 0x14, 0x3c, 0xc9, 0x96, 0x17, 0x9f, 0xd7, 0x20,
-	0x6c, 0xe2, 0xa1, 0xf7, 0x72, 0x26, 0x4c, 0x64, 0x52, 0xd5, 0x35, 0xc2, 0xb7, 0x95, 0x4a, 0x4a,
-	0x2a, 0xa8, 0xec, 0x0e, 0xab, 0x88, 0x50, 0xe9, 0xee, 0x0e, 0x8b, 0x1d, 0x55, 0x18, 0xf6, 0xff,
-	0xb2, 0xe0, 0x4c, 0xee, 0x50, 0xdc, 0x07, 0x73, 0x64, 0x37, 0x6d, 0x8e, 0xd4, 0x8b, 0xda, 0x92,
-	0x1a, 0x6f, 0xd1, 0xc3, 0x34, 0xf9, 0x4f, 0x16, 0x8c, 0x69, 0xfc, 0xfb, 0xf0, 0xaa, 0x5e, 0xfa,
-	0x55, 0x8b, 0xdb, 0x7d, 0xd7, 0xba, 0xde, 0xed, 0x57, 0x4b, 0xa0, 0x4a, 0xfe, 0xcf, 0xba, 0x49,
-	0x7f, 0xe9, 0x66, 0x1d, 0x18, 0x64, 0x11, 0x24, 0x71, 0x31, 0xd1, 0x71, 0x69, 0xfe, 0x2c, 0x1a,
-	0x45, 0x1f, 0xe8, 0xb1, 0xbf, 0x31, 0x16, 0x0c, 0xd9, 0x15, 0x45, 0xbc, 0x9a, 0x7a, 0x43, 0x24,
-	0x4e, 0xeb, 0x2b, 0x8a, 0x44, 0x3b, 0x56, 0x18, 0x54, 0x61, 0x7a, 0x6e, 0x18, 0xcc, 0xfb, 0x4e,
-	0x1c, 0x0b, 0x1b, 0x4e, 0x29, 0xcc, 0x45, 0x09, 0xc0, 0x1a, 0x87, 0x05, 0x97, 0x78, 0x71, 0xcb,
-	0x77, 0x3a, 0x86, 0x8f, 0xc5, 0x28, 0xec, 0xa5, 0x40, 0xd8, 0xc4, 0xb3, 0x9b, 0x30, 0x99, 0x7e,
-	0x89, 0x05, 0xb2, 0xc1, 0x22, 0xbb, 0xfb, 0x1a, 0xce, 0x19, 0xa8, 0x39, 0xec, 0xa9, 0xa5, 0xb6,
-	0x23, 0x64, 0x82, 0x8e, 0x6f, 0x96, 0x00, 0xac, 0x71, 0xec, 0x7f, 0x66, 0xc1, 0xc9, 0x9c, 0x41,
-	0x2b, 0x30, 0x31, 0x3d, 0xd1, 0xd2, 0x26, 0xcf, 0xd4, 0xf9, 0x06, 0x18, 0x6a, 0x90, 0x0d, 0x47,
-	0xc6, 0x0e, 0x9b, 0xa9, 0x06, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x0b, 0x25, 0x18, 0x4f, 0xf7, 0x35,
-	0x66, 0xe9, 0x96, 0x7c, 0x98, 0xbc, 0xd8, 0x0d, 0x77, 0x48, 0xd4, 0xa1, 0x6f, 0x6e, 0x65, 0xd2,
-	0x2d, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xec, 0xc2, 0x8f, 0x86, 0x1a, 0x6d, 0x39, 0x23, 0x6f, 0x14,
-	0x39, 0x23, 0xf5, 0xc7, 0x34, 0xe3, 0x8c, 0x14, 0x4b, 0x6c, 0xf2, 0xa7, 0x26, 0x17, 0x4b, 0x16,
-	0x99, 0x6b, 0x7b, 0x7e, 0xe2, 0x05, 0xe2, 0x95, 0xc5, 0x5c, 0x55, 0x26, 0xd7, 0x72, 0x37, 0x0a,
-	0xce, 0x7b, 0xce, 0xfe, 0xca, 0x00, 0xa8, 0xa2, 0x2b, 0x2c, 0x0e, 0xb4, 0xa0, 0x28, 0xda, 0xc3,
-	0x26, 0xed, 0xaa, 0xb9, 0x35, 0xb0, 0x5f, 0x60, 0x16, 0x77, 0xcc, 0x99, 0x1e, 0x7c, 0x35, 0x60,
-	0x6b, 0x1a, 0x84, 0x4d, 0x3c, 0xda, 0x13, 0xdf, 0xdb, 0x21, 0xfc, 0xa1, 0xc1, 0x74, 0x4f, 0x96,
-	0x24, 0x00, 0x6b, 0x1c, 0x56, 0xdf, 0xdb, 0xdb, 0xd8, 0x10, 0x5e, 0x26, 0x5d, 0xdf, 0xdb, 0xdb,
-	0xd8, 0xc0, 0x0c, 0xc2, 0xaf, 0x84, 0x0a, 0xb7, 0xc5, 0x36, 0xc3, 0xb8, 0x12, 0x2a, 0xdc, 0xc6,
-	0x0c, 0x42, 0xbf, 0x52, 0x10, 0x46, 0x4d, 0xc7, 0xf7, 0xde, 0x20, 0x0d, 0xc5, 0x45, 0x6c, 0x2f,
-	0xd4, 0x57, 0xba, 0xd6, 0x8d, 0x82, 0xf3, 0x9e, 0xa3, 0x13, 0xba, 0x15, 0x91, 0x86, 0xe7, 0x26,
-	0x26, 0x35, 0x48, 0x4f, 0xe8, 0xd5, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x85, 0x71, 0x59, 0x34,
-	0x47, 0x16, 0x9a, 0x1c, 0x4e, 0x57, 0xab, 0xc3, 0x69, 0x30, 0xce, 0xe2, 0x53, 0x21, 0xd9, 0x14,
-	0x65, 0x72, 0xd9, 0x6e, 0xc4, 0x10, 0x92, 0xb2, 0x7c, 0x2e, 0x56, 0x18, 0xf6, 0x27, 0xca, 0x54,
-	0xa9, 0xf7, 0xa8, 0x46, 0x7d, 0xdf, 0xa2, 0xb6, 0xd3, 0x33, 0x72, 0xa0, 0x8f, 0x19, 0xf9, 0x3c,
-	0x8c, 0xdc, 0x8a, 0xc3, 0x40, 0x45, 0x44, 0x57, 0x7a, 0x46, 0x44, 0x1b, 0x58, 0xf9, 0x11, 0xd1,
-	0x83, 0x45, 0x45, 0x44, 0x0f, 0xdd, 0x63, 0x44, 0xf4, 0xbf, 0xa9, 0x80, 0xba, 0xf3, 0xf3, 0x1a,
-	0x49, 0x6e, 0x87, 0xd1, 0xb6, 0x17, 0x6c, 0xb2, 0x02, 0x30, 0x5f, 0xb2, 0x64, 0x0d, 0x99, 0x25,
-	0x33, 0x53, 0x78, 0xa3, 0xa0, 0x7b, 0x1b, 0x53, 0xcc, 0xa6, 0xd7, 0x0c, 0x46, 0x3c, 0xb2, 0x26,
-	0x53, 0xab, 0x46, 0x1c, 0x1a, 0xa4, 0x7a, 0x84, 0xbe, 0x1b, 0x40, 0xba, 0xe4, 0x37, 0xa4, 0x04,
-	0x5e, 0x2c, 0xa6, 0x7f, 0x98, 0x6c, 0x68, 0x93, 0x7a, 0x4d, 0x31, 0xc1, 0x06, 0x43, 0xf4, 0x19,
-	0x9d, 0x45, 0xcd, 0x53, 0xa7, 0x3e, 0x7a, 0x2c, 0x63, 0xd3, 0x4f, 0x0e, 0x35, 0x86, 0x21, 0x2f,
-	0xd8, 0xa4, 0xf3, 0x44, 0x44, 0x8e, 0xbe, 0x27, 0xaf, 0xbe, 0xd8, 0x52, 0xe8, 0x34, 0xe6, 0x1c,
-	0xdf, 0x09, 0x5c, 0x12, 0x2d, 0x72, 0x74, 0xad, 0x41, 0x45, 0x03, 0x96, 0x84, 0xba, 0x2e, 0x26,
-	0xad, 0xf4, 0x73, 0x31, 0xe9, 0xd9, 0x6f, 0x83, 0x89, 0xae, 0x8f, 0x79, 0xa8, 0x94, 0xe9, 0x23,
-	0x54, 0x16, 0xfb, 0xc5, 0x41, 0xad, 0xb4, 0xae, 0x85, 0x0d, 0x7e, 0xcf, 0x65, 0xa4, 0xbf, 0xa8,
-	0x30, 0x99, 0x0b, 0x9c, 0x22, 0x4a, 0xcd, 0x18, 0x8d, 0xd8, 0x64, 0x49, 0xe7, 0x68, 0xcb, 0x89,
-	0x48, 0x70, 0xdc, 0x73, 0x74, 0x55, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x95, 0xca, 0xed, 0xbb, 0x78,
-	0xf4, 0xdc, 0x3e, 0x56, 0xed, 0x35, 0xef, 0x3a, 0xb8, 0xb7, 0x2c, 0x18, 0x0b, 0x52, 0x33, 0xb7,
-	0x98, 0x70, 0xfe, 0xfc, 0x55, 0xc1, 0xaf, 0x8c, 0x4e, 0xb7, 0xe1, 0x0c, 0xff, 0x3c, 0x95, 0x56,
-	0x39, 0xa4, 0x4a, 0xd3, 0xf7, 0xec, 0x0e, 0xf6, 0xba, 0x67, 0x17, 0x05, 0xea, 0x02, 0xf4, 0xa1,
-	0x22, 0x2a, 0xa4, 0xa4, 0x6e, 0x3f, 0x87, 0x9c, 0x9b, 0xcf, 0x6f, 0x9a, 0xa9, 0xbf, 0x87, 0xbf,
-	0x08, 0x7b, 0xb4, 0x57, 0x8a, 0xb0, 0xfd, 0x7f, 0x07, 0xe0, 0x84, 0x1c, 0x11, 0x99, 0x0a, 0x44,
-	0xf5, 0x23, 0xe7, 0xab, 0x6d, 0x65, 0xa5, 0x1f, 0x2f, 0x4b, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b,
-	0xc7, 0x64, 0xa5, 0x45, 0x82, 0x25, 0x6f, 0x3d, 0x16, 0xc7, 0xef, 0x6a, 0xa1, 0x5c, 0xd7, 0x20,
-	0x6c, 0xe2, 0xb1, 0xfc, 0x64, 0xd7, 0x2c, 0x12, 0xa2, 0xf3, 0x93, 0x85, 0xa1, 0x2a, 0xe1, 0xe8,
-	0x47, 0x73, 0xaf, 0xc7, 0x28, 0x26, 0x81, 0xb6, 0x2b, 0x03, 0xea, 0x70, 0xf7, 0x62, 0xa0, 0x7f,
-	0x64, 0xc1, 0x69, 0xde, 0x2a, 0x47, 0xf2, 0x7a, 0xab, 0xe1, 0x24, 0x24, 0x2e, 0xe6, 0x2a, 0xb1,
-	0x9c, 0xfe, 0x69, 0x2f, 0x7a, 0x1e, 0x5b, 0x9c, 0xdf, 0x1b, 0xf4, 0x45, 0x0b, 0xc6, 0xb7, 0x53,
-	0x45, 0xbe, 0xa4, 0xea, 0x38, 0x6a, 0x05, 0x9c, 0x14, 0x51, 0xbd, 0xd4, 0xd2, 0xed, 0x31, 0xce,
-	0x72, 0xb7, 0xff, 0xdc, 0x02, 0x53, 0x8c, 0xde, 0xff, 0xda, 0x60, 0x87, 0x37, 0x05, 0xa5, 0x75,
-	0x59, 0xe9, 0x69, 0x5d, 0x3e, 0x01, 0xe5, 0xb6, 0xd7, 0x10, 0xfb, 0x0b, 0x7d, 0xe0, 0xbf, 0xb8,
-	0x80, 0x69, 0xbb, 0xfd, 0xc7, 0x15, 0xed, 0x06, 0x11, 0xf9, 0xa9, 0x7f, 0x25, 0x5e, 0x7b, 0x43,
-	0x15, 0xfd, 0xe5, 0x6f, 0x7e, 0xad, 0xab, 0xe8, 0xef, 0xb7, 0x1c, 0x3e, 0xfd, 0x98, 0x0f, 0x50,
-	0xaf, 0x9a, 0xbf, 0x43, 0x07, 0xe4, 0x1e, 0xdf, 0x82, 0x2a, 0xdd, 0x82, 0x31, 0x7f, 0x66, 0x35,
-	0xd5, 0xa9, 0xea, 0x65, 0xd1, 0x7e, 0x77, 0x6f, 0xea, 0x9b, 0x0f, 0xdf, 0x2d, 0xf9, 0x34, 0x56,
-	0xf4, 0x51, 0x0c, 0x35, 0xfa, 0x9b, 0xa5, 0x49, 0x8b, 0xcd, 0xdd, 0x75, 0x25, 0x33, 0x25, 0xa0,
-	0x90, 0x1c, 0x6c, 0xcd, 0x07, 0x05, 0x50, 0xa3, 0x88, 0x9c, 0x29, 0xdf, 0x03, 0xae, 0xaa, 0x64,
-	0x65, 0x09, 0xb8, 0xbb, 0x37, 0xf5, 0xd2, 0xe1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0xa8, 0xc6,
-	0xe1, 0x9e, 0x57, 0xd0, 0xff, 0xbf, 0x01, 0x3d, 0xbf, 0x45, 0x3d, 0xe8, 0xbf, 0x12, 0xf3, 0xfb,
-	0xc5, 0xcc, 0xfc, 0x3e, 0xd7, 0x35, 0xbf, 0xc7, 0xe8, 0x98, 0xe5, 0x54, 0xa9, 0xbe, 0xdf, 0xc6,
-	0xc2, 0xc1, 0x3e, 0x09, 0x66, 0x25, 0xbd, 0xde, 0xf6, 0x22, 0x12, 0xaf, 0x46, 0xed, 0xc0, 0x0b,
-	0x36, 0xd9, 0x94, 0xad, 0x9a, 0x56, 0x52, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0xe3, 0x4f, 0xe7, 0xc5,
-	0x4d, 0x67, 0x87, 0xcf, 0x3c, 0xa3, 0x16, 0x67, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0xb6, 0xe0, 0x71,
-	0x49, 0x60, 0x81, 0xf8, 0x84, 0xbe, 0x10, 0x0b, 0x64, 0x8c, 0x9a, 0x3c, 0xcd, 0x80, 0xc7, 0xa2,
-	0xbc, 0x5b, 0x50, 0x78, 0x1c, 0xef, 0x83, 0x8b, 0xf7, 0xa5, 0x64, 0x7f, 0x99, 0x85, 0x2e, 0x18,
-	0xd5, 0x22, 0xe8, 0xec, 0xf3, 0xbd, 0xa6, 0x27, 0x4b, 0x86, 0xaa, 0xd9, 0xb7, 0x44, 0x1b, 0x31,
-	0x87, 0xa1, 0xdb, 0x30, 0xb4, 0xce, 0xaf, 0xa6, 0x2f, 0xe6, 0x4a, 0x28, 0x71, 0xcf, 0x3d, 0x2b,
-	0x17, 0x2e, 0x2f, 0xbd, 0xbf, 0xab, 0x7f, 0x62, 0xc9, 0xcd, 0xfe, 0x9d, 0x0a, 0x8c, 0xcb, 0xf0,
-	0xb2, 0xcb, 0x5e, 0xcc, 0x22, 0x12, 0xcc, 0x3b, 0x14, 0x4a, 0x07, 0xde, 0xa1, 0xf0, 0x11, 0x80,
-	0x06, 0x69, 0xf9, 0x61, 0x87, 0x19, 0x87, 0x03, 0x87, 0x36, 0x0e, 0xd5, 0x7e, 0x62, 0x41, 0x51,
-	0xc1, 0x06, 0x45, 0x51, 0x27, 0x95, 0x5f, 0xc9, 0x90, 0xa9, 0x93, 0x6a, 0x5c, 0x1c, 0x37, 0x78,
-	0x7f, 0x2f, 0x8e, 0xf3, 0x60, 0x9c, 0x77, 0x51, 0xd5, 0x64, 0xb8, 0x87, 0xd2, 0x0b, 0x2c, 0xab,
-	0x6d, 0x21, 0x4d, 0x06, 0x67, 0xe9, 0x9a, 0xb7, 0xc2, 0x55, 0xef, 0xf7, 0xad, 0x70, 0xef, 0x83,
-	0x9a, 0xfc, 0xce, 0xf1, 0x64, 0x4d, 0xd7, 0x0b, 0x92, 0xd3, 0x20, 0xc6, 0x1a, 0xde, 0x55, 0x5e,
-	0x06, 0x1e, 0x54, 0x79, 0x19, 0xfb, 0xad, 0x32, 0xdd, 0x55, 0xf0, 0x7e, 0x1d, 0xfa, 0x52, 0xc5,
-	0xcb, 0xc6, 0xa5, 0x8a, 0x87, 0xfb, 0x9e, 0xd5, 0xcc, 0xe5, 0x8b, 0x8f, 0xc3, 0x40, 0xe2, 0x6c,
-	0xca, 0x24, 0x5c, 0x06, 0x5d, 0x73, 0x36, 0x63, 0xcc, 0x5a, 0x0f, 0x53, 0x56, 0xfa, 0x25, 0x18,
-	0x8d, 0xbd, 0xcd, 0xc0, 0x49, 0xda, 0x11, 0x31, 0xce, 0x2f, 0x75, 0x90, 0x8e, 0x09, 0xc4, 0x69,
-	0x5c, 0xf4, 0x49, 0x0b, 0x20, 0x22, 0x6a, 0xcf, 0x32, 0x58, 0xc4, 0x1c, 0x52, 0x62, 0x40, 0xd2,
-	0x35, 0xcb, 0x82, 0xa8, 0xbd, 0x8a, 0xc1, 0xd6, 0xfe, 0xb4, 0x05, 0x13, 0x5d, 0x4f, 0xa1, 0x16,
-	0x0c, 0xba, 0xec, 0xea, 0xcb, 0x62, 0x4a, 0x61, 0xa6, 0xaf, 0xd1, 0xe4, 0xca, 0x89, 0xb7, 0x61,
-	0xc1, 0xc7, 0xfe, 0xa5, 0x11, 0x38, 0x55, 0x9f, 0x5f, 0x96, 0x17, 0x21, 0x1d, 0x5b, 0x56, 0x71,
-	0x1e, 0x8f, 0xfb, 0x97, 0x55, 0xdc, 0x83, 0xbb, 0x6f, 0x64, 0x15, 0xfb, 0x46, 0x56, 0x71, 0x3a,
-	0xc5, 0xb3, 0x5c, 0x44, 0x8a, 0x67, 0x5e, 0x0f, 0xfa, 0x49, 0xf1, 0x3c, 0xb6, 0x34, 0xe3, 0x7d,
-	0x3b, 0x74, 0xa8, 0x34, 0x63, 0x95, 0x83, 0x5d, 0x48, 0x46, 0x59, 0x8f, 0x4f, 0x95, 0x9b, 0x83,
-	0xad, 0xf2, 0x5f, 0x79, 0xb6, 0xa4, 0x50, 0x7a, 0xaf, 0x16, 0xdf, 0x81, 0x3e, 0xf2, 0x5f, 0x45,
-	0xc2, 0xa6, 0x99, 0x73, 0x3d, 0x54, 0x44, 0xce, 0x75, 0x5e, 0x77, 0x0e, 0xcc, 0xb9, 0x7e, 0x09,
-	0x46, 0x5d, 0x3f, 0x0c, 0xc8, 0x6a, 0x14, 0x26, 0xa1, 0x1b, 0xca, 0x8b, 0xc6, 0xf5, 0x9d, 0x91,
-	0x26, 0x10, 0xa7, 0x71, 0x7b, 0x25, 0x6c, 0xd7, 0x8e, 0x9a, 0xb0, 0x0d, 0x0f, 0x28, 0x61, 0xdb,
-	0x48, 0x49, 0x1e, 0x2e, 0x22, 0x25, 0x39, 0xef, 0x8b, 0xf4, 0x95, 0x92, 0xfc, 0x36, 0xbf, 0x67,
-	0x9f, 0x6e, 0x46, 0xb8, 0x14, 0x66, 0x47, 0x74, 0xc3, 0xe7, 0x5f, 0x3b, 0x86, 0x09, 0x7b, 0xb3,
-	0xae, 0xd9, 0xa8, 0xbb, 0xf7, 0x75, 0x13, 0x4e, 0x77, 0xe4, 0x28, 0x69, 0xcc, 0x3f, 0x56, 0x82,
-	0xaf, 0x3b, 0xb0, 0x0b, 0xe8, 0x36, 0x40, 0xe2, 0x6c, 0x8a, 0x89, 0x2a, 0x0e, 0xb2, 0x8e, 0x18,
-	0x57, 0xbc, 0x26, 0xe9, 0x89, 0x14, 0x3b, 0x45, 0x1e, 0x1b, 0xac, 0x58, 0x38, 0x71, 0xe8, 0x77,
-	0x55, 0xb1, 0xc6, 0xa1, 0x4f, 0x30, 0x83, 0x50, 0x43, 0x28, 0x22, 0x9b, 0xd4, 0xb8, 0x2f, 0xa7,
-	0x0d, 0x21, 0xcc, 0x5a, 0xb1, 0x80, 0xa2, 0x17, 0x60, 0xd8, 0xf1, 0x7d, 0x9e, 0xee, 0x47, 0x62,
-	0x71, 0x99, 0xab, 0xae, 0x5d, 0xab, 0x41, 0xd8, 0xc4, 0xb3, 0xff, 0xac, 0x04, 0x53, 0x07, 0xc8,
-	0x94, 0xae, 0x34, 0xef, 0x4a, 0xdf, 0x69, 0xde, 0x22, 0x5d, 0x69, 0xb0, 0x47, 0xba, 0xd2, 0x0b,
-	0x30, 0x9c, 0x10, 0xa7, 0x29, 0x22, 0x11, 0xb3, 0x25, 0x19, 0xd7, 0x34, 0x08, 0x9b, 0x78, 0x54,
-	0x8a, 0x8d, 0x39, 0xae, 0x4b, 0xe2, 0x58, 0xe6, 0x23, 0x09, 0x2f, 0x77, 0x61, 0xc9, 0x4e, 0xec,
-	0xf0, 0x60, 0x36, 0xc5, 0x02, 0x67, 0x58, 0x66, 0x07, 0xbc, 0xd6, 0xe7, 0x80, 0xff, 0x64, 0x09,
-	0x9e, 0xd8, 0x57, 0xbb, 0xf5, 0x9d, 0x2a, 0xd6, 0x8e, 0x49, 0x94, 0x9d, 0x38, 0xd7, 0x63, 0x12,
-	0x61, 0x06, 0xe1, 0xa3, 0xd4, 0x6a, 0xa9, 0x28, 0xf2, 0xe2, 0x73, 0x2b, 0xf9, 0x28, 0xa5, 0x58,
-	0xe0, 0x0c, 0xcb, 0x7b, 0x9d, 0x96, 0xbf, 0x33, 0x00, 0x4f, 0xf5, 0x61, 0x03, 0x14, 0x98, 0x83,
-	0x9a, 0xce, 0xaf, 0x2e, 0x3f, 0xa0, 0xfc, 0xea, 0x7b, 0x1b, 0xae, 0x77, 0xd2, 0xb2, 0xfb, 0xca,
-	0x75, 0xfd, 0x72, 0x09, 0xce, 0xf6, 0x36, 0x58, 0xd0, 0xb7, 0xc2, 0x78, 0xa4, 0x42, 0x12, 0xcd,
-	0xd4, 0xec, 0x93, 0xdc, 0xc7, 0x95, 0x02, 0xe1, 0x2c, 0x2e, 0x9a, 0x06, 0x68, 0x39, 0xc9, 0x56,
-	0x7c, 0x61, 0xd7, 0x8b, 0x13, 0x51, 0xcb, 0x6e, 0x8c, 0x9f, 0xbc, 0xca, 0x56, 0x6c, 0x60, 0x50,
-	0x76, 0xec, 0xdf, 0x42, 0x78, 0x2d, 0x4c, 0xf8, 0x43, 0x7c, 0xeb, 0x79, 0x52, 0xde, 0xfc, 0x68,
-	0x80, 0x70, 0x16, 0x97, 0xb2, 0x63, 0x67, 0xfb, 0xbc, 0xa3, 0x03, 0x3a, 0x99, 0x7b, 0x49, 0xb5,
-	0x62, 0x03, 0x23, 0x9b, 0x74, 0x5e, 0x39, 0x38, 0xe9, 0xdc, 0xfe, 0x57, 0x25, 0x38, 0xd3, 0xd3,
-	0xe0, 0xed, 0x4f, 0x4c, 0x3d, 0x7c, 0x89, 0xdf, 0xf7, 0xb8, 0xc2, 0x0e, 0x95, 0x30, 0x6c, 0xff,
-	0x51, 0x8f, 0x99, 0x26, 0x92, 0x81, 0xef, 0xbd, 0x6e, 0xca, 0xc3, 0x37, 0x9e, 0x5d, 0xf9, 0xbf,
-	0x03, 0x87, 0xc8, 0xff, 0xcd, 0x7c, 0x8c, 0x4a, 0x9f, 0xda, 0xe1, 0xbf, 0x0d, 0xf4, 0x1c, 0x5e,
-	0xba, 0x41, 0xee, 0xeb, 0x04, 0x61, 0x01, 0x4e, 0x78, 0x01, 0xbb, 0xcb, 0xb7, 0xde, 0x5e, 0x17,
-	0xe5, 0xcd, 0x78, 0x0d, 0x5f, 0x95, 0x7d, 0xb3, 0x98, 0x81, 0xe3, 0xae, 0x27, 0x1e, 0xc2, 0x7c,
-	0xec, 0x7b, 0x1b, 0xd2, 0x43, 0x4a, 0xee, 0x15, 0x38, 0x2d, 0x87, 0x62, 0xcb, 0x89, 0x48, 0x43,
-	0x28, 0xdb, 0x58, 0xe4, 0x5b, 0x9d, 0xe1, 0x39, 0x5b, 0x39, 0x08, 0x38, 0xff, 0x39, 0x76, 0xf1,
-	0x6a, 0xd8, 0xf2, 0x5c, 0xb1, 0x15, 0xd4, 0x17, 0xaf, 0xd2, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0xd4,
-	0xee, 0x8f, 0xbe, 0xf8, 0x08, 0xd4, 0xd4, 0x78, 0xf3, 0x9c, 0x0a, 0x35, 0xc9, 0xbb, 0x72, 0x2a,
-	0xd4, 0x0c, 0x37, 0xb0, 0xe8, 0xec, 0xa0, 0x1b, 0x95, 0xcc, 0x6a, 0xa5, 0xfc, 0x68, 0xbb, 0xfd,
-	0x1c, 0x8c, 0x28, 0x5f, 0x60, 0xbf, 0xd7, 0xdf, 0xda, 0x7f, 0x59, 0x82, 0xcc, 0x4d, 0x6f, 0x68,
-	0x17, 0x6a, 0x8d, 0xa8, 0xc3, 0x1b, 0x8b, 0xa9, 0x21, 0xbd, 0x20, 0xc9, 0xe9, 0x83, 0x30, 0xd5,
-	0x84, 0x35, 0x33, 0xf4, 0x26, 0x2f, 0xd7, 0x2c, 0x58, 0x97, 0x8a, 0xc8, 0xc9, 0xaf, 0x2b, 0x7a,
-	0xe6, 0xfd, 0x96, 0xb2, 0x0d, 0x1b, 0xfc, 0x50, 0x02, 0xb5, 0x2d, 0x79, 0xa3, 0x5d, 0x31, 0xe2,
-	0x4e, 0x5d, 0x90, 0xc7, 0x4d, 0x34, 0xf5, 0x17, 0x6b, 0x46, 0xf6, 0x1f, 0x96, 0xe0, 0x54, 0xfa,
-	0x03, 0x88, 0x83, 0xcb, 0x9f, 0xb6, 0xe0, 0x51, 0xdf, 0x89, 0x93, 0x7a, 0x9b, 0x6d, 0x14, 0x36,
-	0xda, 0xfe, 0x4a, 0xa6, 0xb2, 0xf7, 0x51, 0x9d, 0x2d, 0x8a, 0x70, 0xf6, 0x06, 0xc4, 0xb9, 0xc7,
-	0xee, 0xec, 0x4d, 0x3d, 0xba, 0x94, 0xcf, 0x1c, 0xf7, 0xea, 0x15, 0x7a, 0xcb, 0x82, 0x13, 0x6e,
-	0x3b, 0x8a, 0x48, 0x90, 0xe8, 0xae, 0xf2, 0xaf, 0x78, 0xad, 0x90, 0x81, 0xd4, 0x1d, 0x3c, 0x45,
-	0x05, 0xea, 0x7c, 0x86, 0x17, 0xee, 0xe2, 0x6e, 0xff, 0x20, 0xd5, 0x9c, 0x3d, 0xdf, 0xf3, 0xaf,
-	0xd9, 0x95, 0x8d, 0x7f, 0x32, 0x08, 0xa3, 0xa9, 0xf2, 0xe5, 0xa9, 0xc3, 0x3e, 0xeb, 0xc0, 0xc3,
-	0x3e, 0x96, 0x21, 0xd8, 0x0e, 0xe4, 0x6d, 0xf6, 0x46, 0x86, 0x60, 0x3b, 0x20, 0x98, 0xc3, 0xc4,
-	0x90, 0xe2, 0x76, 0x20, 0x72, 0x01, 0xcc, 0x21, 0xc5, 0xed, 0x00, 0x0b, 0x28, 0xfa, 0xb8, 0x05,
-	0x23, 0x6c, 0xf1, 0x89, 0xa3, 0x52, 0xa1, 0xd0, 0xae, 0x14, 0xb0, 0xdc, 0x65, 0xa9, 0x7e, 0x16,
-	0x3b, 0x6a, 0xb6, 0xe0, 0x14, 0x47, 0xf4, 0x29, 0x0b, 0x6a, 0xea, 0xea, 0x5c, 0x71, 0x36, 0x52,
-	0x2f, 0xb6, 0x3a, 0x7c, 0x46, 0xea, 0xa9, 0x32, 0xdd, 0x58, 0x33, 0x46, 0xb1, 0x3a, 0xc7, 0x1c,
-	0x3a, 0x9e, 0x73, 0x4c, 0xc8, 0x39, 0xc3, 0x7c, 0x1f, 0xd4, 0x9a, 0x4e, 0xe0, 0x6d, 0x90, 0x38,
-	0xe1, 0x47, 0x8b, 0xf2, 0x32, 0x10, 0xd9, 0x88, 0x35, 0x9c, 0x1a, 0xfb, 0x31, 0x7b, 0xb1, 0xc4,
-	0x38, 0x0b, 0x64, 0xc6, 0x7e, 0x5d, 0x37, 0x63, 0x13, 0xc7, 0x3c, 0xb8, 0x84, 0x07, 0x7a, 0x70,
-	0x39, 0x7c, 0xc0, 0xc1, 0x65, 0x1d, 0x4e, 0x3b, 0xed, 0x24, 0xbc, 0x4c, 0x1c, 0x7f, 0x36, 0x49,
-	0x48, 0xb3, 0x95, 0xc4, 0xbc, 0xe2, 0xfd, 0x08, 0x73, 0x01, 0xab, 0x68, 0xb7, 0x3a, 0xf1, 0x37,
-	0xba, 0x90, 0x70, 0xfe, 0xb3, 0xf6, 0xbf, 0xb0, 0xe0, 0x74, 0xee, 0x54, 0x78, 0x78, 0xf3, 0x0c,
-	0xec, 0x1f, 0xae, 0xc0, 0xc9, 0x9c, 0xcb, 0x0d, 0x50, 0xc7, 0x5c, 0x24, 0x56, 0x11, 0x21, 0x7b,
-	0xe9, 0x08, 0x34, 0xf9, 0x6d, 0x72, 0x56, 0xc6, 0xe1, 0x62, 0x11, 0x74, 0x3c, 0x40, 0xf9, 0xfe,
-	0xc6, 0x03, 0x18, 0x73, 0x7d, 0xe0, 0x81, 0xce, 0xf5, 0xca, 0x01, 0x73, 0xfd, 0x67, 0x2c, 0x98,
-	0x6c, 0xf6, 0xb8, 0xa9, 0x4c, 0x9c, 0x27, 0xdd, 0x38, 0x9e, 0x7b, 0xd0, 0xe6, 0x1e, 0xbf, 0xb3,
-	0x37, 0xd5, 0xf3, 0x82, 0x38, 0xdc, 0xb3, 0x57, 0xf6, 0x57, 0xca, 0xc0, 0xec, 0x35, 0x56, 0xc0,
-	0xba, 0x83, 0x3e, 0x66, 0xde, 0x91, 0x62, 0x15, 0x75, 0x9f, 0x07, 0x27, 0xae, 0xee, 0x58, 0xe1,
-	0x23, 0x98, 0x77, 0xe5, 0x4a, 0x56, 0x12, 0x96, 0xfa, 0x90, 0x84, 0xbe, 0xbc, 0x8c, 0xa6, 0x5c,
-	0xfc, 0x65, 0x34, 0xb5, 0xec, 0x45, 0x34, 0xfb, 0x7f, 0xe2, 0x81, 0x87, 0xf2, 0x13, 0xff, 0xb2,
-	0xc5, 0x05, 0x4f, 0xe6, 0x2b, 0x68, 0x73, 0xc3, 0xda, 0xc7, 0xdc, 0x78, 0x06, 0xaa, 0xb1, 0x90,
-	0xcc, 0xc2, 0x2c, 0xd1, 0xa1, 0x60, 0xa2, 0x1d, 0x2b, 0x0c, 0xba, 0xeb, 0x72, 0x7c, 0x3f, 0xbc,
-	0x7d, 0xa1, 0xd9, 0x4a, 0x3a, 0xc2, 0x40, 0x51, 0xdb, 0x82, 0x59, 0x05, 0xc1, 0x06, 0x16, 0x7a,
-	0x0a, 0x06, 0x79, 0xa5, 0x09, 0xe1, 0xdc, 0x19, 0xa6, 0xeb, 0x90, 0x97, 0xa1, 0x68, 0x60, 0x01,
-	0xb2, 0xb7, 0xc0, 0xd8, 0x55, 0xdc, 0xfb, 0x75, 0xd8, 0x07, 0xdf, 0x70, 0x69, 0xff, 0x83, 0x92,
-	0x60, 0xc5, 0x77, 0x09, 0x3a, 0x32, 0xd0, 0x3a, 0x64, 0x64, 0xe0, 0x9b, 0x00, 0x6e, 0xd8, 0x6c,
-	0xd1, 0x7d, 0xf3, 0x5a, 0x58, 0xcc, 0x66, 0x6b, 0x5e, 0xd1, 0xd3, 0xa3, 0xaa, 0xdb, 0xb0, 0xc1,
-	0x2f, 0x25, 0xda, 0xcb, 0x07, 0x8a, 0xf6, 0x94, 0x94, 0x1b, 0xd8, 0x5f, 0xca, 0xd9, 0x7f, 0x66,
-	0x41, 0xca, 0xea, 0x43, 0x2d, 0xa8, 0xd0, 0xee, 0x76, 0x84, 0xc0, 0x58, 0x29, 0xce, 0xc4, 0xa4,
-	0x92, 0x5a, 0xac, 0x42, 0xf6, 0x13, 0x73, 0x46, 0xc8, 0x17, 0x51, 0x90, 0x85, 0x6c, 0x7e, 0x4c,
-	0x86, 0x97, 0xc3, 0x70, 0x9b, 0x07, 0x13, 0xe9, 0x88, 0x4a, 0xfb, 0x45, 0x98, 0xe8, 0xea, 0x14,
-	0xbb, 0x42, 0x3b, 0x94, 0x3b, 0x78, 0x63, 0xf5, 0xb0, 0x82, 0x0f, 0x98, 0xc3, 0xec, 0x2f, 0x5b,
-	0x70, 0x22, 0x4b, 0x1e, 0xbd, 0x6d, 0xc1, 0x44, 0x9c, 0xa5, 0x77, 0x5c, 0x63, 0xa7, 0xb2, 0x1d,
-	0xba, 0x40, 0xb8, 0xbb, 0x13, 0xf6, 0xff, 0x14, 0xda, 0xe0, 0xa6, 0x17, 0x34, 0xc2, 0xdb, 0xca,
-	0x4e, 0xb2, 0x7a, 0xda, 0x49, 0x54, 0x3c, 0xb8, 0x5b, 0xa4, 0xd1, 0xf6, 0xbb, 0xca, 0x50, 0xd4,
-	0x45, 0x3b, 0x56, 0x18, 0x2c, 0xeb, 0xbe, 0x2d, 0xf6, 0xad, 0x99, 0x49, 0xb9, 0x20, 0xda, 0xb1,
-	0xc2, 0x40, 0xcf, 0xc3, 0x88, 0xf1, 0x92, 0x72, 0x5e, 0xb2, 0x4d, 0x87, 0xa1, 0xc1, 0x63, 0x9c,
-	0xc2, 0x42, 0xd3, 0x00, 0xca, 0xe6, 0x92, 0x1a, 0x9b, 0x39, 0xda, 0x95, 0x60, 0x8c, 0xb1, 0x81,
-	0xc1, 0x6a, 0x5c, 0xf8, 0xed, 0x98, 0x9d, 0x24, 0x0f, 0xea, 0x0b, 0x1d, 0xe6, 0x45, 0x1b, 0x56,
-	0x50, 0x2a, 0xdc, 0x9a, 0x4e, 0xd0, 0x76, 0x7c, 0x3a, 0x42, 0xc2, 0x75, 0xa6, 0x96, 0xe1, 0xb2,
-	0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xe2, 0x35, 0xc9, 0x2b, 0x61, 0x20, 0xa3, 0xd4, 0x75, 0x70,
-	0x81, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x08, 0xc3, 0x4e, 0xd0, 0xe0, 0x06, 0x62, 0x18, 0x89, 0x33,
-	0x4a, 0xb5, 0xfb, 0xbc, 0x1e, 0x93, 0x59, 0x0d, 0xc5, 0x26, 0x6a, 0xf6, 0x36, 0x0b, 0xe8, 0xf3,
-	0xb6, 0xbc, 0x3f, 0xb5, 0x60, 0x5c, 0x17, 0x2d, 0x62, 0x1e, 0xb6, 0x94, 0x6b, 0xd1, 0x3a, 0xd0,
-	0xb5, 0x98, 0xae, 0x5d, 0x52, 0xea, 0xab, 0x76, 0x89, 0x59, 0x56, 0xa4, 0xbc, 0x6f, 0x59, 0x91,
-	0xaf, 0x87, 0xa1, 0x6d, 0xd2, 0x31, 0xea, 0x8f, 0x30, 0xe5, 0x70, 0x95, 0x37, 0x61, 0x09, 0x43,
-	0x36, 0x0c, 0xba, 0x8e, 0xaa, 0x61, 0x38, 0x22, 0x62, 0xd3, 0x66, 0x19, 0x92, 0x80, 0xd8, 0x2b,
-	0x50, 0x53, 0x87, 0xfa, 0xd2, 0xd3, 0x67, 0xe5, 0x7b, 0xfa, 0xfa, 0x2a, 0x6f, 0x30, 0xb7, 0xfe,
-	0x1b, 0x5f, 0x7d, 0xf2, 0x5d, 0xbf, 0xfd, 0xd5, 0x27, 0xdf, 0xf5, 0x07, 0x5f, 0x7d, 0xf2, 0x5d,
-	0x1f, 0xbf, 0xf3, 0xa4, 0xf5, 0x1b, 0x77, 0x9e, 0xb4, 0x7e, 0xfb, 0xce, 0x93, 0xd6, 0x1f, 0xdc,
-	0x79, 0xd2, 0xfa, 0xca, 0x9d, 0x27, 0xad, 0xb7, 0xfe, 0xeb, 0x93, 0xef, 0x7a, 0x25, 0x37, 0x2f,
-	0x82, 0xfe, 0x78, 0xbf, 0xdb, 0x98, 0xd9, 0x79, 0x8e, 0x85, 0xe6, 0xd3, 0xf5, 0x3c, 0x63, 0x4c,
-	0xe2, 0x19, 0xb9, 0x9e, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xd1, 0xb1, 0x14, 0x1c,
-	0x01, 0x01, 0x00,
+	// 12981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x25, 0xd9,
+	0x55, 0x18, 0xee, 0x7e, 0x1f, 0xd2, 0xd3, 0x95, 0x46, 0x9a, 0xe9, 0x99, 0xd9, 0x7d, 0x33, 0xde,
+	0xdd, 0x19, 0x7a, 0xcd, 0xda, 0xbf, 0x1f, 0xb6, 0x06, 0xaf, 0x8d, 0xd9, 0xf0, 0x61, 0xd0, 0xc7,
+	0x7c, 0x68, 0x47, 0x1a, 0xc9, 0xe7, 0x69, 0x67, 0xb0, 0x8d, 0x3f, 0x5a, 0xef, 0x5d, 0x3d, 0xf5,
+	0xaa, 0x5f, 0xf7, 0xdb, 0xee, 0x7e, 0x9a, 0xd1, 0x62, 0x8c, 0x0d, 0x38, 0x38, 0x98, 0x0f, 0x07,
+	0x52, 0xc1, 0x24, 0x40, 0x20, 0x7c, 0x24, 0xa9, 0x14, 0x05, 0x09, 0x7f, 0x40, 0x02, 0x94, 0x0b,
+	0xa8, 0xa2, 0x80, 0x24, 0x05, 0xa1, 0x48, 0x42, 0x02, 0x4c, 0xec, 0x4d, 0x52, 0x50, 0xf9, 0x83,
+	0xaa, 0x7c, 0x54, 0x25, 0xb5, 0x49, 0x51, 0xa9, 0x73, 0xbf, 0x6f, 0xbf, 0x7e, 0xd2, 0xd3, 0xa8,
+	0x35, 0x33, 0x86, 0xfd, 0x4b, 0x7a, 0xf7, 0x9c, 0x7b, 0xce, 0xed, 0xdb, 0xb7, 0xcf, 0x39, 0xf7,
+	0xdc, 0x73, 0xce, 0x25, 0xab, 0xdd, 0x20, 0xdb, 0x19, 0x6c, 0xcd, 0xb7, 0xe3, 0xde, 0x15, 0x3f,
+	0xe9, 0xc6, 0xfd, 0x24, 0x7e, 0x99, 0xfd, 0xf3, 0x8e, 0x76, 0xe7, 0xca, 0xde, 0xbb, 0xae, 0xf4,
+	0x77, 0xbb, 0x57, 0xfc, 0x7e, 0x90, 0x5e, 0xf1, 0xfb, 0xfd, 0x30, 0x68, 0xfb, 0x59, 0x10, 0x47,
+	0x57, 0xf6, 0xde, 0xe9, 0x87, 0xfd, 0x1d, 0xff, 0x9d, 0x57, 0xba, 0x34, 0xa2, 0x89, 0x9f, 0xd1,
+	0xce, 0x7c, 0x3f, 0x89, 0xb3, 0xd8, 0xfd, 0x3a, 0x4d, 0x6d, 0x5e, 0x52, 0x63, 0xff, 0x7c, 0xa4,
+	0xdd, 0x99, 0xdf, 0x7b, 0xd7, 0x7c, 0x7f, 0xb7, 0x3b, 0x8f, 0xd4, 0xe6, 0x0d, 0x6a, 0xf3, 0x92,
+	0xda, 0xc5, 0x77, 0x18, 0x63, 0xe9, 0xc6, 0xdd, 0xf8, 0x0a, 0x23, 0xba, 0x35, 0xd8, 0x66, 0xbf,
+	0xd8, 0x0f, 0xf6, 0x1f, 0x67, 0x76, 0xd1, 0xdb, 0x7d, 0x21, 0x9d, 0x0f, 0x62, 0x1c, 0xde, 0x95,
+	0x76, 0x9c, 0xd0, 0x2b, 0x7b, 0x43, 0x03, 0xba, 0x78, 0x43, 0xe3, 0xd0, 0x7b, 0x19, 0x8d, 0xd2,
+	0x20, 0x8e, 0xd2, 0x77, 0xe0, 0x10, 0x68, 0xb2, 0x47, 0x13, 0xf3, 0xf1, 0x0c, 0x84, 0x22, 0x4a,
+	0xef, 0xd6, 0x94, 0x7a, 0x7e, 0x7b, 0x27, 0x88, 0x68, 0xb2, 0xaf, 0xbb, 0xf7, 0x68, 0xe6, 0x17,
+	0xf5, 0xba, 0x32, 0xaa, 0x57, 0x32, 0x88, 0xb2, 0xa0, 0x47, 0x87, 0x3a, 0xbc, 0xe7, 0xb0, 0x0e,
+	0x69, 0x7b, 0x87, 0xf6, 0xfc, 0xa1, 0x7e, 0xef, 0x1a, 0xd5, 0x6f, 0x90, 0x05, 0xe1, 0x95, 0x20,
+	0xca, 0xd2, 0x2c, 0xc9, 0x77, 0xf2, 0x7e, 0xc4, 0x21, 0xa7, 0x16, 0xee, 0xb4, 0x16, 0x06, 0xd9,
+	0xce, 0x52, 0x1c, 0x6d, 0x07, 0x5d, 0xf7, 0xab, 0xc8, 0x74, 0x3b, 0x1c, 0xa4, 0x19, 0x4d, 0x6e,
+	0xf9, 0x3d, 0xda, 0x74, 0x2e, 0x3b, 0x6f, 0x9b, 0x5a, 0x3c, 0xfb, 0x5b, 0xf7, 0x2f, 0xbd, 0xe9,
+	0xb5, 0xfb, 0x97, 0xa6, 0x97, 0x34, 0x08, 0x4c, 0x3c, 0xf7, 0xff, 0x23, 0x93, 0x49, 0x1c, 0xd2,
+	0x05, 0xb8, 0xd5, 0xac, 0xb0, 0x2e, 0x73, 0xa2, 0xcb, 0x24, 0xf0, 0x66, 0x90, 0x70, 0x44, 0xed,
+	0x27, 0xf1, 0x76, 0x10, 0xd2, 0x66, 0xd5, 0x46, 0xdd, 0xe0, 0xcd, 0x20, 0xe1, 0xde, 0x0f, 0x57,
+	0xc8, 0xdc, 0x42, 0xbf, 0x7f, 0x83, 0xfa, 0x61, 0xb6, 0xd3, 0xca, 0xfc, 0x6c, 0x90, 0xba, 0x5d,
+	0x32, 0x91, 0xb2, 0xff, 0xc4, 0xd8, 0xd6, 0x45, 0xef, 0x09, 0x0e, 0x7f, 0xfd, 0xfe, 0xa5, 0xaf,
+	0x2f, 0x5a, 0xd1, 0xdd, 0x20, 0x8b, 0xfb, 0xe9, 0x3b, 0x68, 0xd4, 0x0d, 0x22, 0xca, 0xe6, 0x65,
+	0x87, 0x51, 0x9d, 0x37, 0x89, 0x2f, 0xc5, 0x1d, 0x0a, 0x82, 0x3c, 0x8e, 0xb3, 0x47, 0xd3, 0xd4,
+	0xef, 0xd2, 0xfc, 0x23, 0xad, 0xf1, 0x66, 0x90, 0x70, 0x37, 0x21, 0x6e, 0xe8, 0xa7, 0xd9, 0x66,
+	0xe2, 0x47, 0x69, 0x80, 0x4b, 0x7a, 0x33, 0xe8, 0xf1, 0xa7, 0x9b, 0x7e, 0xfe, 0xff, 0x9f, 0xe7,
+	0x2f, 0x66, 0xde, 0x7c, 0x31, 0xfa, 0x3b, 0xc0, 0x75, 0x33, 0xbf, 0xf7, 0xce, 0x79, 0xec, 0xb1,
+	0xf8, 0xc4, 0x6b, 0xf7, 0x2f, 0xb9, 0xab, 0x43, 0x94, 0xa0, 0x80, 0xba, 0xf7, 0x6f, 0x2b, 0x84,
+	0x2c, 0xf4, 0xfb, 0x1b, 0x49, 0xfc, 0x32, 0x6d, 0x67, 0xee, 0x47, 0x49, 0x03, 0x49, 0x75, 0xfc,
+	0xcc, 0x67, 0x13, 0x33, 0xfd, 0xfc, 0x57, 0x8e, 0xc7, 0x78, 0x7d, 0x0b, 0xfb, 0xaf, 0xd1, 0xcc,
+	0x5f, 0x74, 0xc5, 0x03, 0x12, 0xdd, 0x06, 0x8a, 0xaa, 0x1b, 0x91, 0x5a, 0xda, 0xa7, 0x6d, 0x36,
+	0x19, 0xd3, 0xcf, 0xaf, 0xce, 0x1f, 0xe7, 0x4b, 0x9f, 0xd7, 0x23, 0x6f, 0xf5, 0x69, 0x7b, 0x71,
+	0x46, 0x70, 0xae, 0xe1, 0x2f, 0x60, 0x7c, 0xdc, 0x3d, 0xf5, 0xa2, 0xf9, 0x44, 0xde, 0x2a, 0x8d,
+	0x23, 0xa3, 0xba, 0x38, 0x6b, 0x2f, 0x1c, 0xf9, 0xde, 0xbd, 0x3f, 0x71, 0xc8, 0xac, 0x46, 0x5e,
+	0x0d, 0xd2, 0xcc, 0xfd, 0xe6, 0xa1, 0xc9, 0x9d, 0x1f, 0x6f, 0x72, 0xb1, 0x37, 0x9b, 0xda, 0xd3,
+	0x82, 0x59, 0x43, 0xb6, 0x18, 0x13, 0xdb, 0x23, 0xf5, 0x20, 0xa3, 0xbd, 0xb4, 0x59, 0xb9, 0x5c,
+	0x7d, 0xdb, 0xf4, 0xf3, 0x37, 0xca, 0x7a, 0xce, 0xc5, 0x53, 0x82, 0x69, 0x7d, 0x05, 0xc9, 0x03,
+	0xe7, 0xe2, 0xfd, 0xc8, 0x93, 0xe6, 0xf3, 0xe1, 0x84, 0xbb, 0xef, 0x24, 0xd3, 0x69, 0x3c, 0x48,
+	0xda, 0x14, 0x68, 0x3f, 0xc6, 0x0f, 0xab, 0x8a, 0xcb, 0x1d, 0x3f, 0xf8, 0x96, 0x6e, 0x06, 0x13,
+	0xc7, 0xfd, 0x3e, 0x87, 0xcc, 0x74, 0x68, 0x9a, 0x05, 0x11, 0xe3, 0x2f, 0x07, 0xbf, 0x79, 0xec,
+	0xc1, 0xcb, 0xc6, 0x65, 0x4d, 0x7c, 0xf1, 0x9c, 0x78, 0x90, 0x19, 0xa3, 0x31, 0x05, 0x8b, 0x3f,
+	0x0a, 0xae, 0x0e, 0x4d, 0xdb, 0x49, 0xd0, 0xc7, 0xdf, 0xcd, 0xaa, 0x2d, 0xb8, 0x96, 0x35, 0x08,
+	0x4c, 0x3c, 0x37, 0x22, 0x75, 0x14, 0x4c, 0x69, 0xb3, 0xc6, 0xc6, 0xbf, 0x72, 0xbc, 0xf1, 0x8b,
+	0x49, 0x45, 0x99, 0xa7, 0x67, 0x1f, 0x7f, 0xa5, 0xc0, 0xd9, 0xb8, 0xdf, 0xeb, 0x90, 0xa6, 0x10,
+	0x9c, 0x40, 0xf9, 0x84, 0xde, 0xd9, 0x09, 0x32, 0x1a, 0x06, 0x69, 0xd6, 0xac, 0xb3, 0x31, 0x5c,
+	0x19, 0x6f, 0x6d, 0x5d, 0x4f, 0xe2, 0x41, 0xff, 0x66, 0x10, 0x75, 0x16, 0x2f, 0x0b, 0x4e, 0xcd,
+	0xa5, 0x11, 0x84, 0x61, 0x24, 0x4b, 0xf7, 0x07, 0x1d, 0x72, 0x31, 0xf2, 0x7b, 0x34, 0xed, 0xfb,
+	0x6d, 0x2a, 0xc1, 0x8b, 0xa1, 0xdf, 0xde, 0x65, 0x23, 0x9a, 0x78, 0xb0, 0x11, 0x79, 0x62, 0x44,
+	0x17, 0x6f, 0x8d, 0x24, 0x0d, 0x07, 0xb0, 0x75, 0x7f, 0xd2, 0x21, 0x67, 0xe2, 0xa4, 0xbf, 0xe3,
+	0x47, 0xb4, 0x23, 0xa1, 0x69, 0x73, 0x92, 0x7d, 0x7a, 0x1f, 0x3e, 0xde, 0x2b, 0x5a, 0xcf, 0x93,
+	0x5d, 0x8b, 0xa3, 0x20, 0x8b, 0x93, 0x16, 0xcd, 0xb2, 0x20, 0xea, 0xa6, 0x8b, 0xe7, 0x5f, 0xbb,
+	0x7f, 0xe9, 0xcc, 0x10, 0x16, 0x0c, 0x8f, 0xc7, 0xfd, 0x16, 0x32, 0x9d, 0xee, 0x47, 0xed, 0x3b,
+	0x41, 0xd4, 0x89, 0xef, 0xa6, 0xcd, 0x46, 0x19, 0x9f, 0x6f, 0x4b, 0x11, 0x14, 0x1f, 0xa0, 0x66,
+	0x00, 0x26, 0xb7, 0xe2, 0x17, 0xa7, 0x97, 0xd2, 0x54, 0xd9, 0x2f, 0x4e, 0x2f, 0xa6, 0x03, 0xd8,
+	0xba, 0xdf, 0xe5, 0x90, 0x53, 0x69, 0xd0, 0x8d, 0xfc, 0x6c, 0x90, 0xd0, 0x9b, 0x74, 0x3f, 0x6d,
+	0x12, 0x36, 0x90, 0x17, 0x8f, 0x39, 0x2b, 0x06, 0xc9, 0xc5, 0xf3, 0x62, 0x8c, 0xa7, 0xcc, 0xd6,
+	0x14, 0x6c, 0xbe, 0x45, 0x1f, 0x9a, 0x5e, 0xd6, 0xd3, 0xe5, 0x7e, 0x68, 0x7a, 0x51, 0x8f, 0x64,
+	0xe9, 0x7e, 0x23, 0x39, 0xcd, 0x9b, 0xd4, 0xcc, 0xa6, 0xcd, 0x19, 0x26, 0x68, 0xcf, 0xbd, 0x76,
+	0xff, 0xd2, 0xe9, 0x56, 0x0e, 0x06, 0x43, 0xd8, 0xee, 0x2b, 0xe4, 0x52, 0x9f, 0x26, 0xbd, 0x20,
+	0x5b, 0x8f, 0xc2, 0x7d, 0x29, 0xbe, 0xdb, 0x71, 0x9f, 0x76, 0xc4, 0x70, 0xd2, 0xe6, 0xa9, 0xcb,
+	0xce, 0xdb, 0x1a, 0x8b, 0x6f, 0x15, 0xc3, 0xbc, 0xb4, 0x71, 0x30, 0x3a, 0x1c, 0x46, 0xcf, 0xfd,
+	0x4d, 0x87, 0x5c, 0x34, 0xa4, 0x6c, 0x8b, 0x26, 0x7b, 0x41, 0x9b, 0x2e, 0xb4, 0xdb, 0xf1, 0x20,
+	0xca, 0xd2, 0xe6, 0x2c, 0x9b, 0xc6, 0xad, 0x93, 0x90, 0xf9, 0x36, 0x2b, 0xbd, 0x2e, 0x47, 0xa2,
+	0xa4, 0x70, 0xc0, 0x48, 0xdd, 0xaf, 0x25, 0xa7, 0xb2, 0x78, 0x97, 0x46, 0x0b, 0x83, 0x4e, 0x40,
+	0xa3, 0x36, 0x6d, 0xce, 0x31, 0xfd, 0xa0, 0x96, 0xd2, 0xa6, 0x09, 0x04, 0x1b, 0xd7, 0x7d, 0x91,
+	0xb8, 0x1d, 0x1a, 0x52, 0xa4, 0xbb, 0x91, 0xc4, 0x19, 0x6d, 0xe3, 0x7f, 0xcd, 0xd3, 0x6c, 0xae,
+	0x2f, 0x0a, 0x0a, 0xee, 0xf2, 0x10, 0x06, 0x14, 0xf4, 0x72, 0x17, 0xc8, 0x5c, 0x42, 0xb7, 0x13,
+	0x9a, 0xee, 0xac, 0x44, 0x19, 0x4d, 0xf6, 0xfc, 0xb0, 0x79, 0x86, 0x0d, 0xe5, 0x49, 0x41, 0x68,
+	0x0e, 0x6c, 0x30, 0xe4, 0xf1, 0xdd, 0x1e, 0xb9, 0x84, 0x82, 0xe0, 0xea, 0xbd, 0x76, 0x38, 0xe8,
+	0x68, 0x79, 0xb4, 0x10, 0x45, 0x71, 0x26, 0x94, 0xb1, 0xcb, 0x16, 0xd6, 0xb3, 0xb8, 0x06, 0x5a,
+	0x07, 0xa3, 0xc2, 0x61, 0xb4, 0xdc, 0x1f, 0x71, 0xf0, 0xf1, 0xb7, 0xfd, 0x41, 0x98, 0x19, 0x93,
+	0xdf, 0x3c, 0x7b, 0xd9, 0x39, 0x31, 0x7d, 0xff, 0x04, 0x9f, 0xd0, 0x3c, 0x4f, 0x28, 0x18, 0x87,
+	0xfb, 0x2b, 0x0e, 0x39, 0xdf, 0x4f, 0xe2, 0xbe, 0xdf, 0xc5, 0x7d, 0x8d, 0x39, 0x09, 0xe7, 0xd8,
+	0xea, 0xec, 0x96, 0x69, 0xa8, 0xce, 0x6f, 0x14, 0x71, 0xba, 0x1a, 0x65, 0xc9, 0xfe, 0xe2, 0xd3,
+	0xe2, 0x05, 0x9e, 0x2f, 0xc4, 0x81, 0xe2, 0x41, 0xb2, 0xe1, 0x27, 0xf4, 0x95, 0x41, 0x90, 0xa8,
+	0xcf, 0x6e, 0xd5, 0xdf, 0xa2, 0x61, 0xda, 0x3c, 0x7f, 0x02, 0xc3, 0x87, 0x22, 0x4e, 0xb9, 0xe1,
+	0x17, 0xe2, 0x40, 0xf1, 0x20, 0xdd, 0x5f, 0xb6, 0xb5, 0x50, 0x97, 0xde, 0x33, 0x4d, 0xb4, 0xe6,
+	0x13, 0xec, 0x19, 0xee, 0x1c, 0xef, 0x19, 0x6e, 0x8d, 0xa2, 0x5f, 0xa8, 0xad, 0x72, 0x43, 0x80,
+	0x03, 0x86, 0x77, 0xf1, 0x06, 0xb9, 0x38, 0xfa, 0x85, 0xba, 0xa7, 0x49, 0x75, 0x97, 0xee, 0xf3,
+	0x6d, 0x26, 0xe0, 0xbf, 0xee, 0x39, 0x52, 0xdf, 0xf3, 0xc3, 0x81, 0xd8, 0x10, 0x02, 0xff, 0xf1,
+	0x35, 0x95, 0x17, 0x1c, 0xa4, 0x34, 0x7a, 0x6e, 0x8f, 0x42, 0xc9, 0xfb, 0xed, 0x0a, 0x39, 0x9d,
+	0xdf, 0xab, 0xb8, 0x3f, 0xe3, 0x90, 0xb9, 0x97, 0xef, 0x66, 0x4c, 0x4a, 0xa5, 0x8b, 0xfb, 0x68,
+	0x51, 0x32, 0x2b, 0x7d, 0xfa, 0xf9, 0x76, 0xb9, 0xbb, 0xa2, 0xf9, 0x17, 0x6d, 0x2e, 0x7c, 0x6d,
+	0x28, 0xd9, 0xf4, 0xe2, 0x9d, 0x4d, 0x13, 0x0a, 0xf9, 0x41, 0x5d, 0xfc, 0x8c, 0x43, 0xce, 0x15,
+	0x91, 0x28, 0x98, 0x82, 0x0f, 0x99, 0x53, 0x30, 0xfd, 0xfc, 0xf5, 0xe3, 0x3d, 0x88, 0x1a, 0x99,
+	0x39, 0x97, 0x5f, 0x74, 0xc8, 0x39, 0xfd, 0x84, 0x77, 0xfc, 0xac, 0xbd, 0x73, 0x75, 0x8f, 0x46,
+	0x99, 0x7b, 0x93, 0xd4, 0xb2, 0xfd, 0xbe, 0x74, 0x6f, 0x7c, 0xb5, 0xdc, 0x7d, 0x6e, 0xee, 0xf7,
+	0xe9, 0xeb, 0xf7, 0x2f, 0xbd, 0x75, 0x94, 0x2b, 0xe5, 0x2e, 0x52, 0x98, 0x67, 0x24, 0x10, 0x15,
+	0x18, 0x11, 0xf7, 0x63, 0x84, 0xf8, 0x8a, 0x89, 0x78, 0x9a, 0xf2, 0x36, 0x71, 0x6a, 0x53, 0xae,
+	0xdb, 0xc0, 0xe0, 0xe7, 0xfd, 0x6e, 0x95, 0x4c, 0x1b, 0x62, 0xf4, 0x21, 0x38, 0x02, 0x62, 0xcb,
+	0x11, 0xb0, 0x56, 0x9a, 0x06, 0x18, 0xe9, 0x09, 0xb8, 0x9b, 0xf3, 0x04, 0xac, 0x97, 0xc7, 0xf2,
+	0x40, 0x57, 0x80, 0x9b, 0x91, 0xa9, 0xb8, 0x4f, 0x13, 0xae, 0xf0, 0x6a, 0x65, 0x2c, 0xd3, 0x75,
+	0x49, 0x6e, 0xf1, 0xd4, 0x6b, 0xf7, 0x2f, 0x4d, 0xa9, 0x9f, 0xa0, 0x19, 0x79, 0xff, 0x8e, 0xaf,
+	0x5a, 0xd9, 0x79, 0x29, 0x8e, 0x3a, 0xcc, 0xed, 0xe3, 0x5e, 0xb6, 0x56, 0xed, 0x8c, 0xb9, 0x6a,
+	0xc5, 0x52, 0x7c, 0xcc, 0x7d, 0x56, 0x3f, 0xe8, 0x90, 0x27, 0x8a, 0x55, 0xbe, 0xfb, 0x1c, 0x99,
+	0xe0, 0x1e, 0x59, 0xf1, 0x74, 0xfa, 0x95, 0xb0, 0x56, 0x10, 0x50, 0xf7, 0x0a, 0x99, 0x52, 0x02,
+	0x5d, 0x3c, 0xe3, 0x19, 0x81, 0x3a, 0xa5, 0xb5, 0x80, 0xc6, 0xc1, 0x49, 0x8b, 0x7c, 0xf1, 0x64,
+	0xc6, 0xa4, 0x21, 0x2e, 0x30, 0x88, 0xf7, 0x07, 0x0e, 0x79, 0xcb, 0x38, 0x46, 0xe8, 0xc9, 0x8d,
+	0xb1, 0x45, 0xce, 0x0b, 0xcb, 0xc6, 0xe6, 0x28, 0x06, 0xad, 0x54, 0xf3, 0x72, 0x11, 0x12, 0x14,
+	0xf7, 0xf5, 0xfe, 0xa3, 0x43, 0xe6, 0x8c, 0xc7, 0x7a, 0x08, 0x8e, 0xac, 0xc8, 0x76, 0x64, 0xad,
+	0x94, 0xf6, 0x99, 0x8e, 0xf0, 0x64, 0x7d, 0xaf, 0x43, 0x2e, 0x1a, 0x58, 0x6b, 0x4c, 0xbe, 0xdf,
+	0xeb, 0x27, 0x34, 0x4d, 0x71, 0x49, 0x3d, 0x6d, 0xa8, 0x9c, 0xc5, 0x69, 0x41, 0xa1, 0x7a, 0x93,
+	0xee, 0x73, 0xfd, 0xf3, 0x76, 0xd2, 0xe0, 0xdf, 0x5c, 0x9c, 0x88, 0x97, 0xa4, 0x9e, 0x6d, 0x5d,
+	0xb4, 0x83, 0xc2, 0x70, 0x3d, 0x32, 0xc1, 0xf4, 0x0a, 0xca, 0x20, 0xb4, 0xad, 0x09, 0xbe, 0xf7,
+	0xdb, 0xac, 0x05, 0x04, 0xc4, 0x4b, 0xad, 0xe1, 0x6c, 0x24, 0x94, 0xad, 0x87, 0xce, 0xb5, 0x80,
+	0x86, 0x9d, 0x14, 0x9d, 0x6c, 0xbe, 0x61, 0x9d, 0x1a, 0x4e, 0x36, 0xd3, 0x4c, 0x34, 0x71, 0x90,
+	0x69, 0xc8, 0x8d, 0xc1, 0x8a, 0x66, 0x2a, 0x0c, 0x32, 0x01, 0xf1, 0x5e, 0xab, 0x90, 0x59, 0x83,
+	0x6b, 0x8b, 0x3e, 0x0c, 0x5f, 0x70, 0x62, 0xa9, 0x80, 0x8d, 0xf2, 0xe4, 0x31, 0x1d, 0xed, 0x0f,
+	0x7e, 0x35, 0xa7, 0x05, 0xa0, 0x54, 0xae, 0x07, 0xfb, 0x84, 0x3f, 0x51, 0x25, 0x97, 0xec, 0x0e,
+	0x43, 0x4a, 0x04, 0x1d, 0x90, 0x06, 0xa3, 0xfc, 0xc9, 0x89, 0x81, 0x0f, 0x26, 0xde, 0x08, 0x39,
+	0x5c, 0x39, 0x49, 0x39, 0x6c, 0xaa, 0x89, 0xea, 0x21, 0x6a, 0xe2, 0x39, 0x35, 0xeb, 0xb5, 0x9c,
+	0xcc, 0xb3, 0x55, 0xe5, 0x65, 0x52, 0x4b, 0x33, 0xda, 0x6f, 0xd6, 0x6d, 0x31, 0xdb, 0xca, 0x68,
+	0x1f, 0x18, 0xc4, 0xfd, 0x7a, 0x32, 0x97, 0xf9, 0x49, 0x97, 0x66, 0x09, 0xdd, 0x0b, 0xd8, 0x29,
+	0x1b, 0xf3, 0x2e, 0x4e, 0x2d, 0x9e, 0x45, 0xcb, 0x72, 0x93, 0x81, 0x40, 0x82, 0x20, 0x8f, 0xeb,
+	0xfd, 0xd7, 0x0a, 0x79, 0xd2, 0x7e, 0x05, 0x5a, 0x31, 0x7e, 0x83, 0xa5, 0x18, 0xbf, 0x22, 0x67,
+	0xce, 0xbd, 0x79, 0x44, 0xb7, 0x2f, 0x19, 0xbd, 0xe9, 0x5e, 0xcf, 0xbd, 0x84, 0x2b, 0x43, 0x67,
+	0x5e, 0x4f, 0x8f, 0x78, 0xc6, 0xdc, 0x5b, 0x7a, 0x8e, 0x4c, 0x24, 0xd4, 0x4f, 0xe3, 0xa8, 0x59,
+	0xb7, 0xdf, 0x26, 0xb0, 0x56, 0x10, 0x50, 0xef, 0xf7, 0xa7, 0xf2, 0x93, 0x7d, 0x9d, 0x9f, 0x1c,
+	0xc6, 0x89, 0x1b, 0x90, 0x1a, 0xf3, 0xa1, 0x71, 0xc9, 0x72, 0xf3, 0x78, 0x5f, 0x21, 0x6a, 0x11,
+	0x45, 0x7a, 0xb1, 0x81, 0x6f, 0x0d, 0x9b, 0x80, 0xb1, 0x70, 0xef, 0x91, 0x46, 0x5b, 0xba, 0xb6,
+	0x2a, 0x65, 0x1c, 0x02, 0x89, 0xbd, 0x99, 0xe6, 0x38, 0x83, 0xe2, 0x5e, 0xf9, 0xc3, 0x14, 0x37,
+	0x97, 0x92, 0x6a, 0x37, 0xc8, 0xc4, 0x6b, 0x3d, 0xa6, 0xf3, 0xf2, 0x7a, 0x60, 0x3c, 0xe2, 0x24,
+	0xea, 0xa0, 0xeb, 0x41, 0x06, 0x48, 0xdf, 0xfd, 0x94, 0x43, 0xa6, 0xd3, 0x76, 0x6f, 0x23, 0x89,
+	0xf7, 0x82, 0x0e, 0x4d, 0x9a, 0xb5, 0x32, 0x24, 0x5b, 0x6b, 0x69, 0x4d, 0x12, 0xd4, 0x7c, 0xb9,
+	0x33, 0x59, 0x43, 0xc0, 0xe4, 0x8b, 0xfb, 0xcb, 0x27, 0xc5, 0xb3, 0x2f, 0xd3, 0x36, 0xfb, 0xe2,
+	0xa4, 0x2b, 0xa8, 0x59, 0x2f, 0xc3, 0xe6, 0x5e, 0x1e, 0xb4, 0x77, 0xf1, 0x7b, 0xd3, 0x03, 0x7a,
+	0xf3, 0x6b, 0xf7, 0x2f, 0x3d, 0xb9, 0x54, 0xcc, 0x13, 0x46, 0x0d, 0x86, 0x4d, 0x58, 0x7f, 0x10,
+	0x86, 0xb8, 0xd9, 0xa6, 0xec, 0x7c, 0xa2, 0x84, 0x09, 0xdb, 0xd0, 0x04, 0x73, 0x13, 0x66, 0x40,
+	0xc0, 0xe4, 0xeb, 0xbe, 0x42, 0x26, 0x7a, 0x7e, 0x96, 0x04, 0xf7, 0x9a, 0x93, 0x65, 0xec, 0x82,
+	0xd6, 0x18, 0x2d, 0xcd, 0x9c, 0x29, 0x7a, 0xde, 0x08, 0x82, 0x11, 0x1e, 0x13, 0xf6, 0x68, 0xd2,
+	0xa5, 0xcd, 0x46, 0x19, 0x07, 0xb0, 0x6b, 0x48, 0x4a, 0x33, 0x9c, 0x42, 0xe3, 0x8a, 0xb5, 0x01,
+	0xe7, 0xe2, 0x7e, 0x88, 0x34, 0x52, 0x1a, 0xd2, 0x36, 0x9a, 0x47, 0x53, 0x8c, 0xe3, 0xbb, 0xc6,
+	0x34, 0x15, 0xd1, 0x2e, 0x69, 0x89, 0xae, 0xfc, 0x03, 0x93, 0xbf, 0x40, 0x91, 0xc4, 0x09, 0xec,
+	0x87, 0x83, 0x6e, 0x10, 0x35, 0x49, 0x19, 0x13, 0xb8, 0xc1, 0x68, 0xe5, 0x26, 0x90, 0x37, 0x82,
+	0x60, 0xe4, 0xfd, 0x17, 0x87, 0xb8, 0xb6, 0x50, 0x7b, 0x08, 0x36, 0xf1, 0x2b, 0xb6, 0x4d, 0xbc,
+	0x5a, 0xa6, 0xd1, 0x32, 0xc2, 0x2c, 0xfe, 0xe5, 0x29, 0x92, 0x53, 0x07, 0xb7, 0x68, 0x9a, 0xd1,
+	0xce, 0x1b, 0x22, 0xfc, 0x0d, 0x11, 0xfe, 0x86, 0x08, 0x97, 0x3f, 0xdc, 0xad, 0x9c, 0x08, 0x7f,
+	0xaf, 0xf1, 0xd5, 0xeb, 0x48, 0xb0, 0x8f, 0xa8, 0x50, 0x31, 0x73, 0x04, 0x06, 0x02, 0x4a, 0x82,
+	0x17, 0x5b, 0xeb, 0xb7, 0x0a, 0x65, 0xf6, 0x47, 0x6c, 0x99, 0x7d, 0x5c, 0x16, 0x7f, 0x15, 0xa4,
+	0xf4, 0x6f, 0x3a, 0xe4, 0xad, 0xb6, 0xf4, 0x92, 0x2b, 0x67, 0xa5, 0x1b, 0xc5, 0x09, 0x5d, 0x0e,
+	0xb6, 0xb7, 0x69, 0x42, 0x23, 0x3c, 0x11, 0x95, 0xbe, 0x1d, 0x67, 0x94, 0x6f, 0xc7, 0x7d, 0x37,
+	0x99, 0x79, 0x39, 0x8d, 0xa3, 0x8d, 0x38, 0x88, 0x84, 0x08, 0xc2, 0x1d, 0xc7, 0x69, 0x8c, 0x25,
+	0xc1, 0x19, 0x95, 0xed, 0x60, 0x61, 0xb9, 0x4b, 0xe4, 0xcc, 0xcb, 0xaf, 0x6c, 0xf8, 0x99, 0xe1,
+	0x4d, 0x90, 0xfb, 0x7e, 0x16, 0x1d, 0xf0, 0xe2, 0xfb, 0x72, 0x40, 0x18, 0xc6, 0xf7, 0xfe, 0x6e,
+	0x85, 0x5c, 0xc8, 0x3d, 0x48, 0x1c, 0x86, 0xf1, 0x20, 0xc3, 0x3d, 0x91, 0xfb, 0x63, 0x0e, 0x39,
+	0xdd, 0xb3, 0x1d, 0x16, 0xa9, 0x70, 0xe9, 0x7f, 0x53, 0x69, 0x3a, 0x22, 0xe7, 0x11, 0x59, 0x6c,
+	0x8a, 0x19, 0x3a, 0x9d, 0x03, 0xa4, 0x30, 0x34, 0x16, 0xf7, 0x43, 0x64, 0xaa, 0xe7, 0xdf, 0x7b,
+	0xa9, 0xdf, 0xf1, 0x33, 0xb9, 0x1d, 0x1d, 0xed, 0x45, 0x18, 0x64, 0x41, 0x38, 0xcf, 0x63, 0x0c,
+	0xe7, 0x57, 0xa2, 0x6c, 0x3d, 0x69, 0x65, 0x49, 0x10, 0x75, 0xb9, 0x93, 0x73, 0x4d, 0x92, 0x01,
+	0x4d, 0xd1, 0xfb, 0x51, 0x87, 0x3c, 0x3d, 0x62, 0x76, 0x12, 0x3f, 0xa3, 0xdd, 0x7d, 0xf7, 0x63,
+	0xa4, 0x8e, 0xfb, 0x46, 0x39, 0x2b, 0x77, 0xca, 0xd4, 0x9c, 0xc6, 0x9b, 0xd0, 0x4a, 0x14, 0x7f,
+	0xa5, 0xc0, 0x99, 0x7a, 0x3f, 0x36, 0x95, 0x37, 0x16, 0x58, 0xa4, 0xd4, 0xf3, 0x84, 0x74, 0xe3,
+	0x4d, 0xda, 0xeb, 0x87, 0x7e, 0xc6, 0xd7, 0x5d, 0x43, 0xbb, 0x4a, 0xae, 0x2b, 0x08, 0x18, 0x58,
+	0xee, 0xdf, 0x70, 0x08, 0xe9, 0xca, 0x35, 0x2f, 0x0d, 0x81, 0x97, 0xca, 0x7c, 0x1c, 0xfd, 0x45,
+	0xe9, 0xb1, 0x28, 0x86, 0x60, 0x30, 0x77, 0xbf, 0xdd, 0x21, 0x8d, 0x4c, 0x0e, 0xbf, 0x5a, 0xf2,
+	0x11, 0x6e, 0x8b, 0x66, 0xf2, 0xa1, 0xb5, 0x4d, 0xa4, 0xa6, 0x44, 0xf1, 0x75, 0xff, 0xba, 0x43,
+	0x08, 0x9e, 0x3a, 0x6f, 0xc4, 0x61, 0xd0, 0xde, 0x17, 0x1a, 0xf3, 0x76, 0xa9, 0xee, 0x1c, 0x45,
+	0x7d, 0x71, 0x16, 0x67, 0x43, 0xff, 0x06, 0x83, 0xb3, 0xfb, 0x71, 0xd2, 0x48, 0xc5, 0x72, 0x6b,
+	0xd6, 0xcb, 0x9f, 0x0c, 0xb9, 0x94, 0x85, 0x78, 0x15, 0xbf, 0x40, 0xf1, 0x74, 0x7f, 0xc8, 0x21,
+	0x73, 0x7d, 0xdb, 0x4d, 0x28, 0xd4, 0x61, 0x79, 0x32, 0x20, 0xe7, 0x86, 0xe4, 0xde, 0x96, 0x5c,
+	0x23, 0xe4, 0x47, 0x81, 0x12, 0x50, 0xaf, 0xe0, 0xf5, 0x3e, 0x77, 0x59, 0x4e, 0x6a, 0x09, 0x78,
+	0x3d, 0x0f, 0x84, 0x61, 0x7c, 0x77, 0x83, 0x9c, 0xc3, 0xd1, 0xed, 0x73, 0xf3, 0x53, 0xaa, 0x97,
+	0x94, 0x29, 0xc3, 0xc6, 0xe2, 0x53, 0x62, 0x85, 0x9c, 0x5b, 0x28, 0xc0, 0x81, 0xc2, 0x9e, 0xee,
+	0xef, 0x3a, 0xe4, 0xa9, 0x80, 0xa9, 0x01, 0xd3, 0x61, 0xaf, 0x35, 0x82, 0x08, 0x7b, 0xa2, 0xa5,
+	0xca, 0x8a, 0x51, 0xea, 0x67, 0xf1, 0x2d, 0xe2, 0x09, 0x9e, 0x5a, 0x39, 0x60, 0x48, 0x70, 0xe0,
+	0x80, 0xdd, 0xaf, 0x26, 0xa7, 0xe4, 0x77, 0xb1, 0x81, 0x22, 0x98, 0x29, 0xda, 0xa9, 0xc5, 0x33,
+	0x2c, 0x28, 0xc5, 0x04, 0x80, 0x8d, 0xe7, 0xfd, 0x4e, 0x95, 0x9c, 0xcb, 0x2f, 0x37, 0xe6, 0xe3,
+	0x41, 0x71, 0xd3, 0x96, 0xfe, 0x1f, 0x29, 0x3d, 0x4b, 0x15, 0x37, 0xca, 0xbb, 0xa4, 0xc5, 0x8d,
+	0x6a, 0x4a, 0xc1, 0x60, 0x8e, 0x46, 0xe9, 0x19, 0x3f, 0xef, 0x29, 0x15, 0x12, 0xf0, 0x43, 0x65,
+	0x0e, 0x69, 0xf8, 0x4c, 0xef, 0x82, 0x18, 0xda, 0x99, 0x21, 0x10, 0x0c, 0x0f, 0xc9, 0xfd, 0x56,
+	0x32, 0x95, 0xa8, 0x38, 0xc3, 0x6a, 0x19, 0x5b, 0x35, 0xb9, 0x6c, 0xc4, 0x70, 0xd4, 0x01, 0x90,
+	0x8e, 0x28, 0xd4, 0x1c, 0xbd, 0x4f, 0x57, 0xc8, 0x13, 0xf9, 0x97, 0x29, 0x64, 0xc4, 0xe1, 0x87,
+	0x7e, 0xdf, 0xe7, 0x90, 0xe9, 0x24, 0x0e, 0xc3, 0x20, 0xea, 0xa2, 0x9c, 0x13, 0xca, 0xfa, 0x83,
+	0x27, 0xa2, 0x2f, 0x85, 0x40, 0x63, 0x96, 0x35, 0x68, 0x9e, 0x60, 0x0e, 0x00, 0x83, 0xad, 0x64,
+	0xe4, 0xd3, 0x7a, 0x82, 0x7b, 0xa2, 0xaa, 0x1d, 0x6c, 0xb5, 0x6c, 0x02, 0xc1, 0xc6, 0xc5, 0xf0,
+	0xeb, 0xe6, 0x28, 0x61, 0xee, 0x52, 0xf2, 0x66, 0x29, 0xa9, 0xd4, 0x3c, 0xae, 0x47, 0x92, 0x9e,
+	0xd0, 0xc7, 0xcf, 0x0a, 0x3e, 0x6f, 0xde, 0x18, 0x8d, 0x0a, 0x07, 0xd1, 0x71, 0x3f, 0x40, 0x4e,
+	0x1b, 0x93, 0x92, 0xaa, 0x59, 0x9d, 0x5a, 0x9c, 0x47, 0xeb, 0x69, 0x21, 0x07, 0x7b, 0xfd, 0xfe,
+	0xa5, 0x27, 0xf2, 0x6d, 0x42, 0xdb, 0x0c, 0xd1, 0xf1, 0x7e, 0x6a, 0xe8, 0x55, 0x2b, 0x43, 0xe1,
+	0x73, 0xce, 0x90, 0x2b, 0xe2, 0x9b, 0x4e, 0x42, 0x39, 0x33, 0xa7, 0x85, 0x8a, 0x9d, 0x19, 0x8d,
+	0xf3, 0x08, 0xcf, 0xfc, 0xbd, 0x7f, 0x59, 0x23, 0x07, 0x8c, 0x6c, 0x0c, 0xcb, 0xff, 0xc8, 0x87,
+	0xb0, 0xdf, 0xe3, 0xa8, 0xd3, 0x36, 0x2e, 0x00, 0x3a, 0x27, 0x35, 0xf7, 0xf3, 0x66, 0xdc, 0x95,
+	0x72, 0xc1, 0xdb, 0xe7, 0x7a, 0xee, 0x8f, 0x3b, 0xf6, 0x79, 0x21, 0x8f, 0x4f, 0x0f, 0x4e, 0x6c,
+	0x4c, 0x43, 0xf1, 0x6c, 0xfa, 0xe8, 0x6a, 0xd4, 0xf1, 0xe4, 0x3c, 0x21, 0xdb, 0x41, 0xe4, 0x87,
+	0xc1, 0xab, 0xb8, 0xb5, 0xaa, 0x33, 0xeb, 0x80, 0x99, 0x5b, 0xd7, 0x54, 0x2b, 0x18, 0x18, 0x17,
+	0xff, 0x1a, 0x99, 0x7e, 0xc0, 0xa8, 0xa8, 0x8b, 0xef, 0x25, 0xa7, 0x8f, 0x13, 0x9f, 0xe5, 0xfd,
+	0xef, 0xc9, 0xfc, 0x01, 0xde, 0x26, 0x4d, 0x7a, 0x38, 0xb4, 0x37, 0xbc, 0x62, 0x6f, 0x78, 0xc5,
+	0xde, 0xf0, 0x8a, 0x99, 0x07, 0x1b, 0xc2, 0xe3, 0x33, 0xf9, 0x90, 0x3c, 0x3e, 0x96, 0x0f, 0xab,
+	0x51, 0xba, 0x0f, 0xcb, 0xfb, 0xd4, 0x90, 0xdb, 0x7f, 0x33, 0xa1, 0xd4, 0x8d, 0x49, 0x3d, 0x8a,
+	0x3b, 0x54, 0x1a, 0xc8, 0x2f, 0x96, 0x63, 0xed, 0xdd, 0x8a, 0x3b, 0x46, 0xe6, 0x0f, 0xfe, 0x4a,
+	0x81, 0xf3, 0xf1, 0xbe, 0x73, 0x82, 0x58, 0xb6, 0x28, 0x7f, 0xef, 0x98, 0x38, 0x49, 0xfb, 0xf1,
+	0x4b, 0xb0, 0xda, 0x74, 0xec, 0x93, 0x67, 0xe0, 0xcd, 0x20, 0xe1, 0xa8, 0xf3, 0xfa, 0x7e, 0xb6,
+	0xd3, 0xac, 0xd8, 0x3a, 0x0f, 0xfd, 0x4e, 0xc0, 0x20, 0xee, 0x7b, 0xc9, 0x6c, 0x66, 0x9d, 0xa3,
+	0x8b, 0xf3, 0xe2, 0x27, 0x04, 0xee, 0xac, 0x7d, 0xca, 0x0e, 0x39, 0x6c, 0xf7, 0x15, 0x52, 0xdb,
+	0xa1, 0x61, 0x4f, 0xbc, 0xfa, 0x56, 0x79, 0xba, 0x86, 0x3d, 0xeb, 0x0d, 0x1a, 0xf6, 0xb8, 0x24,
+	0xc4, 0xff, 0x80, 0xb1, 0xc2, 0x75, 0x3f, 0xb5, 0x3b, 0x48, 0xb3, 0xb8, 0x17, 0xbc, 0x2a, 0xdd,
+	0xa4, 0xdf, 0x54, 0x32, 0xe3, 0x9b, 0x92, 0x3e, 0xf7, 0x47, 0xa9, 0x9f, 0xa0, 0x39, 0xb3, 0x71,
+	0x74, 0x82, 0x84, 0x2d, 0x99, 0xfd, 0x26, 0x39, 0x91, 0x71, 0x2c, 0x4b, 0xfa, 0x7c, 0x1c, 0xea,
+	0x27, 0x68, 0xce, 0xee, 0xbe, 0xfa, 0xfe, 0xa6, 0x2f, 0x3b, 0xe5, 0x6e, 0xdc, 0xd8, 0x18, 0xf8,
+	0xb7, 0x57, 0xf8, 0x1d, 0x3e, 0x4b, 0xea, 0xed, 0x1d, 0x3f, 0xc9, 0x9a, 0x33, 0x6c, 0xd1, 0xa8,
+	0x55, 0xbc, 0x84, 0x8d, 0xc0, 0x61, 0x18, 0x54, 0x95, 0xd0, 0xed, 0xe6, 0x29, 0x3b, 0xa8, 0x0a,
+	0xe8, 0x36, 0x60, 0xbb, 0xb2, 0xcb, 0x66, 0x47, 0x46, 0xdb, 0xfd, 0x44, 0x85, 0x5c, 0x1c, 0x1a,
+	0x95, 0x9a, 0x0a, 0xfe, 0x3d, 0xb4, 0x07, 0x49, 0x2a, 0xbd, 0x6b, 0xc6, 0xf7, 0xc0, 0x9a, 0x41,
+	0xc2, 0xdd, 0x4f, 0x3a, 0x64, 0x12, 0xdd, 0xb6, 0x11, 0x95, 0x51, 0xb7, 0xb7, 0x4b, 0x9e, 0xac,
+	0x17, 0x39, 0x75, 0x3d, 0x06, 0xd1, 0x00, 0x92, 0x2f, 0x0e, 0x97, 0xf2, 0xdc, 0x89, 0x7c, 0x24,
+	0x8d, 0x48, 0xa9, 0x00, 0x09, 0x47, 0xd4, 0x20, 0xe2, 0xa8, 0x35, 0x1b, 0x75, 0x25, 0x12, 0xa8,
+	0x02, 0xee, 0xfd, 0x42, 0x83, 0x9c, 0x2f, 0xfc, 0x7c, 0xd0, 0xe4, 0x62, 0x46, 0xcd, 0xb5, 0x20,
+	0xa4, 0x32, 0x86, 0x8c, 0x99, 0x5c, 0xb7, 0x55, 0x2b, 0x18, 0x18, 0xee, 0xb7, 0x11, 0xd2, 0xf7,
+	0x13, 0xbf, 0x47, 0x95, 0xf7, 0xfb, 0xd8, 0x96, 0x0d, 0x8e, 0x63, 0x43, 0xd2, 0xd4, 0x1e, 0x00,
+	0xd5, 0x94, 0x82, 0xc1, 0x12, 0xa3, 0xa2, 0x12, 0x1a, 0x52, 0x3f, 0x65, 0x99, 0x4c, 0xf9, 0xb4,
+	0x4c, 0xd0, 0x20, 0x30, 0xf1, 0x30, 0x50, 0x45, 0x84, 0xdb, 0xe5, 0xc2, 0x8e, 0xec, 0x90, 0x3b,
+	0xf7, 0xfb, 0x1d, 0x32, 0x8b, 0xa9, 0xe2, 0x9a, 0xbb, 0x48, 0xa2, 0x5c, 0x3f, 0xfe, 0x43, 0x5e,
+	0x33, 0xe9, 0x6a, 0x19, 0x6a, 0x35, 0xa7, 0x90, 0x63, 0x8f, 0xaf, 0x79, 0x8f, 0x26, 0x4c, 0xf8,
+	0x4e, 0xd8, 0xaf, 0xf9, 0x36, 0x6f, 0x06, 0x09, 0xc7, 0x5c, 0xa0, 0xbe, 0x9f, 0xa6, 0x4b, 0x09,
+	0xed, 0xd0, 0x28, 0x0b, 0xfc, 0x90, 0xa7, 0x38, 0x36, 0x74, 0xbc, 0xfd, 0x86, 0x0d, 0x86, 0x3c,
+	0xbe, 0xfb, 0x7e, 0xf2, 0x24, 0x77, 0x2f, 0xad, 0x05, 0x69, 0x1a, 0x44, 0x5d, 0xbd, 0x0c, 0x84,
+	0x97, 0xed, 0x92, 0x20, 0xf5, 0xe4, 0x4a, 0x31, 0x1a, 0x8c, 0xea, 0x8f, 0xf1, 0x91, 0xe9, 0x6e,
+	0xd0, 0x5f, 0x4a, 0x3a, 0x29, 0x3b, 0x5a, 0x6a, 0x68, 0x9f, 0x6e, 0x4b, 0xb4, 0x83, 0xc2, 0x70,
+	0xdb, 0x64, 0x86, 0xbf, 0x12, 0x1e, 0x2f, 0x28, 0x24, 0xe8, 0x3b, 0x46, 0x2a, 0x72, 0x51, 0xcd,
+	0x60, 0x1e, 0xfc, 0xbb, 0x57, 0xe5, 0x41, 0x17, 0x3f, 0x97, 0xb9, 0x6d, 0x90, 0x01, 0x8b, 0xa8,
+	0xbd, 0xa7, 0x9b, 0x1e, 0x63, 0x4f, 0xf7, 0x55, 0x64, 0x7a, 0x77, 0xb0, 0x45, 0xc5, 0xcc, 0x37,
+	0x67, 0xec, 0xd5, 0x77, 0x53, 0x83, 0xc0, 0xc4, 0x63, 0xa1, 0x9a, 0xfd, 0x40, 0xfc, 0xc2, 0xac,
+	0x3a, 0x1d, 0xaa, 0xb9, 0xb1, 0x22, 0x9b, 0xc1, 0xc4, 0xc1, 0xa1, 0xe1, 0x5c, 0x6c, 0xd2, 0x94,
+	0xe5, 0xc5, 0xe1, 0x74, 0xa9, 0xa1, 0xb5, 0x24, 0x00, 0x34, 0x0e, 0x3a, 0x47, 0xf1, 0x47, 0x8b,
+	0x55, 0x73, 0xb8, 0xed, 0x87, 0x41, 0x87, 0xc7, 0x0d, 0xce, 0xd9, 0xce, 0xd1, 0x56, 0x01, 0x0e,
+	0x14, 0xf6, 0xc4, 0x6a, 0x09, 0xcd, 0x51, 0x22, 0xcc, 0x4d, 0x51, 0x50, 0x65, 0xb7, 0xfd, 0x44,
+	0x1a, 0x3c, 0xc7, 0xcc, 0x50, 0x10, 0x74, 0x6f, 0xfb, 0x89, 0x29, 0xf2, 0x18, 0x03, 0x90, 0x9c,
+	0xdc, 0x97, 0x49, 0x2d, 0x0b, 0xfd, 0x92, 0x12, 0xdb, 0x0d, 0x8e, 0xda, 0x0b, 0xb6, 0xba, 0x90,
+	0x02, 0xe3, 0xe1, 0x3e, 0x85, 0xbb, 0xb7, 0x2d, 0x79, 0x4c, 0x27, 0x36, 0x5c, 0x5b, 0x29, 0xb0,
+	0x56, 0xef, 0x6f, 0x9d, 0x2a, 0xd0, 0x3a, 0xca, 0x10, 0xc0, 0x63, 0x1d, 0x5c, 0x34, 0x1b, 0x09,
+	0xdd, 0x0e, 0xee, 0x09, 0x43, 0x4c, 0x49, 0xb6, 0x5b, 0x0a, 0x02, 0x06, 0x96, 0xec, 0xd3, 0x1a,
+	0x6c, 0x63, 0x9f, 0xca, 0x70, 0x1f, 0x0e, 0x01, 0x03, 0xcb, 0x7d, 0x37, 0x99, 0x08, 0x7a, 0x7e,
+	0x57, 0x45, 0x11, 0x3f, 0x85, 0x22, 0x6d, 0x85, 0xb5, 0xbc, 0x7e, 0xff, 0xd2, 0xac, 0x1a, 0x10,
+	0x6b, 0x02, 0x81, 0xeb, 0xfe, 0x94, 0x43, 0x66, 0xda, 0x71, 0xaf, 0x17, 0x47, 0x22, 0x35, 0x8c,
+	0xfb, 0x02, 0x5e, 0x3e, 0x29, 0x33, 0x69, 0x7e, 0xc9, 0x60, 0xc6, 0x9d, 0x01, 0x2a, 0x03, 0xdf,
+	0x04, 0x81, 0x35, 0x2a, 0x53, 0xf2, 0xd5, 0x0f, 0x91, 0x7c, 0xbf, 0xe4, 0x90, 0x33, 0xbc, 0xaf,
+	0x99, 0xb0, 0xc7, 0x93, 0xcd, 0xe3, 0x13, 0x7e, 0xac, 0x21, 0x47, 0x87, 0xf2, 0x14, 0x0f, 0xc1,
+	0x61, 0x78, 0x90, 0xee, 0x75, 0x72, 0x66, 0x3b, 0x4e, 0xda, 0xd4, 0x9c, 0x08, 0x21, 0xb6, 0x15,
+	0xa1, 0x6b, 0x79, 0x04, 0x18, 0xee, 0xe3, 0xde, 0x26, 0x4f, 0x18, 0x8d, 0xe6, 0x3c, 0x70, 0xc9,
+	0xfd, 0x8c, 0xa0, 0xf6, 0xc4, 0xb5, 0x42, 0x2c, 0x18, 0xd1, 0xdb, 0x16, 0x92, 0x53, 0x63, 0x08,
+	0xc9, 0x8f, 0x90, 0x0b, 0xed, 0xe1, 0x99, 0xd9, 0x4b, 0x07, 0x5b, 0x29, 0x97, 0xe3, 0x8d, 0xc5,
+	0x2f, 0x13, 0x04, 0x2e, 0x2c, 0x8d, 0x42, 0x84, 0xd1, 0x34, 0xdc, 0x8f, 0x91, 0x46, 0x42, 0xd9,
+	0x5b, 0x49, 0x45, 0xe6, 0xf5, 0x31, 0xbd, 0x1d, 0xda, 0x82, 0xe7, 0x64, 0xb5, 0x66, 0x12, 0x0d,
+	0x29, 0x28, 0x8e, 0xee, 0x5d, 0x32, 0xd9, 0xc7, 0x13, 0x13, 0x91, 0x6f, 0x7d, 0x6c, 0xc7, 0xbe,
+	0x62, 0xce, 0xce, 0x61, 0x8c, 0xea, 0x35, 0x9c, 0x09, 0x48, 0x6e, 0x68, 0xab, 0xb5, 0xe3, 0x5e,
+	0x3f, 0x8e, 0x68, 0x94, 0x49, 0x25, 0x32, 0xcb, 0x0f, 0x4b, 0x64, 0x2b, 0x18, 0x18, 0x43, 0xba,
+	0x5c, 0xa3, 0x35, 0xcf, 0x1c, 0xa0, 0xcb, 0x0d, 0x6a, 0xa3, 0xfa, 0xa3, 0xb2, 0x61, 0x6e, 0xc5,
+	0x3b, 0x41, 0xb6, 0x83, 0x7e, 0x7c, 0xb9, 0xdd, 0x9e, 0xb5, 0x95, 0xcd, 0x6a, 0x01, 0x0e, 0x14,
+	0xf6, 0xcc, 0x6b, 0xd6, 0xb9, 0x07, 0xd3, 0xac, 0xa7, 0xc7, 0xd0, 0xac, 0x2d, 0x72, 0x9e, 0x8d,
+	0x40, 0x58, 0xc9, 0xd2, 0x69, 0x89, 0x49, 0xce, 0x38, 0x78, 0x95, 0x1c, 0xb3, 0x5a, 0x84, 0x04,
+	0xc5, 0x7d, 0x2f, 0x7e, 0x03, 0x39, 0x33, 0x24, 0xe4, 0x8e, 0xe4, 0x90, 0x5c, 0x26, 0x4f, 0x14,
+	0x8b, 0x93, 0x23, 0xb9, 0x25, 0x7f, 0x21, 0x17, 0xd4, 0x6e, 0x6c, 0xd1, 0xc6, 0x70, 0x71, 0xfb,
+	0xa4, 0x4a, 0xa3, 0x3d, 0xa1, 0x5d, 0xaf, 0x1d, 0x6f, 0x55, 0x5f, 0x8d, 0xf6, 0xb8, 0x34, 0x64,
+	0x7e, 0xbc, 0xab, 0xd1, 0x1e, 0x20, 0x6d, 0xf7, 0x07, 0x1c, 0x6b, 0x03, 0xc1, 0x1d, 0xe3, 0x1f,
+	0x3e, 0x91, 0x3d, 0xe9, 0xd8, 0x7b, 0x0a, 0xef, 0x5f, 0x55, 0xc8, 0xe5, 0xc3, 0x88, 0x8c, 0x31,
+	0x7d, 0xcf, 0x62, 0x54, 0x3d, 0x86, 0xa9, 0x08, 0x75, 0x35, 0x8d, 0x5f, 0x31, 0x0f, 0x5c, 0xf9,
+	0x08, 0x08, 0x90, 0x1b, 0x92, 0x6a, 0xcf, 0xef, 0x0b, 0x7f, 0xe9, 0xca, 0x71, 0x93, 0xff, 0xf0,
+	0xb7, 0x1f, 0xae, 0xf9, 0x7d, 0xbe, 0xe6, 0x8d, 0x06, 0x40, 0x36, 0x6e, 0x46, 0xea, 0x7e, 0x92,
+	0xf8, 0x32, 0x26, 0xe2, 0x66, 0x39, 0xfc, 0x16, 0x90, 0x24, 0x3f, 0x52, 0xb6, 0x9a, 0x80, 0x33,
+	0xf3, 0x7e, 0xa8, 0x61, 0x65, 0x8a, 0xb1, 0x40, 0x97, 0x94, 0x4c, 0x08, 0x37, 0xa9, 0x53, 0x76,
+	0xce, 0x25, 0x23, 0xcb, 0x3d, 0x10, 0xfc, 0x7f, 0x10, 0xac, 0xdc, 0xcf, 0x38, 0xac, 0x88, 0x8f,
+	0xaa, 0x31, 0x50, 0x39, 0xc1, 0x1a, 0x03, 0x66, 0x69, 0x20, 0xd9, 0x08, 0x26, 0x77, 0x51, 0xa8,
+	0x8c, 0xed, 0x66, 0x86, 0x0b, 0x95, 0x61, 0x33, 0x48, 0xb8, 0x7b, 0xaf, 0x20, 0xa0, 0xa5, 0x84,
+	0x42, 0x30, 0x63, 0x84, 0xb0, 0xfc, 0xb8, 0x43, 0xce, 0x04, 0xf9, 0xc8, 0x84, 0x66, 0xbd, 0x8c,
+	0x90, 0xa9, 0xd1, 0x81, 0x0f, 0xca, 0xd0, 0x19, 0x02, 0xc1, 0xf0, 0x60, 0xdc, 0x0e, 0xa9, 0x05,
+	0xd1, 0x76, 0x2c, 0xcc, 0xbb, 0xc5, 0xe3, 0x0d, 0x6a, 0x25, 0xda, 0x8e, 0xf5, 0xd7, 0x8c, 0xbf,
+	0x80, 0x51, 0x77, 0x57, 0xc9, 0x39, 0x99, 0x2c, 0x74, 0x23, 0x48, 0xd1, 0x97, 0xb4, 0x1a, 0xf4,
+	0x82, 0x8c, 0x99, 0x66, 0xd5, 0xc5, 0x26, 0xaa, 0x37, 0x28, 0x80, 0x43, 0x61, 0x2f, 0xf7, 0x55,
+	0x32, 0x29, 0xa3, 0x01, 0x1a, 0x65, 0xf8, 0x13, 0x86, 0xd7, 0xbf, 0x5a, 0x4c, 0xfc, 0x77, 0x0a,
+	0x92, 0xa1, 0xfb, 0x69, 0x87, 0xcc, 0xf2, 0xff, 0x6f, 0xec, 0x77, 0x78, 0x7e, 0xe2, 0x54, 0x19,
+	0x21, 0xff, 0x2d, 0x8b, 0xe6, 0xa2, 0x8b, 0xce, 0x0c, 0xbb, 0x0d, 0x72, 0x7c, 0xbd, 0x7f, 0x30,
+	0x43, 0xce, 0x2c, 0x1c, 0x1c, 0x2c, 0xe1, 0x3c, 0xec, 0x60, 0x09, 0xdc, 0x55, 0xa6, 0x3a, 0xce,
+	0xa1, 0x84, 0xcf, 0x4c, 0x70, 0xd5, 0xc7, 0xd0, 0x18, 0xd1, 0xc0, 0x78, 0xb8, 0x03, 0x32, 0xc1,
+	0xeb, 0x04, 0x36, 0xab, 0x65, 0x1c, 0x87, 0xe4, 0x8a, 0x19, 0x6a, 0xb7, 0x16, 0x6f, 0x05, 0xc1,
+	0xcc, 0xbd, 0x47, 0x26, 0x77, 0xf8, 0x72, 0x14, 0x7b, 0xbd, 0xb5, 0xe3, 0xce, 0xaf, 0xb5, 0xc6,
+	0xf5, 0xe2, 0x13, 0x0d, 0x20, 0xd9, 0xb1, 0xd8, 0x3c, 0x23, 0x7a, 0x88, 0x0b, 0x92, 0xf2, 0x52,
+	0x2d, 0xc7, 0x0f, 0x1d, 0xfa, 0x28, 0x99, 0x49, 0x68, 0x3b, 0x8e, 0xda, 0x41, 0x48, 0x3b, 0x0b,
+	0xf2, 0x40, 0xec, 0x28, 0x19, 0x76, 0xcc, 0x9b, 0x04, 0x06, 0x0d, 0xb0, 0x28, 0xb2, 0xef, 0x4c,
+	0x65, 0xdd, 0xe3, 0x0b, 0xa1, 0xe2, 0xe0, 0x63, 0xb5, 0xa4, 0x1c, 0x7f, 0x46, 0x93, 0x7f, 0x67,
+	0x76, 0x1b, 0xe4, 0xf8, 0xba, 0x1f, 0x20, 0x24, 0xde, 0xe2, 0x01, 0x78, 0x0b, 0x59, 0xb3, 0x71,
+	0xe4, 0x47, 0x9d, 0xe5, 0x99, 0xba, 0x92, 0x02, 0x18, 0xd4, 0xdc, 0x9b, 0x84, 0xf0, 0x2f, 0x07,
+	0x8f, 0x29, 0x9b, 0x53, 0x56, 0x8a, 0x24, 0x69, 0x29, 0xc8, 0xeb, 0xf7, 0x2f, 0x0d, 0xfb, 0x9c,
+	0x11, 0x00, 0x46, 0x77, 0xf7, 0x5b, 0xc8, 0x64, 0x3a, 0xe8, 0xf5, 0x7c, 0x75, 0x46, 0x52, 0x62,
+	0xee, 0x2f, 0xa7, 0x6b, 0x08, 0x46, 0xde, 0x00, 0x92, 0xa3, 0xfb, 0x32, 0x8a, 0x78, 0x21, 0xa1,
+	0xf8, 0x57, 0xc4, 0xfe, 0x17, 0x9e, 0xc0, 0xf7, 0xc8, 0x5d, 0x0c, 0x14, 0xe0, 0x60, 0x88, 0x8e,
+	0xdd, 0xbe, 0x1a, 0xb7, 0x85, 0x33, 0xad, 0x88, 0xa6, 0xfb, 0x22, 0x99, 0xd6, 0x8f, 0x2d, 0x2b,
+	0x75, 0xbd, 0x4d, 0x97, 0x44, 0x64, 0xcd, 0xa3, 0xe7, 0xcc, 0xec, 0xec, 0xae, 0x91, 0xb3, 0xed,
+	0x38, 0xca, 0x92, 0x38, 0x0c, 0x79, 0xb9, 0x54, 0xbe, 0x37, 0xe7, 0x67, 0x28, 0x6f, 0x16, 0xc3,
+	0x3e, 0xbb, 0x34, 0x8c, 0x02, 0x45, 0xfd, 0xd0, 0x26, 0xcf, 0xeb, 0x87, 0xd9, 0x52, 0x8e, 0xd7,
+	0x2d, 0x9a, 0x42, 0x42, 0x29, 0xb7, 0xf7, 0x21, 0x9a, 0x22, 0xb2, 0x0f, 0x59, 0xc5, 0x1b, 0x7b,
+	0x37, 0x99, 0xc1, 0x34, 0x86, 0x24, 0xf2, 0xc3, 0x97, 0x60, 0x55, 0x1e, 0x58, 0xb0, 0x0f, 0xf3,
+	0xaa, 0xd1, 0x0e, 0x16, 0x16, 0xa6, 0xbd, 0x0b, 0x2f, 0x99, 0x91, 0xf6, 0xce, 0xbd, 0x64, 0xd2,
+	0x27, 0xe6, 0xfd, 0x7c, 0xd5, 0xb2, 0x59, 0x1f, 0xc9, 0x91, 0x2e, 0xab, 0x76, 0x27, 0xcb, 0x02,
+	0x32, 0x40, 0xb3, 0x52, 0x3a, 0x67, 0x15, 0x35, 0xb7, 0x6e, 0x32, 0x02, 0x9b, 0xaf, 0xbb, 0x4b,
+	0xea, 0x3b, 0x71, 0x9a, 0xc9, 0x1d, 0xda, 0x31, 0x37, 0x83, 0x37, 0xe2, 0x34, 0x63, 0x86, 0x96,
+	0x7a, 0x6c, 0x6c, 0x49, 0x81, 0xf3, 0xc0, 0xbd, 0x7f, 0xba, 0xe3, 0x27, 0x9d, 0x74, 0x89, 0x15,
+	0xa9, 0xa8, 0x31, 0x0b, 0x4b, 0xd9, 0xd3, 0x2d, 0x0d, 0x02, 0x13, 0xcf, 0xfb, 0x53, 0xc7, 0x3a,
+	0xd5, 0x3a, 0xa9, 0x72, 0x3c, 0x9f, 0x70, 0xec, 0x44, 0xfc, 0x4a, 0x19, 0x5b, 0x37, 0x63, 0xdc,
+	0x87, 0xe7, 0xf4, 0x7b, 0x3f, 0xe0, 0x90, 0xc9, 0x45, 0xbf, 0xbd, 0x1b, 0x6f, 0x6f, 0xe3, 0x31,
+	0x4a, 0x67, 0x90, 0x98, 0x35, 0x01, 0x94, 0xb3, 0x6a, 0x59, 0xb4, 0x83, 0xc2, 0xc0, 0xa5, 0xbf,
+	0xed, 0xb7, 0x65, 0x49, 0x8a, 0x2a, 0x5f, 0xfa, 0xd7, 0x58, 0x0b, 0x08, 0x08, 0x4e, 0x7f, 0xcf,
+	0xbf, 0x27, 0x3b, 0xe7, 0x8f, 0xd4, 0xd6, 0x34, 0x08, 0x4c, 0x3c, 0xef, 0x2f, 0x1c, 0xd2, 0x5c,
+	0xf4, 0xd3, 0xa0, 0x8d, 0xd5, 0x9e, 0x17, 0x83, 0x6c, 0x6b, 0xd0, 0xde, 0xa5, 0x19, 0x2f, 0x5d,
+	0x82, 0xa3, 0x1c, 0xa4, 0x34, 0x31, 0x76, 0xcc, 0x6a, 0x94, 0x2f, 0x89, 0x76, 0x50, 0x18, 0xee,
+	0xab, 0x64, 0x1a, 0x0f, 0xa2, 0xee, 0xc6, 0x49, 0x07, 0xe8, 0x76, 0x39, 0x05, 0x9c, 0x5a, 0xb4,
+	0x9d, 0xd0, 0x0c, 0xe8, 0xb6, 0x08, 0x50, 0xd1, 0xf4, 0xc1, 0x64, 0xe6, 0xbe, 0x40, 0x66, 0xe4,
+	0xcf, 0x6b, 0xba, 0x86, 0xb4, 0xf2, 0x4f, 0x6f, 0x18, 0x30, 0xb0, 0x30, 0xbd, 0x7f, 0xee, 0x90,
+	0x73, 0x8b, 0xd4, 0x4f, 0x68, 0xc2, 0x2a, 0x45, 0xa9, 0x29, 0x70, 0x5f, 0x21, 0x0d, 0x56, 0xf0,
+	0x0f, 0x9f, 0xc5, 0x29, 0xf7, 0x59, 0x58, 0x50, 0xca, 0xa6, 0x20, 0x0e, 0x8a, 0x0d, 0x3a, 0x69,
+	0xd9, 0xff, 0xec, 0x11, 0x72, 0xd1, 0x89, 0x9b, 0x12, 0x00, 0x1a, 0xc7, 0xfb, 0xbc, 0x43, 0x2e,
+	0x14, 0x0d, 0x7e, 0x29, 0x8c, 0x07, 0x9d, 0x2f, 0x89, 0x27, 0xf8, 0x3b, 0x0e, 0x99, 0x61, 0xa1,
+	0x04, 0xcb, 0x34, 0xf3, 0x83, 0x70, 0xa8, 0x62, 0xaf, 0x33, 0x66, 0xc5, 0xde, 0xcb, 0xa4, 0xb6,
+	0x13, 0xf7, 0x68, 0x3e, 0x0c, 0xe6, 0x46, 0x8c, 0x8e, 0x1d, 0x84, 0xa0, 0x93, 0xb1, 0xe7, 0x07,
+	0x51, 0xe6, 0xa3, 0xa8, 0x90, 0x47, 0x2d, 0x73, 0xfc, 0xe3, 0x50, 0xcd, 0x60, 0xe2, 0x78, 0xbf,
+	0x36, 0x45, 0x26, 0x45, 0xcc, 0xd6, 0xd8, 0x65, 0x7e, 0xa4, 0x87, 0xa9, 0x32, 0xd2, 0xc3, 0x94,
+	0x92, 0x89, 0x36, 0x2b, 0xab, 0xde, 0xac, 0x96, 0xe1, 0xcf, 0x11, 0x03, 0xe4, 0x95, 0xda, 0xf5,
+	0xb0, 0xf8, 0x6f, 0x10, 0xac, 0xdc, 0xcf, 0x3a, 0x64, 0xae, 0x1d, 0x47, 0x11, 0x2f, 0x38, 0xc9,
+	0xed, 0xda, 0x5a, 0x19, 0x9b, 0x97, 0x25, 0x9b, 0xa8, 0x3e, 0xa5, 0xce, 0x01, 0x20, 0xcf, 0x1e,
+	0x03, 0xc2, 0xf9, 0x9c, 0xdd, 0xb6, 0xce, 0x87, 0x74, 0x21, 0x57, 0x13, 0x08, 0x36, 0x2e, 0xba,
+	0xd1, 0x23, 0x5d, 0x32, 0x75, 0x42, 0xbb, 0xd1, 0x8d, 0x62, 0xa9, 0x06, 0x06, 0x16, 0xe8, 0x10,
+	0x15, 0x33, 0x45, 0x4c, 0x1b, 0xb3, 0xa9, 0x27, 0x1f, 0xac, 0x40, 0x07, 0x0c, 0x51, 0x82, 0x02,
+	0xea, 0xee, 0xae, 0x70, 0x71, 0x34, 0xca, 0xd0, 0x35, 0xe2, 0x35, 0x8f, 0xf4, 0x74, 0x5c, 0x22,
+	0x75, 0xa6, 0x56, 0x99, 0x2d, 0x5f, 0xe5, 0x49, 0xa1, 0x4c, 0xe9, 0x02, 0x6f, 0x77, 0x97, 0xc9,
+	0xe9, 0x5c, 0x19, 0xda, 0x54, 0x9c, 0xe3, 0xa8, 0x04, 0xc0, 0x5c, 0x01, 0xdb, 0x14, 0x86, 0x7a,
+	0x98, 0xee, 0xaf, 0xe9, 0x43, 0xdc, 0x5f, 0xfb, 0x2a, 0x72, 0x9a, 0x9f, 0xb0, 0xbc, 0xaf, 0x94,
+	0x09, 0x18, 0x2b, 0x4c, 0xfa, 0x7b, 0x73, 0x61, 0xd2, 0xa7, 0x2e, 0x57, 0x8f, 0x1f, 0x08, 0x24,
+	0x07, 0x70, 0xf4, 0x98, 0xe8, 0x47, 0x19, 0xe3, 0xfc, 0x3f, 0x1d, 0x22, 0xdf, 0xeb, 0x92, 0xdf,
+	0xde, 0xa1, 0xb8, 0x64, 0x30, 0x24, 0x50, 0x79, 0x4e, 0xb8, 0xb9, 0xe6, 0xb0, 0x55, 0xa3, 0xec,
+	0x7a, 0xb0, 0xa0, 0x90, 0xc3, 0x46, 0x31, 0x8f, 0xf3, 0xc4, 0xbb, 0x72, 0x9b, 0x44, 0x89, 0xf9,
+	0x85, 0x8d, 0x15, 0xd1, 0x4b, 0xe3, 0xb8, 0x31, 0x39, 0x13, 0xfa, 0x69, 0xc6, 0x46, 0x80, 0x8e,
+	0x94, 0x07, 0x2c, 0x8f, 0xc3, 0xb2, 0xcc, 0x56, 0xf3, 0x84, 0x60, 0x98, 0xb6, 0xf7, 0xaf, 0xeb,
+	0xe4, 0x94, 0x25, 0x19, 0x8f, 0x68, 0xcc, 0xbc, 0x9d, 0x34, 0xa4, 0x99, 0x90, 0xaf, 0x03, 0xa6,
+	0x8c, 0x10, 0x85, 0x81, 0x4a, 0x6b, 0x4b, 0xab, 0xe1, 0xbc, 0xf1, 0x65, 0x68, 0x68, 0x30, 0xf1,
+	0x98, 0x50, 0xce, 0xc2, 0x74, 0x29, 0x0c, 0x68, 0x94, 0xf1, 0x61, 0x96, 0x23, 0x94, 0x37, 0x57,
+	0x5b, 0x26, 0x51, 0x2d, 0x94, 0x73, 0x00, 0xc8, 0xb3, 0x77, 0xbf, 0xd3, 0x21, 0xa7, 0xfc, 0xbb,
+	0xa9, 0xbe, 0xfb, 0xa3, 0x59, 0x2f, 0x43, 0x49, 0x59, 0xd7, 0x89, 0xf0, 0x43, 0x07, 0xab, 0x09,
+	0x6c, 0xa6, 0x98, 0xf4, 0xe2, 0xd2, 0x7b, 0xb4, 0x2d, 0x43, 0xb6, 0xc5, 0x58, 0x26, 0xca, 0xf0,
+	0x2e, 0x5c, 0x1d, 0xa2, 0xcb, 0xa5, 0xfa, 0x70, 0x3b, 0x14, 0x8c, 0x81, 0xd5, 0x7d, 0x0e, 0x52,
+	0x7f, 0x2b, 0xc4, 0x53, 0x76, 0x99, 0x19, 0xdd, 0x9c, 0xcc, 0xd5, 0x7d, 0x1e, 0xc2, 0x80, 0x82,
+	0x5e, 0x6c, 0x95, 0x25, 0xf1, 0xbd, 0xfd, 0x97, 0x92, 0xb0, 0xd9, 0xc8, 0xad, 0x32, 0xd1, 0x0e,
+	0x0a, 0xc3, 0xfb, 0xb3, 0xaa, 0xfa, 0x94, 0x75, 0x7e, 0x82, 0x6f, 0xc4, 0x49, 0x3b, 0x0f, 0x1e,
+	0x27, 0xad, 0xf8, 0x16, 0xe4, 0xfb, 0x5b, 0xe9, 0xc1, 0x95, 0x47, 0x94, 0x1e, 0xfc, 0xed, 0x8e,
+	0x55, 0x6b, 0x6f, 0xfa, 0xf9, 0x0f, 0x94, 0x9b, 0x1b, 0x31, 0xcf, 0x23, 0xcc, 0x72, 0x7a, 0x25,
+	0x17, 0x58, 0xf8, 0x76, 0xd2, 0xd8, 0x0e, 0x7d, 0x56, 0x21, 0xa6, 0x59, 0xb3, 0xa3, 0xdf, 0xae,
+	0x89, 0x76, 0x50, 0x18, 0x28, 0xf5, 0x0d, 0xa2, 0x47, 0x92, 0xda, 0xff, 0xa1, 0x4a, 0xa6, 0x0d,
+	0x8d, 0x5f, 0x68, 0xbe, 0x39, 0x8f, 0x99, 0xf9, 0x56, 0x39, 0x82, 0xf9, 0xf6, 0x6d, 0x64, 0xaa,
+	0x2d, 0xb5, 0x51, 0x39, 0x37, 0xb9, 0xe4, 0x75, 0x9c, 0x56, 0x48, 0xaa, 0x09, 0x34, 0x4f, 0x0c,
+	0xd8, 0x31, 0xc8, 0x58, 0x3e, 0x8b, 0xa2, 0x1c, 0x51, 0xa1, 0xd1, 0x86, 0xfb, 0xe4, 0x63, 0x17,
+	0xea, 0x87, 0xc7, 0x2e, 0x60, 0x29, 0x57, 0xf9, 0x72, 0x1f, 0x42, 0xad, 0xa1, 0x97, 0xed, 0x5a,
+	0x43, 0x57, 0x4b, 0x99, 0xe6, 0x11, 0x45, 0x86, 0x6e, 0x91, 0x49, 0x8c, 0x7f, 0xf0, 0xa3, 0x8e,
+	0xfb, 0xe5, 0x64, 0xb2, 0xcd, 0xff, 0x15, 0xfe, 0x3d, 0x76, 0x90, 0x2e, 0xa0, 0x20, 0x61, 0x18,
+	0xa0, 0xe7, 0x27, 0x5d, 0xe9, 0xd3, 0x63, 0x01, 0x7a, 0x0b, 0x49, 0x37, 0x05, 0xd6, 0xea, 0xfd,
+	0x37, 0x87, 0xcc, 0x62, 0x97, 0x20, 0x5b, 0x93, 0x8f, 0xf3, 0x1c, 0x99, 0xf0, 0x07, 0xd9, 0x4e,
+	0x3c, 0xb4, 0x0f, 0x5b, 0x60, 0xad, 0x20, 0xa0, 0xb8, 0x0f, 0x53, 0x45, 0x2a, 0x8c, 0x7d, 0xd8,
+	0x32, 0xae, 0x65, 0x06, 0x41, 0x53, 0x36, 0x1d, 0x6c, 0x15, 0x9d, 0xe4, 0xb6, 0x78, 0x33, 0x48,
+	0x38, 0x12, 0xdb, 0x8a, 0x3b, 0xfb, 0xcd, 0x9a, 0x4d, 0x6c, 0x31, 0xee, 0xec, 0x03, 0x83, 0x60,
+	0x04, 0x7c, 0xba, 0xe3, 0xcb, 0x98, 0x01, 0x81, 0x50, 0x6d, 0xdd, 0x58, 0x00, 0x6c, 0x57, 0x09,
+	0x1d, 0x49, 0xd8, 0x9c, 0x38, 0x28, 0xa1, 0x23, 0x09, 0xbd, 0x7f, 0x5a, 0x23, 0x2c, 0x16, 0xc8,
+	0x4f, 0x68, 0x67, 0x33, 0x66, 0x65, 0x8e, 0x4f, 0xf4, 0xc8, 0x5d, 0x6f, 0x64, 0x1f, 0xe7, 0x63,
+	0x77, 0xe3, 0xe8, 0xb5, 0xfa, 0xb0, 0x8f, 0x5e, 0x8b, 0x4f, 0xd3, 0x6b, 0x8f, 0xd1, 0x69, 0xba,
+	0xf7, 0x3d, 0x0e, 0x71, 0x55, 0x64, 0x97, 0x0e, 0x77, 0xb9, 0x42, 0xa6, 0x54, 0x28, 0x99, 0xf8,
+	0x5e, 0xb4, 0x58, 0x94, 0x00, 0xd0, 0x38, 0x63, 0x78, 0x2f, 0x9e, 0x95, 0x3a, 0xab, 0x6a, 0xe7,
+	0x83, 0x30, 0x4d, 0x27, 0x54, 0x98, 0xf7, 0xeb, 0x15, 0xf2, 0x04, 0x37, 0x97, 0xd6, 0xfc, 0xc8,
+	0xef, 0xd2, 0x1e, 0x8e, 0x6a, 0xdc, 0x00, 0xa6, 0x36, 0x6e, 0x9b, 0x03, 0x99, 0xbd, 0x71, 0x5c,
+	0x79, 0xc5, 0xe5, 0x0c, 0x97, 0x2c, 0x2b, 0x51, 0x90, 0x01, 0x23, 0xee, 0xa6, 0xa4, 0x21, 0xaf,
+	0xbd, 0x6b, 0x56, 0xcb, 0x64, 0xa4, 0x44, 0xb1, 0xb0, 0x2c, 0x28, 0x28, 0x46, 0x68, 0x3e, 0x84,
+	0x71, 0x7b, 0x17, 0x3f, 0xf9, 0xbc, 0xf9, 0xb0, 0x2a, 0xda, 0x41, 0x61, 0x78, 0x3d, 0x32, 0x27,
+	0xe7, 0xb0, 0x8f, 0xf5, 0x89, 0xe9, 0x36, 0xea, 0xdc, 0xb6, 0x6c, 0x32, 0x6e, 0xe2, 0x53, 0x3a,
+	0x77, 0xc9, 0x04, 0x82, 0x8d, 0x2b, 0x2b, 0x1f, 0x57, 0x8a, 0x2b, 0x1f, 0x7b, 0xbf, 0xee, 0x90,
+	0xbc, 0xd2, 0x37, 0xea, 0xbc, 0x3a, 0x07, 0xd6, 0x79, 0x3d, 0x42, 0xa5, 0xd4, 0x6f, 0x26, 0xd3,
+	0x7e, 0x86, 0x56, 0x1d, 0xf7, 0xc0, 0x54, 0x1f, 0xec, 0x54, 0x73, 0x2d, 0xee, 0x04, 0xdb, 0x01,
+	0x52, 0x00, 0x93, 0x9c, 0xf7, 0x39, 0x87, 0x4c, 0x2d, 0x27, 0xfb, 0x47, 0x4f, 0xa3, 0x1b, 0x4e,
+	0x92, 0xab, 0x1c, 0x29, 0x49, 0x4e, 0xa6, 0xe1, 0x55, 0x47, 0xa5, 0xe1, 0x79, 0xff, 0xbd, 0x46,
+	0xce, 0x0c, 0xe5, 0x85, 0xa2, 0xe3, 0x5a, 0xbd, 0x25, 0xe9, 0xa7, 0x9d, 0x32, 0x03, 0xab, 0x35,
+	0x0c, 0x2c, 0xcc, 0x31, 0x3e, 0xd5, 0x15, 0x72, 0x16, 0xef, 0xe7, 0xa0, 0x03, 0xba, 0xb0, 0x9d,
+	0xd1, 0xa4, 0x45, 0xf1, 0x20, 0x9d, 0x17, 0x4a, 0xae, 0x2e, 0x3e, 0x89, 0xa7, 0x8b, 0x30, 0x0c,
+	0x86, 0xa2, 0x3e, 0x6e, 0x9f, 0x9c, 0x0a, 0xcd, 0xfd, 0x42, 0xb3, 0xf6, 0xe0, 0x5b, 0x0d, 0xb5,
+	0x5a, 0xad, 0x66, 0xb0, 0x19, 0xd8, 0x9b, 0x8e, 0xfa, 0x23, 0xda, 0x74, 0x7c, 0x87, 0xde, 0x74,
+	0xf0, 0x38, 0xa5, 0x0f, 0x96, 0x9c, 0x17, 0x3c, 0xce, 0xae, 0xe3, 0x38, 0xfb, 0x88, 0xf7, 0x91,
+	0x86, 0x8c, 0xe1, 0x1c, 0x2b, 0xf6, 0xd1, 0xa4, 0x33, 0x42, 0xb6, 0x3f, 0x47, 0xde, 0x72, 0x35,
+	0x49, 0x8c, 0xc9, 0xbc, 0x15, 0x67, 0x0b, 0x61, 0x18, 0xdf, 0x45, 0x73, 0xe5, 0xa5, 0x94, 0xca,
+	0x2b, 0x28, 0x5e, 0xaf, 0x90, 0x82, 0x2d, 0x35, 0x7e, 0x93, 0xda, 0x2e, 0xb4, 0xbe, 0xc9, 0xa3,
+	0xd9, 0x86, 0xee, 0x3d, 0x1e, 0xe7, 0xca, 0xad, 0x81, 0xf7, 0x97, 0xed, 0x12, 0xd0, 0xa1, 0xaf,
+	0x4a, 0x52, 0xaa, 0xf0, 0xd7, 0xe7, 0x09, 0xd1, 0xe6, 0xbc, 0xb0, 0x09, 0xf5, 0x85, 0x1c, 0xca,
+	0xea, 0x07, 0x03, 0x0b, 0x3d, 0x44, 0x41, 0x94, 0x66, 0x7e, 0x18, 0xde, 0x08, 0xa2, 0x4c, 0xd8,
+	0x89, 0xca, 0xec, 0x59, 0xd1, 0x20, 0x30, 0xf1, 0x2e, 0xbe, 0xc7, 0x78, 0x7f, 0x47, 0x79, 0xef,
+	0x3b, 0xe4, 0xc2, 0xf5, 0x20, 0x53, 0x09, 0x94, 0x6a, 0xbd, 0xa1, 0xb5, 0xae, 0x64, 0x95, 0x33,
+	0x32, 0x65, 0xd8, 0x48, 0x60, 0xac, 0xd8, 0xf9, 0x96, 0xf9, 0x04, 0x46, 0xaf, 0x4d, 0xce, 0x5d,
+	0x0f, 0x32, 0x3c, 0xcb, 0x39, 0x41, 0x26, 0x9f, 0x9f, 0x20, 0x33, 0x66, 0x5d, 0x81, 0xa3, 0x48,
+	0x76, 0x2c, 0x84, 0x23, 0x33, 0x69, 0x03, 0x75, 0x18, 0x7f, 0xe7, 0xd8, 0x45, 0x0e, 0x8a, 0x27,
+	0xd7, 0x30, 0x65, 0x35, 0x4f, 0x30, 0x07, 0xe0, 0xde, 0x25, 0xf5, 0x6d, 0x96, 0x8b, 0x57, 0x2d,
+	0x23, 0x8c, 0xaa, 0x68, 0xf2, 0xf5, 0x97, 0xcb, 0xb3, 0xf9, 0x38, 0x3f, 0x34, 0x3f, 0x12, 0x3b,
+	0x05, 0xdc, 0xc8, 0x90, 0xe0, 0xed, 0xa0, 0x30, 0x46, 0x69, 0x8f, 0xfa, 0x03, 0x68, 0x0f, 0x4b,
+	0x96, 0x4f, 0x3c, 0x22, 0x59, 0xce, 0xf2, 0x2a, 0xb3, 0x1d, 0x66, 0x1c, 0x8b, 0x94, 0xae, 0x49,
+	0xfb, 0x8e, 0xb5, 0x0d, 0x1b, 0x0c, 0x79, 0x7c, 0xf7, 0xe3, 0x4a, 0x1b, 0x34, 0xca, 0x38, 0x50,
+	0x30, 0x57, 0xf4, 0x49, 0x2b, 0x82, 0xef, 0xa9, 0x90, 0xd9, 0xeb, 0xd1, 0x60, 0xe3, 0xfa, 0xc6,
+	0x60, 0x2b, 0x0c, 0xda, 0x37, 0xe9, 0x3e, 0x4a, 0xfb, 0x5d, 0xba, 0xbf, 0xb2, 0x2c, 0xbe, 0x20,
+	0xb5, 0x66, 0x6e, 0x62, 0x23, 0x70, 0x18, 0xca, 0xad, 0xed, 0x20, 0xea, 0xd2, 0xa4, 0x9f, 0x04,
+	0xc2, 0xd7, 0x6f, 0xc8, 0xad, 0x6b, 0x1a, 0x04, 0x26, 0x1e, 0xd2, 0x8e, 0xef, 0x46, 0xaa, 0xc8,
+	0x93, 0xa2, 0xbd, 0x8e, 0x8d, 0xc0, 0x61, 0x88, 0x94, 0x25, 0x03, 0xe1, 0x4a, 0x33, 0x90, 0x36,
+	0xb1, 0x11, 0x38, 0x4c, 0xec, 0xd2, 0x59, 0x94, 0x5a, 0x7d, 0x68, 0x97, 0x8e, 0xcd, 0x20, 0xe1,
+	0x88, 0xba, 0x4b, 0xf7, 0x97, 0xfd, 0xcc, 0xcf, 0x6f, 0xb2, 0x6f, 0xf2, 0x66, 0x90, 0x70, 0x56,
+	0xf5, 0xd9, 0x9e, 0x8e, 0x2f, 0xb9, 0xaa, 0xcf, 0xf6, 0xf0, 0x47, 0x38, 0x64, 0xfe, 0x76, 0x85,
+	0xcc, 0xbc, 0x71, 0x51, 0xf6, 0x30, 0x75, 0xef, 0x0e, 0x39, 0x33, 0x94, 0xcd, 0x3d, 0x86, 0x85,
+	0x74, 0x68, 0xb5, 0x0d, 0x0f, 0xc8, 0x34, 0x12, 0x96, 0xd5, 0x0e, 0x97, 0xc8, 0x19, 0xfe, 0xf1,
+	0x22, 0x27, 0x96, 0x9c, 0xab, 0x32, 0xf4, 0xd9, 0x61, 0xd6, 0xed, 0x3c, 0x10, 0x86, 0xf1, 0xf1,
+	0x4a, 0x9b, 0x53, 0x56, 0x82, 0x7d, 0x49, 0xb6, 0x1c, 0xfb, 0xba, 0x63, 0x16, 0x61, 0xcd, 0x32,
+	0x5e, 0xaa, 0x4c, 0x0d, 0xeb, 0xaf, 0x5b, 0x83, 0xc0, 0xc4, 0xf3, 0x7e, 0xbb, 0x4a, 0x1a, 0x32,
+	0x1a, 0x6c, 0x8c, 0xa1, 0x7c, 0xc6, 0x21, 0xa7, 0xd4, 0x01, 0x22, 0xf6, 0x11, 0x1f, 0xc0, 0xad,
+	0xe3, 0xc7, 0xa3, 0x29, 0xff, 0x09, 0x7a, 0x7c, 0xd5, 0xc6, 0x02, 0x4c, 0x66, 0x60, 0xf3, 0x76,
+	0x6f, 0x63, 0x56, 0x46, 0x9a, 0xd1, 0x9e, 0xe1, 0x7b, 0xf6, 0x8c, 0x55, 0x36, 0xdf, 0x8e, 0x13,
+	0x8a, 0x6b, 0x0a, 0x63, 0xe8, 0x5a, 0x0a, 0x53, 0x5b, 0x78, 0xba, 0x0d, 0x0c, 0x4a, 0x78, 0x13,
+	0x4d, 0x68, 0x26, 0xe2, 0x42, 0x39, 0xd1, 0x76, 0xe3, 0x9c, 0x77, 0x1f, 0xe3, 0x7c, 0xd9, 0xfb,
+	0xb9, 0x0a, 0x39, 0x9d, 0x9f, 0x49, 0xf7, 0x83, 0x18, 0x66, 0xad, 0xaf, 0x9a, 0xcd, 0x85, 0xe0,
+	0xcd, 0x80, 0x01, 0x7b, 0xfd, 0xfe, 0xa5, 0x4b, 0x3a, 0x14, 0xef, 0x0a, 0x4e, 0xde, 0x95, 0x3d,
+	0x23, 0x5a, 0x11, 0x97, 0x81, 0x45, 0x8c, 0x1f, 0x3e, 0x8b, 0x28, 0x89, 0xc5, 0xfd, 0x85, 0x7e,
+	0x5f, 0x9c, 0x20, 0x1b, 0x87, 0xcf, 0x26, 0x14, 0x72, 0xd8, 0x98, 0xb6, 0x68, 0xb4, 0xdc, 0xa2,
+	0x41, 0x77, 0x67, 0x2b, 0x4e, 0xe4, 0xbe, 0xf6, 0x29, 0x1d, 0xf0, 0x3b, 0x8c, 0x03, 0x85, 0x3d,
+	0xd1, 0x30, 0x6a, 0xfb, 0x7d, 0xbf, 0x1d, 0x64, 0xfb, 0xe2, 0x0c, 0x40, 0x89, 0xf1, 0x25, 0xd1,
+	0x0e, 0x0a, 0xc3, 0xfb, 0xfb, 0x35, 0x72, 0x9a, 0x47, 0xb8, 0x52, 0x15, 0xc0, 0xed, 0x7e, 0x90,
+	0x4c, 0xa5, 0x99, 0x9f, 0x70, 0xa7, 0x86, 0x73, 0x64, 0xd1, 0xa5, 0xab, 0x02, 0x48, 0x22, 0xa0,
+	0xe9, 0x61, 0x20, 0xf8, 0x76, 0x10, 0x05, 0xe9, 0x0e, 0xa3, 0x5e, 0x79, 0x30, 0x97, 0xc9, 0x35,
+	0x45, 0x01, 0x0c, 0x6a, 0xee, 0xd7, 0x91, 0x7a, 0x7f, 0xc7, 0x4f, 0xa5, 0x3f, 0xef, 0x39, 0x29,
+	0x27, 0x36, 0xb0, 0x11, 0x43, 0x99, 0xf3, 0x8f, 0xca, 0x00, 0xc0, 0x3b, 0x99, 0x52, 0xbe, 0x76,
+	0xf8, 0x9d, 0x41, 0x9d, 0x64, 0xbf, 0x75, 0x63, 0x21, 0x7f, 0xcb, 0xcc, 0x32, 0x6b, 0x05, 0x01,
+	0x45, 0x99, 0xb4, 0xc3, 0x59, 0x76, 0x10, 0x79, 0xc2, 0xb6, 0x38, 0x6e, 0x68, 0x10, 0x98, 0x78,
+	0x58, 0xa8, 0x2f, 0x1f, 0xff, 0x3c, 0x79, 0x02, 0xf9, 0x31, 0xe3, 0x46, 0x3e, 0x5f, 0x25, 0x53,
+	0xfc, 0x7f, 0xba, 0x19, 0xa3, 0x93, 0x87, 0xbb, 0x8b, 0x16, 0x13, 0x3f, 0x6a, 0xef, 0xe4, 0x9d,
+	0x3c, 0x9b, 0x06, 0x0c, 0x2c, 0x4c, 0x6f, 0x8d, 0xd4, 0xc6, 0x14, 0xb2, 0x63, 0xed, 0xdd, 0xdf,
+	0x47, 0x1a, 0x48, 0x4e, 0x6e, 0xd0, 0xca, 0x20, 0x19, 0x93, 0x86, 0xbc, 0x65, 0xd3, 0xf5, 0x48,
+	0x35, 0xf0, 0x65, 0x2c, 0x89, 0xfa, 0x84, 0x56, 0xd2, 0x74, 0xc0, 0x96, 0x1d, 0x02, 0xdd, 0x67,
+	0x49, 0x95, 0xde, 0xeb, 0xe7, 0x83, 0x46, 0xae, 0xde, 0xeb, 0x07, 0x09, 0x4d, 0x11, 0x89, 0xde,
+	0xeb, 0xbb, 0x17, 0x49, 0x25, 0xe8, 0x88, 0x15, 0x49, 0x04, 0x4e, 0x65, 0x65, 0x19, 0x2a, 0x41,
+	0xc7, 0xbb, 0x47, 0xa6, 0x24, 0x43, 0x16, 0xe1, 0xcc, 0x4d, 0x2a, 0xa7, 0x8c, 0x08, 0x67, 0x49,
+	0x77, 0x84, 0x31, 0x35, 0x20, 0x44, 0x97, 0x9b, 0x28, 0x4b, 0x05, 0x5f, 0x26, 0xb5, 0x76, 0x2c,
+	0x0a, 0x05, 0x35, 0x34, 0x19, 0x66, 0x4b, 0x31, 0x88, 0x77, 0x87, 0xcc, 0xde, 0x8c, 0xe2, 0xbb,
+	0xec, 0x66, 0x2a, 0x56, 0x88, 0x19, 0x09, 0x6f, 0xe3, 0x3f, 0x79, 0xcb, 0x9d, 0x41, 0x81, 0xc3,
+	0x54, 0x89, 0xd8, 0xca, 0xa8, 0x12, 0xb1, 0xde, 0x27, 0x1c, 0x32, 0xa3, 0xf2, 0xd6, 0xaf, 0xef,
+	0xed, 0x22, 0xdd, 0x6e, 0x12, 0x0f, 0xfa, 0x79, 0xba, 0xec, 0xae, 0x73, 0xe0, 0x30, 0xb3, 0xa0,
+	0x43, 0xe5, 0x90, 0x82, 0x0e, 0x97, 0x49, 0x6d, 0x37, 0x88, 0x3a, 0x79, 0xa7, 0x28, 0xde, 0x9a,
+	0x0e, 0x0c, 0x82, 0xe1, 0xc7, 0xa7, 0xd5, 0x10, 0xa4, 0xcd, 0xf4, 0x02, 0x99, 0xd9, 0x1a, 0x04,
+	0x61, 0x47, 0xfc, 0xce, 0x7f, 0x2e, 0x8b, 0x06, 0x0c, 0x2c, 0x4c, 0xf4, 0xcc, 0x6c, 0x05, 0x91,
+	0x9f, 0xec, 0x6f, 0x68, 0x23, 0x4d, 0xe9, 0xed, 0x45, 0x05, 0x01, 0x03, 0x0b, 0xeb, 0x10, 0xec,
+	0xc9, 0xd3, 0xdb, 0x6a, 0xa9, 0x75, 0x08, 0xc4, 0x7c, 0xe8, 0x2f, 0x41, 0x1d, 0x07, 0x2b, 0x8e,
+	0xde, 0xf7, 0x57, 0xc9, 0xac, 0x5d, 0x3b, 0x60, 0x0c, 0xcf, 0xc9, 0xb3, 0xa4, 0xce, 0xca, 0x09,
+	0xe4, 0x17, 0x16, 0xeb, 0x0f, 0x1c, 0x86, 0x61, 0xa6, 0x5c, 0x94, 0x94, 0x73, 0x3f, 0xaa, 0x1a,
+	0xa4, 0xf2, 0xe3, 0xb2, 0x28, 0x74, 0xe1, 0x16, 0x17, 0xac, 0x30, 0x7c, 0x68, 0x32, 0xee, 0x9b,
+	0xb5, 0x49, 0xdf, 0x5f, 0x66, 0x5d, 0x05, 0x91, 0xbc, 0x2c, 0xac, 0x21, 0xb5, 0xf0, 0xe4, 0x62,
+	0x90, 0xac, 0x2f, 0x7e, 0x0d, 0x99, 0x31, 0x31, 0x0f, 0x33, 0x88, 0x1a, 0xa6, 0x41, 0xf4, 0x19,
+	0x73, 0x49, 0x8a, 0xca, 0x11, 0x63, 0x7c, 0xec, 0x2f, 0x91, 0x7a, 0x5b, 0x85, 0xc3, 0x3d, 0xd0,
+	0xad, 0x08, 0xaa, 0xb2, 0x1a, 0x92, 0x01, 0x4e, 0x0d, 0x63, 0x05, 0x66, 0x8d, 0xd1, 0xa4, 0x2b,
+	0x1d, 0x37, 0x21, 0xd5, 0xee, 0xde, 0xae, 0x30, 0x32, 0x5e, 0x2c, 0x69, 0x7a, 0xaf, 0xef, 0xed,
+	0xea, 0x2f, 0xcc, 0x6c, 0x05, 0x64, 0x36, 0xc6, 0x61, 0x83, 0x55, 0x60, 0xa4, 0x7a, 0x78, 0x81,
+	0x11, 0xef, 0x73, 0x15, 0x72, 0x66, 0x68, 0x51, 0xb9, 0xaf, 0x92, 0x7a, 0x82, 0x4f, 0xd9, 0x74,
+	0xca, 0x50, 0xde, 0xf6, 0xcc, 0x69, 0xe5, 0x6d, 0xb7, 0x03, 0x67, 0x89, 0x91, 0x5d, 0x3a, 0x68,
+	0x53, 0x9d, 0x74, 0xf0, 0x47, 0x56, 0x91, 0x5d, 0x0b, 0x43, 0x18, 0x50, 0xd0, 0x0b, 0x4f, 0xea,
+	0xec, 0x03, 0x93, 0x5c, 0xb5, 0xeb, 0x83, 0xce, 0x3e, 0xbc, 0xcf, 0x9a, 0x4b, 0xf0, 0xb6, 0x16,
+	0xa6, 0xc7, 0xdd, 0x9c, 0x0e, 0x49, 0xd6, 0xea, 0xb8, 0x92, 0xd5, 0xfb, 0x95, 0x0a, 0x39, 0x65,
+	0x55, 0xaf, 0x75, 0x43, 0xd2, 0xa0, 0x21, 0x3b, 0xd9, 0x95, 0xda, 0xf7, 0xb8, 0x17, 0xd9, 0x28,
+	0x39, 0x79, 0x55, 0xd0, 0x05, 0xc5, 0xe1, 0xf1, 0x88, 0x41, 0x7b, 0x81, 0xcc, 0xc8, 0x01, 0xbd,
+	0xdf, 0xef, 0x85, 0xf9, 0xe9, 0xbb, 0x6a, 0xc0, 0xc0, 0xc2, 0xf4, 0x7e, 0xa3, 0x4a, 0x9a, 0xfc,
+	0x28, 0xbc, 0xa3, 0x3e, 0x06, 0x15, 0xd2, 0xf2, 0xdd, 0xba, 0xc6, 0x34, 0x9f, 0xc8, 0xad, 0xe3,
+	0xde, 0x1b, 0x57, 0xcc, 0x68, 0xac, 0xd0, 0xe9, 0x1f, 0xcb, 0x85, 0x4e, 0xf3, 0xad, 0x7a, 0xf7,
+	0x84, 0x46, 0xf4, 0xa5, 0x15, 0x4b, 0xfd, 0x0f, 0x2b, 0x64, 0x2e, 0x77, 0x29, 0x1f, 0xd6, 0x1a,
+	0x34, 0xef, 0x71, 0x71, 0xca, 0x38, 0x26, 0x3c, 0xf0, 0x9e, 0xb6, 0xa3, 0xdd, 0xe6, 0xf2, 0x88,
+	0x3e, 0x15, 0xef, 0x0f, 0x2a, 0x64, 0xd6, 0xbe, 0x4d, 0xf0, 0x31, 0x9c, 0xa9, 0xaf, 0x20, 0x53,
+	0xec, 0xc2, 0xac, 0x9b, 0x74, 0x5f, 0x9e, 0x32, 0xf2, 0xbb, 0x89, 0x64, 0x23, 0x68, 0xf8, 0x63,
+	0x71, 0x49, 0x8e, 0xf7, 0xd3, 0x0e, 0xb9, 0xa0, 0xb5, 0x29, 0xed, 0xd2, 0x7b, 0x0f, 0x72, 0x5d,
+	0xfa, 0xe1, 0xda, 0xfc, 0xbd, 0x64, 0x36, 0xb2, 0xd8, 0x08, 0x59, 0xa7, 0x34, 0xa8, 0x3d, 0x08,
+	0xc8, 0x61, 0x7b, 0xff, 0xd8, 0x21, 0xe7, 0xf9, 0xdb, 0xc8, 0x7f, 0x2f, 0x7f, 0xb3, 0x68, 0x15,
+	0x7c, 0xa8, 0xdc, 0x89, 0xcc, 0xd5, 0x70, 0x3f, 0x6c, 0x1d, 0xb0, 0xbb, 0xf5, 0xc5, 0x68, 0xed,
+	0x25, 0xfb, 0x18, 0x0e, 0xf6, 0x48, 0x8b, 0xd6, 0xfb, 0x37, 0x15, 0x32, 0xbd, 0xbe, 0xb4, 0xa2,
+	0x54, 0x0d, 0x06, 0x84, 0x25, 0xd4, 0xd7, 0x6e, 0x2a, 0x33, 0x20, 0x4c, 0x02, 0x40, 0xe3, 0xe0,
+	0x6e, 0x8f, 0x07, 0x54, 0xa6, 0xf9, 0xdd, 0x1e, 0x8f, 0xb7, 0x4c, 0x41, 0xc2, 0xd1, 0x8b, 0xc6,
+	0xd2, 0xb0, 0x31, 0xc8, 0xb1, 0x6a, 0x1f, 0x2f, 0xb2, 0x34, 0x6d, 0x3c, 0x95, 0x55, 0x18, 0x48,
+	0xb8, 0x13, 0xb7, 0x53, 0x44, 0xce, 0x79, 0x8e, 0x96, 0xb1, 0x19, 0x4f, 0x70, 0x05, 0x1c, 0x07,
+	0xcd, 0xbd, 0x2b, 0x88, 0x5c, 0xb7, 0x07, 0xcd, 0xdd, 0x30, 0x88, 0xae, 0x71, 0x8e, 0x52, 0x6d,
+	0x35, 0x97, 0x6e, 0x38, 0x39, 0x5e, 0xba, 0xa1, 0xf7, 0x07, 0x55, 0x32, 0xa5, 0x9d, 0x7f, 0x81,
+	0xa8, 0x3d, 0x52, 0xca, 0x1d, 0x01, 0x98, 0xc2, 0xa2, 0x48, 0xf3, 0xa8, 0x07, 0xa3, 0xf4, 0xc8,
+	0x77, 0x39, 0x18, 0x48, 0x10, 0x64, 0x81, 0xcf, 0x7c, 0x98, 0xe5, 0xdc, 0xb5, 0xae, 0xd8, 0xad,
+	0x70, 0xca, 0x71, 0x62, 0x86, 0x26, 0x28, 0x66, 0x60, 0x72, 0x76, 0x3f, 0x2a, 0xb2, 0xdb, 0xaa,
+	0xa5, 0x15, 0xf0, 0x69, 0xe4, 0x52, 0xda, 0xfa, 0xb8, 0x17, 0xc8, 0x92, 0x92, 0xea, 0x5e, 0x01,
+	0x92, 0x52, 0x77, 0xd5, 0xa8, 0xdd, 0x16, 0x6b, 0x06, 0xce, 0xc8, 0x4b, 0x89, 0x3b, 0x3c, 0x17,
+	0x47, 0xcc, 0x1c, 0xc2, 0xdc, 0xa8, 0x41, 0x16, 0xf7, 0x70, 0x9a, 0x44, 0x60, 0x83, 0xce, 0x8d,
+	0x92, 0x00, 0xd0, 0x38, 0xde, 0xf7, 0xd7, 0x49, 0xae, 0x12, 0x88, 0x7b, 0x8f, 0x4c, 0xa9, 0x5a,
+	0x20, 0xe5, 0xa4, 0xee, 0xea, 0x15, 0xa5, 0x06, 0xa3, 0x9a, 0x40, 0x33, 0x73, 0xbb, 0xd2, 0x1d,
+	0xcc, 0xbf, 0xf6, 0xf7, 0xe5, 0xdd, 0xc1, 0xdf, 0x38, 0xde, 0xe9, 0x20, 0xae, 0xd5, 0x2b, 0xbc,
+	0xf6, 0xe3, 0xfc, 0xa1, 0x9e, 0xe3, 0xc3, 0x6e, 0x9b, 0xff, 0xa4, 0xb8, 0x19, 0x0e, 0x68, 0x3a,
+	0x08, 0x33, 0xb1, 0x1a, 0xde, 0x57, 0xe2, 0x57, 0xc6, 0x09, 0xeb, 0x8a, 0x5a, 0xfc, 0x37, 0x18,
+	0x4c, 0x6d, 0xff, 0xfe, 0xc4, 0x89, 0xfa, 0xf7, 0x27, 0x4b, 0xf5, 0xef, 0x3f, 0x4f, 0x08, 0x5b,
+	0xdb, 0x3c, 0xc3, 0xa1, 0xc1, 0xdc, 0xae, 0x4a, 0xc5, 0x80, 0x82, 0x80, 0x81, 0xe5, 0x7d, 0x25,
+	0xb1, 0x4b, 0xc2, 0x61, 0x72, 0x29, 0xaf, 0x40, 0xc7, 0x4f, 0x2e, 0x59, 0x72, 0xa9, 0x55, 0x2c,
+	0xee, 0x97, 0x1c, 0x62, 0xd6, 0xad, 0x73, 0x5f, 0xe1, 0x05, 0xf2, 0x9c, 0x32, 0x4e, 0xc2, 0x0c,
+	0xba, 0xf3, 0x6b, 0x7e, 0x3f, 0x17, 0x95, 0x25, 0xab, 0xe4, 0x61, 0xa8, 0x94, 0x84, 0x1e, 0xc9,
+	0xa8, 0xff, 0x38, 0x39, 0x2b, 0x8b, 0x68, 0xc8, 0x43, 0x2b, 0x11, 0x1d, 0x71, 0xb8, 0x2f, 0x54,
+	0x3a, 0x38, 0x2b, 0xa3, 0x1c, 0x9c, 0xca, 0xd4, 0xaa, 0x8e, 0x2c, 0x7d, 0xff, 0xc7, 0x0e, 0xb9,
+	0x9c, 0x1f, 0x40, 0xba, 0x16, 0x47, 0x41, 0x16, 0x27, 0x2d, 0x9a, 0x65, 0x41, 0xd4, 0x65, 0x75,
+	0x8c, 0xef, 0xfa, 0x89, 0xbc, 0xcb, 0x8a, 0x09, 0xca, 0x3b, 0x7e, 0x12, 0x01, 0x6b, 0xc5, 0x4c,
+	0x5b, 0x1e, 0x12, 0x2e, 0x76, 0x6b, 0xc7, 0xfc, 0x36, 0x0a, 0xa6, 0x43, 0x9b, 0x92, 0x3c, 0x1c,
+	0x1d, 0x04, 0x43, 0x5c, 0x19, 0x5b, 0x18, 0xb1, 0x2c, 0xfc, 0xd7, 0x6c, 0x65, 0x2c, 0x62, 0x03,
+	0xf0, 0x76, 0xef, 0x0b, 0x0e, 0x71, 0xd7, 0xf7, 0x68, 0x92, 0x04, 0x1d, 0x23, 0xca, 0x9d, 0x5d,
+	0xc1, 0x6a, 0x5c, 0xb5, 0x6a, 0xd6, 0x80, 0xc9, 0x5d, 0xc1, 0x6a, 0xfc, 0x2a, 0xbe, 0x82, 0xb5,
+	0x72, 0xb4, 0x2b, 0x58, 0xdd, 0x75, 0x72, 0xbe, 0xc7, 0xf7, 0xa3, 0xfc, 0x5a, 0x43, 0xbe, 0x39,
+	0x55, 0x25, 0x01, 0x2e, 0x60, 0xd9, 0xd0, 0xb5, 0x22, 0x04, 0x28, 0xee, 0xe7, 0xbd, 0x87, 0xb8,
+	0x3c, 0xb8, 0x7d, 0xa9, 0x28, 0x3e, 0x77, 0xa4, 0xbf, 0xc6, 0xfb, 0xd1, 0x3a, 0x99, 0xcb, 0x5d,
+	0x85, 0x82, 0xbe, 0x80, 0xe1, 0x80, 0xe0, 0x63, 0x2b, 0xf8, 0xe1, 0xe1, 0x8d, 0x15, 0x62, 0x1c,
+	0x91, 0x7a, 0x10, 0xf5, 0x07, 0x59, 0x39, 0xd5, 0x52, 0xf8, 0x20, 0x56, 0x90, 0xa0, 0x71, 0xc0,
+	0x82, 0x3f, 0x81, 0xb3, 0x29, 0x33, 0x60, 0xd9, 0xda, 0xad, 0xd5, 0x1e, 0x91, 0xbf, 0xe8, 0x93,
+	0x3a, 0x7c, 0xb8, 0x5e, 0x86, 0x33, 0x3c, 0xb7, 0x58, 0x4e, 0x3a, 0x66, 0xec, 0xe7, 0x2b, 0x64,
+	0xda, 0x78, 0x69, 0xee, 0x4f, 0xd8, 0x65, 0x5f, 0x9d, 0xf2, 0x1e, 0x89, 0xd1, 0x9f, 0xd7, 0x85,
+	0x5d, 0xf9, 0x23, 0x3d, 0x37, 0x5c, 0xf1, 0xf5, 0xf5, 0xfb, 0x97, 0x4e, 0xe7, 0x6a, 0xba, 0x5a,
+	0x55, 0x60, 0x2f, 0x7e, 0x2b, 0x99, 0xcb, 0x91, 0x29, 0x78, 0xe4, 0x4d, 0xf3, 0x91, 0x8f, 0xed,
+	0xb7, 0x34, 0xa7, 0xec, 0x17, 0xab, 0x64, 0x5a, 0x16, 0x42, 0x88, 0x43, 0x3a, 0x86, 0xd3, 0x36,
+	0xb7, 0x01, 0xa9, 0x8c, 0x59, 0xef, 0xe4, 0x6d, 0xa4, 0xd1, 0x8f, 0xc3, 0xa0, 0x1d, 0xa8, 0xaa,
+	0xf1, 0xac, 0x24, 0xcb, 0x86, 0x68, 0x03, 0x05, 0x75, 0xef, 0x92, 0xa9, 0x97, 0xef, 0x66, 0xfc,
+	0xbc, 0xb4, 0x59, 0x2b, 0xf5, 0x98, 0x54, 0x59, 0x35, 0xb2, 0x25, 0x05, 0xcd, 0x0b, 0xab, 0x16,
+	0x31, 0x2d, 0x29, 0x93, 0x22, 0xd9, 0x79, 0x11, 0x53, 0x9f, 0x29, 0x08, 0x08, 0x0a, 0x74, 0x56,
+	0x0b, 0x46, 0xe4, 0x9e, 0xf9, 0x51, 0x57, 0x55, 0xf3, 0x60, 0x02, 0x7d, 0x33, 0x0f, 0x84, 0x61,
+	0x7c, 0x24, 0xd2, 0xa1, 0x51, 0x40, 0x3b, 0x68, 0xbb, 0x2d, 0xb4, 0x87, 0xae, 0xa5, 0x5d, 0xce,
+	0x03, 0x61, 0x18, 0xdf, 0xfb, 0x99, 0x59, 0x72, 0xae, 0xe8, 0x66, 0x2c, 0xf7, 0x63, 0x64, 0x82,
+	0xcf, 0x56, 0x39, 0x97, 0x2f, 0x16, 0xf1, 0xb8, 0xce, 0x08, 0x8a, 0x09, 0x62, 0xff, 0x83, 0xe0,
+	0x29, 0xb8, 0x87, 0xfe, 0x56, 0xb3, 0x72, 0x82, 0xdc, 0x57, 0x7d, 0xcd, 0x7d, 0xd5, 0xe7, 0xdc,
+	0x43, 0x7f, 0xcb, 0xbd, 0x47, 0xea, 0xdd, 0x20, 0xa3, 0xbe, 0xf0, 0x77, 0xdd, 0x39, 0x11, 0xe6,
+	0xd4, 0xe7, 0x66, 0x03, 0xfb, 0x17, 0x38, 0x43, 0xcc, 0xb9, 0x9b, 0xdb, 0xb2, 0xcb, 0x51, 0x09,
+	0x31, 0xee, 0x97, 0x3f, 0x88, 0x5c, 0xdd, 0x2b, 0x7e, 0x1b, 0x72, 0xae, 0x11, 0xf2, 0xc3, 0xc1,
+	0xe4, 0x90, 0xc9, 0xed, 0x20, 0x34, 0xae, 0x97, 0x39, 0x81, 0x97, 0x73, 0x8d, 0x31, 0xd0, 0x9b,
+	0x23, 0xfe, 0x3b, 0x05, 0xc9, 0x79, 0x94, 0xce, 0x9c, 0x38, 0xae, 0xce, 0x9c, 0x7c, 0x44, 0x3a,
+	0xf3, 0xd3, 0x0e, 0x99, 0x52, 0x33, 0x2d, 0x4a, 0xe7, 0x7c, 0xf0, 0x04, 0x5f, 0x39, 0x77, 0x9e,
+	0xa9, 0x9f, 0xa0, 0x99, 0x63, 0xd2, 0xfd, 0xb4, 0xff, 0xea, 0x20, 0xa1, 0x1d, 0xba, 0x17, 0xf7,
+	0x53, 0x51, 0x6f, 0xf7, 0x43, 0xe5, 0x0f, 0x66, 0x01, 0x99, 0x2c, 0xd3, 0xbd, 0xf5, 0x7e, 0x2a,
+	0x52, 0xc7, 0x75, 0x03, 0x98, 0x43, 0xc0, 0x42, 0xac, 0xd2, 0xa2, 0x20, 0x65, 0x54, 0x5d, 0x2f,
+	0x1a, 0xcd, 0x58, 0x95, 0x10, 0x28, 0x79, 0x73, 0x3b, 0x8e, 0xb2, 0x20, 0x1a, 0xd0, 0xf5, 0x08,
+	0x68, 0x3f, 0xbe, 0x15, 0x67, 0xd7, 0xe2, 0x41, 0xd4, 0xb9, 0x9a, 0x24, 0x71, 0xd2, 0x9c, 0xb6,
+	0xef, 0xdc, 0x5d, 0x1a, 0x8d, 0x0a, 0x07, 0xd1, 0x61, 0x1e, 0xed, 0x38, 0xc9, 0x16, 0xf7, 0xc5,
+	0x2d, 0x3d, 0x46, 0xb2, 0x32, 0xb6, 0x82, 0x80, 0x62, 0x3a, 0x7f, 0x8f, 0xdf, 0x6f, 0x70, 0x83,
+	0xfa, 0x1d, 0x11, 0x66, 0xc5, 0x4b, 0x69, 0xaa, 0x44, 0xda, 0xb5, 0x3c, 0x02, 0x0c, 0xf7, 0xc1,
+	0xeb, 0x16, 0x12, 0x9a, 0xc6, 0xe1, 0x1e, 0x16, 0xfe, 0xec, 0xf0, 0xdc, 0x73, 0xee, 0xe9, 0x6c,
+	0xce, 0xda, 0xd7, 0x2d, 0x40, 0x31, 0x1a, 0x8c, 0xea, 0x8f, 0x05, 0x98, 0x04, 0x68, 0xa1, 0xdf,
+	0x4f, 0xe2, 0x3d, 0x3f, 0x4c, 0x9b, 0x73, 0x76, 0x01, 0x26, 0xc8, 0xc1, 0x61, 0xa8, 0x07, 0x52,
+	0x69, 0xc7, 0xbd, 0xad, 0x20, 0xa2, 0x32, 0x61, 0x09, 0x2f, 0x4c, 0xc0, 0x07, 0x55, 0x54, 0x96,
+	0x72, 0x70, 0x18, 0xea, 0x71, 0x1c, 0xab, 0xf0, 0x57, 0x6a, 0xe4, 0xd2, 0x21, 0x8b, 0x18, 0x0f,
+	0x4a, 0xe3, 0xa4, 0xeb, 0x47, 0xc1, 0xab, 0x66, 0x89, 0x43, 0xb5, 0xe5, 0x58, 0x37, 0x60, 0x60,
+	0x61, 0x9a, 0xf5, 0xa5, 0x2a, 0x87, 0xd4, 0x97, 0xba, 0x4c, 0x6a, 0x09, 0xed, 0xc7, 0xf9, 0xad,
+	0x35, 0xcb, 0xa2, 0x65, 0x10, 0xcc, 0x78, 0xf5, 0xfb, 0x81, 0xf0, 0x2f, 0x2b, 0x8f, 0xc1, 0xc2,
+	0xc6, 0x0a, 0x60, 0xbb, 0x55, 0x1f, 0xaf, 0xfe, 0x70, 0xea, 0xe3, 0x79, 0xea, 0xa4, 0x77, 0x42,
+	0xdb, 0x44, 0xb9, 0x13, 0xd8, 0xb7, 0x93, 0x46, 0xcf, 0xbf, 0xb7, 0x01, 0x0b, 0x5d, 0x2a, 0xfc,
+	0xd1, 0x4a, 0x5e, 0xae, 0x89, 0x76, 0x50, 0x18, 0xb8, 0x01, 0xc7, 0x67, 0xe5, 0x29, 0x29, 0xc2,
+	0x35, 0x83, 0x53, 0x90, 0x02, 0x6f, 0xb7, 0x4b, 0xf2, 0x4d, 0x1d, 0x5e, 0x92, 0xcf, 0xfd, 0x66,
+	0xd2, 0x44, 0xed, 0x10, 0x24, 0xb4, 0x35, 0x68, 0xb7, 0x29, 0xed, 0xd0, 0x0e, 0x8f, 0xef, 0x57,
+	0x05, 0xc3, 0x2e, 0x8b, 0xfe, 0x4d, 0x18, 0x81, 0x07, 0x23, 0x29, 0x78, 0x9f, 0xab, 0x92, 0xa7,
+	0x0f, 0x14, 0xc8, 0x3a, 0x77, 0xc4, 0x39, 0x20, 0x77, 0x44, 0xbe, 0xfc, 0xca, 0x61, 0x2f, 0xbf,
+	0x3a, 0xe2, 0xe5, 0x7f, 0x07, 0xea, 0x19, 0x59, 0xf8, 0x52, 0x98, 0x16, 0xc7, 0xcc, 0xe7, 0x19,
+	0x55, 0x47, 0x53, 0xa8, 0x18, 0x09, 0x05, 0xcd, 0x17, 0xb7, 0xfb, 0x56, 0xe5, 0xa8, 0x7a, 0x19,
+	0x76, 0xd6, 0xc8, 0x8a, 0x90, 0x5c, 0xb9, 0x8c, 0x2a, 0x47, 0xe5, 0xfd, 0x6a, 0x8d, 0x3c, 0x3b,
+	0x86, 0x79, 0x64, 0x7e, 0xa3, 0xce, 0x98, 0xdf, 0xe8, 0x97, 0xf8, 0x6b, 0xfa, 0x54, 0xe1, 0x6b,
+	0x82, 0xf2, 0x5f, 0xd3, 0xc1, 0x6f, 0x88, 0x1d, 0xb1, 0x45, 0x29, 0x6d, 0x0f, 0x12, 0x9e, 0x47,
+	0x67, 0x14, 0x10, 0x58, 0x11, 0xed, 0xa0, 0x30, 0xd0, 0x7d, 0xd3, 0xf6, 0x51, 0xb8, 0x4d, 0x96,
+	0x54, 0x29, 0xc8, 0xac, 0x45, 0xc0, 0x25, 0xcd, 0xd2, 0x02, 0xca, 0x37, 0xce, 0xc6, 0xbb, 0x5f,
+	0x25, 0x17, 0x47, 0xdb, 0xb0, 0x58, 0x29, 0x67, 0x8b, 0x29, 0xd9, 0x35, 0x16, 0xbb, 0x28, 0x96,
+	0x0e, 0x7b, 0x5e, 0xdd, 0x0c, 0x26, 0x0e, 0xdb, 0x1e, 0x1a, 0xe1, 0xd0, 0x6b, 0x46, 0xd0, 0x23,
+	0xdf, 0x1e, 0xe6, 0x81, 0x30, 0x8c, 0x8f, 0xa5, 0x22, 0xb3, 0x20, 0x0b, 0x29, 0xef, 0xcd, 0x17,
+	0x1a, 0xf3, 0x98, 0x6f, 0xaa, 0x56, 0x30, 0x30, 0xd0, 0x35, 0xd9, 0xf7, 0xb3, 0x9d, 0x74, 0x69,
+	0x07, 0xb7, 0x97, 0x9d, 0x66, 0x4d, 0xbb, 0x26, 0x37, 0x8c, 0x76, 0xb0, 0xb0, 0xf0, 0x58, 0x96,
+	0xcb, 0xef, 0x85, 0x30, 0x14, 0x1b, 0x5e, 0xb6, 0x9e, 0x56, 0x65, 0x23, 0x68, 0xb8, 0x81, 0x1c,
+	0xed, 0x37, 0x27, 0x86, 0x90, 0xa3, 0x7d, 0xd0, 0x70, 0xf7, 0xab, 0xc9, 0x29, 0x91, 0x07, 0xab,
+	0xee, 0x15, 0xc3, 0x0e, 0xac, 0x88, 0xda, 0x55, 0x13, 0x00, 0x36, 0x1e, 0x3a, 0x3a, 0xcd, 0xd9,
+	0xd8, 0x48, 0xe2, 0x8c, 0xb6, 0xf1, 0x54, 0x8a, 0x5f, 0x25, 0xc6, 0x1c, 0x9d, 0x9b, 0x45, 0x08,
+	0x50, 0xdc, 0xcf, 0xfb, 0x81, 0x5a, 0xf1, 0x0b, 0xe6, 0xbb, 0xc6, 0xa3, 0xc8, 0x05, 0xf1, 0xd5,
+	0x57, 0xc6, 0xd0, 0xcc, 0xd5, 0x87, 0xad, 0x99, 0x6b, 0x23, 0x35, 0xf3, 0x32, 0x39, 0x6d, 0xdc,
+	0x8a, 0xcd, 0xab, 0x70, 0xd5, 0x6d, 0xdb, 0x6b, 0x23, 0x07, 0x87, 0xa1, 0x1e, 0x8f, 0xf7, 0x47,
+	0x6c, 0x9b, 0x0b, 0x8d, 0x31, 0x2a, 0xf8, 0xfe, 0xaf, 0x0a, 0xb9, 0x30, 0x72, 0x67, 0xff, 0x90,
+	0x94, 0xb9, 0xb9, 0x5e, 0x6a, 0x0f, 0x67, 0xbd, 0x98, 0x6f, 0xb1, 0x7e, 0xe8, 0x5b, 0x1c, 0xc7,
+	0xee, 0xb3, 0x66, 0x7e, 0x72, 0x8c, 0x99, 0xff, 0x9d, 0xea, 0xc8, 0xcf, 0x11, 0x5d, 0x47, 0x7f,
+	0x69, 0xa7, 0xfe, 0x6b, 0xc9, 0x29, 0xbf, 0xdf, 0xe7, 0x78, 0x2c, 0x3d, 0x2d, 0x57, 0x38, 0x78,
+	0xc1, 0x04, 0x82, 0x8d, 0x3b, 0xd6, 0x9b, 0x58, 0x20, 0x73, 0xc2, 0x7e, 0x95, 0xfb, 0xae, 0xfc,
+	0x15, 0xbc, 0x60, 0x83, 0x21, 0x8f, 0x7f, 0xf4, 0xcf, 0xe8, 0x8f, 0x1d, 0x32, 0x05, 0x74, 0x9b,
+	0xcb, 0x63, 0xbc, 0xcd, 0x86, 0xbd, 0x16, 0xa7, 0x8c, 0xdb, 0x6c, 0xd8, 0x76, 0x20, 0x60, 0x57,
+	0xbc, 0x14, 0xbd, 0xe0, 0xe3, 0x96, 0xbe, 0x51, 0x37, 0x84, 0x57, 0x47, 0xdf, 0x10, 0xee, 0x7d,
+	0x7e, 0x0a, 0x1f, 0xaf, 0x1f, 0xe3, 0x35, 0xc5, 0x29, 0xae, 0xa9, 0x41, 0x12, 0x36, 0x1d, 0x7b,
+	0x4d, 0x61, 0x14, 0x0f, 0xb6, 0x5b, 0x01, 0x17, 0x95, 0x23, 0x95, 0x6a, 0xad, 0x1e, 0x5a, 0xaa,
+	0x15, 0xcb, 0x16, 0xa6, 0x3b, 0x1b, 0x49, 0xb0, 0xe7, 0x67, 0x78, 0xb2, 0xd9, 0xac, 0xd9, 0x8b,
+	0xa7, 0xd5, 0xba, 0xa1, 0x81, 0x60, 0xe3, 0xa2, 0x9b, 0x41, 0x17, 0x4c, 0xa5, 0x49, 0xc6, 0x72,
+	0xcd, 0xeb, 0xb6, 0x9b, 0x41, 0x97, 0x58, 0x15, 0x08, 0x30, 0xdc, 0x07, 0x35, 0x89, 0xd5, 0x88,
+	0x03, 0x99, 0xb0, 0x35, 0x89, 0x45, 0x07, 0xc7, 0x32, 0xd4, 0x03, 0xaf, 0x10, 0xe1, 0x0b, 0x63,
+	0xa1, 0xdf, 0x37, 0x9e, 0x68, 0xd2, 0xbe, 0x42, 0xe4, 0xfa, 0x30, 0x0a, 0x14, 0xf5, 0xc3, 0xa3,
+	0x08, 0xd5, 0xbc, 0xb2, 0x2c, 0x62, 0x05, 0xd4, 0x51, 0x84, 0x22, 0xb3, 0xd2, 0x01, 0x13, 0x0f,
+	0x5d, 0x26, 0xfa, 0x27, 0xaf, 0x5d, 0xc2, 0x03, 0x68, 0x96, 0x45, 0x2d, 0x6a, 0xe5, 0x32, 0xb9,
+	0x5e, 0x88, 0xd6, 0x81, 0x51, 0xfd, 0xdd, 0x2d, 0x72, 0x51, 0x81, 0xae, 0x46, 0x19, 0xab, 0x2e,
+	0x90, 0xd2, 0x45, 0x3f, 0x65, 0xa1, 0x60, 0x84, 0x3d, 0xa7, 0x27, 0xa8, 0x5f, 0xbc, 0x1e, 0x64,
+	0x37, 0x8a, 0x30, 0x61, 0x15, 0x0e, 0xa0, 0x82, 0x5f, 0x2a, 0x8d, 0xfc, 0xad, 0x90, 0xae, 0x2f,
+	0xad, 0x08, 0xbf, 0x95, 0x4e, 0x4b, 0x93, 0x00, 0xd0, 0x38, 0x2a, 0xb1, 0x6a, 0x66, 0x54, 0x62,
+	0x15, 0x66, 0xa8, 0x76, 0xdb, 0x7d, 0xdc, 0x55, 0x04, 0x6d, 0xba, 0xd0, 0x66, 0x99, 0x1c, 0xf8,
+	0x62, 0xb8, 0x43, 0x4a, 0x65, 0xa8, 0x5e, 0x5f, 0xda, 0x18, 0xc2, 0x81, 0xc2, 0x9e, 0x2c, 0xe3,
+	0x07, 0xcb, 0xc0, 0x36, 0xcf, 0xe6, 0x32, 0x7e, 0xb0, 0x11, 0x38, 0x0c, 0xf3, 0x17, 0x58, 0x96,
+	0xf6, 0x8d, 0x2c, 0xeb, 0xab, 0x6d, 0x4c, 0xf3, 0x9c, 0x5d, 0x99, 0xf6, 0xda, 0x10, 0x06, 0x14,
+	0xf4, 0x42, 0x5b, 0x2e, 0x8a, 0x19, 0xf5, 0xe6, 0x93, 0xb6, 0x2d, 0x77, 0x8b, 0x37, 0x83, 0x84,
+	0xa3, 0xbf, 0x60, 0x90, 0x52, 0xe6, 0xfe, 0xb9, 0x13, 0x27, 0xbb, 0x61, 0xec, 0x77, 0x56, 0xd8,
+	0x55, 0xe4, 0xd9, 0x7e, 0xb3, 0x69, 0xfb, 0x0b, 0x5e, 0x1a, 0x81, 0x07, 0x23, 0x29, 0xe4, 0x4b,
+	0x2b, 0x5f, 0x18, 0xb3, 0xb4, 0xf2, 0x06, 0x39, 0x27, 0x95, 0xef, 0xfa, 0xd2, 0x8a, 0x7a, 0xe8,
+	0xe6, 0x45, 0xfb, 0x6e, 0xd3, 0x95, 0x02, 0x1c, 0x28, 0xec, 0xe9, 0xfd, 0x91, 0x43, 0x4e, 0x29,
+	0x09, 0xf6, 0x10, 0xaa, 0x45, 0x84, 0x76, 0xb5, 0x88, 0xeb, 0xc7, 0xd7, 0x01, 0x6c, 0xe4, 0x23,
+	0x72, 0x1b, 0x7f, 0xe8, 0x14, 0x21, 0x5a, 0x4f, 0x28, 0xb3, 0xc0, 0x19, 0x69, 0x16, 0x3c, 0xb6,
+	0x32, 0xba, 0xa8, 0x54, 0x6e, 0xfd, 0xd1, 0x96, 0xca, 0x6d, 0x91, 0xf3, 0x72, 0x49, 0xf1, 0x10,
+	0x18, 0x4c, 0xb8, 0x97, 0x22, 0xdf, 0xb8, 0xac, 0x76, 0xa5, 0x08, 0x09, 0x8a, 0xfb, 0x5a, 0x06,
+	0xe8, 0xe4, 0xa1, 0x06, 0xa8, 0x92, 0x72, 0xab, 0xdb, 0xf2, 0x2a, 0xe9, 0x9c, 0x94, 0x5b, 0xbd,
+	0xd6, 0x02, 0x8d, 0x53, 0xac, 0xea, 0xa6, 0x4a, 0x52, 0x75, 0xe4, 0xc8, 0xaa, 0x4e, 0x0a, 0xdd,
+	0xe9, 0x91, 0x42, 0x57, 0x1e, 0xb5, 0xcf, 0x1c, 0x14, 0xd4, 0x1e, 0x44, 0x3b, 0x34, 0x09, 0x32,
+	0xda, 0x61, 0xdf, 0x02, 0x13, 0xc8, 0x0d, 0x6d, 0xe8, 0xac, 0x58, 0x50, 0xc8, 0x61, 0xdb, 0x9a,
+	0x62, 0x76, 0x0c, 0x4d, 0x31, 0x42, 0x3f, 0xcf, 0x95, 0xa3, 0x9f, 0x4f, 0x1f, 0x5f, 0x3f, 0x9f,
+	0x39, 0x51, 0xfd, 0xec, 0x96, 0xa2, 0x9f, 0xc7, 0x52, 0x7d, 0x86, 0xeb, 0xe1, 0xdc, 0x21, 0xae,
+	0x87, 0x51, 0xca, 0xf9, 0xfc, 0x03, 0x2b, 0xe7, 0x62, 0xbd, 0xfb, 0xc4, 0x1b, 0x7a, 0xb7, 0x14,
+	0xbd, 0xfb, 0xe9, 0x0a, 0x39, 0xaf, 0x35, 0x13, 0xca, 0x83, 0x60, 0x1b, 0x65, 0x33, 0xc5, 0xd0,
+	0x56, 0x1e, 0xa0, 0x63, 0xd4, 0x28, 0xd1, 0x55, 0x5a, 0x14, 0x04, 0x0c, 0x2c, 0x56, 0xea, 0x83,
+	0x26, 0xec, 0x66, 0xb0, 0xbc, 0xda, 0x5a, 0x12, 0xed, 0xa0, 0x30, 0x70, 0x12, 0xf0, 0x7f, 0x51,
+	0x69, 0x2a, 0x7f, 0xaf, 0xc3, 0x92, 0x06, 0x81, 0x89, 0x87, 0xc1, 0x39, 0x6d, 0x29, 0x32, 0x51,
+	0x75, 0xcd, 0xf0, 0xad, 0xac, 0x92, 0x92, 0x0a, 0x2a, 0x87, 0xc3, 0x4a, 0xd1, 0xd4, 0x87, 0x87,
+	0x83, 0xed, 0xa0, 0x30, 0xbc, 0xff, 0xe1, 0x90, 0x0b, 0x85, 0x53, 0xf1, 0x10, 0xcc, 0x91, 0x7b,
+	0xb6, 0x39, 0xd2, 0x2a, 0x6b, 0x4b, 0x6a, 0x3c, 0xc5, 0x08, 0xd3, 0xe4, 0xdf, 0x3b, 0x64, 0x56,
+	0xe3, 0x3f, 0x84, 0x47, 0x0d, 0xec, 0x47, 0x2d, 0x6f, 0xf7, 0x3d, 0x35, 0xf4, 0x6c, 0xbf, 0x51,
+	0x21, 0xea, 0xae, 0x15, 0x1e, 0x89, 0x34, 0x46, 0xc8, 0xd8, 0x3e, 0x99, 0x60, 0x11, 0x6f, 0x69,
+	0x39, 0xe1, 0xbe, 0x36, 0x7f, 0x16, 0x3d, 0xa7, 0xcf, 0xd9, 0xd9, 0xcf, 0x14, 0x04, 0x43, 0x76,
+	0x6f, 0x1d, 0xbf, 0xc6, 0xa2, 0x23, 0x22, 0x7e, 0xf5, 0xbd, 0x75, 0xa2, 0x1d, 0x14, 0x06, 0x2a,
+	0xcc, 0xa0, 0x1d, 0x47, 0x4b, 0xa1, 0x9f, 0xa6, 0xc2, 0x86, 0x53, 0x0a, 0x73, 0x45, 0x02, 0x40,
+	0xe3, 0xb0, 0x60, 0xb8, 0x20, 0xed, 0x87, 0xfe, 0xbe, 0xe1, 0xd7, 0x31, 0x2a, 0x2a, 0x2a, 0x10,
+	0x98, 0x78, 0x5e, 0x8f, 0x34, 0xed, 0x87, 0x58, 0xa6, 0xdb, 0x2c, 0x55, 0x65, 0xac, 0xe9, 0xc4,
+	0x84, 0x0d, 0xd6, 0x6b, 0x75, 0xe0, 0xe7, 0xef, 0x2c, 0x5b, 0x90, 0x00, 0xd0, 0x38, 0xde, 0x3f,
+	0x72, 0xc8, 0xd9, 0x82, 0x49, 0x2b, 0xb1, 0x22, 0x48, 0xa6, 0xa5, 0x4d, 0x91, 0xa9, 0x83, 0xb9,
+	0x53, 0x74, 0xdb, 0x97, 0xc9, 0x10, 0x66, 0xee, 0x14, 0x6f, 0x06, 0x09, 0xc7, 0xbc, 0xed, 0x39,
+	0x7b, 0xac, 0x29, 0xcb, 0x73, 0xe7, 0xd3, 0x14, 0xa4, 0xed, 0x78, 0x8f, 0x26, 0xfb, 0xf8, 0xe4,
+	0x4e, 0x2e, 0xcf, 0x7d, 0x08, 0x03, 0x0a, 0x7a, 0xb1, 0x9b, 0x96, 0x3a, 0x6a, 0xb6, 0xe5, 0x8a,
+	0xbc, 0x5d, 0xe6, 0x8a, 0xd4, 0x2f, 0xd3, 0x58, 0x0a, 0x9a, 0x25, 0x98, 0xfc, 0xd1, 0xe4, 0x62,
+	0xd9, 0x6f, 0x98, 0xca, 0x9e, 0x05, 0x91, 0x78, 0x64, 0xb1, 0x56, 0x95, 0xc9, 0xb5, 0x36, 0x8c,
+	0x02, 0x45, 0xfd, 0xbc, 0x2f, 0xd4, 0x88, 0xaa, 0x76, 0xc5, 0xe2, 0xd6, 0x4b, 0x4a, 0x0b, 0x38,
+	0x6a, 0xb5, 0x04, 0xb5, 0xb6, 0x6a, 0x07, 0x05, 0x92, 0x72, 0xc7, 0x9c, 0x79, 0x2e, 0xa1, 0x26,
+	0x6c, 0x53, 0x83, 0xc0, 0xc4, 0xc3, 0x91, 0x84, 0xc1, 0x1e, 0xe5, 0x9d, 0x26, 0xec, 0x91, 0xac,
+	0x4a, 0x00, 0x68, 0x1c, 0x1c, 0x49, 0x27, 0xd8, 0xde, 0x6e, 0x4e, 0xda, 0x23, 0xc1, 0xd9, 0x01,
+	0x06, 0xe1, 0x77, 0xf1, 0xc5, 0xbb, 0x62, 0x9b, 0x61, 0xdc, 0xc5, 0x17, 0xef, 0x02, 0x83, 0xe0,
+	0x5b, 0x8a, 0xe2, 0xa4, 0xe7, 0x87, 0xc1, 0xab, 0xb4, 0xa3, 0xb8, 0x88, 0xed, 0x85, 0x7a, 0x4b,
+	0xb7, 0x86, 0x51, 0xa0, 0xa8, 0x1f, 0x2e, 0xe8, 0x7e, 0x42, 0x3b, 0x41, 0x3b, 0x33, 0x5a, 0x9b,
+	0xc4, 0x5e, 0xd0, 0x1b, 0x43, 0x18, 0x50, 0xd0, 0x8b, 0xfb, 0x7e, 0xf9, 0x0b, 0x97, 0x15, 0x7e,
+	0xa7, 0xed, 0x32, 0xa1, 0x60, 0x83, 0x21, 0x8f, 0xcf, 0x02, 0x38, 0x44, 0x7d, 0xf2, 0xe6, 0x8c,
+	0x2d, 0x24, 0x65, 0xdd, 0x72, 0x50, 0x18, 0xde, 0x27, 0xab, 0xa8, 0xd4, 0x47, 0x5c, 0x03, 0xf0,
+	0xd0, 0xd2, 0x50, 0xec, 0x15, 0x59, 0x1b, 0x63, 0x45, 0x62, 0x06, 0x47, 0x1a, 0x47, 0x2a, 0x83,
+	0xa3, 0x3e, 0x32, 0x83, 0xc3, 0xc0, 0x2a, 0xce, 0xe0, 0x98, 0x28, 0x2b, 0x83, 0x63, 0xf2, 0x01,
+	0x33, 0x38, 0xfe, 0x45, 0x9d, 0xa8, 0x8b, 0xa0, 0x6f, 0xd1, 0xec, 0x6e, 0x9c, 0xec, 0x06, 0x51,
+	0x97, 0x55, 0xde, 0xfa, 0x71, 0x47, 0x16, 0xef, 0x5a, 0x35, 0x4b, 0x34, 0x6c, 0x97, 0x74, 0x99,
+	0xaf, 0xc5, 0x6c, 0x7e, 0xd3, 0x60, 0xc4, 0xe3, 0xef, 0x72, 0x45, 0xc2, 0x38, 0x08, 0xac, 0x11,
+	0xb9, 0xdf, 0x4a, 0x88, 0x74, 0xc9, 0x6f, 0x4b, 0x09, 0xbc, 0x52, 0xce, 0xf8, 0xf0, 0x18, 0x46,
+	0x99, 0xd4, 0x9b, 0x8a, 0x09, 0x18, 0x0c, 0x31, 0x62, 0x53, 0x1e, 0xa9, 0xf0, 0x5c, 0xd0, 0x8f,
+	0x9e, 0xc8, 0xdc, 0x8c, 0x53, 0xbc, 0x02, 0xc8, 0x64, 0x10, 0x75, 0x71, 0x9d, 0x88, 0x48, 0xf7,
+	0xb7, 0x16, 0x15, 0x76, 0x5c, 0x8d, 0xfd, 0xce, 0xa2, 0x1f, 0xfa, 0x51, 0x1b, 0x6f, 0x57, 0x62,
+	0xe8, 0x5a, 0x83, 0x8a, 0x06, 0x90, 0x84, 0x86, 0x6e, 0xab, 0xae, 0x8f, 0x73, 0x5b, 0xf5, 0xc5,
+	0x6f, 0x20, 0x67, 0x86, 0x5e, 0xe6, 0x91, 0x6a, 0x55, 0x1c, 0xa3, 0xa4, 0xe3, 0xaf, 0x4e, 0x68,
+	0xa5, 0x85, 0x45, 0x2c, 0xd9, 0xe5, 0xc7, 0x89, 0x7e, 0xa3, 0xc2, 0x64, 0x2e, 0x71, 0x89, 0x28,
+	0x35, 0x63, 0x34, 0x82, 0xc9, 0x12, 0xd7, 0x68, 0xdf, 0x4f, 0x68, 0x74, 0xd2, 0x6b, 0x74, 0x43,
+	0x31, 0x01, 0x83, 0xa1, 0xbb, 0x63, 0x25, 0x2b, 0x5f, 0x3b, 0x7e, 0xb2, 0x32, 0x2b, 0xb3, 0x5d,
+	0x74, 0x0f, 0xe7, 0x67, 0x1d, 0x32, 0x1b, 0x59, 0x2b, 0xb7, 0x9c, 0xf4, 0xa3, 0xe2, 0xaf, 0x62,
+	0xd1, 0x65, 0xc5, 0x18, 0xac, 0x36, 0xc8, 0xf1, 0x2f, 0x52, 0x69, 0xf5, 0x23, 0xaa, 0x34, 0x7d,
+	0xf9, 0xfa, 0xc4, 0xa8, 0xcb, 0xd7, 0xdd, 0x88, 0x4c, 0xf0, 0xa2, 0xc0, 0xcd, 0xc9, 0x32, 0x4a,
+	0x53, 0x99, 0x95, 0x85, 0x39, 0x3f, 0xde, 0x02, 0x82, 0x8b, 0x7b, 0xc7, 0xac, 0x65, 0xd0, 0x38,
+	0x72, 0xd2, 0xec, 0xa9, 0x51, 0x35, 0x0f, 0xbc, 0xff, 0x53, 0x23, 0xa7, 0xe5, 0x8c, 0xc8, 0xd4,
+	0x45, 0xd4, 0x8f, 0x9c, 0xaf, 0xb6, 0x95, 0x95, 0x7e, 0xbc, 0x21, 0x01, 0xa0, 0x71, 0xd0, 0x1e,
+	0x1b, 0xa4, 0x58, 0x36, 0x33, 0x5a, 0x0d, 0xb6, 0x52, 0x11, 0x23, 0xa0, 0x3e, 0x94, 0x97, 0x34,
+	0x08, 0x4c, 0x3c, 0x56, 0x70, 0xa1, 0x6d, 0x56, 0x67, 0xd2, 0x05, 0x17, 0xda, 0xa2, 0xca, 0x99,
+	0x80, 0xbb, 0x3f, 0x5c, 0x78, 0x2f, 0x51, 0x39, 0x15, 0x01, 0x86, 0x32, 0x36, 0x8f, 0x76, 0x21,
+	0x91, 0xfb, 0xd3, 0x0e, 0x39, 0xcf, 0x5b, 0xe5, 0x4c, 0xbe, 0xd4, 0xef, 0xf8, 0x19, 0x4d, 0x9b,
+	0x13, 0x27, 0x34, 0x3e, 0xed, 0x45, 0x2f, 0x62, 0x0b, 0xc5, 0xa3, 0xc1, 0xa2, 0x34, 0x73, 0xbb,
+	0x56, 0x75, 0x45, 0xa9, 0x3a, 0x8e, 0x5b, 0x7a, 0xcc, 0x22, 0xaa, 0x3f, 0x35, 0xbb, 0x3d, 0x85,
+	0x3c, 0x77, 0xbc, 0xf3, 0xcc, 0x14, 0xa3, 0x0f, 0xbf, 0x28, 0xe3, 0xd1, 0x4d, 0x41, 0x69, 0x5d,
+	0xd6, 0x47, 0x5a, 0x97, 0x78, 0xe0, 0x1f, 0x74, 0x9a, 0x13, 0xb9, 0x03, 0xff, 0x95, 0x65, 0xc0,
+	0x76, 0xef, 0x4f, 0xea, 0xda, 0x0d, 0x22, 0x12, 0xee, 0xff, 0x52, 0x3c, 0xf6, 0xb6, 0xaa, 0xb6,
+	0xce, 0x9f, 0xfc, 0xd6, 0x50, 0xb5, 0xf5, 0xaf, 0x3b, 0x7a, 0x3d, 0x05, 0x3e, 0x41, 0xa3, 0x8a,
+	0xad, 0x4f, 0x1e, 0x52, 0x4c, 0xe1, 0x65, 0xd2, 0xc0, 0x2d, 0x18, 0xf3, 0x67, 0x36, 0xac, 0x41,
+	0x35, 0x6e, 0x88, 0xf6, 0xd7, 0xef, 0x5f, 0xfa, 0x9a, 0xa3, 0x0f, 0x4b, 0xf6, 0x06, 0x45, 0xdf,
+	0x4d, 0xc9, 0x14, 0xfe, 0xcf, 0xea, 0x3e, 0x88, 0xcd, 0xdd, 0x4b, 0x4a, 0x66, 0x4a, 0x40, 0x29,
+	0x45, 0x25, 0x34, 0x1f, 0x37, 0x22, 0x53, 0x88, 0xc8, 0x99, 0xf2, 0x3d, 0xe0, 0x86, 0x64, 0xda,
+	0x92, 0x80, 0xd7, 0xef, 0x5f, 0xfa, 0xda, 0xa3, 0x33, 0x55, 0xdd, 0x41, 0xb3, 0x30, 0x54, 0xe3,
+	0xf4, 0x28, 0xd5, 0xe8, 0xfd, 0xdf, 0x9a, 0x5e, 0xdf, 0xfc, 0xd5, 0xff, 0xe5, 0x58, 0xdf, 0x2f,
+	0xe4, 0xd6, 0xf7, 0xe5, 0xa1, 0xf5, 0x3d, 0x8b, 0x73, 0x56, 0x70, 0x3d, 0xc0, 0xc3, 0x36, 0x16,
+	0x0e, 0xf7, 0x49, 0xe8, 0xa0, 0xaf, 0x74, 0x23, 0x19, 0x44, 0x58, 0x0f, 0x7f, 0xaa, 0x30, 0xe8,
+	0x4b, 0x82, 0x21, 0x8f, 0x8f, 0x1b, 0x7f, 0x5c, 0x17, 0x77, 0xfc, 0x3d, 0xbe, 0xf2, 0x8c, 0x22,
+	0xc8, 0x2d, 0xd1, 0x0e, 0x0a, 0xc3, 0xdd, 0x21, 0x4f, 0x49, 0x02, 0xcb, 0x34, 0xa4, 0xf8, 0x40,
+	0x2c, 0x3c, 0x33, 0xe9, 0xf9, 0x99, 0x74, 0x3b, 0x34, 0x16, 0xdf, 0x22, 0x28, 0x3c, 0x05, 0x07,
+	0xe0, 0xc2, 0x81, 0x94, 0xbc, 0x9f, 0x65, 0xa1, 0x0b, 0x46, 0xf9, 0x1b, 0x5c, 0x7d, 0x61, 0xd0,
+	0x0b, 0x64, 0xad, 0x66, 0xb5, 0xfa, 0x56, 0xb1, 0x11, 0x38, 0xcc, 0xbd, 0x4b, 0x26, 0xb7, 0xfc,
+	0xf6, 0x6e, 0xbc, 0xbd, 0x5d, 0xce, 0x5d, 0x7c, 0x8b, 0x9c, 0x18, 0xbb, 0xa7, 0x61, 0x52, 0xfc,
+	0x78, 0x5d, 0xff, 0x0b, 0x92, 0x9b, 0xf7, 0xfb, 0x75, 0x32, 0x27, 0xc3, 0xcb, 0x6e, 0x04, 0x29,
+	0x8b, 0x48, 0x30, 0x2f, 0xaf, 0xa9, 0x1c, 0x7a, 0x79, 0xcd, 0x87, 0x09, 0xe9, 0xd0, 0x7e, 0x18,
+	0xef, 0x33, 0xe3, 0xb0, 0x76, 0x64, 0xe3, 0x50, 0xed, 0x27, 0x96, 0x15, 0x15, 0x30, 0x28, 0x8a,
+	0x02, 0xd5, 0xfc, 0x2e, 0x9c, 0x5c, 0x81, 0x6a, 0xe3, 0xc6, 0xce, 0x89, 0x87, 0x7b, 0x63, 0x67,
+	0x40, 0xe6, 0xf8, 0x10, 0x55, 0x91, 0x99, 0x07, 0xa8, 0x25, 0xc3, 0x72, 0x5f, 0x97, 0x6d, 0x32,
+	0x90, 0xa7, 0x6b, 0x5e, 0xc7, 0xd9, 0x78, 0xd8, 0xd7, 0x71, 0x7e, 0x05, 0x99, 0x92, 0xef, 0x19,
+	0x73, 0x32, 0x55, 0xf0, 0xbc, 0x5c, 0x06, 0x29, 0x68, 0xf8, 0x50, 0xbd, 0x2c, 0xf2, 0xa8, 0xea,
+	0x65, 0x79, 0x9f, 0xad, 0xe2, 0xae, 0x82, 0x8f, 0xeb, 0xc8, 0xb7, 0xd9, 0xde, 0x30, 0x6e, 0xb3,
+	0x3d, 0xda, 0xfb, 0x6c, 0xe4, 0x6e, 0xbd, 0x7d, 0x8a, 0xd4, 0x32, 0xbf, 0x2b, 0x8b, 0x06, 0x30,
+	0xe8, 0xa6, 0x8f, 0x97, 0xaa, 0x61, 0xeb, 0x51, 0xea, 0xf9, 0x63, 0x90, 0x4e, 0xd0, 0x8d, 0xfc,
+	0x0c, 0x23, 0x53, 0xf4, 0xf9, 0xa5, 0x0e, 0xd2, 0x31, 0x81, 0x60, 0xe3, 0x62, 0x5a, 0x0f, 0x49,
+	0xa8, 0xda, 0xb3, 0x4c, 0x94, 0xb1, 0x86, 0x94, 0x18, 0x90, 0x74, 0xcd, 0x3a, 0x47, 0x6a, 0xaf,
+	0x62, 0xb0, 0xf5, 0x3e, 0xe5, 0x90, 0x33, 0x43, 0xbd, 0xdc, 0x3e, 0x99, 0x68, 0xb3, 0xbc, 0xcd,
+	0x72, 0x6a, 0x10, 0xdb, 0xf7, 0x17, 0x73, 0xe5, 0xc4, 0xdb, 0x40, 0xf0, 0xf1, 0x3e, 0x3f, 0x43,
+	0xce, 0xb5, 0x96, 0xd6, 0x64, 0x7a, 0xe6, 0x89, 0xd5, 0x1e, 0x28, 0xe2, 0xf1, 0xf0, 0x6a, 0x0f,
+	0x8c, 0xe0, 0x1e, 0x1a, 0xb5, 0x07, 0x42, 0xa3, 0xf6, 0x80, 0x9d, 0x08, 0x5e, 0x2d, 0x23, 0x11,
+	0xbc, 0x68, 0x04, 0xe3, 0x24, 0x82, 0x9f, 0x58, 0x31, 0x82, 0x03, 0x07, 0x74, 0xa4, 0x62, 0x04,
+	0xaa, 0x52, 0x43, 0x29, 0x19, 0x84, 0x23, 0x5e, 0x55, 0x61, 0xa5, 0x06, 0x95, 0x25, 0xcf, 0x73,
+	0x7f, 0x9b, 0x13, 0x65, 0x64, 0xc9, 0x17, 0x0d, 0x60, 0x8c, 0x2c, 0x79, 0xfe, 0xc3, 0xaa, 0xcc,
+	0x30, 0x59, 0x46, 0x65, 0x86, 0xa2, 0xe1, 0x1c, 0x5a, 0x99, 0x01, 0x2f, 0xeb, 0x0d, 0x63, 0x96,
+	0x75, 0x9d, 0xc5, 0xed, 0x38, 0x6c, 0x36, 0x6c, 0x01, 0xb9, 0x64, 0x02, 0xc1, 0xc6, 0x1d, 0x55,
+	0xd6, 0x61, 0xea, 0xb8, 0x65, 0x1d, 0xc8, 0x23, 0x2a, 0xeb, 0x60, 0x14, 0x2e, 0x98, 0x2e, 0xa3,
+	0x70, 0x41, 0xd1, 0x1b, 0x19, 0xab, 0x70, 0xc1, 0xe7, 0x1c, 0x72, 0xca, 0xbf, 0xcb, 0x36, 0x23,
+	0x5c, 0x0a, 0xb3, 0x23, 0xba, 0xe9, 0xe7, 0x3f, 0x72, 0x02, 0x0b, 0xf6, 0x4e, 0x4b, 0xb3, 0xe1,
+	0xf9, 0x7a, 0x56, 0x13, 0xd8, 0x03, 0x39, 0x4e, 0x52, 0xfe, 0x8f, 0x56, 0xc8, 0x97, 0x1d, 0x3a,
+	0x04, 0xf7, 0x2e, 0x1e, 0x14, 0x75, 0xc5, 0x42, 0x6d, 0x3a, 0x65, 0xc4, 0x15, 0x6f, 0x4a, 0x7a,
+	0x22, 0xa5, 0x52, 0x91, 0x07, 0x83, 0x15, 0x0b, 0x27, 0x8e, 0xc3, 0xa1, 0x82, 0xc3, 0x10, 0x87,
+	0x14, 0x18, 0x04, 0x0d, 0xa1, 0x84, 0x76, 0xd1, 0xb8, 0xaf, 0xda, 0x86, 0x10, 0xb0, 0x56, 0x10,
+	0x50, 0xf4, 0xaa, 0xfa, 0x61, 0xc8, 0x13, 0x13, 0x69, 0x2a, 0x6e, 0xd1, 0xd6, 0x45, 0xc3, 0x35,
+	0x08, 0x4c, 0x3c, 0xef, 0xcf, 0x2b, 0xe4, 0xd2, 0x21, 0x32, 0x65, 0xa8, 0x68, 0x41, 0x7d, 0xec,
+	0xa2, 0x05, 0x22, 0x45, 0x6a, 0x62, 0x44, 0x8a, 0x14, 0x9e, 0xcc, 0x53, 0xbc, 0x44, 0x92, 0x07,
+	0x28, 0xe6, 0x6a, 0xcc, 0x6e, 0x6a, 0x10, 0x98, 0x78, 0x28, 0xc5, 0x66, 0xfd, 0x76, 0x9b, 0xa6,
+	0xa9, 0xcc, 0x81, 0x12, 0x5e, 0xee, 0xd2, 0x12, 0xac, 0xd8, 0xe1, 0xc1, 0x82, 0xc5, 0x02, 0x72,
+	0x2c, 0xf3, 0x13, 0x3e, 0x35, 0xe6, 0x84, 0xff, 0x64, 0x85, 0x3c, 0x7d, 0xa0, 0x76, 0x1b, 0x3b,
+	0x3d, 0x0d, 0x63, 0xc8, 0xf3, 0x0b, 0x07, 0x23, 0xcc, 0x81, 0x41, 0xf8, 0x2c, 0xf5, 0xfb, 0x2a,
+	0x8a, 0xbc, 0xfc, 0x8c, 0x51, 0x3e, 0x4b, 0x16, 0x0b, 0xc8, 0xb1, 0x7c, 0xd0, 0x65, 0xf9, 0xfb,
+	0x35, 0xf2, 0xec, 0x18, 0x36, 0x40, 0x89, 0x99, 0xb5, 0x76, 0x3e, 0x7d, 0xf5, 0x11, 0xe5, 0xd3,
+	0x3f, 0xd8, 0x74, 0xbd, 0x91, 0x86, 0x3f, 0x56, 0x1a, 0xfe, 0xcf, 0x56, 0xc8, 0xc5, 0xd1, 0x06,
+	0x8b, 0xfb, 0xf5, 0xe8, 0xe7, 0x92, 0x21, 0x89, 0x66, 0x2a, 0xfe, 0x59, 0xee, 0xe3, 0xb2, 0x40,
+	0x90, 0xc7, 0xc5, 0x6c, 0x7a, 0x96, 0xf7, 0x7e, 0xf5, 0x5e, 0x90, 0x66, 0xa2, 0xf6, 0xe6, 0x2c,
+	0x3f, 0x79, 0x95, 0xad, 0x60, 0x60, 0x20, 0x3b, 0xf6, 0x6b, 0x19, 0x2b, 0xfb, 0xf0, 0x4e, 0x7c,
+	0xeb, 0x79, 0x56, 0x5e, 0xb9, 0x6b, 0x80, 0x20, 0x8f, 0x8b, 0xec, 0xd8, 0xd9, 0x3e, 0x1f, 0x68,
+	0x4d, 0x27, 0xef, 0xaf, 0xaa, 0x56, 0x30, 0x30, 0xf2, 0x45, 0x06, 0xea, 0x87, 0x17, 0x19, 0xf0,
+	0x7e, 0xb1, 0x42, 0x2e, 0x8c, 0x34, 0x78, 0xc7, 0x13, 0x53, 0x8f, 0x5f, 0x3a, 0xfb, 0x03, 0x7e,
+	0x61, 0x47, 0xca, 0x6a, 0xf6, 0xfe, 0x78, 0xc4, 0x4a, 0x13, 0x09, 0xc8, 0x0f, 0x5e, 0x05, 0xe8,
+	0xf1, 0x9b, 0xcf, 0xa1, 0x9c, 0xe3, 0xda, 0x11, 0x72, 0x8e, 0x73, 0x2f, 0xa3, 0x3e, 0xa6, 0x76,
+	0xf8, 0xcf, 0xb5, 0x91, 0xd3, 0x8b, 0x1b, 0xe4, 0xb1, 0x4e, 0x10, 0x96, 0xc9, 0xe9, 0x20, 0x62,
+	0x45, 0x21, 0x5a, 0x83, 0x2d, 0x51, 0x8e, 0xb1, 0x62, 0x17, 0x9d, 0x5a, 0xc9, 0xc1, 0x61, 0xa8,
+	0xc7, 0x63, 0x98, 0x03, 0xfe, 0x60, 0x53, 0x7a, 0x44, 0xc9, 0xbd, 0x4e, 0xce, 0xcb, 0xa9, 0xd8,
+	0xf1, 0x13, 0xda, 0x11, 0xca, 0x36, 0x15, 0xf9, 0x56, 0x17, 0x78, 0xce, 0x56, 0x01, 0x02, 0x14,
+	0xf7, 0xc3, 0x57, 0x96, 0xc5, 0xfd, 0xa0, 0xdd, 0x6c, 0xd8, 0xaf, 0x6c, 0x13, 0x1b, 0x81, 0xc3,
+	0xb4, 0xbe, 0x98, 0x7a, 0x38, 0xfa, 0xe2, 0xc3, 0x64, 0x4a, 0xcd, 0x37, 0xcf, 0xa9, 0x50, 0x8b,
+	0x7c, 0x28, 0xa7, 0x42, 0xad, 0x70, 0x03, 0xcb, 0x7d, 0x9a, 0x6f, 0x54, 0x72, 0x5f, 0x2b, 0xf2,
+	0xc3, 0x76, 0xef, 0x5d, 0x64, 0x46, 0xf9, 0x02, 0xc7, 0xbd, 0x77, 0xdc, 0xfb, 0x8b, 0x0a, 0xc9,
+	0x5d, 0xb1, 0x89, 0x45, 0xf1, 0xf1, 0x8a, 0x50, 0xd6, 0x58, 0x4e, 0x51, 0xfc, 0x65, 0x49, 0x4e,
+	0x1f, 0x84, 0xa9, 0x26, 0xd0, 0xcc, 0xdc, 0x8f, 0xf1, 0xfa, 0xf3, 0x82, 0x75, 0xa5, 0x8c, 0x9c,
+	0xfc, 0x96, 0xa2, 0x67, 0x5e, 0x2c, 0x2c, 0xdb, 0xc0, 0xe0, 0xe7, 0x66, 0x64, 0x6a, 0x47, 0x5e,
+	0x25, 0x5a, 0x8e, 0xb8, 0x53, 0x37, 0x93, 0x72, 0x13, 0x4d, 0xfd, 0x04, 0xcd, 0xc8, 0xfb, 0xa3,
+	0x0a, 0x39, 0x67, 0xbf, 0x00, 0x71, 0x70, 0xf9, 0x73, 0x0e, 0x79, 0x32, 0xf4, 0xd3, 0x8c, 0x95,
+	0xf6, 0x4a, 0xd3, 0xed, 0x41, 0xb8, 0x9e, 0xbb, 0xaa, 0xe0, 0xb8, 0xce, 0x16, 0x45, 0x38, 0x7f,
+	0xf5, 0xec, 0xe2, 0x9b, 0x31, 0x4b, 0x6d, 0xb5, 0x98, 0x39, 0x8c, 0x1a, 0x15, 0x7a, 0xa8, 0x4e,
+	0xb7, 0x07, 0x49, 0x42, 0xa3, 0x4c, 0x0f, 0x95, 0xbf, 0xc5, 0x5b, 0xa5, 0x4c, 0xa4, 0x1e, 0xe0,
+	0x39, 0x56, 0x7f, 0x2f, 0xc7, 0x0b, 0x86, 0xb8, 0x7b, 0xdf, 0x8d, 0x9a, 0x73, 0xe4, 0x73, 0xfe,
+	0x15, 0xbb, 0x2b, 0xf7, 0x4f, 0x27, 0xc8, 0x29, 0xeb, 0x3e, 0x06, 0xeb, 0xb0, 0xcf, 0x39, 0xf4,
+	0xb0, 0x8f, 0x65, 0x08, 0x0e, 0x22, 0x71, 0x97, 0xa3, 0x99, 0x21, 0x38, 0x88, 0xf0, 0xbe, 0x09,
+	0xfc, 0x23, 0xa6, 0x14, 0x06, 0x91, 0xc8, 0x05, 0x30, 0xa7, 0x14, 0x06, 0x11, 0x08, 0x28, 0xc6,
+	0x4a, 0xce, 0xb0, 0x8f, 0x4f, 0x1c, 0x95, 0x36, 0x6b, 0x65, 0x9c, 0x4f, 0xb7, 0x0c, 0x8a, 0x3c,
+	0x76, 0xd4, 0x6c, 0x01, 0x8b, 0x23, 0x5e, 0xa2, 0x39, 0xa5, 0xee, 0x2c, 0x6f, 0x4e, 0x94, 0x91,
+	0x6f, 0x95, 0xbf, 0xee, 0x22, 0x27, 0xf5, 0x64, 0x0b, 0x3b, 0x3a, 0x13, 0xff, 0xe2, 0x05, 0xa2,
+	0xfc, 0x5f, 0xb1, 0x38, 0x4a, 0x3f, 0xe2, 0x23, 0x05, 0x67, 0x98, 0x78, 0xbb, 0x91, 0x1f, 0x05,
+	0xdb, 0x34, 0xcd, 0x64, 0x49, 0x43, 0x7e, 0xbb, 0x91, 0x6c, 0x04, 0x0d, 0x47, 0x63, 0x3f, 0x65,
+	0x0f, 0x96, 0x19, 0x67, 0x81, 0xcc, 0xd8, 0x6f, 0xe9, 0x66, 0x30, 0x71, 0xcc, 0x83, 0x4b, 0xf2,
+	0x48, 0x0f, 0x2e, 0xa7, 0x0f, 0x39, 0xb8, 0x6c, 0x91, 0xf3, 0xfe, 0x20, 0x8b, 0x31, 0x8c, 0x61,
+	0x21, 0x43, 0x37, 0x6a, 0x96, 0xf2, 0x2b, 0x3c, 0x66, 0x98, 0x0b, 0x58, 0x45, 0xbb, 0xb5, 0x68,
+	0xb8, 0x3d, 0x84, 0x04, 0xc5, 0x7d, 0xbd, 0x7f, 0xe2, 0x90, 0xf3, 0x85, 0x4b, 0xe1, 0xf1, 0xcd,
+	0x33, 0xf0, 0x7e, 0xb0, 0x4e, 0xce, 0x16, 0xdc, 0xd6, 0xe2, 0xee, 0x9b, 0x1f, 0x89, 0x53, 0x46,
+	0xc8, 0x9e, 0x1d, 0x81, 0x26, 0xdf, 0x4d, 0xc1, 0x97, 0x71, 0xb4, 0x58, 0x04, 0x1d, 0x0f, 0x50,
+	0x7d, 0xb8, 0xf1, 0x00, 0xc6, 0x5a, 0xaf, 0x3d, 0xd2, 0xb5, 0x5e, 0x3f, 0x64, 0xad, 0xff, 0xbc,
+	0x43, 0x9a, 0xbd, 0x11, 0x57, 0x44, 0x36, 0x27, 0xca, 0xf0, 0x51, 0x8d, 0xba, 0x80, 0x72, 0xf1,
+	0x29, 0x4c, 0x8f, 0x1e, 0x05, 0x85, 0x91, 0xa3, 0xf2, 0xbe, 0x50, 0x25, 0xcc, 0x5e, 0x63, 0x05,
+	0xf7, 0xf7, 0xdd, 0x8f, 0x9b, 0x97, 0x3e, 0x39, 0x65, 0x5d, 0x50, 0xc4, 0x89, 0xab, 0x4b, 0xa3,
+	0xf8, 0x0c, 0x16, 0xdd, 0x21, 0x95, 0x97, 0x84, 0x95, 0x31, 0x24, 0x61, 0x28, 0x6f, 0xd7, 0xaa,
+	0x96, 0x7f, 0xbb, 0xd6, 0x54, 0xfe, 0x66, 0xad, 0x83, 0x5f, 0x71, 0xed, 0xb1, 0x7c, 0xc5, 0xbf,
+	0xe6, 0x90, 0xb3, 0x05, 0x6f, 0x41, 0x9b, 0x1b, 0xce, 0x01, 0xe6, 0x06, 0x86, 0x82, 0x09, 0xc9,
+	0x2c, 0xcc, 0x12, 0x1d, 0x0a, 0x26, 0xda, 0x41, 0x61, 0xe0, 0xae, 0xcb, 0x0f, 0xc3, 0xf8, 0xee,
+	0xd5, 0x5e, 0x3f, 0xdb, 0x17, 0x06, 0x8a, 0xda, 0x16, 0x2c, 0x28, 0x08, 0x18, 0x58, 0xee, 0xb3,
+	0x64, 0x82, 0x57, 0x9a, 0x10, 0xce, 0x9d, 0x69, 0xfc, 0x0e, 0x79, 0x19, 0x8a, 0x0e, 0x08, 0x90,
+	0xb7, 0x43, 0x8c, 0x5d, 0x05, 0x3a, 0x64, 0xcc, 0xc2, 0x8e, 0x79, 0x87, 0x8c, 0x59, 0x0b, 0x12,
+	0x2c, 0xcc, 0xc3, 0xaf, 0x16, 0xf6, 0xfe, 0x5e, 0x45, 0xb0, 0xe2, 0xbb, 0x04, 0x1d, 0x19, 0xe8,
+	0x1c, 0x31, 0x32, 0xf0, 0x63, 0x84, 0xb4, 0xe3, 0x5e, 0x1f, 0xf7, 0xcd, 0x9b, 0x71, 0x39, 0x9b,
+	0xad, 0x25, 0x45, 0x4f, 0xcf, 0xaa, 0x6e, 0x03, 0x83, 0x9f, 0x25, 0xda, 0xab, 0x87, 0x8a, 0x76,
+	0x4b, 0xca, 0xd5, 0x0e, 0x96, 0x72, 0xde, 0x9f, 0x3b, 0xc4, 0xb2, 0xfa, 0xf0, 0x7e, 0x3b, 0x1c,
+	0xee, 0xbe, 0x10, 0x18, 0xeb, 0xe5, 0x99, 0x98, 0x28, 0xa9, 0xc5, 0x57, 0xc8, 0xfe, 0x05, 0xce,
+	0xc8, 0x0d, 0x45, 0x14, 0x64, 0x29, 0x9b, 0x1f, 0x93, 0x21, 0xc6, 0x51, 0xf2, 0x60, 0x22, 0x1d,
+	0x51, 0xe9, 0xbd, 0x40, 0xce, 0x0c, 0x0d, 0x0a, 0xbf, 0x1e, 0x56, 0xf6, 0x22, 0xff, 0xf5, 0xb0,
+	0x82, 0x0f, 0xc0, 0x61, 0x18, 0xb0, 0x78, 0x3a, 0x4f, 0x1e, 0x4f, 0x6e, 0xcf, 0xa4, 0x79, 0x7a,
+	0x27, 0x35, 0x77, 0x2a, 0xdb, 0x61, 0x08, 0x04, 0xc3, 0x83, 0xf0, 0xfe, 0x59, 0x8d, 0x2f, 0xfe,
+	0x3b, 0x41, 0xd4, 0x89, 0xef, 0x2a, 0x3b, 0xc9, 0x19, 0x69, 0x27, 0xa1, 0x78, 0x68, 0xef, 0xd0,
+	0xce, 0x20, 0x1c, 0x2a, 0x43, 0xd1, 0x12, 0xed, 0xa0, 0x30, 0x10, 0xbb, 0x33, 0x10, 0xfb, 0xd6,
+	0xdc, 0xa2, 0x5c, 0x16, 0xed, 0xa0, 0x30, 0x30, 0x61, 0xcd, 0x78, 0xc8, 0xd4, 0xac, 0x5f, 0x6b,
+	0x68, 0xf0, 0x14, 0x2c, 0x2c, 0x74, 0xb4, 0x2b, 0x9b, 0x4b, 0x6a, 0x6c, 0xe6, 0x68, 0x57, 0x82,
+	0x31, 0x05, 0x03, 0x83, 0xd5, 0xb8, 0x08, 0x07, 0x29, 0x3b, 0x49, 0x9e, 0xd0, 0x17, 0xd0, 0x2c,
+	0x89, 0x36, 0x50, 0x50, 0x14, 0x6e, 0x3d, 0x3f, 0x1a, 0xf8, 0x21, 0xce, 0x90, 0x70, 0x9d, 0xa9,
+	0xcf, 0x70, 0x4d, 0x41, 0xc0, 0xc0, 0xc2, 0x27, 0xce, 0x82, 0x1e, 0xfd, 0x40, 0x1c, 0xc9, 0x28,
+	0x75, 0x1d, 0x5c, 0x20, 0xda, 0x41, 0x61, 0xb8, 0x2f, 0xe0, 0x95, 0xd5, 0x1d, 0x6e, 0x20, 0xc6,
+	0x89, 0x38, 0xa3, 0x54, 0xbb, 0x4f, 0x2c, 0x7e, 0xa2, 0xa1, 0x60, 0xa2, 0xe6, 0x6f, 0xdf, 0x21,
+	0x63, 0xde, 0xbe, 0xf3, 0x22, 0x71, 0xe5, 0xcb, 0xd1, 0x79, 0xa9, 0xcd, 0x69, 0x3b, 0xe1, 0xb8,
+	0x35, 0x84, 0x01, 0x05, 0xbd, 0xbc, 0x3f, 0x73, 0xc8, 0x9c, 0x2e, 0x80, 0xc4, 0xbc, 0x75, 0x96,
+	0x9b, 0xd2, 0x39, 0xd4, 0x4d, 0x69, 0xd7, 0x41, 0xa9, 0x8c, 0x55, 0x07, 0xc5, 0x2c, 0x51, 0x52,
+	0x3d, 0xb0, 0x44, 0xc9, 0x97, 0x93, 0xc9, 0x5d, 0xba, 0x6f, 0xd4, 0x32, 0x61, 0x8a, 0xe6, 0x26,
+	0x6f, 0x02, 0x09, 0xc3, 0x30, 0xf8, 0xb6, 0xaf, 0xea, 0x21, 0xce, 0x88, 0x38, 0xb7, 0x05, 0x86,
+	0x24, 0x20, 0xde, 0x3a, 0x99, 0x52, 0x01, 0x02, 0xd2, 0x6b, 0xe8, 0x14, 0x7b, 0x0d, 0xc7, 0x2a,
+	0x95, 0xb0, 0xb8, 0xf5, 0x5b, 0x5f, 0x7c, 0xe6, 0x4d, 0xbf, 0xf7, 0xc5, 0x67, 0xde, 0xf4, 0x87,
+	0x5f, 0x7c, 0xe6, 0x4d, 0x9f, 0x78, 0xed, 0x19, 0xe7, 0xb7, 0x5e, 0x7b, 0xc6, 0xf9, 0xbd, 0xd7,
+	0x9e, 0x71, 0xfe, 0xf0, 0xb5, 0x67, 0x9c, 0x2f, 0xbc, 0xf6, 0x8c, 0xf3, 0xd9, 0xff, 0xf4, 0xcc,
+	0x9b, 0x3e, 0x50, 0x98, 0x63, 0x81, 0xff, 0xbc, 0xa3, 0xdd, 0xb9, 0xb2, 0xf7, 0x2e, 0x16, 0xe6,
+	0x8f, 0xb2, 0xe1, 0x8a, 0xf1, 0x41, 0x5c, 0x91, 0xb2, 0xe1, 0xff, 0x0d, 0x00, 0xa9, 0xd5, 0x8b,
+	0xf0, 0x0b, 0x0d, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5925,6 +6021,121 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NamespaceRegexDestinations) > 0 {
+		for iNdEx := len(m.NamespaceRegexDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceRegexDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RequiredClusterLabels) > 0 {
+		keysForRequiredClusterLabels := make([]string, 0, len(m.RequiredClusterLabels))
+		for k := range m.RequiredClusterLabels {
+			keysForRequiredClusterLabels = append(keysForRequiredClusterLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredClusterLabels)
+		for iNdEx := len(keysForRequiredClusterLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.RequiredClusterLabels[string(keysForRequiredClusterLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRequiredClusterLabels[iNdEx])
+			copy(dAtA[i:], keysForRequiredClusterLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRequiredClusterLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.PropagatedAnnotations) > 0 {
+		keysForPropagatedAnnotations := make([]string, 0, len(m.PropagatedAnnotations))
+		for k := range m.PropagatedAnnotations {
+			keysForPropagatedAnnotations = append(keysForPropagatedAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPropagatedAnnotations)
+		for iNdEx := len(keysForPropagatedAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.PropagatedAnnotations[string(keysForPropagatedAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPropagatedAnnotations[iNdEx])
+			copy(dAtA[i:], keysForPropagatedAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPropagatedAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.DefaultDestination != nil {
+		{
+			size, err := m.DefaultDestination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SyncExcludedResourceAnnotations) > 0 {
+		for iNdEx := len(m.SyncExcludedResourceAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SyncExcludedResourceAnnotations[iNdEx])
+			copy(dAtA[i:], m.SyncExcludedResourceAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncExcludedResourceAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	i -= len(m.RefreshInterval)
+	copy(dAtA[i:], m.RefreshInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RefreshInterval)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	i--
+	if m.DeletionProtection {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i -= len(m.TokenAudience)
+	copy(dAtA[i:], m.TokenAudience)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenAudience)))
+	i--
+	dAtA[i] = 0x7a
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6149,6 +6360,44 @@ func (m *AppProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppProjectWatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppProjectWatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppProjectWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AppProject.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Application) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8837,6 +9086,11 @@ func (m *BasicAuthBitbucketServer) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PasswordFile)
+	copy(dAtA[i:], m.PasswordFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PasswordFile)))
+	i--
+	dAtA[i] = 0x1a
 	if m.PasswordRef != nil {
 		{
 			size, err := m.PasswordRef.MarshalToSizedBuffer(dAtA[:i])
@@ -8877,6 +9131,11 @@ func (m *BearerTokenBitbucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x12
 	if m.TokenRef != nil {
 		{
 			size, err := m.TokenRef.MarshalToSizedBuffer(dAtA[:i])
@@ -8912,6 +9171,11 @@ func (m *BearerTokenBitbucketCloud) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x12
 	if m.TokenRef != nil {
 		{
 			size, err := m.TokenRef.MarshalToSizedBuffer(dAtA[:i])
@@ -11418,6 +11682,44 @@ func (m *MergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceRegexDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceRegexDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceRegexDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.NamespaceRegex)
+	copy(dAtA[i:], m.NamespaceRegex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NamespaceRegex)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NestedMatrixGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11881,6 +12183,16 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Block != nil {
+		i--
+		if *m.Block {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Ignore) > 0 {
 		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12140,6 +12452,24 @@ func (m *ProjectRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeniedSyncActions) > 0 {
+		for iNdEx := len(m.DeniedSyncActions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedSyncActions[iNdEx])
+			copy(dAtA[i:], m.DeniedSyncActions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeniedSyncActions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TokenSourceRanges) > 0 {
+		for iNdEx := len(m.TokenSourceRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenSourceRanges[iNdEx])
+			copy(dAtA[i:], m.TokenSourceRanges[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenSourceRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Groups[iNdEx])
@@ -12205,6 +12535,39 @@ func (m *PullRequestGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CombineProviders)
+	copy(dAtA[i:], m.CombineProviders)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CombineProviders)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i--
+	if m.ResolveApprovals {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
+	i--
+	if m.ResolveHeadCommitAuthor {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.MissingHeadBranch)
+	copy(dAtA[i:], m.MissingHeadBranch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MissingHeadBranch)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.SortBy)
+	copy(dAtA[i:], m.SortBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SortBy)))
+	i--
+	dAtA[i] = 0x62
 	i--
 	if m.ContinueOnRepoNotFoundError {
 		dAtA[i] = 1
@@ -12361,6 +12724,33 @@ func (m *PullRequestGeneratorAzureDevOps) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequireSucceededStatuses {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repos[iNdEx])
+			copy(dAtA[i:], m.Repos[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repos[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.MaxPRAge)
+	copy(dAtA[i:], m.MaxPRAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxPRAge)))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.Labels) > 0 {
 		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Labels[iNdEx])
@@ -12569,6 +12959,52 @@ func (m *PullRequestGeneratorFilter) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.TargetBranchProtected != nil {
+		i--
+		if *m.TargetBranchProtected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ExcludeLabels) > 0 {
+		for iNdEx := len(m.ExcludeLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeLabels[iNdEx])
+			copy(dAtA[i:], m.ExcludeLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExcludeLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LabelsAny) > 0 {
+		for iNdEx := len(m.LabelsAny) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LabelsAny[iNdEx])
+			copy(dAtA[i:], m.LabelsAny[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelsAny[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LabelsAll) > 0 {
+		for iNdEx := len(m.LabelsAll) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LabelsAll[iNdEx])
+			copy(dAtA[i:], m.LabelsAll[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelsAll[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PathsChanged) > 0 {
+		for iNdEx := len(m.PathsChanged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PathsChanged[iNdEx])
+			copy(dAtA[i:], m.PathsChanged[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.PathsChanged[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TitleMatch != nil {
 		i -= len(*m.TitleMatch)
 		copy(dAtA[i:], *m.TitleMatch)
@@ -12613,6 +13049,11 @@ func (m *PullRequestGeneratorGitLab) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x42
 	if m.CARef != nil {
 		{
 			size, err := m.CARef.MarshalToSizedBuffer(dAtA[:i])
@@ -12692,6 +13133,11 @@ func (m *PullRequestGeneratorGitea) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.Labels) > 0 {
 		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Labels[iNdEx])
@@ -12759,6 +13205,19 @@ func (m *PullRequestGeneratorGithub) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenFile)
+	copy(dAtA[i:], m.TokenFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenFile)))
+	i--
+	dAtA[i] = 0x42
+	i--
+	if m.RequireApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
 	if len(m.Labels) > 0 {
 		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Labels[iNdEx])
@@ -15979,6 +16438,11 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ScheduleExpression)
+	copy(dAtA[i:], m.ScheduleExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ScheduleExpression)))
+	i--
+	dAtA[i] = 0x5a
 	i -= len(m.Description)
 	copy(dAtA[i:], m.Description)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
@@ -16294,6 +16758,43 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.TokenAudience)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 3
+	l = len(m.RefreshInterval)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.SyncExcludedResourceAnnotations) > 0 {
+		for _, s := range m.SyncExcludedResourceAnnotations {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.DefaultDestination != nil {
+		l = m.DefaultDestination.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.PropagatedAnnotations) > 0 {
+		for k, v := range m.PropagatedAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredClusterLabels) > 0 {
+		for k, v := range m.RequiredClusterLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NamespaceRegexDestinations) > 0 {
+		for _, e := range m.NamespaceRegexDestinations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16315,6 +16816,19 @@ func (m *AppProjectStatus) Size() (n int) {
 	return n
 }
 
+func (m *AppProjectWatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.AppProject.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Application) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17326,6 +17840,8 @@ func (m *BasicAuthBitbucketServer) Size() (n int) {
 		l = m.PasswordRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.PasswordFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17339,6 +17855,8 @@ func (m *BearerTokenBitbucket) Size() (n int) {
 		l = m.TokenRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17352,6 +17870,8 @@ func (m *BearerTokenBitbucketCloud) Size() (n int) {
 		l = m.TokenRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18275,6 +18795,21 @@ func (m *MergeGenerator) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceRegexDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NamespaceRegex)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *NestedMatrixGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18457,6 +18992,9 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Block != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -18570,6 +19108,18 @@ func (m *ProjectRole) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.TokenSourceRanges) > 0 {
+		for _, s := range m.TokenSourceRanges {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DeniedSyncActions) > 0 {
+		for _, s := range m.DeniedSyncActions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18623,6 +19173,14 @@ func (m *PullRequestGenerator) Size() (n int) {
 		}
 	}
 	n += 2
+	l = len(m.SortBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MissingHeadBranch)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	l = len(m.CombineProviders)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18650,6 +19208,17 @@ func (m *PullRequestGeneratorAzureDevOps) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.MaxPRAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -18722,6 +19291,33 @@ func (m *PullRequestGeneratorFilter) Size() (n int) {
 		l = len(*m.TitleMatch)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.PathsChanged) > 0 {
+		for _, s := range m.PathsChanged {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.LabelsAll) > 0 {
+		for _, s := range m.LabelsAll {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.LabelsAny) > 0 {
+		for _, s := range m.LabelsAny {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ExcludeLabels) > 0 {
+		for _, s := range m.ExcludeLabels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TargetBranchProtected != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -18752,6 +19348,8 @@ func (m *PullRequestGeneratorGitLab) Size() (n int) {
 		l = m.CARef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18778,6 +19376,8 @@ func (m *PullRequestGeneratorGitea) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18805,6 +19405,9 @@ func (m *PullRequestGeneratorGithub) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
+	l = len(m.TokenFile)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19981,6 +20584,8 @@ func (m *SyncWindow) Size() (n int) {
 	n += 2
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ScheduleExpression)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20128,6 +20733,31 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForNamespaceRegexDestinations := "[]NamespaceRegexDestination{"
+	for _, f := range this.NamespaceRegexDestinations {
+		repeatedStringForNamespaceRegexDestinations += strings.Replace(strings.Replace(f.String(), "NamespaceRegexDestination", "NamespaceRegexDestination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaceRegexDestinations += "}"
+	keysForPropagatedAnnotations := make([]string, 0, len(this.PropagatedAnnotations))
+	for k := range this.PropagatedAnnotations {
+		keysForPropagatedAnnotations = append(keysForPropagatedAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPropagatedAnnotations)
+	mapStringForPropagatedAnnotations := "map[string]string{"
+	for _, k := range keysForPropagatedAnnotations {
+		mapStringForPropagatedAnnotations += fmt.Sprintf("%v: %v,", k, this.PropagatedAnnotations[k])
+	}
+	mapStringForPropagatedAnnotations += "}"
+	keysForRequiredClusterLabels := make([]string, 0, len(this.RequiredClusterLabels))
+	for k := range this.RequiredClusterLabels {
+		keysForRequiredClusterLabels = append(keysForRequiredClusterLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredClusterLabels)
+	mapStringForRequiredClusterLabels := "map[string]string{"
+	for _, k := range keysForRequiredClusterLabels {
+		mapStringForRequiredClusterLabels += fmt.Sprintf("%v: %v,", k, this.RequiredClusterLabels[k])
+	}
+	mapStringForRequiredClusterLabels += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -20143,6 +20773,14 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`TokenAudience:` + fmt.Sprintf("%v", this.TokenAudience) + `,`,
+		`DeletionProtection:` + fmt.Sprintf("%v", this.DeletionProtection) + `,`,
+		`RefreshInterval:` + fmt.Sprintf("%v", this.RefreshInterval) + `,`,
+		`SyncExcludedResourceAnnotations:` + fmt.Sprintf("%v", this.SyncExcludedResourceAnnotations) + `,`,
+		`DefaultDestination:` + strings.Replace(this.DefaultDestination.String(), "ApplicationDestination", "ApplicationDestination", 1) + `,`,
+		`PropagatedAnnotations:` + mapStringForPropagatedAnnotations + `,`,
+		`RequiredClusterLabels:` + mapStringForRequiredClusterLabels + `,`,
+		`NamespaceRegexDestinations:` + repeatedStringForNamespaceRegexDestinations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20167,6 +20805,17 @@ func (this *AppProjectStatus) String() string {
 	}, "")
 	return s
 }
+func (this *AppProjectWatchEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppProjectWatchEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`AppProject:` + strings.Replace(strings.Replace(this.AppProject.String(), "AppProject", "AppProject", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Application) String() string {
 	if this == nil {
 		return "nil"
@@ -20869,6 +21518,7 @@ func (this *BasicAuthBitbucketServer) String() string {
 	s := strings.Join([]string{`&BasicAuthBitbucketServer{`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
 		`PasswordRef:` + strings.Replace(this.PasswordRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`PasswordFile:` + fmt.Sprintf("%v", this.PasswordFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20879,6 +21529,7 @@ func (this *BearerTokenBitbucket) String() string {
 	}
 	s := strings.Join([]string{`&BearerTokenBitbucket{`,
 		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20889,6 +21540,7 @@ func (this *BearerTokenBitbucketCloud) String() string {
 	}
 	s := strings.Join([]string{`&BearerTokenBitbucketCloud{`,
 		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21661,6 +22313,18 @@ func (this *MergeGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceRegexDestination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceRegexDestination{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`NamespaceRegex:` + fmt.Sprintf("%v", this.NamespaceRegex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NestedMatrixGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -21807,6 +22471,7 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
 		`Warn:` + valueToStringGenerated(this.Warn) + `,`,
 		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Block:` + valueToStringGenerated(this.Block) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21892,6 +22557,8 @@ func (this *ProjectRole) String() string {
 		`Policies:` + fmt.Sprintf("%v", this.Policies) + `,`,
 		`JWTTokens:` + repeatedStringForJWTTokens + `,`,
 		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`TokenSourceRanges:` + fmt.Sprintf("%v", this.TokenSourceRanges) + `,`,
+		`DeniedSyncActions:` + fmt.Sprintf("%v", this.DeniedSyncActions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21927,6 +22594,11 @@ func (this *PullRequestGenerator) String() string {
 		`AzureDevOps:` + strings.Replace(this.AzureDevOps.String(), "PullRequestGeneratorAzureDevOps", "PullRequestGeneratorAzureDevOps", 1) + `,`,
 		`Values:` + mapStringForValues + `,`,
 		`ContinueOnRepoNotFoundError:` + fmt.Sprintf("%v", this.ContinueOnRepoNotFoundError) + `,`,
+		`SortBy:` + fmt.Sprintf("%v", this.SortBy) + `,`,
+		`MissingHeadBranch:` + fmt.Sprintf("%v", this.MissingHeadBranch) + `,`,
+		`ResolveHeadCommitAuthor:` + fmt.Sprintf("%v", this.ResolveHeadCommitAuthor) + `,`,
+		`ResolveApprovals:` + fmt.Sprintf("%v", this.ResolveApprovals) + `,`,
+		`CombineProviders:` + fmt.Sprintf("%v", this.CombineProviders) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21942,6 +22614,10 @@ func (this *PullRequestGeneratorAzureDevOps) String() string {
 		`API:` + fmt.Sprintf("%v", this.API) + `,`,
 		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`MaxPRAge:` + fmt.Sprintf("%v", this.MaxPRAge) + `,`,
+		`Repos:` + fmt.Sprintf("%v", this.Repos) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
+		`RequireSucceededStatuses:` + fmt.Sprintf("%v", this.RequireSucceededStatuses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21984,6 +22660,11 @@ func (this *PullRequestGeneratorFilter) String() string {
 		`BranchMatch:` + valueToStringGenerated(this.BranchMatch) + `,`,
 		`TargetBranchMatch:` + valueToStringGenerated(this.TargetBranchMatch) + `,`,
 		`TitleMatch:` + valueToStringGenerated(this.TitleMatch) + `,`,
+		`PathsChanged:` + fmt.Sprintf("%v", this.PathsChanged) + `,`,
+		`LabelsAll:` + fmt.Sprintf("%v", this.LabelsAll) + `,`,
+		`LabelsAny:` + fmt.Sprintf("%v", this.LabelsAny) + `,`,
+		`ExcludeLabels:` + fmt.Sprintf("%v", this.ExcludeLabels) + `,`,
+		`TargetBranchProtected:` + valueToStringGenerated(this.TargetBranchProtected) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22000,6 +22681,7 @@ func (this *PullRequestGeneratorGitLab) String() string {
 		`PullRequestState:` + fmt.Sprintf("%v", this.PullRequestState) + `,`,
 		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`CARef:` + strings.Replace(this.CARef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22015,6 +22697,7 @@ func (this *PullRequestGeneratorGitea) String() string {
 		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
 		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22030,6 +22713,8 @@ func (this *PullRequestGeneratorGithub) String() string {
 		`TokenRef:` + strings.Replace(this.TokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
 		`AppSecretName:` + fmt.Sprintf("%v", this.AppSecretName) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`RequireApproval:` + fmt.Sprintf("%v", this.RequireApproval) + `,`,
+		`TokenFile:` + fmt.Sprintf("%v", this.TokenFile) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22854,6 +23539,7 @@ func (this *SyncWindow) String() string {
 		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
 		`UseAndOperator:` + fmt.Sprintf("%v", this.UseAndOperator) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`ScheduleExpression:` + fmt.Sprintf("%v", this.ScheduleExpression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23940,59 +24626,499 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAudience", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.TokenAudience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionProtection", wireType)
 			}
-			if iNdEx >= l {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeletionProtection = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RefreshInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncExcludedResourceAnnotations", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncExcludedResourceAnnotations = append(m.SyncExcludedResourceAnnotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultDestination == nil {
+				m.DefaultDestination = &ApplicationDestination{}
+			}
+			if err := m.DefaultDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagatedAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PropagatedAnnotations == nil {
+				m.PropagatedAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PropagatedAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClusterLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredClusterLabels == nil {
+				m.RequiredClusterLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredClusterLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRegexDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceRegexDestinations = append(m.NamespaceRegexDestinations, NamespaceRegexDestination{})
+			if err := m.NamespaceRegexDestinations[len(m.NamespaceRegexDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24140,6 +25266,121 @@ func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AppProjectWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppProject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AppProject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Application) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32509,6 +33750,38 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32595,6 +33868,38 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32681,6 +33986,38 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40898,103 +42235,220 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41017,7 +42471,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41040,10 +42494,10 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -41081,6 +42535,38 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
@@ -41134,7 +42620,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *NamespaceRegexDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41157,17 +42643,17 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceRegexDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceRegexDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41177,29 +42663,27 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41227,13 +42711,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRegex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41243,24 +42727,23 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42805,6 +44288,27 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Block = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43720,6 +45224,70 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			}
 			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSourceRanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSourceRanges = append(m.TokenSourceRanges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedSyncActions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedSyncActions = append(m.DeniedSyncActions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44220,6 +45788,142 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ContinueOnRepoNotFoundError = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingHeadBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingHeadBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveHeadCommitAuthor", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResolveHeadCommitAuthor = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveApprovals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResolveApprovals = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombineProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CombineProviders = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44466,6 +46170,122 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPRAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPRAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSucceededStatuses", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSucceededStatuses = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45039,11 +46859,44 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.BranchMatch = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranchMatch = &s
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TitleMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45072,11 +46925,11 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.TargetBranchMatch = &s
+			m.TitleMatch = &s
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TitleMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsChanged", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45104,9 +46957,125 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TitleMatch = &s
+			m.PathsChanged = append(m.PathsChanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsAll", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelsAll = append(m.LabelsAll, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsAny", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelsAny = append(m.LabelsAny, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeLabels = append(m.ExcludeLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchProtected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TargetBranchProtected = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45377,6 +47346,38 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45611,6 +47612,38 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45857,6 +47890,58 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireApproval = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56839,6 +58924,38 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19,6 +19,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectList":                          schema_pkg_apis_application_v1alpha1_AppProjectList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectSpec":                          schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectStatus":                        schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectWatchEvent":                    schema_pkg_apis_application_v1alpha1_AppProjectWatchEvent(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application":                             schema_pkg_apis_application_v1alpha1_Application(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition":                    schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination":                  schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref),
@@ -106,6 +107,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata":                schema_pkg_apis_application_v1alpha1_ManagedNamespaceMetadata(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator":                         schema_pkg_apis_application_v1alpha1_MatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator":                          schema_pkg_apis_application_v1alpha1_MergeGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceRegexDestination":               schema_pkg_apis_application_v1alpha1_NamespaceRegexDestination(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMatrixGenerator":                   schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
@@ -495,11 +497,25 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"namespaceRegexDestinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceRegexDestinations are destinations permitted in addition to Destinations, for namespaces which cannot be described by a glob. Deny destinations in Destinations take precedence over them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceRegexDestination"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceRegexDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -525,6 +541,80 @@ func schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref common.ReferenceC
 							},
 						},
 					},
+					"tokenAudience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenAudience is the audience claim of the tokens created for the roles of this project, unless overridden when creating a token",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionProtection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionProtection prevents the project from being deleted through the API until the protection is removed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval is the interval at which the applications of the project are refreshed, e.g. \"10m\", overriding the reconciliation timeout of the application controller. The argocd.argoproj.io/refresh-interval annotation of an application takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncExcludedResourceAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncExcludedResourceAnnotations are selectors, in label selector syntax (e.g. \"argocd.argoproj.io/manual-only\" or \"team=ops\"), matched against the annotations of the resources of the project's applications. Resources matching any of the selectors are skipped during sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultDestination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultDestination is the destination of the project's applications which specify neither a server nor a name in their destination. It must be permitted by the destinations of the project. It is only applied by the API server when applications are created or updated through it, not to applications created or updated directly in Kubernetes, e.g. with kubectl or by the ApplicationSet controller.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
+						},
+					},
+					"propagatedAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PropagatedAnnotations are annotations, e.g. \"team\" or \"cost-center\", which the application controller adds to the project's applications. An annotation an application already has is never overridden, whatever its value.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"requiredClusterLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredClusterLabels are labels, e.g. \"compliance: pci\", which the destination cluster of the project's applications must have. Applications targeting a cluster lacking any of them are rejected.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -533,6 +623,36 @@ func schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_AppProjectWatchEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppProjectWatchEvent contains information about project change.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"appProject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppProject is:\n * If Type is Added or Modified: the new state of the object.\n * If Type is Deleted: the state of the object immediately before deletion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProject"),
+						},
+					},
+				},
+				Required: []string{"type", "appProject"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProject"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Application(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2663,8 +2783,15 @@ func schema_pkg_apis_application_v1alpha1_BasicAuthBitbucketServer(ref common.Re
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"),
 						},
 					},
+					"passwordFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PasswordFile is the path of a file holding the password (or personal access token), relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. PasswordRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"username", "passwordRef"},
+				Required: []string{"username"},
 			},
 		},
 		Dependencies: []string{
@@ -2685,8 +2812,14 @@ func schema_pkg_apis_application_v1alpha1_BearerTokenBitbucket(ref common.Refere
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"),
 						},
 					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"tokenRef"},
 			},
 		},
 		Dependencies: []string{
@@ -2707,8 +2840,14 @@ func schema_pkg_apis_application_v1alpha1_BearerTokenBitbucketCloud(ref common.R
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"),
 						},
 					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"tokenRef"},
 			},
 		},
 		Dependencies: []string{
@@ -4682,6 +4821,42 @@ func schema_pkg_apis_application_v1alpha1_MergeGenerator(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_NamespaceRegexDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceRegexDestination is a destination of an AppProject whose namespaces are matched by a regular expression instead of a glob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server is the URL of the permitted cluster, or a glob matching it. Either Server or Name must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the permitted cluster, or a glob matching it. Either Server or Name must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceRegex is a regular expression matching the permitted namespaces. It must match the whole namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespaceRegex"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4823,6 +4998,27 @@ func schema_pkg_apis_application_v1alpha1_OperationInitiator(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"sortBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SortBy is the key used to order the generated pull requests. One of \"number\" (default) or \"updatedAt\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"missingHeadBranch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MissingHeadBranch defines how to handle pull requests whose head branch cannot be resolved, e.g. because it was deleted. One of \"skip\" (default), which skips them, or \"useHeadSHA\", which uses the head SHA as branch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolveHeadCommitAuthor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolveHeadCommitAuthor resolves the author of the head commit of each pull request, which costs one additional API call per pull request. Only supported by the GitHub and GitLab providers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5171,6 +5367,13 @@ func schema_pkg_apis_application_v1alpha1_PluginInput(ref common.ReferenceCallba
 							},
 						},
 					},
+					"block": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Block indicates if the sync of apps which have orphaned resources should be prevented",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5245,6 +5448,36 @@ func schema_pkg_apis_application_v1alpha1_ProjectRole(ref common.ReferenceCallba
 							},
 						},
 					},
+					"tokenSourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSourceRanges is a list of CIDRs from which the JWT tokens of this role may be used. If empty, the tokens may be used from any address.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deniedSyncActions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeniedSyncActions is a list of sync actions, \"prune\" or \"force\", which the JWT tokens of this role may not use when syncing applications, even if they are allowed to sync them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -5353,8 +5586,7 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAzureDevOps(ref co
 					},
 					"repo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Azure DevOps repo name to scan. Required.",
-							Default:     "",
+							Description: "Azure DevOps repo name to scan. Required unless repos is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5387,8 +5619,44 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAzureDevOps(ref co
 							},
 						},
 					},
+					"maxPRAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPRAge excludes pull requests whose last update is older than the given duration (e.g. \"72h\"). The last update is the most recent of the creation date and the date of the last pushed iteration. Disabled if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repos is a list of Azure DevOps repo names or IDs to scan, in addition to repo.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requireSucceededStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireSucceededStatuses only includes pull requests whose latest status of every status context succeeded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"organization", "project", "repo"},
+				Required: []string{"organization", "project"},
 			},
 		},
 		Dependencies: []string{
@@ -5531,6 +5799,87 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorFilter(ref common.
 							Format: "",
 						},
 					},
+					"pathsChanged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathsChanged is a list of globs, e.g. \"apps/**\", at least one of which must match a file changed by the pull request. Only supported by providers which can list the changed files of a pull request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"labelsAll": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelsAll is a list of labels, all of which the pull request must have.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"labelsAny": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelsAny is a list of labels, at least one of which the pull request must have.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"excludeLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeLabels is a list of labels, none of which the pull request may have. It takes precedence over the other criteria of the filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetBranchProtected": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetBranchProtected includes only pull requests whose target branch is protected if true, or not protected if false. Only supported by the GitHub and GitLab providers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"resolveApprovals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolveApprovals resolves the number of approvals of each pull request, which costs one additional API call per pull request for the GitHub and GitLab providers. Azure DevOps reports approvals without additional calls, other providers report none.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"combineProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CombineProviders lists the pull requests of all the configured providers instead of only the first one, e.g. of a repository which is mirrored on several providers. Pull requests with the same branches and head SHA are generated once. One of \"all\", which fails if any provider fails, or \"available\", which ignores failing providers as long as one of them succeeds. Disabled if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5600,6 +5949,13 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitLab(ref common.
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
 						},
 					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"project"},
 			},
@@ -5653,6 +6009,13 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitea(ref common.R
 							Format:      "",
 						},
 					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"owner", "repo", "api"},
 			},
@@ -5720,6 +6083,20 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref common.
 							},
 						},
 					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval only includes pull requests with at least one approving review and no reviewer requesting changes.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenFile is the path of a file holding the authentication token, relative to the SCM tokens directory of the ApplicationSet controller, e.g. a mounted Secret. TokenRef takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -8485,6 +8862,13 @@ func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"scheduleExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduleExpression is an optional expression which must evaluate to true at a time the schedule fires for the window to be open for the duration following that time, e.g. \"businessDay == 1\" to only open the window on the first business day of the month",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13,15 +13,19 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -1129,6 +1133,17 @@ type ApplicationDestination struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// NamespaceRegexDestination is a destination of an AppProject whose namespaces are matched by a regular expression
+// instead of a glob
+type NamespaceRegexDestination struct {
+	// Server is the URL of the permitted cluster, or a glob matching it. Either Server or Name must be set.
+	Server string `json:"server,omitempty" protobuf:"bytes,1,opt,name=server"`
+	// Name is the name of the permitted cluster, or a glob matching it. Either Server or Name must be set.
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// NamespaceRegex is a regular expression matching the permitted namespaces. It must match the whole namespace.
+	NamespaceRegex string `json:"namespaceRegex" protobuf:"bytes,3,opt,name=namespaceRegex"`
+}
+
 type ResourceHealthLocation string
 
 var (
@@ -1267,6 +1282,21 @@ type OperationInitiator struct {
 	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
 }
 
+// IsInteractive returns whether the operation was initiated interactively by a user, rather than automatically by the
+// application controller or by automation authenticated with a project role token or an account API key. Deny sync
+// windows with manual sync enabled only permit interactive operations.
+func (o OperationInitiator) IsInteractive() bool {
+	if o.Automated {
+		return false
+	}
+	parts := strings.Split(o.Username, ":")
+	// the subjects of project role tokens are proj:<project>:<role>, those of account API keys <account>:apiKey
+	if len(parts) == 3 && parts[0] == "proj" {
+		return false
+	}
+	return len(parts) != 2 || parts[1] != "apiKey"
+}
+
 // Operation contains information about a requested or running operation
 type Operation struct {
 	// Sync contains parameters for the operation
@@ -2592,10 +2622,23 @@ func validatePolicy(proj string, role string, policy string) error {
 		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': invalid action '%s'", policy, action)
 	}
 	// object
-	object := strings.Trim(policyComponents[4], " ")
+	object, condition, hasCondition := splitPolicyObject(strings.Trim(policyComponents[4], " "))
 	if !isValidObject(proj, object) {
 		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': object must be of form '%s/*', '%s[/<NAMESPACE>]/<APPNAME>' or '%s/<APPNAME>', not '%s'", policy, proj, proj, proj, object)
 	}
+	// label condition
+	if hasCondition {
+		if resource != rbac.ResourceApplications {
+			return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': label conditions are only supported for the 'applications' resource", policy)
+		}
+		selector, err := parsePolicyCondition(condition)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': invalid label condition '%s': %v", policy, condition, err)
+		}
+		if selector.Empty() {
+			return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': label condition must not be empty", policy)
+		}
+	}
 	// effect
 	effect := strings.Trim(policyComponents[5], " ")
 	if effect != "allow" && effect != "deny" {
@@ -2645,6 +2688,8 @@ type OrphanedResourcesMonitorSettings struct {
 	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,name=warn"`
 	// Ignore contains a list of resources that are to be excluded from orphaned resources monitoring
 	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,2,opt,name=ignore"`
+	// Block indicates if the sync of apps which have orphaned resources should be prevented
+	Block *bool `json:"block,omitempty" protobuf:"bytes,3,opt,name=block"`
 }
 
 // OrphanedResourceKey is a reference to a resource to be ignored from
@@ -2659,6 +2704,11 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 	return s.Warn != nil && *s.Warn
 }
 
+// IsBlock returns true if the sync of apps which have orphaned resources should be prevented
+func (s *OrphanedResourcesMonitorSettings) IsBlock() bool {
+	return s != nil && s.Block != nil && *s.Block
+}
+
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
 	// The ID of the key in hexadecimal notation
@@ -2696,6 +2746,33 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// TokenAudience is the audience claim of the tokens created for the roles of this project, unless overridden when
+	// creating a token
+	TokenAudience string `json:"tokenAudience,omitempty" protobuf:"bytes,15,opt,name=tokenAudience"`
+	// DeletionProtection prevents the project from being deleted through the API until the protection is removed
+	DeletionProtection bool `json:"deletionProtection,omitempty" protobuf:"bytes,16,opt,name=deletionProtection"`
+	// RefreshInterval is the interval at which the applications of the project are refreshed, e.g. "10m", overriding the
+	// reconciliation timeout of the application controller. The argocd.argoproj.io/refresh-interval annotation of an
+	// application takes precedence.
+	RefreshInterval string `json:"refreshInterval,omitempty" protobuf:"bytes,17,opt,name=refreshInterval"`
+	// SyncExcludedResourceAnnotations are selectors, in label selector syntax (e.g. "argocd.argoproj.io/manual-only" or
+	// "team=ops"), matched against the annotations of the resources of the project's applications. Resources matching
+	// any of the selectors are skipped during sync.
+	SyncExcludedResourceAnnotations []string `json:"syncExcludedResourceAnnotations,omitempty" protobuf:"bytes,18,rep,name=syncExcludedResourceAnnotations"`
+	// DefaultDestination is the destination of the project's applications which specify neither a server nor a name
+	// in their destination. It must be permitted by the destinations of the project. It is only applied by the API
+	// server when applications are created or updated through it, not to applications created or updated directly in
+	// Kubernetes, e.g. with kubectl or by the ApplicationSet controller.
+	DefaultDestination *ApplicationDestination `json:"defaultDestination,omitempty" protobuf:"bytes,19,opt,name=defaultDestination"`
+	// PropagatedAnnotations are annotations, e.g. "team" or "cost-center", which the application controller adds to
+	// the project's applications. An annotation an application already has is never overridden, whatever its value.
+	PropagatedAnnotations map[string]string `json:"propagatedAnnotations,omitempty" protobuf:"bytes,20,rep,name=propagatedAnnotations"`
+	// RequiredClusterLabels are labels, e.g. "compliance: pci", which the destination cluster of the project's
+	// applications must have. Applications targeting a cluster lacking any of them are rejected.
+	RequiredClusterLabels map[string]string `json:"requiredClusterLabels,omitempty" protobuf:"bytes,21,rep,name=requiredClusterLabels"`
+	// NamespaceRegexDestinations are destinations permitted in addition to Destinations, for namespaces which cannot be
+	// described by a glob. Deny destinations in Destinations take precedence over them.
+	NamespaceRegexDestinations []NamespaceRegexDestination `json:"namespaceRegexDestinations,omitempty" protobuf:"bytes,22,rep,name=namespaceRegexDestinations"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2723,6 +2800,9 @@ type SyncWindow struct {
 	UseAndOperator bool `json:"andOperator,omitempty" protobuf:"bytes,9,opt,name=andOperator"`
 	// Description of the sync that will be applied to the schedule, can be used to add any information such as a ticket number for example
 	Description string `json:"description,omitempty" protobuf:"bytes,10,opt,name=description"`
+	// ScheduleExpression is an optional expression which must evaluate to true at a time the schedule fires for the window to be open
+	// for the duration following that time, e.g. "businessDay == 1" to only open the window on the first business day of the month
+	ScheduleExpression string `json:"scheduleExpression,omitempty" protobuf:"bytes,11,opt,name=scheduleExpression"`
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2742,21 +2822,12 @@ func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
 
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -2781,24 +2852,15 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind != "allow" {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -2850,6 +2912,12 @@ func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []
 		return err
 	}
 
+	for i, existing := range spec.SyncWindows {
+		if reflect.DeepEqual(existing, window) {
+			return fmt.Errorf("cannot create window: an identical window already exists with id '%d'", i)
+		}
+	}
+
 	spec.SyncWindows = append(spec.SyncWindows, window)
 
 	return nil
@@ -2871,6 +2939,41 @@ func (spec *AppProjectSpec) DeleteWindow(id int) error {
 	return nil
 }
 
+// Duplicates returns the ids of the windows which are identical to a previous window, mapped to the id of the first
+// window they duplicate
+func (w *SyncWindows) Duplicates() map[int]int {
+	duplicates := map[int]int{}
+	if !w.HasWindows() {
+		return duplicates
+	}
+	for i, window := range *w {
+		for j := range i {
+			if _, isDuplicate := duplicates[j]; !isDuplicate && reflect.DeepEqual((*w)[j], window) {
+				duplicates[i] = j
+				break
+			}
+		}
+	}
+	return duplicates
+}
+
+// DedupWindows removes the sync windows which are identical to a previous window from the AppProject, and returns the
+// number of removed windows
+func (spec *AppProjectSpec) DedupWindows() int {
+	duplicates := spec.SyncWindows.Duplicates()
+	if len(duplicates) == 0 {
+		return 0
+	}
+	windows := make(SyncWindows, 0, len(spec.SyncWindows)-len(duplicates))
+	for i, window := range spec.SyncWindows {
+		if _, isDuplicate := duplicates[i]; !isDuplicate {
+			windows = append(windows, window)
+		}
+	}
+	spec.SyncWindows = windows
+	return len(duplicates)
+}
+
 // Matches returns a list of sync windows that are defined for a given application
 // It will use the AND operator if the UseAndOperator is set to true otherwise will default to the OR operator
 func (w *SyncWindows) Matches(app *Application) *SyncWindows {
@@ -3055,11 +3158,97 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
 	}
 
-	// Offset the nextWindow time to consider the timeZone of the sync window
+	// Offset the current time to consider the timeZone of the sync window
 	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+	windowTime := currentTime.Add(timeZoneOffsetDuration)
+	// the window is active if the schedule fired within the last duration, at a time matching the schedule expression.
+	// The firing times are the wall clock times of the sync window time zone.
+	for firing := schedule.Next(windowTime.Add(-duration)); firing.Before(windowTime); firing = schedule.Next(firing) {
+		if w.ScheduleExpression == "" {
+			return true, nil
+		}
+		matches, err := w.scheduleExpressionMatches(firing)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
 
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+// maxScheduleExpressionPrograms bounds the number of compiled schedule expressions kept in memory. The cache is emptied
+// once it is reached.
+const maxScheduleExpressionPrograms = 1000
+
+// scheduleExpressionPrograms caches the compiled schedule expressions of sync windows by their expression, since they
+// are evaluated for every firing of the window on each check of the sync windows of an application
+var scheduleExpressionPrograms = struct {
+	sync.Mutex
+	programs map[string]*vm.Program
+}{programs: map[string]*vm.Program{}}
+
+// compileScheduleExpression returns the compiled schedule expression, compiling it on its first use
+func compileScheduleExpression(expression string) (*vm.Program, error) {
+	scheduleExpressionPrograms.Lock()
+	defer scheduleExpressionPrograms.Unlock()
+	if program, ok := scheduleExpressionPrograms.programs[expression]; ok {
+		return program, nil
+	}
+	program, err := expr.Compile(expression, expr.Env(scheduleExpressionEnv(time.Time{})), expr.AsBool())
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schedule expression '%s': %w", expression, err)
+	}
+	if len(scheduleExpressionPrograms.programs) >= maxScheduleExpressionPrograms {
+		clear(scheduleExpressionPrograms.programs)
+	}
+	scheduleExpressionPrograms.programs[expression] = program
+	return program, nil
+}
+
+// scheduleExpressionMatches returns true if the schedule expression of the sync window evaluates to true at the given time.
+func (w *SyncWindow) scheduleExpressionMatches(t time.Time) (bool, error) {
+	program, err := compileScheduleExpression(w.ScheduleExpression)
+	if err != nil {
+		return false, err
+	}
+	out, err := expr.Run(program, scheduleExpressionEnv(t))
+	if err != nil {
+		return false, fmt.Errorf("cannot evaluate schedule expression '%s': %w", w.ScheduleExpression, err)
+	}
+	return out.(bool), nil
+}
+
+// scheduleExpressionEnv returns the variables available to the schedule expression of a sync window at the given time.
+func scheduleExpressionEnv(t time.Time) map[string]any {
+	firstOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	daysInMonth := firstOfMonth.AddDate(0, 1, -1).Day()
+	businessDay := 0
+	businessDaysInMonth := 0
+	for day := 1; day <= daysInMonth; day++ {
+		if weekday := firstOfMonth.AddDate(0, 0, day-1).Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			continue
+		}
+		businessDaysInMonth++
+		if day == t.Day() {
+			businessDay = businessDaysInMonth
+		}
+	}
+	return map[string]any{
+		"year":    t.Year(),
+		"month":   int(t.Month()),
+		"day":     t.Day(),
+		"weekday": int(t.Weekday()),
+		"hour":    t.Hour(),
+		"minute":  t.Minute(),
+		// daysInMonth is the number of days of the month
+		"daysInMonth": daysInMonth,
+		// businessDay is the number of the day among the Monday to Friday days of the month, 0 on weekends
+		"businessDay": businessDay,
+		// businessDaysInMonth is the number of Monday to Friday days of the month
+		"businessDaysInMonth": businessDaysInMonth,
+	}
 }
 
 // Update updates a sync window's settings with the given parameter
@@ -3122,6 +3311,11 @@ func (w *SyncWindow) Validate() error {
 	if err != nil {
 		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
 	}
+	if w.ScheduleExpression != "" {
+		if _, err := w.scheduleExpressionMatches(time.Now()); err != nil {
+			return err
+		}
+	}
 
 	if len(w.Description) > 255 {
 		return errors.New("description must not exceed 255 characters")
@@ -3130,6 +3324,39 @@ func (w *SyncWindow) Validate() error {
 	return nil
 }
 
+// NextFireTime returns the next time after the given time at which the schedule of the sync window fires, evaluated in
+// the time zone of the window. It returns the zero time if the schedule does not fire within the next five years, e.g.
+// for "0 0 30 2 *" (February 30th).
+func (w *SyncWindow) NextFireTime(after time.Time) (time.Time, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot load time zone '%s': %w", w.TimeZone, err)
+	}
+	return schedule.Next(after.In(loc)), nil
+}
+
+// NeverFiring returns the IDs of the sync windows whose schedule does not fire within the next five years, and
+// which therefore never become active. Windows with an invalid schedule are ignored.
+func (w *SyncWindows) NeverFiring() []int {
+	if w == nil {
+		return nil
+	}
+	now := time.Now()
+	var ids []int
+	for i, window := range *w {
+		next, err := window.NextFireTime(now)
+		if err == nil && next.IsZero() {
+			ids = append(ids, i)
+		}
+	}
+	return ids
+}
+
 // DestinationClusters returns a list of cluster URLs allowed as destination in an AppProject
 func (spec AppProjectSpec) DestinationClusters() []string {
 	servers := make([]string, 0)
@@ -3153,6 +3380,46 @@ type ProjectRole struct {
 	JWTTokens []JWTToken `json:"jwtTokens,omitempty" protobuf:"bytes,4,rep,name=jwtTokens"`
 	// Groups are a list of OIDC group claims bound to this role
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
+	// TokenSourceRanges is a list of CIDRs from which the JWT tokens of this role may be used. If empty, the tokens
+	// may be used from any address.
+	TokenSourceRanges []string `json:"tokenSourceRanges,omitempty" protobuf:"bytes,6,rep,name=tokenSourceRanges"`
+	// DeniedSyncActions is a list of sync actions, "prune" or "force", which the JWT tokens of this role may not use
+	// when syncing applications, even if they are allowed to sync them.
+	DeniedSyncActions []string `json:"deniedSyncActions,omitempty" protobuf:"bytes,7,rep,name=deniedSyncActions"`
+}
+
+const (
+	// SyncActionPrune is the sync action of deleting resources which are no longer defined in the source
+	SyncActionPrune = "prune"
+	// SyncActionForce is the sync action of deleting and recreating resources which cannot be updated
+	SyncActionForce = "force"
+)
+
+// IsSyncActionDenied returns true if the role's tokens may not use the given sync action.
+func (r *ProjectRole) IsSyncActionDenied(action string) bool {
+	for _, denied := range r.DeniedSyncActions {
+		if denied == action {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTokenSourceAllowed returns true if the role's tokens may be used from the given client IP address.
+func (r *ProjectRole) IsTokenSourceAllowed(ip net.IP) bool {
+	if len(r.TokenSourceRanges) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, sourceRange := range r.TokenSourceRanges {
+		_, ipNet, err := net.ParseCIDR(sourceRange)
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
 }
 
 // JWTToken holds the issuedAt and expiresAt values of a token
@@ -3457,6 +3724,8 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
+// isResourceInList returns true if the resource matches any entry of the list. Group and kind of an entry are glob
+// patterns, so a "*" kind matches all kinds within the group.
 func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
@@ -3470,6 +3739,18 @@ func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
 	return false
 }
 
+// isResourceInBlacklist returns true if the resource matches any entry of the blacklist. In addition to the matching
+// of isResourceInList, an entry with an empty group and a "*" kind denies every resource regardless of its group. This
+// only applies to blacklists, so that such an entry never permits resources of other groups when used in a whitelist.
+func isResourceInBlacklist(res metav1.GroupKind, list []metav1.GroupKind) bool {
+	return slices.ContainsFunc(list, isDenyAllEntry) || isResourceInList(res, list)
+}
+
+// isDenyAllEntry returns true if the blacklist entry denies resources of all groups and kinds
+func isDenyAllEntry(gk metav1.GroupKind) bool {
+	return gk.Group == "" && gk.Kind == "*"
+}
+
 // getFinalizerIndex returns finalizer index in the list of object finalizers or -1 if finalizer does not exist
 func getFinalizerIndex(meta metav1.ObjectMeta, name string) int {
 	for i, finalizer := range meta.Finalizers {
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
@@ -109,10 +111,11 @@ func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	t.Parallel()
 
 	testData := []struct {
-		name        string
-		projDest    []ApplicationDestination
-		appDest     ApplicationDestination
-		isPermitted bool
+		name          string
+		projDest      []ApplicationDestination
+		projRegexDest []NamespaceRegexDestination
+		appDest       ApplicationDestination
+		isPermitted   bool
 	}{
 		{
 			name: "server an namespace match",
@@ -146,6 +149,49 @@ func TestAppProject_IsDestinationPermitted(t *testing.T) {
 			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "kube-system"},
 			isPermitted: true,
 		},
+		{
+			name: "namespace regex match",
+			projRegexDest: []NamespaceRegexDestination{{
+				Server: "https://kubernetes.default.svc", NamespaceRegex: `team-[a-z]+-(dev|staging)`,
+			}},
+			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-payments-staging"},
+			isPermitted: true,
+		},
+		{
+			name: "namespace regex does not match",
+			projRegexDest: []NamespaceRegexDestination{{
+				Server: "https://kubernetes.default.svc", NamespaceRegex: `team-[a-z]+-(dev|staging)`,
+			}},
+			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-payments-prod"},
+			isPermitted: false,
+		},
+		{
+			name: "namespace regex must match the whole namespace",
+			projRegexDest: []NamespaceRegexDestination{{
+				Server: "https://kubernetes.default.svc", NamespaceRegex: `team-[a-z]+-dev`,
+			}},
+			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-payments-dev-old"},
+			isPermitted: false,
+		},
+		{
+			name: "namespace regex does not match the server",
+			projRegexDest: []NamespaceRegexDestination{{
+				Server: "https://other.default.svc", NamespaceRegex: `team-.*`,
+			}},
+			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-payments-dev"},
+			isPermitted: false,
+		},
+		{
+			name: "deny destination takes precedence over namespace regex",
+			projDest: []ApplicationDestination{{
+				Server: "*", Namespace: "!team-payments-dev",
+			}},
+			projRegexDest: []NamespaceRegexDestination{{
+				Server: "https://kubernetes.default.svc", NamespaceRegex: `team-.*`,
+			}},
+			appDest:     ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-payments-dev"},
+			isPermitted: false,
+		},
 		{
 			name: "wildcard server",
 			projDest: []ApplicationDestination{{
@@ -232,7 +278,8 @@ func TestAppProject_IsDestinationPermitted(t *testing.T) {
 
 			proj := AppProject{
 				Spec: AppProjectSpec{
-					Destinations: data.projDest,
+					Destinations:               data.projDest,
+					NamespaceRegexDestinations: data.projRegexDest,
 				},
 			}
 			destCluster := &Cluster{
@@ -557,6 +604,58 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	assert.True(t, proj6.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Action"}, true))
 }
 
+func TestAppProject_IsGroupKindPermitted_GroupWildcards(t *testing.T) {
+	t.Run("GroupWildcardDeny", func(t *testing.T) {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "*", Kind: "*"}},
+				ClusterResourceBlacklist:   []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "*"}},
+				NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "*"}},
+			},
+		}
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "Role"}, true))
+		assert.True(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, false))
+		assert.True(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Deployment"}, true))
+	})
+	t.Run("GlobalWildcardDeny", func(t *testing.T) {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "*", Kind: "*"}},
+				ClusterResourceBlacklist:   []metav1.GroupKind{{Group: "", Kind: "*"}},
+				NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "*"}},
+			},
+		}
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "ConfigMap"}, true))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Deployment"}, true))
+	})
+	t.Run("ExactGroupKindStillMatches", func(t *testing.T) {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+			},
+		}
+		assert.True(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "PersistentVolume"}, false))
+	})
+	t.Run("EmptyGroupWildcardAllowIsCoreOnly", func(t *testing.T) {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "*"}},
+				NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "*"}},
+			},
+		}
+		assert.True(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "Namespace"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, false))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}, false))
+		assert.True(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "", Kind: "ConfigMap"}, true))
+		assert.False(t, proj.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Deployment"}, true))
+	})
+}
+
 func TestAppProject_GetRoleByName(t *testing.T) {
 	t.Run("NotExists", func(t *testing.T) {
 		p := &AppProject{}
@@ -649,6 +748,302 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateProjectAll tests that all violations are reported, with the first one matching ValidateProject
+func TestAppProject_ValidateProjectAll(t *testing.T) {
+	p := newTestProject()
+	assert.Empty(t, p.ValidateProjectAll())
+
+	p.Spec.SourceRepos = []string{"foo", "foo"}
+	p.Spec.Destinations = append(p.Spec.Destinations, p.Spec.Destinations[0])
+	p.Spec.Roles = append(p.Spec.Roles, ProjectRole{Name: "bad role"})
+	errs := p.ValidateProjectAll()
+	require.Len(t, errs, 3)
+	assert.Contains(t, errs[0].Error(), "destination")
+	assert.Contains(t, errs[1].Error(), "source repository 'foo' already added")
+	assert.Contains(t, errs[2].Error(), "bad role")
+	assert.Equal(t, errs[0], p.ValidateProject())
+}
+
+func TestAppProject_ValidateDeniedSyncActions(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Roles[0].DeniedSyncActions = []string{SyncActionPrune, SyncActionForce}
+	require.NoError(t, p.ValidateProject())
+	assert.True(t, p.Spec.Roles[0].IsSyncActionDenied(SyncActionPrune))
+
+	p.Spec.Roles[0].DeniedSyncActions = []string{"delete"}
+	require.ErrorContains(t, p.ValidateProject(), "denied sync action 'delete' of role 'my-role' is invalid")
+	assert.False(t, p.Spec.Roles[0].IsSyncActionDenied(SyncActionPrune))
+}
+
+func TestAppProject_ValidateNegationPatterns(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(p *AppProject)
+		expectedError string
+	}{
+		{
+			name: "destination deny pattern",
+			modify: func(p *AppProject) {
+				p.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "!kube-system"}}
+			},
+		},
+		{
+			name:          "destination deny all",
+			modify:        func(p *AppProject) { p.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "!*"}} },
+			expectedError: "namespace has an invalid format, '!*'",
+		},
+		{
+			name:   "source repository deny pattern",
+			modify: func(p *AppProject) { p.Spec.SourceRepos = []string{"!https://github.com/argoproj/test", "*"} },
+		},
+		{
+			name:          "source repository deny all",
+			modify:        func(p *AppProject) { p.Spec.SourceRepos = []string{"!*"} },
+			expectedError: "source repository has an invalid format, '!*'",
+		},
+		{
+			name:          "source namespace",
+			modify:        func(p *AppProject) { p.Spec.SourceNamespaces = []string{"!argocd"} },
+			expectedError: "source namespace has an invalid format, '!argocd': negation patterns are not supported",
+		},
+		{
+			name: "cluster resource whitelist",
+			modify: func(p *AppProject) {
+				p.Spec.ClusterResourceWhitelist = []metav1.GroupKind{{Group: "*", Kind: "!Namespace"}}
+			},
+			expectedError: "cluster resource whitelist entry has an invalid format, '*/!Namespace': negation patterns are not supported",
+		},
+		{
+			name: "namespace resource whitelist",
+			modify: func(p *AppProject) {
+				p.Spec.NamespaceResourceWhitelist = []metav1.GroupKind{{Group: "!apps", Kind: "*"}}
+			},
+			expectedError: "namespace resource whitelist entry has an invalid format, '!apps/*': negation patterns are not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProject()
+			tt.modify(p)
+			err := p.ValidateProject()
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestAppProject_ValidateTokenAudience(t *testing.T) {
+	p := newTestProject()
+	p.Spec.TokenAudience = "my-audience"
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.TokenAudience = "  "
+	require.ErrorContains(t, p.ValidateProject(), "token audience must not be blank")
+}
+
+func TestAppProject_ValidateRefreshInterval(t *testing.T) {
+	p := newTestProject()
+	p.Spec.RefreshInterval = "10m"
+	require.NoError(t, p.ValidateProject())
+	interval, err := p.Spec.GetRefreshInterval()
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, interval)
+
+	p.Spec.RefreshInterval = "soon"
+	require.ErrorContains(t, p.ValidateProject(), "refresh interval 'soon' is invalid")
+
+	p.Spec.RefreshInterval = "0s"
+	require.ErrorContains(t, p.ValidateProject(), "refresh interval '0s' must be a positive duration")
+
+	p.Spec.RefreshInterval = "-5m"
+	require.ErrorContains(t, p.ValidateProject(), "must be a positive duration")
+}
+
+func TestAppProject_ValidateSyncExcludedResourceAnnotations(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncExcludedResourceAnnotations = []string{"argocd.argoproj.io/manual-only", "team in (ops, sre)", "team=ops,!skip"}
+	require.NoError(t, p.ValidateProject())
+
+	// selectors matching resources without annotations would exclude almost every resource
+	for _, selector := range []string{"!skip", "team!=ops", "team notin (ops)"} {
+		p.Spec.SyncExcludedResourceAnnotations = []string{selector}
+		require.ErrorContains(t, p.ValidateProject(), "sync excluded resource annotation selector '"+selector+"' matches resources without annotations")
+	}
+
+	p.Spec.SyncExcludedResourceAnnotations = []string{"team in ops"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid sync excluded resource annotation selector 'team in ops'")
+
+	p.Spec.SyncExcludedResourceAnnotations = []string{" "}
+	require.ErrorContains(t, p.ValidateProject(), "sync excluded resource annotation selector must not be empty")
+}
+
+func TestAppProject_ValidateDefaultDestination(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Destinations = []ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "team-*"}, {Name: "prod", Namespace: "*"}}
+	p.Spec.DefaultDestination = &ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}
+	require.NoError(t, p.ValidateProject())
+	p.Spec.DefaultDestination = &ApplicationDestination{Name: "prod", Namespace: "default"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.DefaultDestination = &ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}
+	require.ErrorContains(t, p.ValidateProject(), "do not match any of the allowed destinations")
+
+	p.Spec.DefaultDestination = &ApplicationDestination{Namespace: "team-a"}
+	require.ErrorContains(t, p.ValidateProject(), "default destination must have a server or a name")
+
+	p.Spec.DefaultDestination = &ApplicationDestination{Server: "https://kubernetes.default.svc", Name: "prod", Namespace: "team-a"}
+	require.ErrorContains(t, p.ValidateProject(), "can't have both name and server defined")
+}
+
+func TestAppProject_ApplyDefaultDestination(t *testing.T) {
+	p := newTestProject()
+	spec := &ApplicationSpec{}
+	assert.False(t, p.ApplyDefaultDestination(spec))
+	assert.Equal(t, ApplicationDestination{}, spec.Destination)
+
+	p.Spec.DefaultDestination = &ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}
+	t.Run("Applied", func(t *testing.T) {
+		spec := &ApplicationSpec{}
+		assert.True(t, p.ApplyDefaultDestination(spec))
+		assert.Equal(t, ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}, spec.Destination)
+	})
+	t.Run("KeepsNamespace", func(t *testing.T) {
+		spec := &ApplicationSpec{Destination: ApplicationDestination{Namespace: "guestbook"}}
+		assert.True(t, p.ApplyDefaultDestination(spec))
+		assert.Equal(t, ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}, spec.Destination)
+	})
+	t.Run("NeverOverrides", func(t *testing.T) {
+		spec := &ApplicationSpec{Destination: ApplicationDestination{Name: "prod", Namespace: "guestbook"}}
+		assert.False(t, p.ApplyDefaultDestination(spec))
+		assert.Equal(t, ApplicationDestination{Name: "prod", Namespace: "guestbook"}, spec.Destination)
+		spec = &ApplicationSpec{Destination: ApplicationDestination{Server: "https://remote"}}
+		assert.False(t, p.ApplyDefaultDestination(spec))
+		assert.Equal(t, ApplicationDestination{Server: "https://remote"}, spec.Destination)
+	})
+}
+
+func TestAppProject_ValidatePropagatedAnnotations(t *testing.T) {
+	p := newTestProject()
+	p.Spec.PropagatedAnnotations = map[string]string{"team": "payments", "example.com/cost-center": ""}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.PropagatedAnnotations = map[string]string{"cost center": "1234"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid propagated annotation key 'cost center'")
+
+	p.Spec.PropagatedAnnotations = map[string]string{"Example.com/team": "payments"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid propagated annotation key 'Example.com/team'")
+
+	for _, key := range []string{"argocd.argoproj.io/refresh", "notifications.argoproj.io/subscribe.on-sync-failed.slack", "argoproj.io/team"} {
+		p.Spec.PropagatedAnnotations = map[string]string{key: "x"}
+		require.ErrorContains(t, p.ValidateProject(), "annotations of the argoproj.io domain are reserved", key)
+	}
+
+	p.Spec.PropagatedAnnotations = map[string]string{"notargoproj.io/team": "payments"}
+	require.NoError(t, p.ValidateProject())
+}
+
+func TestAppProject_WildcardEntries(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SourceRepos = []string{"https://github.com/argoproj/*"}
+	p.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "team-a"}, {Server: "https://kubernetes.default.svc", Namespace: "*"}}
+	assert.Empty(t, p.WildcardEntries())
+
+	p.Spec.SourceRepos = append(p.Spec.SourceRepos, "*")
+	p.Spec.Destinations = append(p.Spec.Destinations, ApplicationDestination{Name: "*", Namespace: "*"})
+	assert.Equal(t, []string{"source repository '*'", "destination server '', name '*' and namespace '*'"}, p.WildcardEntries())
+
+	t.Run("SemanticallyMatchAll", func(t *testing.T) {
+		p := newTestProject()
+		p.Spec.SourceRepos = []string{"**", "https://**"}
+		p.Spec.Destinations = []ApplicationDestination{{Server: "**", Namespace: "**"}}
+		p.Spec.NamespaceRegexDestinations = []NamespaceRegexDestination{
+			{Name: "*", NamespaceRegex: ".*"},
+			{Server: "*", NamespaceRegex: "[a-z0-9-]+"},
+			{Server: "*", NamespaceRegex: "team-.*"},
+			{Server: "https://*", NamespaceRegex: ".*"},
+		}
+		assert.Equal(t, []string{
+			"source repository '**'",
+			"destination server '**', name '' and namespace '**'",
+			"destination server '', name '*' and namespace regex '.*'",
+			"destination server '*', name '' and namespace regex '[a-z0-9-]+'",
+		}, p.WildcardEntries())
+	})
+}
+
+func TestAppProject_AdminRolePolicies(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Roles[0].Policies = []string{
+		"p, proj:my-proj:my-role, applications, get, my-proj/*, allow",
+		"p, proj:my-proj:my-role, applications, *, my-proj/my-app, allow",
+		"p, proj:my-proj:my-role, applications, *, my-proj/*, deny",
+		"p, proj:my-proj:my-role, applications, *, my-proj/*;team=payments, allow",
+	}
+	assert.Empty(t, p.AdminRolePolicies())
+
+	p.Spec.Roles[0].Policies = append(p.Spec.Roles[0].Policies,
+		"p, proj:my-proj:my-role, applications, *, my-proj/*, allow",
+		"p, proj:my-proj:my-role, logs, *, my-proj/*/*, allow",
+	)
+	assert.Equal(t, []string{
+		"p, proj:my-proj:my-role, applications, *, my-proj/*, allow",
+		"p, proj:my-proj:my-role, logs, *, my-proj/*/*, allow",
+	}, p.AdminRolePolicies())
+}
+
+func TestAppProject_MissingRequiredClusterLabels(t *testing.T) {
+	p := newTestProject()
+	assert.Empty(t, p.MissingRequiredClusterLabels(&Cluster{}))
+
+	p.Spec.RequiredClusterLabels = map[string]string{"compliance": "pci", "region": "eu"}
+	assert.Empty(t, p.MissingRequiredClusterLabels(&Cluster{Labels: map[string]string{"compliance": "pci", "region": "eu", "team": "a"}}))
+	assert.Equal(t, []string{"region=eu"}, p.MissingRequiredClusterLabels(&Cluster{Labels: map[string]string{"compliance": "pci"}}))
+	assert.Equal(t, []string{"compliance=pci", "region=eu"}, p.MissingRequiredClusterLabels(&Cluster{Labels: map[string]string{"compliance": "none"}}))
+}
+
+func TestAppProject_ValidateRequiredClusterLabels(t *testing.T) {
+	p := newTestProject()
+	p.Spec.RequiredClusterLabels = map[string]string{"compliance": "pci"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.RequiredClusterLabels = map[string]string{"not a key": "pci"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid required cluster label key 'not a key'")
+
+	p.Spec.RequiredClusterLabels = map[string]string{"compliance": "pci/dss"}
+	require.ErrorContains(t, p.ValidateProject(), "invalid value 'pci/dss' of required cluster label 'compliance'")
+}
+
+func TestAppProject_MissingPropagatedAnnotations(t *testing.T) {
+	p := newTestProject()
+	app := &Application{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"team": "checkout"}}}
+	assert.Nil(t, p.MissingPropagatedAnnotations(app))
+
+	p.Spec.PropagatedAnnotations = map[string]string{"team": "payments", "cost-center": "1234"}
+	assert.Equal(t, map[string]string{"cost-center": "1234"}, p.MissingPropagatedAnnotations(app))
+	assert.Equal(t, p.Spec.PropagatedAnnotations, p.MissingPropagatedAnnotations(&Application{}))
+
+	app.Annotations["cost-center"] = ""
+	assert.Nil(t, p.MissingPropagatedAnnotations(app))
+
+	p.Spec.PropagatedAnnotations = map[string]string{"argocd.argoproj.io/refresh": "hard"}
+	assert.Nil(t, p.MissingPropagatedAnnotations(app), "reserved annotations are never propagated")
+}
+
+func TestAppProject_IsResourceSyncExcluded(t *testing.T) {
+	p := newTestProject()
+	assert.False(t, p.IsResourceSyncExcluded(map[string]string{"argocd.argoproj.io/manual-only": "true"}))
+
+	p.Spec.SyncExcludedResourceAnnotations = []string{"argocd.argoproj.io/manual-only", "team=ops", "invalid in selector", "", "!skip"}
+	assert.Len(t, p.SyncExcludedResourceSelectors(), 2)
+	assert.True(t, p.IsResourceSyncExcluded(map[string]string{"argocd.argoproj.io/manual-only": "true"}))
+	assert.True(t, p.IsResourceSyncExcluded(map[string]string{"team": "ops", "other": "value"}))
+	assert.False(t, p.IsResourceSyncExcluded(map[string]string{"team": "dev"}))
+	assert.False(t, p.IsResourceSyncExcluded(nil))
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -711,6 +1106,25 @@ func TestAppProject_ValidateDestinations(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	p.Spec.NamespaceRegexDestinations = []NamespaceRegexDestination{{Server: "some-server", NamespaceRegex: `team-[a-z]+-(dev|staging)`}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.NamespaceRegexDestinations[0] = NamespaceRegexDestination{Server: "some-server", NamespaceRegex: `team-(dev`}
+	require.ErrorContains(t, p.ValidateProject(), "namespace regex 'team-(dev' is invalid")
+
+	p.Spec.NamespaceRegexDestinations[0] = NamespaceRegexDestination{NamespaceRegex: `team-.*`}
+	require.ErrorContains(t, p.ValidateProject(), "namespace regex destination 'team-.*' must set a server or a name")
+
+	p.Spec.NamespaceRegexDestinations[0] = NamespaceRegexDestination{Server: "!some-server", NamespaceRegex: `team-.*`}
+	require.ErrorContains(t, p.ValidateProject(), "negation patterns are not supported")
+
+	p.Spec.NamespaceRegexDestinations[0] = NamespaceRegexDestination{Server: "some-server"}
+	require.ErrorContains(t, p.ValidateProject(), "namespace regex destination must set a namespace regex")
+
+	p.Spec.NamespaceRegexDestinations = []NamespaceRegexDestination{{Server: "some-server", NamespaceRegex: `team-.*`}, {Server: "some-server", NamespaceRegex: `team-.*`}}
+	require.ErrorContains(t, p.ValidateProject(), "namespace regex destination 'some-server/team-.*' already added")
+	p.Spec.NamespaceRegexDestinations = nil
+
 	validDestination := ApplicationDestination{
 		Server:    "some-server",
 		Namespace: "some-namespace",
@@ -811,6 +1225,29 @@ func TestAppProject_ValidateGroupName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateTokenSourceRanges(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Roles[0].TokenSourceRanges = []string{"10.0.0.0/8", "192.168.1.1/32", "2001:db8::/32"}
+	require.NoError(t, p.ValidateProject())
+
+	for _, badRange := range []string{"", "10.0.0.1", "10.0.0.0/33", "not-a-cidr"} {
+		p.Spec.Roles[0].TokenSourceRanges = []string{badRange}
+		require.ErrorContains(t, p.ValidateProject(), "is not a valid CIDR")
+	}
+}
+
+func TestProjectRole_IsTokenSourceAllowed(t *testing.T) {
+	role := ProjectRole{Name: "my-role"}
+	assert.True(t, role.IsTokenSourceAllowed(net.ParseIP("192.168.1.1")))
+	assert.True(t, role.IsTokenSourceAllowed(nil))
+
+	role.TokenSourceRanges = []string{"10.0.0.0/8", "2001:db8::/32"}
+	assert.True(t, role.IsTokenSourceAllowed(net.ParseIP("10.20.30.40")))
+	assert.True(t, role.IsTokenSourceAllowed(net.ParseIP("2001:db8::1")))
+	assert.False(t, role.IsTokenSourceAllowed(net.ParseIP("192.168.1.1")))
+	assert.False(t, role.IsTokenSourceAllowed(nil))
+}
+
 func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	t.Run("WorkingSyncWindow", func(t *testing.T) {
 		p := newTestProjectWithSyncWindows()
@@ -858,6 +1295,10 @@ func TestAppProject_InvalidPolicyRules(t *testing.T) {
 		{"p, proj:my-proj:my-role, applications, get, my-proj/, allow", "object must be of form"},
 		{"p, proj:my-proj:my-role, applications, get, /, allow", "object must be of form"},
 		{"p, proj:my-proj:my-role, applications, get, different-my-proj/*, allow", "object must be of form"},
+		// invalid label condition
+		{"p, proj:my-proj:my-role, applications, get, my-proj/*;, allow", "label condition must not be empty"},
+		{"p, proj:my-proj:my-role, applications, get, my-proj/*;=payments, allow", "invalid label condition"},
+		{"p, proj:my-proj:my-role, logs, get, my-proj/*;team=payments, allow", "label conditions are only supported for the 'applications' resource"},
 		// invalid effect
 		{"p, proj:my-proj:my-role, applications, get, my-proj/*, ", "effect must be: 'allow' or 'deny'"},
 		{"p, proj:my-proj:my-role, applications, get, my-proj/*, foo", "effect must be: 'allow' or 'deny'"},
@@ -894,6 +1335,9 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 		"p, proj:my-proj:my-role, applications, delete/*/Pod/*, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/*, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/apps/Deployment/restart, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, sync, my-proj/*;team=payments, allow",
+		"p, proj:my-proj:my-role, applications, sync, my-proj/*;team=payments;env!=prod;!frozen, allow",
+		"p, proj:my-proj:my-role, applications, delete, my-proj/*;env=prod, deny",
 	}
 	for _, good := range goodPolicies {
 		p.Spec.Roles[0].Policies = []string{good}
@@ -902,6 +1346,29 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 	}
 }
 
+func TestAppProject_PoliciesStringLabelConditions(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Roles[0].Policies = []string{
+		"p, proj:my-proj:my-role, applications, get, my-proj/*, allow",
+		"p, proj:my-proj:my-role, applications, sync, my-proj/*;team=payments, allow",
+		"p, proj:my-proj:my-role, applications, delete, my-proj/*;env=prod, deny",
+	}
+	project := "p, proj:my-proj:my-role, projects, get, my-proj, allow"
+	get := "p, proj:my-proj:my-role, applications, get, my-proj/*, allow"
+	sync := "p, proj:my-proj:my-role, applications, sync, my-proj/*, allow"
+	deny := "p, proj:my-proj:my-role, applications, delete, my-proj/*, deny"
+
+	t.Run("UnknownApplicationFailsClosed", func(t *testing.T) {
+		assert.Equal(t, strings.Join([]string{project, get, deny}, "\n"), p.ProjectPoliciesString())
+	})
+	t.Run("MatchingLabels", func(t *testing.T) {
+		assert.Equal(t, strings.Join([]string{project, get, sync, deny}, "\n"), p.ApplicationPoliciesString(map[string]string{"team": "payments", "env": "prod"}))
+	})
+	t.Run("OtherLabels", func(t *testing.T) {
+		assert.Equal(t, strings.Join([]string{project, get}, "\n"), p.ApplicationPoliciesString(map[string]string{"env": "dev"}))
+	})
+}
+
 func TestExplicitType(t *testing.T) {
 	src := ApplicationSource{
 		Kustomize: &ApplicationSourceKustomize{
@@ -2235,6 +2702,43 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 	}
 }
 
+func TestAppProjectSpec_AddWindowDuplicate(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	require.NoError(t, proj.Spec.AddWindow("deny", "0 22 * * *", "2h", []string{"app1"}, nil, nil, false, "", false, ""))
+	err := proj.Spec.AddWindow("deny", "0 22 * * *", "2h", []string{"app1"}, nil, nil, false, "", false, "")
+	require.EqualError(t, err, "cannot create window: an identical window already exists with id '1'")
+	// a window only differing by its description is not a duplicate
+	require.NoError(t, proj.Spec.AddWindow("deny", "0 22 * * *", "2h", []string{"app1"}, nil, nil, false, "", false, "maintenance"))
+	assert.Len(t, proj.Spec.SyncWindows, 3)
+}
+
+func TestAppProjectSpec_DedupWindows(t *testing.T) {
+	window := func(kind, schedule, duration string, apps ...string) *SyncWindow {
+		return &SyncWindow{Kind: kind, Schedule: schedule, Duration: duration, Applications: apps}
+	}
+	spec := AppProjectSpec{SyncWindows: SyncWindows{
+		window("allow", "0 8 * * *", "10h", "*"),
+		window("deny", "0 22 * * *", "2h", "*"),
+		window("allow", "0 8 * * *", "10h", "*"),
+		// same kind and schedule, but different duration or applications
+		window("allow", "0 8 * * *", "8h", "*"),
+		window("allow", "0 8 * * *", "10h", "app1"),
+		window("deny", "0 22 * * *", "2h", "*"),
+		window("allow", "0 8 * * *", "10h", "*"),
+	}}
+
+	assert.Equal(t, map[int]int{2: 0, 5: 1, 6: 0}, spec.SyncWindows.Duplicates())
+	assert.Equal(t, 3, spec.DedupWindows())
+	assert.Equal(t, SyncWindows{
+		window("allow", "0 8 * * *", "10h", "*"),
+		window("deny", "0 22 * * *", "2h", "*"),
+		window("allow", "0 8 * * *", "8h", "*"),
+		window("allow", "0 8 * * *", "10h", "app1"),
+	}, spec.SyncWindows)
+	assert.Empty(t, spec.SyncWindows.Duplicates())
+	assert.Equal(t, 0, spec.DedupWindows())
+}
+
 func TestAppProjectSpecWindowWithDescription(t *testing.T) {
 	proj := newTestProjectWithSyncWindows()
 	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA"))
@@ -2836,6 +3340,16 @@ func TestSyncWindows_CanSync(t *testing.T) {
 	})
 }
 
+func TestOperationInitiator_IsInteractive(t *testing.T) {
+	assert.True(t, OperationInitiator{}.IsInteractive())
+	assert.True(t, OperationInitiator{Username: "admin"}.IsInteractive())
+	assert.True(t, OperationInitiator{Username: "jane@example.com"}.IsInteractive())
+	assert.True(t, OperationInitiator{Username: "admin:login"}.IsInteractive())
+	assert.False(t, OperationInitiator{Automated: true}.IsInteractive())
+	assert.False(t, OperationInitiator{Username: "proj:my-proj:ci"}.IsInteractive())
+	assert.False(t, OperationInitiator{Username: "ci-bot:apiKey"}.IsInteractive())
+}
+
 func TestSyncWindows_hasDeny(t *testing.T) {
 	t.Run("True", func(t *testing.T) {
 		proj := newTestProjectWithSyncWindows()
@@ -3039,6 +3553,47 @@ func TestSyncWindow_Update(t *testing.T) {
 	})
 }
 
+func TestSyncWindow_ActiveScheduleExpression(t *testing.T) {
+	firstBusinessDay := SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "8h", ScheduleExpression: "businessDay == 1"}
+	lastBusinessDay := SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "8h", ScheduleExpression: "businessDay == businessDaysInMonth"}
+	// the hourly window overlaps the following firings, of which only the one at 10:00 matches
+	tenOClock := SyncWindow{Kind: "allow", Schedule: "0 * * * *", Duration: "3h", ScheduleExpression: "hour == 10"}
+
+	tests := []struct {
+		name           string
+		syncWindow     SyncWindow
+		currentTime    time.Time
+		expectedResult bool
+	}{
+		{"FirstBusinessDay-Start", firstBusinessDay, time.Date(2024, 7, 1, 9, 0, 30, 0, time.UTC), true},
+		{"FirstBusinessDay-BeforeStart", firstBusinessDay, time.Date(2024, 7, 1, 8, 59, 0, 0, time.UTC), false},
+		{"FirstBusinessDay-BeforeEnd", firstBusinessDay, time.Date(2024, 7, 1, 16, 59, 0, 0, time.UTC), true},
+		{"FirstBusinessDay-End", firstBusinessDay, time.Date(2024, 7, 1, 17, 0, 0, 0, time.UTC), false},
+		{"SecondBusinessDay", firstBusinessDay, time.Date(2024, 7, 2, 10, 0, 0, 0, time.UTC), false},
+		{"FirstBusinessDay-AfterWeekend", firstBusinessDay, time.Date(2024, 6, 3, 10, 0, 0, 0, time.UTC), true},
+		{"FirstDayOfMonth-Weekend", firstBusinessDay, time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), false},
+		{"LastBusinessDay", lastBusinessDay, time.Date(2024, 6, 28, 10, 0, 0, 0, time.UTC), true},
+		{"LastDayOfMonth-Weekend", lastBusinessDay, time.Date(2024, 6, 30, 10, 0, 0, 0, time.UTC), false},
+		{"LaterFiring-Matches", tenOClock, time.Date(2024, 6, 3, 11, 30, 0, 0, time.UTC), true},
+		{"LaterFiring-BeforeEnd", tenOClock, time.Date(2024, 6, 3, 12, 59, 0, 0, time.UTC), true},
+		{"LaterFiring-BeforeMatch", tenOClock, time.Date(2024, 6, 3, 9, 30, 0, 0, time.UTC), false},
+		{"LaterFiring-End", tenOClock, time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.syncWindow.active(tt.currentTime)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+
+			windows := SyncWindows{&tt.syncWindow}
+			active, err := windows.active(tt.currentTime)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, active != nil)
+		})
+	}
+}
+
 func TestSyncWindow_Validate(t *testing.T) {
 	window := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h"}
 	t.Run("Validates", func(t *testing.T) {
@@ -3059,6 +3614,73 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		require.Error(t, window.Validate())
 	})
+	t.Run("ScheduleExpression", func(t *testing.T) {
+		window.Duration = "1h"
+		window.ScheduleExpression = "weekday in [1, 3, 5] && hour < 12"
+		require.NoError(t, window.Validate())
+	})
+	t.Run("IncorrectScheduleExpression", func(t *testing.T) {
+		window.ScheduleExpression = "businessDay =="
+		require.ErrorContains(t, window.Validate(), "cannot parse schedule expression")
+		window.ScheduleExpression = "day"
+		require.ErrorContains(t, window.Validate(), "cannot parse schedule expression")
+		window.ScheduleExpression = "unknown == 1"
+		require.ErrorContains(t, window.Validate(), "cannot parse schedule expression")
+	})
+	t.Run("CachesScheduleExpression", func(t *testing.T) {
+		window.ScheduleExpression = "hour == 10"
+		require.NoError(t, window.Validate())
+		program, err := compileScheduleExpression(window.ScheduleExpression)
+		require.NoError(t, err)
+		cached, err := compileScheduleExpression(window.ScheduleExpression)
+		require.NoError(t, err)
+		assert.Same(t, program, cached)
+	})
+}
+
+func TestSyncWindow_NextFireTime(t *testing.T) {
+	after := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+	t.Run("Fires", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h"}
+		next, err := window.NextFireTime(after)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC), next)
+	})
+	t.Run("TimeZone", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "America/New_York"}
+		next, err := window.NextFireTime(after)
+		require.NoError(t, err)
+		// 22:00 in New York is 03:00 UTC on the next day in winter
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("NeverFires", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 0 30 2 *", Duration: "1h"}
+		next, err := window.NextFireTime(after)
+		require.NoError(t, err)
+		assert.True(t, next.IsZero())
+	})
+	t.Run("IncorrectSchedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "* * *", Duration: "1h"}
+		_, err := window.NextFireTime(after)
+		require.ErrorContains(t, err, "cannot parse schedule")
+	})
+	t.Run("IncorrectTimeZone", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "Mars/Olympus_Mons"}
+		_, err := window.NextFireTime(after)
+		require.ErrorContains(t, err, "cannot load time zone")
+	})
+}
+
+func TestSyncWindows_NeverFiring(t *testing.T) {
+	windows := SyncWindows{
+		{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h"},
+		{Kind: "deny", Schedule: "0 0 30 2 *", Duration: "1h"},
+		{Kind: "deny", Schedule: "* * *", Duration: "1h"},
+		{Kind: "allow", Schedule: "0 0 31 4 *", Duration: "1h"},
+	}
+	assert.Equal(t, []int{1, 3}, windows.NeverFiring())
+	fires := windows[:1]
+	assert.Empty(t, fires.NeverFiring())
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -4512,6 +5134,121 @@ func TestAppProject_ValidateDestinationServiceAccount(t *testing.T) {
 	}
 }
 
+func TestAppProject_UncoveredDestinationServiceAccounts(t *testing.T) {
+	proj := AppProject{
+		Spec: AppProjectSpec{
+			Destinations: []ApplicationDestination{
+				{Server: "https://kubernetes.default.svc", Namespace: "guestbook-*"},
+				{Server: "https://remote", Namespace: "*"},
+			},
+			DestinationServiceAccounts: []ApplicationDestinationServiceAccount{
+				{Server: "https://kubernetes.default.svc", Namespace: "guestbook-dev", DefaultServiceAccount: "covered"},
+				{Server: "https://remote", Namespace: "any", DefaultServiceAccount: "covered-by-wildcard"},
+				{Server: "https://kubernetes.default.svc", Namespace: "kube-system", DefaultServiceAccount: "uncovered-namespace"},
+				{Server: "https://unknown", Namespace: "guestbook-dev", DefaultServiceAccount: "uncovered-server"},
+				{Server: "https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "pattern"},
+			},
+		},
+	}
+
+	uncovered := proj.UncoveredDestinationServiceAccounts()
+	assert.Equal(t, []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "kube-system", DefaultServiceAccount: "uncovered-namespace"},
+		{Server: "https://unknown", Namespace: "guestbook-dev", DefaultServiceAccount: "uncovered-server"},
+	}, uncovered)
+
+	proj.Spec.DestinationServiceAccounts = proj.Spec.DestinationServiceAccounts[:2]
+	assert.Empty(t, proj.UncoveredDestinationServiceAccounts())
+}
+
+func TestAppProject_AmbiguousDestinationServiceAccounts(t *testing.T) {
+	newProj := func(dsas ...ApplicationDestinationServiceAccount) *AppProject {
+		proj := newTestProject()
+		proj.Spec.DestinationServiceAccounts = dsas
+		return proj
+	}
+
+	t.Run("ambiguous pair", func(t *testing.T) {
+		proj := newProj(
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "team-sa"},
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "*-prod", DefaultServiceAccount: "prod-sa"},
+		)
+		assert.Equal(t, [][2]ApplicationDestinationServiceAccount{{proj.Spec.DestinationServiceAccounts[0], proj.Spec.DestinationServiceAccounts[1]}}, proj.AmbiguousDestinationServiceAccounts())
+		// ambiguous pairs are only rejected by the API server when they are added, so that existing projects stay valid
+		require.NoError(t, proj.ValidateProject())
+	})
+
+	t.Run("clearly ordered pair", func(t *testing.T) {
+		proj := newProj(
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "team-prod", DefaultServiceAccount: "team-sa"},
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "default-sa"},
+		)
+		assert.Empty(t, proj.AmbiguousDestinationServiceAccounts())
+	})
+
+	t.Run("not overlapping", func(t *testing.T) {
+		proj := newProj(
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "team-sa"},
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "app-*", DefaultServiceAccount: "app-sa"},
+			ApplicationDestinationServiceAccount{Server: "https://remote", Namespace: "*-prod", DefaultServiceAccount: "prod-sa"},
+			ApplicationDestinationServiceAccount{Server: "https://kubernetes.default.svc", Namespace: "*-prod", DefaultServiceAccount: "team-sa"},
+		)
+		assert.Empty(t, proj.AmbiguousDestinationServiceAccounts())
+	})
+}
+
+func Test_globsIntersect(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"foo", "foo", true},
+		{"foo", "bar", false},
+		{"*", "anything", true},
+		{"team-*", "*-prod", true},
+		{"team-*", "app-*", false},
+		{"f?o", "*o", true},
+		{"f?o", "fooo", false},
+		{"*a*", "*b*", true},
+		{"a*", "*b", true},
+		{"a?", "?", false},
+		{"[ab]*", "a*", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, globsIntersect(tt.a, tt.b), "%s and %s", tt.a, tt.b)
+		assert.Equal(t, tt.expected, globsIntersect(tt.b, tt.a), "%s and %s", tt.b, tt.a)
+	}
+}
+
+func TestAppProject_OrphanedResourcesIgnoreConflicts(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{
+		ClusterResourceBlacklist:   []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole*"}},
+		NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+	}}
+	assert.Empty(t, proj.OrphanedResourcesIgnoreConflicts())
+
+	proj.Spec.OrphanedResources = &OrphanedResourcesMonitorSettings{Ignore: []OrphanedResourceKey{
+		{Group: "", Kind: "Secret", Name: "token-*"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+		{Group: "", Kind: "ConfigMap"},
+		{Group: "*", Kind: ""},
+		{Group: "apps", Kind: "Deployment"},
+	}}
+	assert.Equal(t, []OrphanedResourceKey{
+		{Group: "", Kind: "Secret", Name: "token-*"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+		{Group: "*", Kind: ""},
+	}, proj.OrphanedResourcesIgnoreConflicts())
+
+	// the entry only permits core resources in a whitelist, so it does not conflict with ignore entries of other groups
+	proj.Spec.NamespaceResourceBlacklist = nil
+	proj.Spec.NamespaceResourceWhitelist = []metav1.GroupKind{{Group: "", Kind: "*"}}
+	assert.Equal(t, []OrphanedResourceKey{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}, {Group: "*", Kind: ""}}, proj.OrphanedResourcesIgnoreConflicts())
+
+	proj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{Group: "", Kind: "*"}}
+	assert.Len(t, proj.OrphanedResourcesIgnoreConflicts(), 5)
+}
+
 func TestCluster_ParseProxyUrl(t *testing.T) {
 	testData := []struct {
 		url            string
@@ -194,6 +194,35 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.SyncExcludedResourceAnnotations != nil {
+		in, out := &in.SyncExcludedResourceAnnotations, &out.SyncExcludedResourceAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultDestination != nil {
+		in, out := &in.DefaultDestination, &out.DefaultDestination
+		*out = new(ApplicationDestination)
+		**out = **in
+	}
+	if in.PropagatedAnnotations != nil {
+		in, out := &in.PropagatedAnnotations, &out.PropagatedAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequiredClusterLabels != nil {
+		in, out := &in.RequiredClusterLabels, &out.RequiredClusterLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceRegexDestinations != nil {
+		in, out := &in.NamespaceRegexDestinations, &out.NamespaceRegexDestinations
+		*out = make([]NamespaceRegexDestination, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -230,6 +259,23 @@ func (in *AppProjectStatus) DeepCopy() *AppProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppProjectWatchEvent) DeepCopyInto(out *AppProjectWatchEvent) {
+	*out = *in
+	in.AppProject.DeepCopyInto(&out.AppProject)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppProjectWatchEvent.
+func (in *AppProjectWatchEvent) DeepCopy() *AppProjectWatchEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(AppProjectWatchEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Application) DeepCopyInto(out *Application) {
 	*out = *in
@@ -2769,6 +2815,22 @@ func (in *MergeGenerator) DeepCopy() *MergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceRegexDestination) DeepCopyInto(out *NamespaceRegexDestination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceRegexDestination.
+func (in *NamespaceRegexDestination) DeepCopy() *NamespaceRegexDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceRegexDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
 	*out = *in
@@ -2986,6 +3048,11 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopyInto(out *OrphanedResourcesM
 		*out = make([]OrphanedResourceKey, len(*in))
 		copy(*out, *in)
 	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3140,6 +3207,16 @@ func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TokenSourceRanges != nil {
+		in, out := &in.TokenSourceRanges, &out.TokenSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedSyncActions != nil {
+		in, out := &in.DeniedSyncActions, &out.DeniedSyncActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3232,6 +3309,11 @@ func (in *PullRequestGeneratorAzureDevOps) DeepCopyInto(out *PullRequestGenerato
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Repos != nil {
+		in, out := &in.Repos, &out.Repos
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3320,6 +3402,31 @@ func (in *PullRequestGeneratorFilter) DeepCopyInto(out *PullRequestGeneratorFilt
 		*out = new(string)
 		**out = **in
 	}
+	if in.PathsChanged != nil {
+		in, out := &in.PathsChanged, &out.PathsChanged
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelsAll != nil {
+		in, out := &in.LabelsAll, &out.LabelsAll
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelsAny != nil {
+		in, out := &in.LabelsAny, &out.LabelsAny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeLabels != nil {
+		in, out := &in.ExcludeLabels, &out.ExcludeLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetBranchProtected != nil {
+		in, out := &in.TargetBranchProtected, &out.TargetBranchProtected
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
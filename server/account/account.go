@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -126,6 +127,18 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Resources, r.Resource)
 	}
 
+	if r.Explain {
+		ok, policy := s.enf.EnforceExplain(ctx.Value("claims"), r.Resource, r.Action, r.Subresource)
+		response := &account.CanIResponse{Value: "no"}
+		if ok {
+			response.Value = "yes"
+		}
+		if len(policy) > 0 {
+			response.Policy = "p, " + strings.Join(policy, ", ")
+		}
+		return response, nil
+	}
+
 	ok := s.enf.Enforce(ctx.Value("claims"), r.Resource, r.Action, r.Subresource)
 	if ok {
 		return &account.CanIResponse{Value: "yes"}, nil
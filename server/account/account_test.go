@@ -319,6 +319,32 @@ func TestCanI_GetLogsAllow(t *testing.T) {
 	assert.Equal(t, "yes", resp.Value)
 }
 
+func TestCanI_Explain(t *testing.T) {
+	accountServer, _ := newTestAccountServer(t, t.Context())
+	accountServer.enf.SetClaimsExplainFunc(func(_ jwt.Claims, rvals ...any) (bool, []string) {
+		if rvals[2] == "sync" {
+			return true, []string{"role:deployer", "applications", "sync", "default/*", "allow"}
+		}
+		return false, []string{"role:deployer", "applications", "delete", "default/*", "deny"}
+	})
+	ctx := adminContext(t.Context())
+
+	resp, err := accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications", Action: "sync", Subresource: "default/guestbook", Explain: true})
+	require.NoError(t, err)
+	assert.Equal(t, "yes", resp.Value)
+	assert.Equal(t, "p, role:deployer, applications, sync, default/*, allow", resp.Policy)
+
+	resp, err = accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications", Action: "delete", Subresource: "default/guestbook", Explain: true})
+	require.NoError(t, err)
+	assert.Equal(t, "no", resp.Value)
+	assert.Equal(t, "p, role:deployer, applications, delete, default/*, deny", resp.Policy)
+
+	resp, err = accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications", Action: "sync", Subresource: "default/guestbook"})
+	require.NoError(t, err)
+	assert.Equal(t, "yes", resp.Value)
+	assert.Empty(t, resp.Policy)
+}
+
 func TestCanI_GetLogsDeny(t *testing.T) {
 	enforcer := func(_ jwt.Claims, _ ...any) bool {
 		return false
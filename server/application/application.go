@@ -24,6 +24,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/argoproj/pkg/v2/sync"
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang-jwt/jwt/v5"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,6 +50,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/deeplinks"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/collections"
@@ -56,6 +58,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
@@ -1314,6 +1317,9 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		}
 	}
 
+	// applications without a destination cluster are deployed to the default destination of the project, if any
+	proj.ApplyDefaultDestination(&app.Spec)
+
 	if _, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db); err != nil {
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
@@ -1981,6 +1987,48 @@ func isTheSelectedOne(currentNode *v1alpha1.ResourceNode, q *application.Applica
 	return false
 }
 
+// enforceDeniedSyncActions rejects a sync, or a rollback, using actions which the project role of the caller's token may
+// not use. The effective sync options of the sync are checked as well as the requested prune and force, since the
+// Force=true and Replace=true options of the application force the sync too.
+func enforceDeniedSyncActions(ctx context.Context, proj *v1alpha1.AppProject, prune bool, force bool, syncOptions v1alpha1.SyncOptions) error {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return nil
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return nil
+	}
+	projName, roleName, ok := rbacpolicy.GetProjectRoleFromSubject(jwtutil.GetUserIdentifier(mapClaims))
+	if !ok || projName != proj.Name {
+		return nil
+	}
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	}
+	var actions []string
+	if prune {
+		actions = append(actions, v1alpha1.SyncActionPrune)
+	}
+	if force {
+		actions = append(actions, v1alpha1.SyncActionForce)
+	}
+	for _, action := range actions {
+		if role.IsSyncActionDenied(action) {
+			return status.Errorf(codes.PermissionDenied, "permission denied: role '%s' of project '%s' may not sync with %s", roleName, proj.Name, action)
+		}
+	}
+	if role.IsSyncActionDenied(v1alpha1.SyncActionForce) {
+		for _, option := range []string{common.SyncOptionForce, common.SyncOptionReplace} {
+			if syncOptions.HasOption(option) {
+				return status.Errorf(codes.PermissionDenied, "permission denied: role '%s' of project '%s' may not sync with %s: sync option %s is set", roleName, proj.Name, v1alpha1.SyncActionForce, option)
+			}
+		}
+	}
+	return nil
+}
+
 // Sync syncs an application to its target state
 func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*v1alpha1.Application, error) {
 	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName(), "")
@@ -1990,7 +2038,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	// syncs with project role tokens and API keys are not exempted by manual sync windows
+	initiator := v1alpha1.OperationInitiator{Username: session.Username(ctx)}
+	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(initiator.IsInteractive())
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
@@ -2001,6 +2051,22 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
+	var retry *v1alpha1.RetryStrategy
+	var syncOptions v1alpha1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+		retry = a.Spec.SyncPolicy.Retry
+	}
+	if syncReq.RetryStrategy != nil {
+		retry = syncReq.RetryStrategy
+	}
+	if syncReq.SyncOptions != nil {
+		syncOptions = syncReq.SyncOptions.Items
+	}
+
+	if err := enforceDeniedSyncActions(ctx, proj, syncReq.GetPrune(), syncReq.GetStrategy().Force(), syncOptions); err != nil {
+		return nil, err
+	}
 
 	if syncReq.Manifests != nil {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
@@ -2019,19 +2085,6 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, err
 	}
 
-	var retry *v1alpha1.RetryStrategy
-	var syncOptions v1alpha1.SyncOptions
-	if a.Spec.SyncPolicy != nil {
-		syncOptions = a.Spec.SyncPolicy.SyncOptions
-		retry = a.Spec.SyncPolicy.Retry
-	}
-	if syncReq.RetryStrategy != nil {
-		retry = syncReq.RetryStrategy
-	}
-	if syncReq.SyncOptions != nil {
-		syncOptions = syncReq.SyncOptions.Items
-	}
-
 	if syncOptions.HasOption(common.SyncOptionReplace) && !s.syncWithReplaceAllowed {
 		return nil, status.Error(codes.FailedPrecondition, "sync with replace was disabled on the API Server level via the server configuration")
 	}
@@ -2061,7 +2114,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Sources:      a.Spec.Sources,
 			Revisions:    sourceRevisions,
 		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+		InitiatedBy: initiator,
 		Info:        syncReq.Infos,
 	}
 	if retry != nil {
@@ -2134,7 +2187,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Applica
 }
 
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*v1alpha1.Application, error) {
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -2169,6 +2222,10 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if a.Spec.SyncPolicy != nil {
 		syncOptions = a.Spec.SyncPolicy.SyncOptions
 	}
+	// the rollback applies without force, but the sync options of the application may still force it
+	if err := enforceDeniedSyncActions(ctx, proj, rollbackReq.GetPrune(), false, syncOptions); err != nil {
+		return nil, err
+	}
 
 	// Rollback is just a convenience around Sync
 	op := v1alpha1.Operation{
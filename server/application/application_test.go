@@ -1478,6 +1478,38 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateAppWithDefaultDestination(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-default-dest", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:        []string{"*"},
+			Destinations:       []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			DefaultDestination: &v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "team-a"},
+		},
+	}
+	appServer := newTestAppServer(t, proj)
+
+	t.Run("Applied", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Name = "no-destination"
+		testApp.Spec.Project = proj.Name
+		testApp.Spec.Destination = v1alpha1.ApplicationDestination{}
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "team-a"}, app.Spec.Destination)
+	})
+
+	t.Run("NotOverridden", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Name = "with-destination"
+		testApp.Spec.Project = proj.Name
+		testApp.Spec.Destination = v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "guestbook"}
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "guestbook"}, app.Spec.Destination)
+	})
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -1852,6 +1884,122 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncDeniedSyncActions(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-ci", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Roles: []v1alpha1.ProjectRole{{
+				Name: "ci",
+				Policies: []string{
+					"p, proj:proj-ci:ci, applications, get, proj-ci/*, allow",
+					"p, proj:proj-ci:ci, applications, sync, proj-ci/*, allow",
+				},
+				DeniedSyncActions: []string{v1alpha1.SyncActionPrune, v1alpha1.SyncActionForce},
+			}, {
+				Name: "deployer",
+				Policies: []string{
+					"p, proj:proj-ci:deployer, applications, get, proj-ci/*, allow",
+					"p, proj:proj-ci:deployer, applications, sync, proj-ci/*, allow",
+				},
+			}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-ci"
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	})
+	otherApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other-app"
+		app.Spec.Project = "proj-ci"
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	})
+	forceApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "force-app"
+		app.Spec.Project = "proj-ci"
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"Force=true"}}
+	})
+	appServer := newTestAppServer(t, proj, testApp, otherApp, forceApp)
+	tokenCtx := func(role string) context.Context {
+		//nolint:staticcheck
+		return context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "proj:proj-ci:" + role})
+	}
+
+	_, err := appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{Name: &testApp.Name, Prune: ptr.To(true)})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with prune")
+
+	_, err = appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{
+		Name:     &testApp.Name,
+		Strategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{Force: true}},
+	})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with force")
+
+	for _, option := range []string{"Force=true", "Replace=true"} {
+		_, err = appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{
+			Name:        &testApp.Name,
+			SyncOptions: &application.SyncOptions{Items: []string{option}},
+		})
+		require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with force: sync option "+option+" is set")
+	}
+
+	_, err = appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{Name: &forceApp.Name})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with force: sync option Force=true is set", "the sync options of the application apply when the request has none")
+
+	_, err = appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{
+		Name:        &forceApp.Name,
+		SyncOptions: &application.SyncOptions{Items: []string{"Validate=false"}},
+	})
+	require.NoError(t, err, "the sync options of the request replace those of the application")
+
+	_, err = appServer.Sync(tokenCtx("ci"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(tokenCtx("deployer"), &application.ApplicationSyncRequest{Name: &otherApp.Name, Prune: ptr.To(true)})
+	require.NoError(t, err)
+}
+
+func TestSyncManualSyncWindow(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-ci", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Roles: []v1alpha1.ProjectRole{{
+				Name: "ci",
+				Policies: []string{
+					"p, proj:proj-ci:ci, applications, get, proj-ci/*, allow",
+					"p, proj:proj-ci:ci, applications, sync, proj-ci/*, allow",
+				},
+			}},
+			SyncWindows: v1alpha1.SyncWindows{{
+				Kind:         "deny",
+				Schedule:     "* * * * *",
+				Duration:     "1h",
+				Applications: []string{"*"},
+				ManualSync:   true,
+			}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-ci"
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	})
+	appServer := newTestAppServer(t, proj, testApp)
+
+	//nolint:staticcheck
+	tokenCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "proj:proj-ci:ci"})
+	_, err := appServer.Sync(tokenCtx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = cannot sync: blocked by sync window")
+
+	//nolint:staticcheck
+	userCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "admin"})
+	app, err := appServer.Sync(userCtx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", app.Operation.InitiatedBy.Username)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -1904,6 +2052,46 @@ func TestSyncGit(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync locally", events.Items[1].Message)
 }
 
+func TestSyncLocalRequiresGlobalSignatureKeys(t *testing.T) {
+	ctx := t.Context()
+	globalProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "global-signed", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		},
+	}
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{
+		"globalProjects": `
+ - projectName: global-signed
+   labelSelector:
+     matchExpressions:
+      - key: unsigned
+        operator: DoesNotExist
+`,
+	}, globalProj)
+	testApp := newTestApp()
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name: &app.Name,
+		Manifests: []string{
+			`apiVersion: v1
+			kind: ServiceAccount
+			metadata:
+			  name: test
+			  namespace: test`,
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Cannot use local sync when signature keys are required")
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -1926,6 +2114,51 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestRollbackDeniedSyncActions(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-ci", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Roles: []v1alpha1.ProjectRole{{
+				Name: "ci",
+				Policies: []string{
+					"p, proj:proj-ci:ci, applications, get, proj-ci/*, allow",
+					"p, proj:proj-ci:ci, applications, sync, proj-ci/*, allow",
+				},
+				DeniedSyncActions: []string{v1alpha1.SyncActionPrune, v1alpha1.SyncActionForce},
+			}},
+		},
+	}
+	withHistory := func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-ci"
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+		app.Status.History = []v1alpha1.RevisionHistory{{
+			ID:       1,
+			Revision: "abc",
+			Source:   *app.Spec.Source.DeepCopy(),
+		}}
+	}
+	testApp := newTestApp(withHistory)
+	forceApp := newTestApp(withHistory, func(app *v1alpha1.Application) {
+		app.Name = "force-app"
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"Replace=true"}}
+	})
+	appServer := newTestAppServer(t, proj, testApp, forceApp)
+	//nolint:staticcheck
+	tokenCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "proj:proj-ci:ci"})
+
+	_, err := appServer.Rollback(tokenCtx, &application.ApplicationRollbackRequest{Name: &testApp.Name, Id: ptr.To(int64(1)), Prune: ptr.To(true)})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with prune")
+
+	_, err = appServer.Rollback(tokenCtx, &application.ApplicationRollbackRequest{Name: &forceApp.Name, Id: ptr.To(int64(1))})
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role 'ci' of project 'proj-ci' may not sync with force: sync option Replace=true is set")
+
+	app, err := appServer.Rollback(tokenCtx, &application.ApplicationRollbackRequest{Name: &testApp.Name, Id: ptr.To(int64(1))})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", app.Operation.Sync.Revision)
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := t.Context()
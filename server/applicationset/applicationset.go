@@ -41,11 +41,13 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 type Server struct {
 	ns                       string
 	db                       db.ArgoDB
+	settingsMgr              *settings.SettingsManager
 	enf                      *rbac.Enforcer
 	k8sClient                kubernetes.Interface
 	dynamicClient            dynamic.Interface
@@ -68,6 +70,7 @@ type Server struct {
 // NewServer returns a new instance of the ApplicationSet service
 func NewServer(
 	db db.ArgoDB,
+	settingsMgr *settings.SettingsManager,
 	kubeclientset kubernetes.Interface,
 	dynamicClientset dynamic.Interface,
 	kubeControllerClientset client.Client,
@@ -90,6 +93,7 @@ func NewServer(
 	s := &Server{
 		ns:                       namespace,
 		db:                       db,
+		settingsMgr:              settingsMgr,
 		enf:                      enf,
 		dynamicClient:            dynamicClientset,
 		client:                   kubeControllerClientset,
@@ -263,9 +267,9 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.Entry, appset v1alpha1.ApplicationSet, namespace string) ([]v1alpha1.Application, error) {
 	argoCDDB := s.db
 
-	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
+	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true, false, 0)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig, s.settingsMgr)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -92,7 +92,8 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		},
 	})
 	ctx := t.Context()
-	db := db.NewDB(testNamespace, settings.NewSettingsManager(ctx, kubeclientset, testNamespace), kubeclientset)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
+	db := db.NewDB(testNamespace, settingsMgr, kubeclientset)
 	_, err := db.CreateRepository(ctx, fakeRepo())
 	require.NoError(t, err)
 	_, err = db.CreateCluster(ctx, fakeCluster())
@@ -146,6 +147,7 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 
 	server := NewServer(
 		db,
+		settingsMgr,
 		kubeclientset,
 		nil,
 		nil,
@@ -0,0 +1,82 @@
+package project
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type subscriber struct {
+	ch     chan *v1alpha1.AppProjectWatchEvent
+	filter func(*v1alpha1.AppProjectWatchEvent) bool
+}
+
+// broadcasterHandler broadcasts the project informer watch events to the subscribed watches, so that the informer has
+// a single event handler however many projects are watched
+type broadcasterHandler struct {
+	lock        sync.Mutex
+	subscribers []*subscriber
+}
+
+func (b *broadcasterHandler) notify(event *v1alpha1.AppProjectWatchEvent) {
+	// send the events outside the lock, so that subscribers may unsubscribe meanwhile
+	b.lock.Lock()
+	subscribers := append([]*subscriber{}, b.subscribers...)
+	b.lock.Unlock()
+
+	for _, s := range subscribers {
+		if s.filter != nil && !s.filter(event) {
+			continue
+		}
+		select {
+		case s.ch <- event:
+		default:
+			// drop the event if it cannot be sent right away
+			log.WithField("project", event.AppProject.Name).Warn("unable to send project event notification")
+		}
+	}
+}
+
+// Subscribe forwards the project informer watch events matching the filter, if any, to the channel. The events are
+// dropped if nothing receives them, so the channel must be buffered if dropping events is not acceptable.
+func (b *broadcasterHandler) Subscribe(ch chan *v1alpha1.AppProjectWatchEvent, filter func(*v1alpha1.AppProjectWatchEvent) bool) func() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	subscriber := &subscriber{ch: ch, filter: filter}
+	b.subscribers = append(b.subscribers, subscriber)
+	return func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		for i := range b.subscribers {
+			if b.subscribers[i] == subscriber {
+				b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func (b *broadcasterHandler) OnAdd(obj any, _ bool) {
+	if proj, ok := obj.(*v1alpha1.AppProject); ok {
+		b.notify(&v1alpha1.AppProjectWatchEvent{AppProject: *proj.DeepCopy(), Type: watch.Added})
+	}
+}
+
+func (b *broadcasterHandler) OnUpdate(_, newObj any) {
+	if proj, ok := newObj.(*v1alpha1.AppProject); ok {
+		b.notify(&v1alpha1.AppProjectWatchEvent{AppProject: *proj.DeepCopy(), Type: watch.Modified})
+	}
+}
+
+func (b *broadcasterHandler) OnDelete(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if proj, ok := obj.(*v1alpha1.AppProject); ok {
+		b.notify(&v1alpha1.AppProjectWatchEvent{AppProject: *proj.DeepCopy(), Type: watch.Deleted})
+	}
+}
@@ -0,0 +1,47 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestBroadcasterHandler_SubscribeUnsubscribe(t *testing.T) {
+	broadcaster := broadcasterHandler{}
+
+	unsubscribe := broadcaster.Subscribe(make(chan *v1alpha1.AppProjectWatchEvent), nil)
+	assert.Len(t, broadcaster.subscribers, 1)
+
+	unsubscribe()
+	assert.Empty(t, broadcaster.subscribers)
+}
+
+func TestBroadcasterHandler_ReceiveEvents(t *testing.T) {
+	broadcaster := broadcasterHandler{}
+	all := make(chan *v1alpha1.AppProjectWatchEvent, 10)
+	filtered := make(chan *v1alpha1.AppProjectWatchEvent, 10)
+	_ = broadcaster.Subscribe(all, nil)
+	_ = broadcaster.Subscribe(filtered, func(event *v1alpha1.AppProjectWatchEvent) bool {
+		return event.AppProject.Name == "team-a"
+	})
+
+	teamA := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	teamB := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+	broadcaster.OnAdd(teamA, false)
+	broadcaster.OnUpdate(teamB, teamB)
+	broadcaster.OnDelete(cache.DeletedFinalStateUnknown{Key: "argocd/team-a", Obj: teamA})
+
+	require.Len(t, all, 3)
+	assert.Equal(t, watch.Added, (<-all).Type)
+	assert.Equal(t, watch.Modified, (<-all).Type)
+	assert.Equal(t, watch.Deleted, (<-all).Type)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, watch.Added, (<-filtered).Type)
+	assert.Equal(t, watch.Deleted, (<-filtered).Type)
+}
@@ -2,9 +2,13 @@ package project
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -17,10 +21,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -30,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/session"
@@ -37,10 +45,20 @@ import (
 )
 
 const (
+	// EventReasonResourceScopeMismatch is the reason of the events recorded for resource list entries of the wrong scope
+	EventReasonResourceScopeMismatch = "ResourceScopeMismatch"
+	// EventReasonWildcard is the reason of the events recorded for wildcard source repositories and destinations
+	EventReasonWildcard = "Wildcard"
+	// EventReasonAdminRole is the reason of the events recorded for role policies allowing every action on every object
+	EventReasonAdminRole = "AdminRole"
+	// EventReasonNeverFiringSyncWindow is the reason of the events recorded for sync windows whose schedule never fires
+	EventReasonNeverFiringSyncWindow = "NeverFiringSyncWindow"
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
 )
 
+var watchAPIBufferSize = env.ParseNumFromEnv(common.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+
 // Server provides a Project service
 type Server struct {
 	ns            string
@@ -52,8 +70,15 @@ type Server struct {
 	projectLock   sync.KeyLock
 	sessionMgr    *session.SessionManager
 	projInformer  cache.SharedIndexInformer
-	settingsMgr   *settings.SettingsManager
-	db            db.ArgoDB
+	// projBroadcaster forwards the events of the project informer to the project watches
+	projBroadcaster *broadcasterHandler
+	// projBroadcasterSynced returns true once the broadcaster was notified of the projects already in the informer's
+	// cache when it was registered
+	projBroadcasterSynced cache.InformerSynced
+	// resourceScopeDisco caches the API resources used to warn about resource list entries of the wrong scope
+	resourceScopeDisco *resourceScopeDiscovery
+	settingsMgr        *settings.SettingsManager
+	db                 db.ArgoDB
 }
 
 // NewServer returns a new instance of the Project service
@@ -61,9 +86,20 @@ func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appcl
 	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB, enableK8sEvent []string,
 ) *Server {
 	auditLogger := argo.NewAuditLogger(kubeclientset, "argocd-server", enableK8sEvent)
+	projBroadcaster := &broadcasterHandler{}
+	projBroadcasterSynced := func() bool { return true }
+	if projInformer != nil {
+		registration, err := projInformer.AddEventHandler(projBroadcaster)
+		if err != nil {
+			log.Error(err)
+		} else {
+			projBroadcasterSynced = registration.HasSynced
+		}
+	}
 	return &Server{
 		enf: enf, policyEnf: policyEnf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr,
-		projInformer: projInformer, settingsMgr: settingsMgr, db: db,
+		projInformer: projInformer, projBroadcaster: projBroadcaster, projBroadcasterSynced: projBroadcasterSynced, resourceScopeDisco: newResourceScopeDiscovery(kubeclientset.Discovery()),
+		settingsMgr: settingsMgr, db: db,
 	}
 }
 
@@ -112,37 +148,55 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 			return nil, err
 		}
 	}
-	id := q.Id
-	if err := prj.ValidateJWTTokenID(q.Role, q.Id); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	ids := q.Ids
+	if len(ids) == 0 {
+		ids = []string{q.Id}
+	} else if q.Id != "" {
+		return nil, status.Error(codes.InvalidArgument, "id and ids cannot be set at the same time")
 	}
-	if id == "" {
-		uniqueId, _ := uuid.NewRandom()
-		id = uniqueId.String()
+	for i, id := range ids {
+		if err := prj.ValidateJWTTokenID(q.Role, id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if id != "" && slices.Contains(ids[:i], id) {
+			return nil, status.Errorf(codes.InvalidArgument, "token id '%s' is requested more than once", id)
+		}
 	}
 	subject := fmt.Sprintf(JWTTokenSubFormat, q.Project, q.Role)
-	jwtToken, err := s.sessionMgr.Create(subject, q.ExpiresIn, id)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
-	claims := jwt.RegisteredClaims{}
-	_, _, err = parser.ParseUnverified(jwtToken, &claims)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-	var issuedAt, expiresAt int64
-	if claims.IssuedAt != nil {
-		issuedAt = claims.IssuedAt.Unix()
-	}
-	if claims.ExpiresAt != nil {
-		expiresAt = claims.ExpiresAt.Unix()
+	audience := q.Audience
+	if audience == "" {
+		audience = prj.Spec.TokenAudience
 	}
-	id = claims.ID
 
 	prj.NormalizeJWTTokens()
 
-	items := append(prj.Status.JWTTokensByRole[q.Role].Items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: id})
+	items := prj.Status.JWTTokensByRole[q.Role].Items
+	tokens := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			uniqueId, _ := uuid.NewRandom()
+			id = uniqueId.String()
+		}
+		jwtToken, err := s.sessionMgr.CreateWithAudience(subject, q.ExpiresIn, id, audience)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+		claims := jwt.RegisteredClaims{}
+		_, _, err = parser.ParseUnverified(jwtToken, &claims)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var issuedAt, expiresAt int64
+		if claims.IssuedAt != nil {
+			issuedAt = claims.IssuedAt.Unix()
+		}
+		if claims.ExpiresAt != nil {
+			expiresAt = claims.ExpiresAt.Unix()
+		}
+		items = append(items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: claims.ID})
+		tokens = append(tokens, jwtToken)
+	}
 	if _, found := prj.Status.JWTTokensByRole[q.Role]; found {
 		prj.Status.JWTTokensByRole[q.Role] = v1alpha1.JWTTokens{Items: items}
 	} else {
@@ -157,8 +211,8 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 	if err != nil {
 		return nil, err
 	}
-	s.logEvent(ctx, prj, argo.EventReasonResourceCreated, "created token")
-	return &project.ProjectTokenResponse{Token: jwtToken}, nil
+	s.logEvent(ctx, prj, argo.EventReasonResourceCreated, fmt.Sprintf("created %d token(s)", len(tokens)))
+	return &project.ProjectTokenResponse{Token: tokens[0], Tokens: tokens}, nil
 }
 
 func (s *Server) ListLinks(ctx context.Context, q *project.ListProjectLinksRequest) (*application.LinksResponse, error) {
@@ -246,6 +300,70 @@ func (s *Server) deleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 	return &project.EmptyResponse{}, nil
 }
 
+// VerifyToken verifies that the token was signed by this Argo CD instance for a role of the project, has not expired
+// and has not been deleted from the project
+func (s *Server) VerifyToken(ctx context.Context, q *project.ProjectTokenVerifyRequest) (*project.ProjectTokenVerifyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Project); err != nil {
+		return nil, err
+	}
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings: %w", err)
+	}
+
+	claims := jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(q.Token, &claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return argoCDSettings.ServerSignature, nil
+	})
+	// the claims are only validated once the signature is, so the claims of an expired token can be trusted
+	expired := errors.Is(err, jwt.ErrTokenExpired)
+	if err != nil && !expired {
+		return &project.ProjectTokenVerifyResponse{Reason: fmt.Sprintf("token is invalid: %v", err)}, nil
+	}
+
+	projName, role, ok := rbacpolicy.GetProjectRoleFromSubject(claims.Subject)
+	if !ok {
+		return &project.ProjectTokenVerifyResponse{Reason: fmt.Sprintf("token subject '%s' is not a project role", claims.Subject)}, nil
+	}
+	resp := &project.ProjectTokenVerifyResponse{Role: role, Id: claims.ID}
+	if claims.IssuedAt != nil {
+		resp.IssuedAt = claims.IssuedAt.Unix()
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+
+	switch {
+	case projName != prj.Name:
+		resp.Reason = fmt.Sprintf("token was issued for project '%s'", projName)
+	case expired:
+		resp.Reason = fmt.Sprintf("token expired at %s", claims.ExpiresAt.UTC().Format(time.RFC3339))
+	default:
+		if _, _, err := prj.GetRoleByName(role); err != nil {
+			resp.Reason = fmt.Sprintf("role '%s' does not exist in project '%s'", role, prj.Name)
+			break
+		}
+		// tokens are identified by their id, only tokens created before ids were introduced by their issue time
+		issuedAt := int64(-1)
+		if claims.ID == "" {
+			issuedAt = resp.IssuedAt
+		}
+		if _, _, err := prj.GetJWTToken(role, issuedAt, claims.ID); err != nil {
+			resp.Reason = fmt.Sprintf("token has been deleted from role '%s'", role)
+			break
+		}
+		resp.Valid = true
+	}
+	return resp, nil
+}
+
 // Create a new project
 func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	if q.Project == nil {
@@ -254,11 +372,33 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionCreate, q.Project.Name); err != nil {
 		return nil, err
 	}
+	requireDescription, err := s.settingsMgr.GetProjectsRequireDescription()
+	if err != nil {
+		return nil, fmt.Errorf("error getting projects.requireDescription setting: %w", err)
+	}
+	if requireDescription && strings.TrimSpace(q.Project.Spec.Description) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "project %q must have a description: 'projects.requireDescription' is enabled in argocd-cm", q.Project.Name)
+	}
 	q.Project.NormalizePolicies()
-	err := validateProject(q.Project)
+	err = validateProject(q.Project)
 	if err != nil {
 		return nil, fmt.Errorf("error validating project: %w", err)
 	}
+	wildcardWarnings, err := s.checkAddedEntries(ctx, s.wildcardPolicy(), q.Project, nil, q.AllowWildcard)
+	if err != nil {
+		return nil, err
+	}
+	adminRoleWarnings, err := s.checkAddedEntries(ctx, s.adminRolePolicy(), q.Project, nil, q.AllowAdminRole)
+	if err != nil {
+		return nil, err
+	}
+	syncWindowWarnings, err := s.checkAddedEntries(ctx, s.neverFiringSyncWindowPolicy(), q.Project, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAmbiguousDestinationServiceAccounts(q.Project, nil); err != nil {
+		return nil, err
+	}
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Create(ctx, q.Project, metav1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
 		existing, getErr := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project.Name, metav1.GetOptions{})
@@ -279,6 +419,10 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	}
 	if err == nil {
 		s.logEvent(ctx, res, argo.EventReasonResourceCreated, "created project")
+		s.warnResourceScopeMismatch(ctx, res)
+		s.recordWarnings(ctx, res, EventReasonWildcard, wildcardWarnings)
+		s.recordWarnings(ctx, res, EventReasonAdminRole, adminRoleWarnings)
+		s.recordWarnings(ctx, res, EventReasonNeverFiringSyncWindow, syncWindowWarnings)
 	}
 	return res, err
 }
@@ -299,6 +443,60 @@ func (s *Server) List(ctx context.Context, _ *project.ProjectQuery) (*v1alpha1.A
 	return list, err
 }
 
+// Watch returns a stream of project change events. Like the Kubernetes watch API, the existing projects are sent as
+// added events first.
+func (s *Server) Watch(q *project.ProjectQuery, ws project.ProjectService_WatchServer) error {
+	ctx := ws.Context()
+	claims := ctx.Value("claims")
+	send := func(event *v1alpha1.AppProjectWatchEvent) error {
+		if !s.enf.Enforce(claims, rbac.ResourceProjects, rbac.ActionGet, event.AppProject.Name) {
+			return nil
+		}
+		event.AppProject.NormalizeJWTTokens()
+		if err := ws.Send(event); err != nil {
+			return fmt.Errorf("error sending project event: %w", err)
+		}
+		return nil
+	}
+	// the broadcaster is notified of the projects already in the informer's cache when it is registered, which must
+	// not be sent twice
+	if !cache.WaitForCacheSync(ctx.Done(), s.projBroadcasterSynced) {
+		return nil
+	}
+	// subscribe before listing the existing projects, so that no change in between is missed
+	events := make(chan *v1alpha1.AppProjectWatchEvent, watchAPIBufferSize)
+	unsubscribe := s.projBroadcaster.Subscribe(events, func(event *v1alpha1.AppProjectWatchEvent) bool {
+		return q.Name == "" || event.AppProject.Name == q.Name
+	})
+	defer unsubscribe()
+
+	projects, err := listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+	slices.SortFunc(projects, func(a, b *v1alpha1.AppProject) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, proj := range projects {
+		if q.Name != "" && proj.Name != q.Name {
+			continue
+		}
+		if err := send(&v1alpha1.AppProjectWatchEvent{Type: watch.Added, AppProject: *proj.DeepCopy()}); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event := <-events:
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
 // GetDetailedProject returns a project with scoped resources
 func (s *Server) GetDetailedProject(ctx context.Context, q *project.ProjectQuery) (*project.DetailedProjectsResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
@@ -380,6 +578,22 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		}
 	}
 
+	wildcardWarnings, err := s.checkAddedEntries(ctx, s.wildcardPolicy(), q.Project, oldProj, q.AllowWildcard)
+	if err != nil {
+		return nil, err
+	}
+	adminRoleWarnings, err := s.checkAddedEntries(ctx, s.adminRolePolicy(), q.Project, oldProj, q.AllowAdminRole)
+	if err != nil {
+		return nil, err
+	}
+	syncWindowWarnings, err := s.checkAddedEntries(ctx, s.neverFiringSyncWindowPolicy(), q.Project, oldProj, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAmbiguousDestinationServiceAccounts(q.Project, oldProj); err != nil {
+		return nil, err
+	}
+
 	clusterResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceWhitelist, oldProj.Spec.ClusterResourceWhitelist)
 	clusterResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceBlacklist, oldProj.Spec.ClusterResourceBlacklist)
 	namespacesResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceBlacklist, oldProj.Spec.NamespaceResourceBlacklist)
@@ -443,9 +657,17 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "as a result of project update %s", strings.Join(parts, " and "))
 	}
 
+	if err := argo.RecordProjectRevision(q.Project, oldProj); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, q.Project, metav1.UpdateOptions{})
 	if err == nil {
 		s.logEvent(ctx, res, argo.EventReasonResourceUpdated, "updated project")
+		s.warnResourceScopeMismatch(ctx, res)
+		s.recordWarnings(ctx, res, EventReasonWildcard, wildcardWarnings)
+		s.recordWarnings(ctx, res, EventReasonAdminRole, adminRoleWarnings)
+		s.recordWarnings(ctx, res, EventReasonNeverFiringSyncWindow, syncWindowWarnings)
 	}
 	return res, err
 }
@@ -467,6 +689,10 @@ func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.
 		return nil, err
 	}
 
+	if p.Spec.DeletionProtection {
+		return nil, status.Errorf(codes.InvalidArgument, "project '%s' is protected from deletion, remove the deletion protection first", q.Name)
+	}
+
 	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -498,6 +724,162 @@ func (s *Server) ListEvents(ctx context.Context, q *project.ProjectQuery) (*core
 	return s.kubeclientset.CoreV1().Events(s.ns).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
 }
 
+// warnResourceScopeMismatch logs a warning and records a warning event for each resource list entry of the project of
+// the wrong scope, if enabled in argocd-cm. The scopes are looked up on the cluster Argo CD runs in, and cached for
+// resourceScopeDiscoveryTTL; the project is saved regardless, and the check is skipped if the cluster cannot be reached.
+func (s *Server) warnResourceScopeMismatch(ctx context.Context, proj *v1alpha1.AppProject) {
+	enabled, err := s.settingsMgr.GetProjectsWarnResourceScopeMismatch()
+	if err != nil || !enabled {
+		return
+	}
+	warnings, err := argo.ResourceScopeWarnings(s.resourceScopeDisco.get(), proj.Spec)
+	if err != nil {
+		log.WithField("project", proj.Name).Warnf("Skipping resource scope validation: %v", err)
+		return
+	}
+	for _, warning := range warnings {
+		log.WithField("project", proj.Name).Warn(warning)
+		s.auditLogger.LogAppProjEvent(proj, argo.EventInfo{Type: corev1.EventTypeWarning, Reason: EventReasonResourceScopeMismatch}, warning, session.Username(ctx))
+	}
+}
+
+// addedEntriesPolicy is an argocd-cm setting which warns about, or rejects, the entries of a kind, e.g. wildcard
+// destinations, which a project adds
+type addedEntriesPolicy struct {
+	// setting is the argocd-cm key of the policy
+	setting string
+	// get returns the configured policy, which is empty if the entries are not guarded
+	get func() (string, error)
+	// reject is the value of the policy rejecting added entries, any other non-empty value only warns about them
+	reject string
+	// entries returns the entries of the project the policy guards
+	entries func(proj *v1alpha1.AppProject) []string
+	// rejection returns the message of the error rejecting the added entries of the project
+	rejection func(proj *v1alpha1.AppProject, entries []string) string
+	// warning returns the message of the warning about an added entry
+	warning func(entry string) string
+}
+
+// wildcardPolicy returns the projects.wildcardPolicy setting guarding source repositories and destinations which
+// permit everything
+func (s *Server) wildcardPolicy() addedEntriesPolicy {
+	return addedEntriesPolicy{
+		setting: "projects.wildcardPolicy",
+		get:     s.settingsMgr.GetProjectsWildcardPolicy,
+		reject:  settings.ProjectsWildcardPolicyReject,
+		entries: func(proj *v1alpha1.AppProject) []string { return proj.WildcardEntries() },
+		rejection: func(proj *v1alpha1.AppProject, wildcards []string) string {
+			return fmt.Sprintf("project %q permits everything with the %s, which 'projects.wildcardPolicy' in argocd-cm rejects unless the wildcard is explicitly allowed by a user permitted to override the project, e.g. with argocd proj create or set --allow-wildcard", proj.Name, strings.Join(wildcards, " and the "))
+		},
+		warning: func(wildcard string) string { return "Project permits everything with the " + wildcard },
+	}
+}
+
+// adminRolePolicy returns the projects.adminRolePolicy setting guarding role policies which allow every action on every
+// object
+func (s *Server) adminRolePolicy() addedEntriesPolicy {
+	return addedEntriesPolicy{
+		setting: "projects.adminRolePolicy",
+		get:     s.settingsMgr.GetProjectsAdminRolePolicy,
+		reject:  settings.ProjectsAdminRolePolicyReject,
+		entries: (*v1alpha1.AppProject).AdminRolePolicies,
+		rejection: func(proj *v1alpha1.AppProject, adminPolicies []string) string {
+			return fmt.Sprintf("project %q has role policies allowing every action on every object, which 'projects.adminRolePolicy' in argocd-cm rejects unless the admin role is explicitly allowed by a user permitted to override the project, e.g. with argocd proj role add-policy --allow-admin-role: '%s'", proj.Name, strings.Join(adminPolicies, "', '"))
+		},
+		warning: func(adminPolicy string) string {
+			return fmt.Sprintf("Project role policy '%s' allows every action on every object", adminPolicy)
+		},
+	}
+}
+
+// neverFiringSyncWindowPolicy returns the projects.neverFiringSyncWindowPolicy setting guarding sync windows whose
+// schedule never fires, so that they never become active
+func (s *Server) neverFiringSyncWindowPolicy() addedEntriesPolicy {
+	return addedEntriesPolicy{
+		setting: "projects.neverFiringSyncWindowPolicy",
+		get:     s.settingsMgr.GetProjectsNeverFiringSyncWindowPolicy,
+		reject:  settings.ProjectsNeverFiringSyncWindowPolicyReject,
+		entries: func(proj *v1alpha1.AppProject) []string {
+			var windows []string
+			for _, id := range proj.Spec.SyncWindows.NeverFiring() {
+				window := proj.Spec.SyncWindows[id]
+				windows = append(windows, fmt.Sprintf("%s sync window with schedule '%s'", window.Kind, window.Schedule))
+			}
+			return windows
+		},
+		rejection: func(proj *v1alpha1.AppProject, windows []string) string {
+			return fmt.Sprintf("project %q has a %s which does not fire within the next five years, which 'projects.neverFiringSyncWindowPolicy' in argocd-cm rejects", proj.Name, strings.Join(windows, " and a "))
+		},
+		warning: func(window string) string {
+			return fmt.Sprintf("Project %s does not fire within the next five years, so it never becomes active", window)
+		},
+	}
+}
+
+// checkAddedEntries applies the policy to the entries which the project adds to its previous version, which is nil for
+// a new project. It fails if the policy rejects them, and otherwise returns the warnings to record once the project is
+// saved. Explicitly allowed entries pass silently, but only if the caller may override the policies of the project,
+// since the request flag allowing them is set by the client.
+func (s *Server) checkAddedEntries(ctx context.Context, policy addedEntriesPolicy, proj, oldProj *v1alpha1.AppProject, allowed bool) ([]string, error) {
+	if allowed && s.enf.Enforce(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionOverride, proj.Name) {
+		return nil, nil
+	}
+	value, err := policy.get()
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s setting: %w", policy.setting, err)
+	}
+	if value == "" {
+		return nil, nil
+	}
+	entries := policy.entries(proj)
+	if oldProj != nil {
+		oldEntries := policy.entries(oldProj)
+		entries = slices.DeleteFunc(entries, func(entry string) bool {
+			return slices.Contains(oldEntries, entry)
+		})
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if value == policy.reject {
+		return nil, status.Error(codes.InvalidArgument, policy.rejection(proj, entries))
+	}
+	warnings := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		warnings = append(warnings, policy.warning(entry))
+	}
+	return warnings, nil
+}
+
+// checkAmbiguousDestinationServiceAccounts rejects the ambiguous pairs of destination service accounts which the project
+// adds to its previous version, which is nil for a new project. Pairs which were already ambiguous are kept, so that
+// existing projects can still be updated.
+func checkAmbiguousDestinationServiceAccounts(proj, oldProj *v1alpha1.AppProject) error {
+	var existing [][2]v1alpha1.ApplicationDestinationServiceAccount
+	if oldProj != nil {
+		existing = oldProj.AmbiguousDestinationServiceAccounts()
+	}
+	var conflicts []string
+	for _, pair := range proj.AmbiguousDestinationServiceAccounts() {
+		if slices.Contains(existing, pair) {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("'%s/%s' and '%s/%s'", pair[0].Server, pair[0].Namespace, pair[1].Server, pair[1].Namespace))
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "destinationServiceAccounts %s are equally specific and match the same destinations with different service accounts, narrow one of them", strings.Join(conflicts, ", "))
+}
+
+// recordWarnings logs and records a warning event with the reason for each of the warnings of the saved project
+func (s *Server) recordWarnings(ctx context.Context, proj *v1alpha1.AppProject, reason string, warnings []string) {
+	for _, warning := range warnings {
+		log.WithField("project", proj.Name).Warn(warning)
+		s.auditLogger.LogAppProjEvent(proj, argo.EventInfo{Type: corev1.EventTypeWarning, Reason: reason}, warning, session.Username(ctx))
+	}
+}
+
 func (s *Server) logEvent(ctx context.Context, a *v1alpha1.AppProject, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -14,10 +15,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
 
@@ -139,6 +143,32 @@ func TestProjectServer(t *testing.T) {
 		assert.ElementsMatch(t, appList.Items[0].Status.JWTTokensByRole[roleName].Items, appList.Items[0].Spec.Roles[0].JWTTokens)
 	})
 
+	t.Run("TestDeleteRoleWithTokens", func(t *testing.T) {
+		projectWithRoles := existingProj.DeepCopy()
+		tokens := []v1alpha1.JWTToken{{IssuedAt: 1, ID: "ci"}}
+		projectWithRoles.Spec.Roles = []v1alpha1.ProjectRole{{Name: "ci", JWTTokens: tokens}, {Name: "dev"}}
+		projectWithRoles.Status.JWTTokensByRole = map[string]v1alpha1.JWTTokens{"ci": {Items: tokens}, "dev": {}}
+		kubeClient := fake.NewClientset()
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", kubeClient, apps.NewSimpleClientset(projectWithRoles), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		updatedProj := projectWithRoles.DeepCopy()
+		updatedProj.Spec.Roles = updatedProj.Spec.Roles[1:]
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
+		require.NoError(t, err)
+
+		proj, err := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace).Get(t.Context(), projectWithRoles.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, proj.Spec.Roles, 1)
+		assert.NotContains(t, proj.Status.JWTTokensByRole, "ci")
+		assert.Contains(t, proj.Status.JWTTokensByRole, "dev")
+
+		events, err := kubeClient.CoreV1().Events(testNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, events.Items, 1)
+		assert.Equal(t, argo.EventReasonResourceUpdated, events.Items[0].Reason)
+	})
+
 	t.Run("TestClusterUpdateDenied", func(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
@@ -271,6 +301,43 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, "as a result of project update 1 applications source became invalid", statusCode.Message())
 	})
 
+	t.Run("TestUpdateRecordsRevisionHistory", func(t *testing.T) {
+		proj := existingProj.DeepCopy()
+		proj.ResourceVersion = "1"
+		proj.Annotations = map[string]string{common.AnnotationKeyRevisionHistoryLimit: "1"}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		projects := projectServer.appclientset.ArgoprojV1alpha1().AppProjects(testNamespace)
+
+		update := func(description, resourceVersion string) {
+			stored, err := projects.Get(t.Context(), proj.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			stored.Spec.Description = description
+			// the fake clientset does not bump resource versions on updates
+			stored.ResourceVersion = resourceVersion
+			_, err = projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: stored})
+			require.NoError(t, err)
+		}
+		update("first", "2")
+		update("second", "3")
+
+		stored, err := projects.Get(t.Context(), proj.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		prior, err := argo.GetProjectRevision(stored, "2")
+		require.NoError(t, err)
+		assert.Equal(t, "first", prior.Spec.Description)
+		assert.Equal(t, "2", prior.ResourceVersion)
+
+		// the revision history limit compacts older revisions
+		_, err = argo.GetProjectRevision(stored, "1")
+		require.ErrorContains(t, err, "it was not recorded or has been compacted")
+
+		invalid := stored.DeepCopy()
+		invalid.Annotations[common.AnnotationKeyRevisionHistoryLimit] = "many"
+		_, err = projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: invalid})
+		require.ErrorContains(t, err, "must be an integer between 0 and 20")
+	})
+
 	t.Run("TestRemoveSourceUsedByAppSuccessfulIfPermittedByAnotherSrc", func(t *testing.T) {
 		proj := existingProj.DeepCopy()
 		proj.Spec.SourceRepos = []string{"https://github.com/argoproj/argo-cd.git", "https://github.com/argoproj/*"}
@@ -328,6 +395,26 @@ func TestProjectServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("TestDeleteProtectedProject", func(t *testing.T) {
+		protectedProj := existingProj.DeepCopy()
+		protectedProj.Spec.DeletionProtection = true
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(protectedProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		_, err := projectServer.Delete(t.Context(), &project.ProjectQuery{Name: "test"})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Equal(t, "project 'test' is protected from deletion, remove the deletion protection first", statusCode.Message())
+
+		// toggling the protection is allowed
+		protectedProj.Spec.DeletionProtection = false
+		_, err = projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: protectedProj})
+		require.NoError(t, err)
+
+		_, err = projectServer.Delete(t.Context(), &project.ProjectQuery{Name: "test"})
+		require.NoError(t, err)
+	})
+
 	t.Run("TestDeleteDefaultProjectFailure", func(t *testing.T) {
 		defaultProj := v1alpha1.AppProject{
 			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
@@ -435,6 +522,71 @@ func TestProjectServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("TestCreateTokenWithAudienceSuccessfully", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		projectWithRole.Spec.TokenAudience = "project-audience"
+		clientset := apps.NewSimpleClientset(projectWithRole)
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		for _, tc := range []struct {
+			audience         string
+			expectedAudience string
+		}{
+			{audience: "", expectedAudience: "project-audience"},
+			{audience: "token-audience", expectedAudience: "token-audience"},
+		} {
+			tokenResponse, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Audience: tc.audience})
+			require.NoError(t, err)
+			claims, _, err := sessionMgr.Parse(tokenResponse.Token)
+			require.NoError(t, err)
+
+			mapClaims, err := jwtutil.MapClaims(claims)
+			require.NoError(t, err)
+			audience, err := mapClaims.GetAudience()
+			require.NoError(t, err)
+			assert.Equal(t, jwt.ClaimStrings{tc.expectedAudience}, audience)
+		}
+	})
+
+	t.Run("TestCreateMultipleTokensSuccessfully", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		clientset := apps.NewSimpleClientset(projectWithRole)
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		ids := []string{"ci-1", "ci-2", "ci-3"}
+		tokenResponse, err := projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Ids: ids})
+		require.NoError(t, err)
+		require.Len(t, tokenResponse.Tokens, 3)
+		assert.Equal(t, tokenResponse.Tokens[0], tokenResponse.Token)
+		for i, token := range tokenResponse.Tokens {
+			claims, _, err := sessionMgr.Parse(token)
+			require.NoError(t, err)
+			mapClaims, err := jwtutil.MapClaims(claims)
+			require.NoError(t, err)
+			assert.Equal(t, ids[i], mapClaims["jti"])
+		}
+
+		proj, err := clientset.ArgoprojV1alpha1().AppProjects("default").Get(t.Context(), projectWithRole.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		var statusIDs []string
+		for _, token := range proj.Status.JWTTokensByRole[tokenName].Items {
+			statusIDs = append(statusIDs, token.ID)
+		}
+		assert.ElementsMatch(t, ids, statusIDs)
+
+		_, err = projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, Ids: []string{"ci-4", "ci-4"}})
+		require.ErrorContains(t, err, "token id 'ci-4' is requested more than once")
+		_, err = projectServer.CreateToken(t.Context(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, Ids: []string{"ci-4", "ci-1"}})
+		require.ErrorContains(t, err, "Token id 'ci-1' has been used.")
+	})
+
 	t.Run("TestCreateTokenWithSameIdDeny", func(t *testing.T) {
 		projectWithRole := existingProj.DeepCopy()
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
@@ -492,6 +644,72 @@ func TestProjectServer(t *testing.T) {
 	_ = enforcer.SetBuiltinPolicy(`p, role:admin, projects, get, *, allow
 p, role:admin, projects, update, *, allow`)
 
+	t.Run("TestVerifyToken", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		clientset := apps.NewSimpleClientset(projectWithRole)
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		verify := func(token string) *project.ProjectTokenVerifyResponse {
+			t.Helper()
+			resp, err := projectServer.VerifyToken(ctx, &project.ProjectTokenVerifyRequest{Project: projectWithRole.Name, Token: token})
+			require.NoError(t, err)
+			return resp
+		}
+
+		t.Run("Valid", func(t *testing.T) {
+			tokenResponse, err := projectServer.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 3600, Id: "valid"})
+			require.NoError(t, err)
+			resp := verify(tokenResponse.Token)
+			assert.True(t, resp.Valid, resp.Reason)
+			assert.Equal(t, tokenName, resp.Role)
+			assert.Equal(t, "valid", resp.Id)
+			assert.Equal(t, resp.IssuedAt+3600, resp.ExpiresAt)
+		})
+
+		t.Run("Expired", func(t *testing.T) {
+			argoCDSettings, err := settingsMgr.GetSettings()
+			require.NoError(t, err)
+			issuedAt := time.Now().Add(-2 * time.Hour)
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+				Subject:   fmt.Sprintf(JWTTokenSubFormat, projectWithRole.Name, tokenName),
+				IssuedAt:  jwt.NewNumericDate(issuedAt),
+				ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
+				ID:        "expired",
+			}).SignedString(argoCDSettings.ServerSignature)
+			require.NoError(t, err)
+			resp := verify(token)
+			assert.False(t, resp.Valid)
+			assert.Equal(t, tokenName, resp.Role)
+			assert.Contains(t, resp.Reason, "token expired at ")
+		})
+
+		t.Run("Revoked", func(t *testing.T) {
+			tokenResponse, err := projectServer.CreateToken(ctx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, Id: "revoked"})
+			require.NoError(t, err)
+			require.True(t, verify(tokenResponse.Token).Valid)
+			_, err = projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projectWithRole.Name, Role: tokenName, Id: "revoked"})
+			require.NoError(t, err)
+			resp := verify(tokenResponse.Token)
+			assert.False(t, resp.Valid)
+			assert.Equal(t, tokenName, resp.Role)
+			assert.Equal(t, fmt.Sprintf("token has been deleted from role '%s'", tokenName), resp.Reason)
+		})
+
+		t.Run("InvalidSignature", func(t *testing.T) {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+				Subject: fmt.Sprintf(JWTTokenSubFormat, projectWithRole.Name, tokenName),
+				ID:      "valid",
+			}).SignedString([]byte("another-secret"))
+			require.NoError(t, err)
+			resp := verify(token)
+			assert.False(t, resp.Valid)
+			assert.Empty(t, resp.Role)
+			assert.Contains(t, resp.Reason, "token is invalid")
+		})
+	})
+
 	t.Run("TestDeleteTokenSuccessfully", func(t *testing.T) {
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
 		projWithToken := existingProj.DeepCopy()
@@ -605,6 +823,19 @@ p, role:admin, projects, update, *, allow`)
 		assert.EqualError(t, err, expectedErr)
 	})
 
+	t.Run("TestValidateProjectDuplicateRoleFailure", func(t *testing.T) {
+		roleName := "testRole"
+
+		projWithRole := existingProj.DeepCopy()
+		projWithRole.Spec.Roles = append(projWithRole.Spec.Roles, v1alpha1.ProjectRole{Name: roleName}, v1alpha1.ProjectRole{Name: roleName, Description: "duplicate"})
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithRole), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		request := &project.ProjectUpdateRequest{Project: projWithRole}
+		_, err := projectServer.Update(t.Context(), request)
+		expectedErr := fmt.Sprintf("rpc error: code = AlreadyExists desc = role '%s' already exists", roleName)
+		assert.EqualError(t, err, expectedErr)
+	})
+
 	t.Run("TestValidateProjectAccessToSeparateProjectObjectFailure", func(t *testing.T) {
 		action := "create"
 		object := "testApplication"
@@ -745,6 +976,346 @@ p, role:admin, projects, update, *, allow`)
 	})
 }
 
+func TestProjectServer_CreateRequireDescription(t *testing.T) {
+	newProjectServer := func() *Server {
+		return newTestSettingsProjectServer(t, map[string]string{"projects.requireDescription": "true"})
+	}
+
+	t.Run("WithoutDescription", func(t *testing.T) {
+		proj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-description", Namespace: testNamespace},
+			Spec:       v1alpha1.AppProjectSpec{Description: "  "},
+		}
+		_, err := newProjectServer().Create(t.Context(), &project.ProjectCreateRequest{Project: proj})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "'projects.requireDescription' is enabled")
+	})
+
+	t.Run("WithDescription", func(t *testing.T) {
+		proj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "with-description", Namespace: testNamespace},
+			Spec:       v1alpha1.AppProjectSpec{Description: "Team A workloads"},
+		}
+		res, err := newProjectServer().Create(t.Context(), &project.ProjectCreateRequest{Project: proj})
+		require.NoError(t, err)
+		assert.Equal(t, "Team A workloads", res.Spec.Description)
+	})
+}
+
+func TestProjectServer_WildcardPolicy(t *testing.T) {
+	newProjectServer := func(t *testing.T, policy string, objects ...runtime.Object) *Server {
+		t.Helper()
+		return newTestSettingsProjectServer(t, map[string]string{"projects.wildcardPolicy": policy}, objects...)
+	}
+	wildcardProject := func() *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "wildcard", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"*"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			},
+		}
+	}
+
+	t.Run("Unguarded", func(t *testing.T) {
+		_, err := newProjectServer(t, "").Create(t.Context(), &project.ProjectCreateRequest{Project: wildcardProject()})
+		require.NoError(t, err)
+	})
+
+	t.Run("Warn", func(t *testing.T) {
+		_, err := newProjectServer(t, "warn").Create(t.Context(), &project.ProjectCreateRequest{Project: wildcardProject()})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreate", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: wildcardProject()})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "permits everything with the source repository '*' and the destination server '*', name '' and namespace '*'")
+	})
+
+	t.Run("RejectCreateAllowed", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: wildcardProject(), AllowWildcard: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreateAllowedWithoutOverride", func(t *testing.T) {
+		projectServer := newProjectServer(t, "reject")
+		ctx := withoutProjectOverride(t, projectServer)
+		_, err := projectServer.Create(ctx, &project.ProjectCreateRequest{Project: wildcardProject(), AllowWildcard: true})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "explicitly allowed by a user permitted to override the project")
+	})
+
+	t.Run("RejectUpdateAllowedWithoutOverride", func(t *testing.T) {
+		existing := wildcardProject()
+		existing.Spec.SourceRepos = []string{"https://github.com/argoproj/argo-cd"}
+		projectServer := newProjectServer(t, "reject", existing)
+		ctx := withoutProjectOverride(t, projectServer)
+		_, err := projectServer.Update(ctx, &project.ProjectUpdateRequest{Project: wildcardProject(), AllowWildcard: true})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("RejectUpdateAddingWildcard", func(t *testing.T) {
+		existing := wildcardProject()
+		existing.Spec.SourceRepos = []string{"https://github.com/argoproj/argo-cd"}
+		updated := wildcardProject()
+		_, err := newProjectServer(t, "reject", existing).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "permits everything with the source repository '*', which")
+	})
+
+	t.Run("RejectUpdateRemovingWildcard", func(t *testing.T) {
+		updated := wildcardProject()
+		updated.Spec.SourceRepos = []string{"https://github.com/argoproj/argo-cd"}
+		_, err := newProjectServer(t, "reject", wildcardProject()).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectUpdateKeepingWildcard", func(t *testing.T) {
+		updated := wildcardProject()
+		updated.Spec.Description = "Still a wildcard"
+		res, err := newProjectServer(t, "reject", wildcardProject()).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "Still a wildcard", res.Spec.Description)
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "deny").Create(t.Context(), &project.ProjectCreateRequest{Project: wildcardProject()})
+		require.ErrorContains(t, err, "error getting projects.wildcardPolicy setting")
+	})
+}
+
+func TestProjectServer_AdminRolePolicy(t *testing.T) {
+	newProjectServer := func(t *testing.T, policy string, objects ...runtime.Object) *Server {
+		t.Helper()
+		return newTestSettingsProjectServer(t, map[string]string{"projects.adminRolePolicy": policy}, objects...)
+	}
+	adminRoleProject := func(policies ...string) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-role", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				Roles: []v1alpha1.ProjectRole{{Name: "deployer", Policies: policies}},
+			},
+		}
+	}
+	const (
+		readPolicy  = "p, proj:admin-role:deployer, applications, get, admin-role/*, allow"
+		adminPolicy = "p, proj:admin-role:deployer, applications, *, admin-role/*, allow"
+	)
+
+	t.Run("Unguarded", func(t *testing.T) {
+		_, err := newProjectServer(t, "").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy)})
+		require.NoError(t, err)
+	})
+
+	t.Run("Warn", func(t *testing.T) {
+		_, err := newProjectServer(t, "warn").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy)})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreate", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy)})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "has role policies allowing every action on every object")
+		assert.Contains(t, err.Error(), adminPolicy)
+	})
+
+	t.Run("RejectCreateNarrowPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(readPolicy)})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreateAllowed", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy), AllowAdminRole: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectUpdateAddingPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject", adminRoleProject(readPolicy)).Update(t.Context(), &project.ProjectUpdateRequest{Project: adminRoleProject(readPolicy, adminPolicy)})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), adminPolicy)
+	})
+
+	t.Run("RejectCreateAllowedWithoutOverride", func(t *testing.T) {
+		projectServer := newProjectServer(t, "reject")
+		ctx := withoutProjectOverride(t, projectServer)
+		_, err := projectServer.Create(ctx, &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy), AllowAdminRole: true})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "explicitly allowed by a user permitted to override the project")
+	})
+
+	t.Run("RejectUpdateAddingPolicyAllowedWithoutOverride", func(t *testing.T) {
+		projectServer := newProjectServer(t, "reject", adminRoleProject(readPolicy))
+		ctx := withoutProjectOverride(t, projectServer)
+		_, err := projectServer.Update(ctx, &project.ProjectUpdateRequest{Project: adminRoleProject(readPolicy, adminPolicy), AllowAdminRole: true})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), adminPolicy)
+	})
+
+	t.Run("RejectUpdateAddingPolicyAllowed", func(t *testing.T) {
+		res, err := newProjectServer(t, "reject", adminRoleProject(readPolicy)).Update(t.Context(), &project.ProjectUpdateRequest{Project: adminRoleProject(readPolicy, adminPolicy), AllowAdminRole: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{readPolicy, adminPolicy}, res.Spec.Roles[0].Policies)
+	})
+
+	t.Run("RejectUpdateRespacingPolicy", func(t *testing.T) {
+		respaced := "p,proj:admin-role:deployer,applications,  *,admin-role/*,allow"
+		_, err := newProjectServer(t, "reject", adminRoleProject(adminPolicy)).Update(t.Context(), &project.ProjectUpdateRequest{Project: adminRoleProject(respaced)})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreateRespacedPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject("p,proj:admin-role:deployer,applications,*,admin-role/*,allow")})
+		require.ErrorContains(t, err, adminPolicy)
+	})
+
+	t.Run("RejectUpdateKeepingPolicy", func(t *testing.T) {
+		updated := adminRoleProject(adminPolicy)
+		updated.Spec.Description = "Still an admin role"
+		res, err := newProjectServer(t, "reject", adminRoleProject(adminPolicy)).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "Still an admin role", res.Spec.Description)
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "deny").Create(t.Context(), &project.ProjectCreateRequest{Project: adminRoleProject(adminPolicy)})
+		require.ErrorContains(t, err, "error getting projects.adminRolePolicy setting")
+	})
+}
+
+func TestProjectServer_NeverFiringSyncWindowPolicy(t *testing.T) {
+	newProjectServer := func(t *testing.T, policy string, objects ...runtime.Object) *Server {
+		t.Helper()
+		return newTestSettingsProjectServer(t, map[string]string{"projects.neverFiringSyncWindowPolicy": policy}, objects...)
+	}
+	windowsProject := func(schedules ...string) *v1alpha1.AppProject {
+		proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "windows", Namespace: testNamespace}}
+		for _, schedule := range schedules {
+			proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, &v1alpha1.SyncWindow{Kind: "deny", Schedule: schedule, Duration: "1h", Applications: []string{"*"}})
+		}
+		return proj
+	}
+	const (
+		daily    = "0 22 * * *"
+		february = "0 0 30 2 *"
+	)
+
+	t.Run("WarnByDefault", func(t *testing.T) {
+		_, err := newProjectServer(t, "").Create(t.Context(), &project.ProjectCreateRequest{Project: windowsProject(february)})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectCreate", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: windowsProject(daily, february)})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "has a deny sync window with schedule '0 0 30 2 *' which does not fire within the next five years")
+	})
+
+	t.Run("RejectCreateFiring", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject").Create(t.Context(), &project.ProjectCreateRequest{Project: windowsProject(daily)})
+		require.NoError(t, err)
+	})
+
+	t.Run("RejectUpdateAddingWindow", func(t *testing.T) {
+		_, err := newProjectServer(t, "reject", windowsProject(daily)).Update(t.Context(), &project.ProjectUpdateRequest{Project: windowsProject(daily, february)})
+		require.ErrorContains(t, err, february)
+	})
+
+	t.Run("RejectUpdateKeepingWindow", func(t *testing.T) {
+		updated := windowsProject(february, daily)
+		updated.Spec.Description = "Existing window"
+		res, err := newProjectServer(t, "reject", windowsProject(february)).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "Existing window", res.Spec.Description)
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		_, err := newProjectServer(t, "deny").Create(t.Context(), &project.ProjectCreateRequest{Project: windowsProject(daily)})
+		require.ErrorContains(t, err, "error getting projects.neverFiringSyncWindowPolicy setting")
+	})
+}
+
+func TestProjectServer_AmbiguousDestinationServiceAccounts(t *testing.T) {
+	const server = "https://kubernetes.default.svc"
+	dsaProject := func(dsas ...v1alpha1.ApplicationDestinationServiceAccount) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "dsas", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				Destinations:               []v1alpha1.ApplicationDestination{{Server: server, Namespace: "*"}},
+				DestinationServiceAccounts: dsas,
+			},
+		}
+	}
+	team := v1alpha1.ApplicationDestinationServiceAccount{Server: server, Namespace: "team-*", DefaultServiceAccount: "team-sa"}
+	prod := v1alpha1.ApplicationDestinationServiceAccount{Server: server, Namespace: "*-prod", DefaultServiceAccount: "prod-sa"}
+	apps := v1alpha1.ApplicationDestinationServiceAccount{Server: server, Namespace: "app-*", DefaultServiceAccount: "app-sa"}
+
+	t.Run("RejectCreate", func(t *testing.T) {
+		_, err := newTestSettingsProjectServer(t, nil).Create(t.Context(), &project.ProjectCreateRequest{Project: dsaProject(team, prod)})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "destinationServiceAccounts 'https://kubernetes.default.svc/team-*' and 'https://kubernetes.default.svc/*-prod' are equally specific")
+	})
+
+	t.Run("RejectUpdateAddingPair", func(t *testing.T) {
+		_, err := newTestSettingsProjectServer(t, nil, dsaProject(team)).Update(t.Context(), &project.ProjectUpdateRequest{Project: dsaProject(team, prod)})
+		require.ErrorContains(t, err, "are equally specific")
+	})
+
+	t.Run("UpdateKeepingPair", func(t *testing.T) {
+		updated := dsaProject(team, prod, apps)
+		updated.Spec.Description = "Existing pair"
+		res, err := newTestSettingsProjectServer(t, nil, dsaProject(team, prod)).Update(t.Context(), &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Equal(t, "Existing pair", res.Spec.Description)
+	})
+
+	t.Run("RejectUpdateChangingPair", func(t *testing.T) {
+		changed := prod
+		changed.DefaultServiceAccount = "other-sa"
+		_, err := newTestSettingsProjectServer(t, nil, dsaProject(team, prod)).Update(t.Context(), &project.ProjectUpdateRequest{Project: dsaProject(team, changed)})
+		require.ErrorContains(t, err, "are equally specific")
+	})
+}
+
+// newTestSettingsProjectServer returns a project server whose argocd-cm has the given data, and whose project clientset
+// has the given objects
+func newTestSettingsProjectServer(t *testing.T, argoCDCMData map[string]string, objects ...runtime.Object) *Server {
+	t.Helper()
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: argoCDCMData,
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+	return NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(objects...), newEnforcer(kubeclientset), sync.NewKeyLock(), nil, nil, nil, settingsMgr, argoDB, testEnableEventList)
+}
+
 func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
@@ -754,3 +1325,145 @@ func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
 	})
 	return enforcer
 }
+
+// withoutProjectOverride restricts the project server to a caller which may do everything but override projects, and
+// returns the context of that caller
+func withoutProjectOverride(t *testing.T, s *Server) context.Context {
+	t.Helper()
+	s.enf.SetDefaultRole("")
+	s.enf.SetClaimsEnforcerFunc(func(_ jwt.Claims, rvals ...any) bool {
+		return rvals[2] != rbac.ActionOverride
+	})
+	//nolint:staticcheck
+	return context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "developer"})
+}
+
+// fakeProjectWatchServer is a stubbed watch stream collecting the sent events
+type fakeProjectWatchServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *v1alpha1.AppProjectWatchEvent
+}
+
+func (s *fakeProjectWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeProjectWatchServer) Send(event *v1alpha1.AppProjectWatchEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestProjectServer_Watch(t *testing.T) {
+	existingProj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: testNamespace}}
+	secretProj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: testNamespace}}
+	appClientset := apps.NewSimpleClientset(existingProj, secretProj)
+	factory := informer.NewSharedInformerFactoryWithOptions(appClientset, 0, informer.WithNamespace(testNamespace))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	go projInformer.Run(t.Context().Done())
+	require.True(t, k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced))
+
+	kubeclientset := fake.NewClientset()
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	// the caller may get every project but the secret one
+	enforcer.SetClaimsEnforcerFunc(func(_ jwt.Claims, rvals ...any) bool {
+		return rvals[3] != "secret"
+	})
+	projectServer := NewServer(testNamespace, kubeclientset, appClientset, enforcer, sync.NewKeyLock(), nil, nil, projInformer, nil, nil, testEnableEventList)
+
+	ctx, cancel := context.WithCancel(context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "alice"}))
+	ws := &fakeProjectWatchServer{ctx: ctx, events: make(chan *v1alpha1.AppProjectWatchEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- projectServer.Watch(&project.ProjectQuery{}, ws)
+	}()
+	nextEvent := func() *v1alpha1.AppProjectWatchEvent {
+		t.Helper()
+		select {
+		case event := <-ws.events:
+			return event
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a project event")
+			return nil
+		}
+	}
+
+	// the existing projects are sent first
+	event := nextEvent()
+	assert.Equal(t, watch.Added, event.Type)
+	assert.Equal(t, "existing", event.AppProject.Name)
+
+	modifiedProj := existingProj.DeepCopy()
+	modifiedProj.Spec.Description = "modified"
+	_, err := appClientset.ArgoprojV1alpha1().AppProjects(testNamespace).Update(t.Context(), modifiedProj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	event = nextEvent()
+	assert.Equal(t, watch.Modified, event.Type)
+	assert.Equal(t, "modified", event.AppProject.Spec.Description)
+
+	require.NoError(t, appClientset.ArgoprojV1alpha1().AppProjects(testNamespace).Delete(t.Context(), "existing", metav1.DeleteOptions{}))
+	event = nextEvent()
+	assert.Equal(t, watch.Deleted, event.Type)
+	assert.Equal(t, "existing", event.AppProject.Name)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Empty(t, ws.events)
+}
+
+func TestProjectServer_GetDetailedProject_GlobalProjects(t *testing.T) {
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"globalProjects": `
+- projectName: global-prod
+  labelSelector:
+    matchLabels:
+      env: prod
+- projectName: global-staging
+  labelSelector:
+    matchLabels:
+      env: staging
+`,
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "app-team", Namespace: testNamespace, Labels: map[string]string{"env": "prod"}}}
+	globalProd := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "global-prod", Namespace: testNamespace}}
+	globalStaging := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "global-staging", Namespace: testNamespace}}
+	appClientset := apps.NewSimpleClientset(proj, globalProd, globalStaging)
+	factory := informer.NewSharedInformerFactoryWithOptions(appClientset, 0, informer.WithNamespace(testNamespace))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	go projInformer.Run(t.Context().Done())
+	require.True(t, k8scache.WaitForCacheSync(t.Context().Done(), projInformer.HasSynced))
+
+	projectServer := NewServer(testNamespace, kubeclientset, appClientset, newEnforcer(kubeclientset), sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+	// only the global project whose selector matches the labels of the project is listed
+	res, err := projectServer.GetDetailedProject(t.Context(), &project.ProjectQuery{Name: "app-team"})
+	require.NoError(t, err)
+	require.Len(t, res.GlobalProjects, 1)
+	assert.Equal(t, "global-prod", res.GlobalProjects[0].Name)
+
+	// a project matching no selector has no global projects
+	res, err = projectServer.GetDetailedProject(t.Context(), &project.ProjectQuery{Name: "global-staging"})
+	require.NoError(t, err)
+	assert.Empty(t, res.GlobalProjects)
+}
@@ -0,0 +1,36 @@
+package project
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+)
+
+// resourceScopeDiscoveryTTL is how long the API resources discovered to validate the resource scopes are reused
+const resourceScopeDiscoveryTTL = 10 * time.Minute
+
+// resourceScopeDiscovery caches the API resources of the cluster Argo CD runs in, so that creating or updating a project
+// does not query the API server each time. The cache is discarded once it is older than resourceScopeDiscoveryTTL, to
+// pick up the kinds added since, e.g. by installing a CRD.
+type resourceScopeDiscovery struct {
+	disco  discovery.CachedDiscoveryInterface
+	lock   sync.Mutex
+	expiry time.Time
+}
+
+func newResourceScopeDiscovery(disco discovery.DiscoveryInterface) *resourceScopeDiscovery {
+	return &resourceScopeDiscovery{disco: memory.NewMemCacheClient(disco)}
+}
+
+// get returns the caching discovery client, after discarding the cache if it expired
+func (d *resourceScopeDiscovery) get() discovery.DiscoveryInterface {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if now := time.Now(); !now.Before(d.expiry) {
+		d.disco.Invalidate()
+		d.expiry = now.Add(resourceScopeDiscoveryTTL)
+	}
+	return d.disco
+}
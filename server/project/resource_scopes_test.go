@@ -0,0 +1,46 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakedisco "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+func TestResourceScopeDiscovery(t *testing.T) {
+	fakeDisco := fake.NewClientset().Discovery().(*fakedisco.FakeDiscovery)
+	fakeDisco.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Kind: "Namespace", Namespaced: false}},
+	}}
+	disco := newResourceScopeDiscovery(fakeDisco)
+	spec := v1alpha1.AppProjectSpec{ClusterResourceWhitelist: []metav1.GroupKind{{Group: "example.com", Kind: "Widget"}}}
+
+	warnings, err := argo.ResourceScopeWarnings(disco.get(), spec)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	discovered := len(fakeDisco.Actions())
+	require.Positive(t, discovered)
+
+	// the discovered resources are reused until the cache expires
+	fakeDisco.Resources = append(fakeDisco.Resources, &metav1.APIResourceList{
+		GroupVersion: "example.com/v1",
+		APIResources: []metav1.APIResource{{Kind: "Widget", Namespaced: true}},
+	})
+	warnings, err = argo.ResourceScopeWarnings(disco.get(), spec)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Len(t, fakeDisco.Actions(), discovered)
+
+	disco.expiry = time.Now()
+	warnings, err = argo.ResourceScopeWarnings(disco.get(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster resource whitelist entry 'example.com/Widget' is a namespaced kind and has no effect"}, warnings)
+}
@@ -1,6 +1,8 @@
 package rbacpolicy
 
 import (
+	"fmt"
+	"hash/fnv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -18,6 +20,8 @@ import (
 type RBACPolicyEnforcer struct {
 	enf        *rbac.Enforcer
 	projLister applister.AppProjectNamespaceLister
+	appLister  applister.ApplicationLister
+	namespace  string
 	scopes     []string
 }
 
@@ -34,6 +38,13 @@ func (p *RBACPolicyEnforcer) SetScopes(scopes []string) {
 	p.scopes = scopes
 }
 
+// SetAppLister sets the application lister used to evaluate the label conditions of project role policies. Applications
+// referenced without a namespace are looked up in the given namespace.
+func (p *RBACPolicyEnforcer) SetAppLister(appLister applister.ApplicationLister, namespace string) {
+	p.appLister = appLister
+	p.namespace = namespace
+}
+
 func (p *RBACPolicyEnforcer) GetScopes() []string {
 	scopes := p.scopes
 	if scopes == nil {
@@ -57,9 +68,23 @@ func GetProjectRoleFromSubject(subject string) (string, string, bool) {
 
 // EnforceClaims is an RBAC claims enforcer specific to the Argo CD API server
 func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool {
+	ok, _ := p.enforceClaims(claims, func(enf rbac.CasbinEnforcer, vals ...any) (bool, []string) {
+		return p.enf.EnforceWithCustomEnforcer(enf, vals...), nil
+	}, rvals...)
+	return ok
+}
+
+// ExplainClaims is like EnforceClaims, but additionally returns the policy which decided the enforcement, if any
+func (p *RBACPolicyEnforcer) ExplainClaims(claims jwt.Claims, rvals ...any) (bool, []string) {
+	return p.enforceClaims(claims, p.enf.EnforceExplainWithCustomEnforcer, rvals...)
+}
+
+// enforceClaims enforces the claims with the given enforce function, which returns the deciding policy if it explains
+// the enforcement
+func (p *RBACPolicyEnforcer) enforceClaims(claims jwt.Claims, enforce func(enf rbac.CasbinEnforcer, vals ...any) (bool, []string), rvals ...any) (bool, []string) {
 	mapClaims, err := jwtutil.MapClaims(claims)
 	if err != nil {
-		return false
+		return false, nil
 	}
 
 	subject := jwtutil.GetUserIdentifier(mapClaims)
@@ -67,23 +92,25 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	// into consideration the project's token and group bindings
 	var runtimePolicy string
 	var projName string
+	var cacheKey string
 	proj := p.getProjectFromRequest(rvals...)
 	if proj != nil {
 		if IsProjectSubject(subject) {
-			return p.enforceProjectToken(subject, proj, rvals...)
+			return p.enforceProjectToken(subject, proj, enforce, rvals...)
 		}
-		runtimePolicy = proj.ProjectPoliciesString()
+		cacheKey, runtimePolicy = p.getProjectPolicy(proj, rvals...)
 		projName = proj.Name
 	}
 
 	// NOTE: This calls prevent multiple creation of the wrapped enforcer
-	enforcer := p.enf.CreateEnforcerWithRuntimePolicy(projName, runtimePolicy)
+	enforcer := p.enf.CreateEnforcerWithRuntimePolicy(cacheKey, runtimePolicy)
 
 	// Check the subject. This is typically the 'admin' case.
 	// NOTE: the call to EnforceWithCustomEnforcer will also consider the default role
 	vals := append([]any{subject}, rvals[1:]...)
-	if p.enf.EnforceWithCustomEnforcer(enforcer, vals...) {
-		return true
+	ok, policy := enforce(enforcer, vals...)
+	if ok {
+		return true, policy
 	}
 
 	scopes := p.scopes
@@ -97,15 +124,19 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	groupingPolicies, err := enforcer.GetGroupingPolicy()
 	if err != nil {
 		log.WithError(err).Error("failed to get grouping policy")
-		return false
+		return false, nil
 	}
 	for gidx := range groups {
 		for gpidx := range groupingPolicies {
 			// Prefilter user groups by groups defined in the model
 			if groupingPolicies[gpidx][0] == groups[gidx] {
 				vals := append([]any{groups[gidx]}, rvals[1:]...)
-				if p.enf.EnforceWithCustomEnforcer(enforcer, vals...) {
-					return true
+				ok, groupPolicy := enforce(enforcer, vals...)
+				if ok {
+					return true, groupPolicy
+				}
+				if policy == nil {
+					policy = groupPolicy
 				}
 				break
 			}
@@ -113,7 +144,7 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	}
 	logCtx := log.WithFields(log.Fields{"claims": claims, "rval": rvals, "subject": subject, "groups": groups, "project": projName, "scopes": scopes})
 	logCtx.Debug("enforce failed")
-	return false
+	return false, policy
 }
 
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
@@ -146,17 +177,66 @@ func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppPr
 }
 
 // enforceProjectToken will check to see the valid token has not yet been revoked in the project
-func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.AppProject, rvals ...any) bool {
+func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.AppProject, enforce func(enf rbac.CasbinEnforcer, vals ...any) (bool, []string), rvals ...any) (bool, []string) {
 	subjectSplit := strings.Split(subject, ":")
 	if len(subjectSplit) != 3 {
-		return false
+		return false, nil
 	}
 	projName, _ := subjectSplit[1], subjectSplit[2]
 	if projName != proj.Name {
 		// this should never happen (we generated a project token for a different project)
-		return false
+		return false, nil
 	}
 
 	vals := append([]any{subject}, rvals[1:]...)
-	return p.enf.EnforceRuntimePolicy(proj.Name, proj.ProjectPoliciesString(), vals...)
+	cacheKey, policy := p.getProjectPolicy(proj, rvals...)
+	return enforce(p.enf.CreateEnforcerWithRuntimePolicy(cacheKey, policy), vals...)
+}
+
+// getProjectPolicy returns the runtime policy of the project for the RBAC request, along with the key the enforcer
+// built from it is cached under. The label conditions of the role policies are evaluated against the labels of the
+// requested application. If the application cannot be looked up, e.g. because it does not exist yet or the informer
+// has not caught up with it, the conditions fail closed, see AppProject.ProjectPoliciesString.
+func (p *RBACPolicyEnforcer) getProjectPolicy(proj *v1alpha1.AppProject, rvals ...any) (string, string) {
+	if !proj.HasConditionalPolicies() {
+		return proj.Name, proj.ProjectPoliciesString()
+	}
+	policy := proj.ProjectPoliciesString()
+	if app := p.getApplicationFromRequest(rvals...); app != nil {
+		policy = proj.ApplicationPoliciesString(app.Labels)
+	}
+	// cache the enforcers of the different outcomes of the conditions separately, so that requests for applications
+	// with different labels do not keep evicting each other
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(policy))
+	return fmt.Sprintf("%s/%x", proj.Name, hash.Sum32()), policy
+}
+
+// getApplicationFromRequest parses the application from an RBAC request for the applications resource and returns it
+// (if it exists)
+func (p *RBACPolicyEnforcer) getApplicationFromRequest(rvals ...any) *v1alpha1.Application {
+	if p.appLister == nil || len(rvals) != 4 {
+		return nil
+	}
+	if res, ok := rvals[1].(string); !ok || res != rbac.ResourceApplications {
+		return nil
+	}
+	obj, ok := rvals[3].(string)
+	if !ok {
+		return nil
+	}
+	namespace, name := p.namespace, ""
+	switch objSplit := strings.Split(obj, "/"); len(objSplit) {
+	case 2:
+		name = objSplit[1]
+	case 3:
+		namespace, name = objSplit[1], objSplit[2]
+	default:
+		return nil
+	}
+	app, err := p.appLister.Applications(namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return app
 }
@@ -9,9 +9,11 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 )
@@ -95,6 +97,37 @@ func TestEnforceAllPolicies(t *testing.T) {
 	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
 }
 
+func TestExplainClaims(t *testing.T) {
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetUserPolicy(`p, alice, applications, create, my-proj/*, allow` + "\n" + `p, my-org:my-team, applications, delete, my-proj/*, deny`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainFunc(rbacEnf.ExplainClaims)
+
+	ok, policy := enf.EnforceExplain(jwt.MapClaims{"sub": "alice"}, "applications", "create", "my-proj/my-app")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"alice", "applications", "create", "my-proj/*", "allow"}, policy)
+
+	// the group is granted by the project role
+	ok, policy = enf.EnforceExplain(jwt.MapClaims{"groups": []string{"my-org:my-team"}}, "logs", "get", "my-proj/my-app")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"proj:my-proj:my-role", "logs", "get", "my-proj/*", "allow"}, policy)
+
+	ok, policy = enf.EnforceExplain(jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234}, "exec", "create", "my-proj/my-app")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"proj:my-proj:my-role", "exec", "create", "my-proj/*", "allow"}, policy)
+
+	ok, policy = enf.EnforceExplain(jwt.MapClaims{"groups": []string{"my-org:my-team"}}, "applications", "delete", "my-proj/my-app")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"my-org:my-team", "applications", "delete", "my-proj/*", "deny"}, policy)
+
+	ok, policy = enf.EnforceExplain(jwt.MapClaims{"sub": "cathy"}, "applications", "create", "my-proj/my-app")
+	assert.False(t, ok)
+	assert.Empty(t, policy)
+}
+
 func TestEnforceActionActions(t *testing.T) {
 	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
@@ -128,6 +161,55 @@ p, cam, applications, %s/argoproj.io/Rollout/resume, my-proj/*, allow
 	assert.False(t, enf.Enforce(claims, "applications", rbac.ActionAction+"/argoproj.io/Rollout/resume", "my-proj/my-app"))
 }
 
+func TestEnforceLabelConditions(t *testing.T) {
+	proj := newFakeProj()
+	proj.Spec.Roles = append(proj.Spec.Roles, argoappv1.ProjectRole{
+		Name: "payments",
+		Policies: []string{
+			"p, proj:my-proj:payments, applications, sync, my-proj/*;team=payments, allow",
+			"p, proj:my-proj:payments, applications, get, my-proj/*, allow",
+			"p, proj:my-proj:payments, applications, get, my-proj/*;env=prod, deny",
+		},
+		Groups:    []string{"my-org:payments"},
+		JWTTokens: []argoappv1.JWTToken{{IssuedAt: 1234}},
+	})
+	proj.Status.JWTTokensByRole["payments"] = argoappv1.JWTTokens{Items: []argoappv1.JWTToken{{IssuedAt: 1234}}}
+	require.NoError(t, proj.ValidateProject())
+
+	newApp := func(namespace, name string, labels map[string]string) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       argoappv1.ApplicationSpec{Project: "my-proj"},
+		}
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, indexer.Add(newApp(test.FakeArgoCDNamespace, "labeled", map[string]string{"team": "payments"})))
+	require.NoError(t, indexer.Add(newApp(test.FakeArgoCDNamespace, "unlabeled", nil)))
+	require.NoError(t, indexer.Add(newApp("other-ns", "labeled", map[string]string{"team": "payments", "env": "prod"})))
+
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	rbacEnf := NewRBACPolicyEnforcer(enf, test.NewFakeProjLister(proj))
+	rbacEnf.SetAppLister(applister.NewApplicationLister(indexer), test.FakeArgoCDNamespace)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	for _, claims := range []jwt.MapClaims{
+		{"sub": "proj:my-proj:payments", "iat": 1234},
+		{"sub": "alice", "groups": []string{"my-org:payments"}},
+	} {
+		assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/labeled"))
+		assert.False(t, enf.Enforce(claims, "applications", "sync", "my-proj/unlabeled"))
+		assert.False(t, enf.Enforce(claims, "applications", "sync", "my-proj/missing"))
+		assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/other-ns/labeled"))
+
+		assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/labeled"))
+		assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/unlabeled"))
+		assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/other-ns/labeled"))
+		// the labels of an application which cannot be looked up are unknown, so conditional deny rules apply to it
+		assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/missing"))
+	}
+}
+
 func TestInvalidatedCache(t *testing.T) {
 	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
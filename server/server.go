@@ -3,6 +3,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -50,6 +51,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
@@ -122,6 +124,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v3/util/notification/settings"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
+	"github.com/argoproj/argo-cd/v3/util/rand"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	util_session "github.com/argoproj/argo-cd/v3/util/session"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
@@ -205,6 +208,9 @@ type ArgoCDServer struct {
 	Shutdown           func()
 	terminateRequested atomic.Bool
 	available          atomic.Bool
+	// gatewayToken authenticates the client address which the gRPC gateway passes to the gRPC server, so that it cannot
+	// be set by other clients
+	gatewayToken string
 }
 
 type ArgoCDServerOpts struct {
@@ -327,7 +333,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
+	policyEnf.SetAppLister(appLister, opts.Namespace)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	enf.SetClaimsExplainFunc(policyEnf.ExplainClaims)
 
 	staticFS, err := fs.Sub(ui.Embedded, "dist/app")
 	errorsutil.CheckError(err)
@@ -362,6 +370,8 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	noopShutdown := func() {
 		log.Error("API Server Shutdown function called but server is not started yet.")
 	}
+	gatewayToken, err := rand.String(32)
+	errorsutil.CheckError(err)
 
 	a := &ArgoCDServer{
 		ArgoCDServerOpts:   opts,
@@ -386,6 +396,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		extensionManager:   em,
 		Shutdown:           noopShutdown,
 		stopCh:             make(chan os.Signal, 1),
+		gatewayToken:       gatewayToken,
 	}
 
 	err = a.logInClusterWarnings()
@@ -940,6 +951,7 @@ func (server *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResour
 		"/repocreds.RepoCredsService/CreateWriteRepositoryCredentials": true,
 		"/repocreds.RepoCredsService/UpdateWriteRepositoryCredentials": true,
 		"/application.ApplicationService/PatchResource":                true,
+		"/project.ProjectService/VerifyToken":                          true,
 		// Remove from logs both because the contents are sensitive and because they may be very large.
 		"/application.ApplicationService/GetManifestsWithFiles": true,
 	}
@@ -1043,6 +1055,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 
 	applicationSetService := applicationset.NewServer(
 		a.db,
+		a.settingsMgr,
 		a.KubeClientset,
 		a.DynamicClientset,
 		a.KubeControllerClientset,
@@ -1189,7 +1202,9 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(server.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	gwHeaderOpts := runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher)
+	gwClientIPOpts := runtime.WithMetadata(server.gatewayClientMetadata)
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwHeaderOpts, gwClientIPOpts)
 
 	var handler http.Handler = gwmux
 	if server.EnableGZip {
@@ -1560,6 +1575,9 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 	if err != nil {
 		return claims, "", status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
 	}
+	if err := server.sessionMgr.VerifyTokenSource(claims, server.clientIP(ctx)); err != nil {
+		return nil, "", status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+	}
 
 	// Some SSO implementations (Okta) require a call to
 	// the OIDC user info path to get attributes like groups
@@ -1591,6 +1609,68 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 	return groupClaims, newToken, nil
 }
 
+const (
+	// gatewayClientIPKey is the metadata key of the address of the client which sent a request to the gRPC gateway
+	gatewayClientIPKey = "x-argocd-gateway-client-ip"
+	// gatewayTokenKey is the metadata key of the token authenticating the client address set by the gRPC gateway
+	gatewayTokenKey = "x-argocd-gateway-token"
+)
+
+// gatewayHeaderMatcher passes the HTTP headers to the gRPC server like the default matcher of the gRPC gateway, except
+// for forwarded addresses and gateway metadata a client sets with the Grpc-Metadata- prefix
+func gatewayHeaderMatcher(key string) (string, bool) {
+	h, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok {
+		return "", false
+	}
+	switch strings.ToLower(h) {
+	case "x-forwarded-for", gatewayClientIPKey, gatewayTokenKey:
+		return "", false
+	}
+	return h, true
+}
+
+// gatewayClientMetadata returns the metadata passing the address of the client which sent the request to the gRPC
+// gateway on to the gRPC server
+func (server *ArgoCDServer) gatewayClientMetadata(_ context.Context, req *http.Request) metadata.MD {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return nil
+	}
+	return metadata.Pairs(gatewayClientIPKey, host, gatewayTokenKey, server.gatewayToken)
+}
+
+// clientIP returns the address of the client which sent the request. Requests proxied by the gRPC gateway of the API
+// server originate from the loopback interface, so the client address is taken from the metadata set by the gateway
+// instead, if it carries the token of the gateway. Forwarded addresses set by clients are never trusted.
+func (server *ArgoCDServer) clientIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return ip
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ip
+	}
+	tokens := md.Get(gatewayTokenKey)
+	clientIPs := md.Get(gatewayClientIPKey)
+	if len(tokens) != 1 || len(clientIPs) != 1 || server.gatewayToken == "" || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(server.gatewayToken)) != 1 {
+		return ip
+	}
+	if gatewayClientIP := net.ParseIP(clientIPs[0]); gatewayClientIP != nil {
+		return gatewayClientIP
+	}
+	return ip
+}
+
 // getToken extracts the token from gRPC metadata or cookie headers
 func getToken(md metadata.MD) string {
 	// check the "token" metadata
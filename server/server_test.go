@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -15,10 +16,12 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
@@ -542,6 +545,68 @@ func TestAuthenticate(t *testing.T) {
 	}
 }
 
+func TestClientIP(t *testing.T) {
+	server := &ArgoCDServer{gatewayToken: "gateway-token"}
+	withPeer := func(addr string) context.Context {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+		require.NoError(t, err)
+		return peer.NewContext(t.Context(), &peer.Peer{Addr: tcpAddr})
+	}
+
+	t.Run("NoPeer", func(t *testing.T) {
+		assert.Nil(t, server.clientIP(t.Context()))
+	})
+
+	t.Run("RemotePeer", func(t *testing.T) {
+		assert.Equal(t, "10.1.2.3", server.clientIP(withPeer("10.1.2.3:51234")).String())
+	})
+
+	t.Run("RemotePeerIgnoresGatewayMetadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(withPeer("10.1.2.3:51234"), metadata.Pairs(gatewayClientIPKey, "192.168.1.1", gatewayTokenKey, "gateway-token"))
+		assert.Equal(t, "10.1.2.3", server.clientIP(ctx).String())
+	})
+
+	t.Run("GatewayPeer", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(withPeer("127.0.0.1:51234"), metadata.Pairs(gatewayClientIPKey, "10.1.2.3", gatewayTokenKey, "gateway-token"))
+		assert.Equal(t, "10.1.2.3", server.clientIP(ctx).String())
+	})
+
+	t.Run("LoopbackPeerIgnoresForwardedFor", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(withPeer("127.0.0.1:51234"), metadata.Pairs("x-forwarded-for", "10.1.2.3"))
+		assert.Equal(t, "127.0.0.1", server.clientIP(ctx).String())
+	})
+
+	t.Run("LoopbackPeerWithWrongToken", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(withPeer("127.0.0.1:51234"), metadata.Pairs(gatewayClientIPKey, "10.1.2.3", gatewayTokenKey, "guessed"))
+		assert.Equal(t, "127.0.0.1", server.clientIP(ctx).String())
+	})
+
+	t.Run("GatewayPeerWithoutClientIP", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", server.clientIP(withPeer("127.0.0.1:51234")).String())
+	})
+}
+
+func TestGatewayClientMetadata(t *testing.T) {
+	server := &ArgoCDServer{gatewayToken: "gateway-token"}
+	mux := gwruntime.NewServeMux(gwruntime.WithIncomingHeaderMatcher(gatewayHeaderMatcher), gwruntime.WithMetadata(server.gatewayClientMetadata))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/session/userinfo", http.NoBody)
+	req.RemoteAddr = "10.1.2.3:51234"
+	// a client cannot pass forwarded addresses or gateway metadata to the gRPC server
+	req.Header.Set("Grpc-Metadata-X-Forwarded-For", "192.168.1.1")
+	req.Header.Set("Grpc-Metadata-X-Argocd-Gateway-Client-Ip", "192.168.1.1")
+	req.Header.Set("Grpc-Metadata-X-Argocd-Gateway-Token", "guessed")
+	req.Header.Set("Grpc-Metadata-Custom", "value")
+
+	ctx, err := gwruntime.AnnotateContext(t.Context(), mux, req)
+	require.NoError(t, err)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"10.1.2.3"}, md.Get(gatewayClientIPKey))
+	assert.Equal(t, []string{"gateway-token"}, md.Get(gatewayTokenKey))
+	assert.Equal(t, []string{"10.1.2.3"}, md.Get("x-forwarded-for"))
+	assert.Equal(t, []string{"value"}, md.Get("custom"))
+}
+
 func dexMockHandler(t *testing.T, url string) func(http.ResponseWriter, *http.Request) {
 	t.Helper()
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -94,6 +96,104 @@ func TestProjectCreation(t *testing.T) {
 	assert.Equal(t, newDescription, proj.Spec.Description)
 }
 
+func TestProjectCreationWithDestName(t *testing.T) {
+	fixture.EnsureCleanState(t)
+
+	projectName := "proj-" + fixture.Name()
+	_, err := fixture.RunCli("proj", "create", projectName,
+		"-d", "https://192.168.99.100:8443,default",
+		"--dest-name", "in-cluster,service")
+	require.NoError(t, err)
+
+	proj, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{
+		{Server: "https://192.168.99.100:8443", Namespace: "default"},
+		{Name: "in-cluster", Namespace: "service"},
+	}, proj.Spec.Destinations)
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--merge", "--dest-name", "in-cluster,guestbook")
+	require.NoError(t, err)
+
+	proj, err = fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{
+		{Server: "https://192.168.99.100:8443", Namespace: "default"},
+		{Name: "in-cluster", Namespace: "service"},
+		{Name: "in-cluster", Namespace: "guestbook"},
+	}, proj.Spec.Destinations)
+
+	_, err = fixture.RunCli("proj", "create", "proj-"+fixture.Name()+"-url", "--dest-name", "https://192.168.99.100:8443,default")
+	require.ErrorContains(t, err, "Use --dest for server-based destinations")
+}
+
+func TestProjectSetNoOp(t *testing.T) {
+	fixture.EnsureCleanState(t)
+
+	projectName := "proj-" + fixture.Name()
+	_, err := fixture.RunCli("proj", "create", projectName, "--description", "old")
+	require.NoError(t, err)
+
+	countUpdateEvents := func() int {
+		proj, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+		require.NoError(t, err)
+		list, err := fixture.KubeClientset.CoreV1().Events(fixture.TestNamespace()).List(t.Context(), metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(map[string]string{
+				"involvedObject.name": proj.Name,
+				"involvedObject.uid":  string(proj.UID),
+			}).String(),
+		})
+		require.NoError(t, err)
+		count := 0
+		for _, event := range list.Items {
+			if event.Reason == argo.EventReasonResourceUpdated {
+				count++
+			}
+		}
+		return count
+	}
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--description", "new")
+	require.NoError(t, err)
+	events := countUpdateEvents()
+	assert.Equal(t, 1, events)
+
+	// setting the same description again does not update the project
+	output, err := fixture.RunCli("proj", "set", projectName, "--description", "new")
+	require.NoError(t, err)
+	assert.Contains(t, output, "unchanged")
+	assert.Equal(t, events, countUpdateEvents())
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--description", "new", "--force")
+	require.NoError(t, err)
+	assert.Equal(t, events+1, countUpdateEvents())
+}
+
+func TestProjectCreationWithLabels(t *testing.T) {
+	fixture.EnsureCleanState(t)
+
+	projectName := "proj-" + fixture.Name()
+	_, err := fixture.RunCli("proj", "create", projectName,
+		"-d", "https://192.168.99.100:8443,default",
+		"-s", "https://github.com/argoproj/argo-cd.git",
+		"--label", "opt=me")
+	require.NoError(t, err)
+
+	proj, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "me", proj.Labels["opt"])
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--label", "team=a")
+	require.NoError(t, err)
+
+	proj, err = fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"opt": "me", "team": "a"}, proj.Labels)
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--label", "invalid key=a")
+	require.ErrorContains(t, err, "invalid label key")
+}
+
 func TestProjectDeletion(t *testing.T) {
 	fixture.EnsureCleanState(t)
 
@@ -110,6 +210,42 @@ func TestProjectDeletion(t *testing.T) {
 	assertProjHasEvent(t, proj, "delete", argo.EventReasonResourceDeleted)
 }
 
+func TestProjectImportPrune(t *testing.T) {
+	fixture.EnsureCleanState(t)
+
+	suffix := strconv.FormatInt(time.Now().Unix(), 10)
+	labels := map[string]string{"import-test": suffix}
+	for _, name := range []string{"proj-a-" + suffix, "proj-b-" + suffix} {
+		_, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Create(
+			t.Context(), &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	manifest := filepath.Join(t.TempDir(), "projects.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(fmt.Sprintf(`apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: proj-a-%s
+  labels:
+    import-test: "%s"
+spec:
+  description: imported
+`, suffix, suffix)), 0o644))
+
+	_, err := fixture.RunCli("proj", "import", manifest, "--prune", "-l", "import-test="+suffix)
+	require.ErrorContains(t, err, "pruning projects requires --yes")
+
+	_, err = fixture.RunCli("proj", "import", manifest, "--prune", "-l", "import-test="+suffix, "--yes")
+	require.NoError(t, err)
+
+	proj, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), "proj-a-"+suffix, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "imported", proj.Spec.Description)
+
+	_, err = fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), "proj-b-"+suffix, metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
 func TestSetProject(t *testing.T) {
 	fixture.EnsureCleanState(t)
 
@@ -142,6 +278,43 @@ func TestSetProject(t *testing.T) {
 	assertProjHasEvent(t, proj, "update", argo.EventReasonResourceUpdated)
 }
 
+func TestSetProjectDestinationsReplaceAndMerge(t *testing.T) {
+	fixture.EnsureCleanState(t)
+
+	projectName := "proj-" + strconv.FormatInt(time.Now().Unix(), 10)
+	_, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Create(
+		t.Context(), &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: projectName}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	getDestinations := func() []v1alpha1.ApplicationDestination {
+		proj, err := fixture.AppClientset.ArgoprojV1alpha1().AppProjects(fixture.TestNamespace()).Get(t.Context(), projectName, metav1.GetOptions{})
+		require.NoError(t, err)
+		return proj.Spec.Destinations
+	}
+
+	_, err = fixture.RunCli("proj", "set", projectName, "-d", "https://192.168.99.100:8443,default")
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://192.168.99.100:8443", Namespace: "default"}}, getDestinations())
+
+	// --merge appends and drops duplicates
+	_, err = fixture.RunCli("proj", "set", projectName, "--merge",
+		"-d", "https://192.168.99.100:8443,default",
+		"-d", "https://192.168.99.100:8443,service")
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{
+		{Server: "https://192.168.99.100:8443", Namespace: "default"},
+		{Server: "https://192.168.99.100:8443", Namespace: "service"},
+	}, getDestinations())
+
+	// --replace overwrites
+	_, err = fixture.RunCli("proj", "set", projectName, "--replace", "-d", "https://192.168.99.100:8443,other")
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://192.168.99.100:8443", Namespace: "other"}}, getDestinations())
+
+	_, err = fixture.RunCli("proj", "set", projectName, "--merge", "--replace", "-d", "https://192.168.99.100:8443,other")
+	require.ErrorContains(t, err, "none of the others can be")
+}
+
 func TestAddProjectDestination(t *testing.T) {
 	fixture.EnsureCleanState(t)
 
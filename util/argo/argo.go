@@ -21,6 +21,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -643,6 +644,12 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", spec.Destination.Server, spec.Destination.Namespace, spec.Project),
 			})
 		}
+		if missing := proj.MissingRequiredClusterLabels(destCluster); permitted && len(missing) > 0 {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application destination cluster '%s' is missing the labels %s required by project '%s'", destCluster.Server, strings.Join(missing, ", "), spec.Project),
+			})
+		}
 	} else if destCluster.Server == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: ErrDestinationMissing})
 	}
@@ -1050,13 +1057,23 @@ func GetDestinationCluster(ctx context.Context, destination argoappv1.Applicatio
 
 func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) []*argoappv1.AppProject {
 	gps, err := settingsManager.GetGlobalProjectsSettings()
-	globalProjects := make([]*argoappv1.AppProject, 0)
-
 	if err != nil {
 		log.Warnf("Failed to get global project settings: %v", err)
-		return globalProjects
+		return make([]*argoappv1.AppProject, 0)
+	}
+	globalProjects, err := MatchGlobalProjects(proj, gps, projLister.AppProjects(proj.Namespace).Get)
+	if err != nil {
+		log.Warnf("Failed to get global projects of project %s: %v", proj.Name, err)
 	}
+	return globalProjects
+}
 
+// MatchGlobalProjects returns the global projects of the project, i.e. the projects of the global project settings
+// whose label selector matches the labels of the project, getting them with getProject. Global projects which do not
+// exist are skipped. It is the counterpart of GetGlobalProjects for callers without an AppProject lister, and returns
+// the global projects found before an error.
+func MatchGlobalProjects(proj *argoappv1.AppProject, gps []settings.GlobalProjectSettings, getProject func(name string) (*argoappv1.AppProject, error)) ([]*argoappv1.AppProject, error) {
+	globalProjects := make([]*argoappv1.AppProject, 0)
 	for _, gp := range gps {
 		// The project itself is not its own the global project
 		if proj.Name == gp.ProjectName {
@@ -1065,41 +1082,36 @@ func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.App
 
 		selector, err := metav1.LabelSelectorAsSelector(&gp.LabelSelector)
 		if err != nil {
-			break
+			return globalProjects, fmt.Errorf("error parsing label selector of global project %s: %w", gp.ProjectName, err)
 		}
-		// Get projects which match the label selector, then see if proj is a match
-		projList, err := projLister.AppProjects(proj.Namespace).List(selector)
-		if err != nil {
-			break
-		}
-		var matchMe bool
-		for _, item := range projList {
-			if item.Name == proj.Name {
-				matchMe = true
-				break
-			}
-		}
-		if !matchMe {
+		if !selector.Matches(labels.Set(proj.Labels)) {
 			continue
 		}
 		// If proj is a match for this global project setting, then it is its global project
-		globalProj, err := projLister.AppProjects(proj.Namespace).Get(gp.ProjectName)
+		globalProj, err := getProject(gp.ProjectName)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
 		if err != nil {
-			break
+			return globalProjects, fmt.Errorf("error getting global project %s: %w", gp.ProjectName, err)
 		}
 		globalProjects = append(globalProjects, globalProj)
 	}
-	return globalProjects
+	return globalProjects, nil
 }
 
 func GetAppVirtualProject(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) (*argoappv1.AppProject, error) {
-	virtualProj := proj.DeepCopy()
-	globalProjects := GetGlobalProjects(proj, projLister, settingsManager)
+	return MergeGlobalProjects(proj, GetGlobalProjects(proj, projLister, settingsManager)), nil
+}
 
+// MergeGlobalProjects returns the virtual project of the project, i.e. a copy of the project merged with its global
+// projects
+func MergeGlobalProjects(proj *argoappv1.AppProject, globalProjects []*argoappv1.AppProject) *argoappv1.AppProject {
+	virtualProj := proj.DeepCopy()
 	for _, gp := range globalProjects {
 		virtualProj = mergeVirtualProject(virtualProj, gp)
 	}
-	return virtualProj, nil
+	return virtualProj
 }
 
 func mergeVirtualProject(proj *argoappv1.AppProject, globalProj *argoappv1.AppProject) *argoappv1.AppProject {
@@ -1118,6 +1130,15 @@ func mergeVirtualProject(proj *argoappv1.AppProject, globalProj *argoappv1.AppPr
 
 	proj.Spec.Destinations = append(proj.Spec.Destinations, globalProj.Spec.Destinations...)
 
+	// Signature keys are merged as a union, so that a global project can mandate signed commits org-wide
+	for _, key := range globalProj.Spec.SignatureKeys {
+		if !slices.ContainsFunc(proj.Spec.SignatureKeys, func(k argoappv1.SignatureKey) bool {
+			return gpg.KeyID(k.KeyID) == gpg.KeyID(key.KeyID)
+		}) {
+			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, key)
+		}
+	}
+
 	return proj
 }
 
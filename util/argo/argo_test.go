@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -871,6 +872,50 @@ func TestValidatePermissions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, conditions)
 	})
+
+	t.Run("Destination cluster labels required by project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    ".",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "default",
+			},
+			Project: "payments",
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "default",
+					},
+				},
+				SourceRepos:           []string{"http://some/where"},
+				RequiredClusterLabels: map[string]string{"compliance": "pci", "region": "eu"},
+			},
+		}
+		validate := func(labels map[string]string) []argoappv1.ApplicationCondition {
+			t.Helper()
+			db := &dbmocks.ArgoDB{}
+			db.On("GetCluster", t.Context(), "https://127.0.0.1:6443").Return(&argoappv1.Cluster{
+				Server: "https://127.0.0.1:6443",
+				Labels: labels,
+			}, nil)
+			conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+			require.NoError(t, err)
+			return conditions
+		}
+
+		assert.Empty(t, validate(map[string]string{"compliance": "pci", "region": "eu", "team": "payments"}))
+
+		conditions := validate(map[string]string{"compliance": "none", "team": "payments"})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Equal(t, "application destination cluster 'https://127.0.0.1:6443' is missing the labels compliance=pci, region=eu required by project 'payments'", conditions[0].Message)
+	})
 }
 
 func TestSetAppOperations(t *testing.T) {
@@ -1162,6 +1207,41 @@ func TestGetGlobalProjects(t *testing.T) {
 	})
 }
 
+func TestMatchGlobalProjects(t *testing.T) {
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "proj", Labels: map[string]string{"team": "a"}}}
+	globalProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "global"},
+		Spec:       argoappv1.AppProjectSpec{SignatureKeys: []argoappv1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}},
+	}
+	gps := []settings.GlobalProjectSettings{
+		{ProjectName: "missing", LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+		{ProjectName: "other", LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}}},
+		{ProjectName: "global", LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+	}
+	getProject := func(name string) (*argoappv1.AppProject, error) {
+		switch name {
+		case "global":
+			return globalProj, nil
+		case "other":
+			return nil, errors.New("unexpected get of a global project which does not match")
+		}
+		return nil, apierrors.NewNotFound(argoappv1.SchemeGroupVersion.WithResource("appprojects").GroupResource(), name)
+	}
+
+	globalProjects, err := MatchGlobalProjects(proj, gps, getProject)
+	require.NoError(t, err)
+	assert.Equal(t, []*argoappv1.AppProject{globalProj}, globalProjects, "global projects which do not exist are skipped")
+
+	virtualProj := MergeGlobalProjects(proj, globalProjects)
+	assert.Equal(t, globalProj.Spec.SignatureKeys, virtualProj.Spec.SignatureKeys)
+	assert.Empty(t, proj.Spec.SignatureKeys, "the project is left untouched")
+
+	_, err = MatchGlobalProjects(proj, gps, func(string) (*argoappv1.AppProject, error) {
+		return nil, errors.New("connection refused")
+	})
+	require.EqualError(t, err, "error getting global project missing: connection refused")
+}
+
 func Test_GetDifferentPathsBetweenStructs(t *testing.T) {
 	r1 := argoappv1.Repository{}
 	r2 := argoappv1.Repository{
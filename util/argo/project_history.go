@@ -0,0 +1,106 @@
+package argo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// MaxProjectRevisionHistoryLimit is the maximum number of prior revisions a project may keep in its revision history
+	MaxProjectRevisionHistoryLimit = 20
+	// maxProjectRevisionHistoryBytes is the maximum size of the revision history annotation. The oldest revisions are
+	// dropped to stay within it, so that the annotations of the project stay well below the 256KB Kubernetes allows.
+	maxProjectRevisionHistoryBytes = 128 * 1024
+)
+
+// ProjectRevision is a prior spec of a project, as recorded in its revision history annotation
+type ProjectRevision struct {
+	ResourceVersion string                   `json:"resourceVersion"`
+	Spec            argoappv1.AppProjectSpec `json:"spec"`
+}
+
+// GetProjectRevisionHistory returns the prior revisions of the project recorded in its revision history annotation,
+// most recent first
+func GetProjectRevisionHistory(proj *argoappv1.AppProject) ([]ProjectRevision, error) {
+	value, ok := proj.Annotations[common.AnnotationKeyRevisionHistory]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var history []ProjectRevision
+	if err := json.Unmarshal([]byte(value), &history); err != nil {
+		return nil, fmt.Errorf("failed to parse the revision history of project '%s': %w", proj.Name, err)
+	}
+	return history, nil
+}
+
+// RecordProjectRevision records the spec of the project before an update in the revision history annotation of the
+// updated project, keeping as many revisions as set by its revision history limit annotation, but not more than fit
+// into maxProjectRevisionHistoryBytes. The history is removed if the updated project does not set a limit. Updates
+// which do not change the spec are not recorded.
+func RecordProjectRevision(updated *argoappv1.AppProject, previous *argoappv1.AppProject) error {
+	limit := 0
+	if value, ok := updated.Annotations[common.AnnotationKeyRevisionHistoryLimit]; ok {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 || limit > MaxProjectRevisionHistoryLimit {
+			return fmt.Errorf("annotation %s must be an integer between 0 and %d, not '%s'", common.AnnotationKeyRevisionHistoryLimit, MaxProjectRevisionHistoryLimit, value)
+		}
+	}
+	if limit == 0 {
+		delete(updated.Annotations, common.AnnotationKeyRevisionHistory)
+		return nil
+	}
+	// the recorded history of the stored project is authoritative, whatever the update sets
+	history, err := GetProjectRevisionHistory(previous)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(updated.Spec, previous.Spec) {
+		history = append([]ProjectRevision{{ResourceVersion: previous.ResourceVersion, Spec: previous.Spec}}, history...)
+	}
+	if len(history) > limit {
+		history = history[:limit]
+	}
+	for len(history) > 0 {
+		value, err := json.Marshal(history)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the revision history of project '%s': %w", updated.Name, err)
+		}
+		if len(value) <= maxProjectRevisionHistoryBytes {
+			updated.Annotations[common.AnnotationKeyRevisionHistory] = string(value)
+			return nil
+		}
+		// drop the oldest revision until the history fits into the annotation
+		history = history[:len(history)-1]
+	}
+	delete(updated.Annotations, common.AnnotationKeyRevisionHistory)
+	return nil
+}
+
+// GetProjectRevision returns the project as it was at the given resource version. Prior revisions are only available
+// if they are still recorded in the revision history annotation of the project.
+func GetProjectRevision(proj *argoappv1.AppProject, resourceVersion string) (*argoappv1.AppProject, error) {
+	if resourceVersion == proj.ResourceVersion {
+		return proj, nil
+	}
+	history, err := GetProjectRevisionHistory(proj)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range history {
+		if revision.ResourceVersion == resourceVersion {
+			res := proj.DeepCopy()
+			res.ResourceVersion = revision.ResourceVersion
+			res.Spec = revision.Spec
+			return res, nil
+		}
+	}
+	if _, ok := proj.Annotations[common.AnnotationKeyRevisionHistoryLimit]; !ok {
+		return nil, fmt.Errorf("revision %s of project '%s' is not available: the project does not record its revision history, set the %s annotation to enable it", resourceVersion, proj.Name, common.AnnotationKeyRevisionHistoryLimit)
+	}
+	return nil, fmt.Errorf("revision %s of project '%s' is not available: it was not recorded or has been compacted", resourceVersion, proj.Name)
+}
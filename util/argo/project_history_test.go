@@ -0,0 +1,113 @@
+package argo
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRecordProjectRevision(t *testing.T) {
+	newProj := func(resourceVersion, description string, annotations map[string]string) *argoappv1.AppProject {
+		return &argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", ResourceVersion: resourceVersion, Annotations: annotations},
+			Spec:       argoappv1.AppProjectSpec{Description: description},
+		}
+	}
+
+	t.Run("records prior revisions up to the limit", func(t *testing.T) {
+		proj := newProj("1", "first", map[string]string{common.AnnotationKeyRevisionHistoryLimit: "2"})
+		for i, description := range []string{"second", "third", "fourth"} {
+			updated := proj.DeepCopy()
+			updated.Spec.Description = description
+			require.NoError(t, RecordProjectRevision(updated, proj))
+			updated.ResourceVersion = []string{"2", "3", "4"}[i]
+			proj = updated
+		}
+
+		history, err := GetProjectRevisionHistory(proj)
+		require.NoError(t, err)
+		assert.Equal(t, []ProjectRevision{
+			{ResourceVersion: "3", Spec: argoappv1.AppProjectSpec{Description: "third"}},
+			{ResourceVersion: "2", Spec: argoappv1.AppProjectSpec{Description: "second"}},
+		}, history)
+
+		prior, err := GetProjectRevision(proj, "2")
+		require.NoError(t, err)
+		assert.Equal(t, "second", prior.Spec.Description)
+		assert.Equal(t, "fourth", proj.Spec.Description)
+
+		current, err := GetProjectRevision(proj, "4")
+		require.NoError(t, err)
+		assert.Same(t, proj, current)
+
+		_, err = GetProjectRevision(proj, "1")
+		require.EqualError(t, err, "revision 1 of project 'test' is not available: it was not recorded or has been compacted")
+	})
+
+	t.Run("does not record updates of the metadata only", func(t *testing.T) {
+		proj := newProj("1", "first", map[string]string{common.AnnotationKeyRevisionHistoryLimit: "2"})
+		updated := proj.DeepCopy()
+		updated.Labels = map[string]string{"team": "payments"}
+		require.NoError(t, RecordProjectRevision(updated, proj))
+		assert.NotContains(t, updated.Annotations, common.AnnotationKeyRevisionHistory)
+	})
+
+	t.Run("removes the history without limit", func(t *testing.T) {
+		proj := newProj("2", "second", map[string]string{
+			common.AnnotationKeyRevisionHistoryLimit: "2",
+			common.AnnotationKeyRevisionHistory:      `[{"resourceVersion":"1","spec":{"description":"first"}}]`,
+		})
+		updated := proj.DeepCopy()
+		delete(updated.Annotations, common.AnnotationKeyRevisionHistoryLimit)
+		require.NoError(t, RecordProjectRevision(updated, proj))
+		assert.Empty(t, updated.Annotations)
+
+		_, err := GetProjectRevision(updated, "1")
+		require.ErrorContains(t, err, "the project does not record its revision history")
+	})
+
+	t.Run("drops the oldest revisions of large specs", func(t *testing.T) {
+		// each revision takes up about 50KB, so only two fit into the annotation
+		largeDescription := func(c string) string { return strings.Repeat(c, 50*1024) }
+		proj := newProj("1", largeDescription("a"), map[string]string{common.AnnotationKeyRevisionHistoryLimit: "5"})
+		for i, c := range []string{"b", "c", "d"} {
+			updated := proj.DeepCopy()
+			updated.Spec.Description = largeDescription(c)
+			require.NoError(t, RecordProjectRevision(updated, proj))
+			updated.ResourceVersion = strconv.Itoa(i + 2)
+			proj = updated
+		}
+
+		assert.LessOrEqual(t, len(proj.Annotations[common.AnnotationKeyRevisionHistory]), maxProjectRevisionHistoryBytes)
+		history, err := GetProjectRevisionHistory(proj)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, "3", history[0].ResourceVersion)
+		assert.Equal(t, "2", history[1].ResourceVersion)
+	})
+
+	t.Run("does not record a revision larger than the annotation", func(t *testing.T) {
+		proj := newProj("1", strings.Repeat("a", maxProjectRevisionHistoryBytes), map[string]string{common.AnnotationKeyRevisionHistoryLimit: "5"})
+		updated := proj.DeepCopy()
+		updated.Spec.Description = "second"
+		require.NoError(t, RecordProjectRevision(updated, proj))
+		assert.NotContains(t, updated.Annotations, common.AnnotationKeyRevisionHistory)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		proj := newProj("1", "first", map[string]string{common.AnnotationKeyRevisionHistoryLimit: "-1"})
+		require.EqualError(t, RecordProjectRevision(proj.DeepCopy(), proj), "annotation argocd.argoproj.io/revision-history-limit must be an integer between 0 and 20, not '-1'")
+	})
+
+	t.Run("limit above the maximum", func(t *testing.T) {
+		proj := newProj("1", "first", map[string]string{common.AnnotationKeyRevisionHistoryLimit: "1000"})
+		require.EqualError(t, RecordProjectRevision(proj.DeepCopy(), proj), "annotation argocd.argoproj.io/revision-history-limit must be an integer between 0 and 20, not '1000'")
+	})
+}
@@ -0,0 +1,56 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ResourceScopeWarnings returns a warning for each entry of the cluster resource lists of the project which is a
+// namespaced kind, and for each entry of the namespace resource lists which is a cluster-scoped kind. Entries with
+// wildcards and kinds unknown to the discovery client are ignored. Groups whose discovery fails are ignored, other
+// discovery errors are returned.
+func ResourceScopeWarnings(disco discovery.DiscoveryInterface, spec v1alpha1.AppProjectSpec) ([]string, error) {
+	_, resourceLists, err := disco.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("error discovering API resources: %w", err)
+	}
+	namespaced := map[schema.GroupKind]bool{}
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			namespaced[schema.GroupKind{Group: gv.Group, Kind: resource.Kind}] = resource.Namespaced
+		}
+	}
+
+	var warnings []string
+	check := func(list string, entries []metav1.GroupKind, wantNamespaced bool) {
+		for _, gk := range entries {
+			if strings.ContainsAny(gk.Group+gk.Kind, "*?[") {
+				continue
+			}
+			isNamespaced, known := namespaced[schema.GroupKind{Group: gk.Group, Kind: gk.Kind}]
+			if !known || isNamespaced == wantNamespaced {
+				continue
+			}
+			scope := "cluster-scoped"
+			if isNamespaced {
+				scope = "namespaced"
+			}
+			warnings = append(warnings, fmt.Sprintf("%s entry '%s/%s' is a %s kind and has no effect", list, gk.Group, gk.Kind, scope))
+		}
+	}
+	check("cluster resource whitelist", spec.ClusterResourceWhitelist, false)
+	check("cluster resource blacklist", spec.ClusterResourceBlacklist, false)
+	check("namespace resource whitelist", spec.NamespaceResourceWhitelist, true)
+	check("namespace resource blacklist", spec.NamespaceResourceBlacklist, true)
+	return warnings, nil
+}
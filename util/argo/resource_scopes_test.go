@@ -0,0 +1,93 @@
+package argo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakedisco "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newFakeDiscovery() *fakedisco.FakeDiscovery {
+	disco := fake.NewClientset().Discovery().(*fakedisco.FakeDiscovery)
+	disco.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Kind: "Namespace", Namespaced: false},
+			{Kind: "ConfigMap", Namespaced: true},
+		},
+	}, {
+		GroupVersion: "batch/v1",
+		APIResources: []metav1.APIResource{{Kind: "Job", Namespaced: true}},
+	}, {
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Kind: "ClusterRole", Namespaced: false},
+			{Kind: "Role", Namespaced: true},
+		},
+	}}
+	return disco
+}
+
+func TestResourceScopeWarnings(t *testing.T) {
+	spec := v1alpha1.AppProjectSpec{
+		ClusterResourceWhitelist: []metav1.GroupKind{
+			{Group: "", Kind: "Namespace"},
+			{Group: "batch", Kind: "Job"},
+			{Group: "rbac.authorization.k8s.io", Kind: "*"},
+			{Group: "example.com", Kind: "Unknown"},
+		},
+		ClusterResourceBlacklist: []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "Role"}},
+		NamespaceResourceWhitelist: []metav1.GroupKind{
+			{Group: "", Kind: "ConfigMap"},
+			{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+		},
+		NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+	}
+
+	warnings, err := ResourceScopeWarnings(newFakeDiscovery(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"cluster resource whitelist entry 'batch/Job' is a namespaced kind and has no effect",
+		"cluster resource blacklist entry 'rbac.authorization.k8s.io/Role' is a namespaced kind and has no effect",
+		"namespace resource whitelist entry 'rbac.authorization.k8s.io/ClusterRole' is a cluster-scoped kind and has no effect",
+		"namespace resource blacklist entry '/Namespace' is a cluster-scoped kind and has no effect",
+	}, warnings)
+
+	warnings, err = ResourceScopeWarnings(newFakeDiscovery(), v1alpha1.AppProjectSpec{
+		ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "batch", Kind: "Job"}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+type failingDiscovery struct {
+	*fakedisco.FakeDiscovery
+	err error
+}
+
+func (d *failingDiscovery) ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error) {
+	_, resources, _ := d.FakeDiscovery.ServerGroupsAndResources()
+	return nil, resources, d.err
+}
+
+func TestResourceScopeWarnings_DiscoveryFailure(t *testing.T) {
+	spec := v1alpha1.AppProjectSpec{ClusterResourceWhitelist: []metav1.GroupKind{{Group: "batch", Kind: "Job"}}}
+
+	_, err := ResourceScopeWarnings(&failingDiscovery{FakeDiscovery: newFakeDiscovery(), err: errors.New("connection refused")}, spec)
+	require.ErrorContains(t, err, "connection refused")
+
+	// groups which fail discovery are skipped, the other groups are still checked
+	partialErr := &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{{Group: "metrics.k8s.io", Version: "v1beta1"}: errors.New("unavailable")}}
+	warnings, err := ResourceScopeWarnings(&failingDiscovery{FakeDiscovery: newFakeDiscovery(), err: partialErr}, spec)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster resource whitelist entry 'batch/Job' is a namespaced kind and has no effect"}, warnings)
+}
@@ -83,6 +83,58 @@ func IsSSHURL(url string) (bool, string) {
 	return false, ""
 }
 
+// ValidateSSHURL returns an error if the given SSH repository URL, either of the form ssh://user@host[:port]/path or of
+// the scp-like form user@host:path, is malformed. URLs which are not SSH URLs are not validated.
+func ValidateSSHURL(repo string) error {
+	if yes, _ := IsSSHURL(repo); !yes && !strings.HasPrefix(repo, "ssh://") {
+		return nil
+	}
+	if strings.ContainsAny(repo, " \t\n") {
+		return fmt.Errorf("SSH URL '%s' must not contain whitespace", repo)
+	}
+	if strings.HasPrefix(repo, "ssh://") {
+		repoURL, err := url.Parse(repo)
+		if err != nil {
+			return fmt.Errorf("invalid SSH URL '%s': %w", repo, err)
+		}
+		if repoURL.Hostname() == "" {
+			return fmt.Errorf("SSH URL '%s' has no host", repo)
+		}
+		if strings.Trim(repoURL.Path, "/") == "" {
+			return fmt.Errorf("SSH URL '%s' has no repository path", repo)
+		}
+		return nil
+	}
+	userHost, path, ok := strings.Cut(repo, ":")
+	if !ok {
+		return fmt.Errorf("SSH URL '%s' must separate the host and the repository path with ':', e.g. git@github.com:org/repo.git", repo)
+	}
+	at := strings.LastIndex(userHost, "@")
+	switch {
+	case at <= 0:
+		return fmt.Errorf("SSH URL '%s' has no user", repo)
+	case at == len(userHost)-1 || strings.Contains(userHost[at+1:], "/"):
+		return fmt.Errorf("SSH URL '%s' has no valid host", repo)
+	case strings.Trim(path, "/") == "":
+		return fmt.Errorf("SSH URL '%s' has no repository path", repo)
+	}
+	return nil
+}
+
+// CanonicalSSHURL converts a scp-like SSH URL, e.g. git@github.com:org/repo.git, to the equivalent
+// ssh://git@github.com/org/repo.git form. Other URLs are returned unchanged.
+func CanonicalSSHURL(repo string) string {
+	if yes, _ := IsSSHURL(repo); !yes || strings.HasPrefix(repo, "ssh://") {
+		return repo
+	}
+	userHost, path, ok := strings.Cut(repo, ":")
+	if !ok {
+		return repo
+	}
+	// the path is kept as is, so that the canonical URL normalizes to the same URL as the original one
+	return "ssh://" + userHost + "/" + path
+}
+
 // IsHTTPSURL returns true if supplied URL is HTTPS URL
 func IsHTTPSURL(url string) bool {
 	return httpsURLRegex.MatchString(url)
@@ -89,6 +89,51 @@ func TestIsSSHURLUserName(t *testing.T) {
 	assert.Equal(t, "john@doe.org", user)
 }
 
+func TestValidateSSHURL(t *testing.T) {
+	valid := []string{
+		"git@github.com:argoproj/argo-cd.git",
+		"git@github.com:/argoproj/argo-cd.git",
+		"john@doe.org@john-server.org:project",
+		"ssh://git@github.com/argoproj/argo-cd.git",
+		"ssh://git@github.com:22/argoproj/argo-cd",
+		"git@github.com:argoproj/*",
+		"https://github.com/argoproj/argo-cd",
+		"https://github.com/argoproj/argo-cd.git",
+	}
+	for _, repo := range valid {
+		require.NoError(t, ValidateSSHURL(repo), repo)
+	}
+
+	malformed := map[string]string{
+		"git@github.com/argoproj/argo-cd.git":     "must separate the host and the repository path with ':'",
+		"git@:argoproj/argo-cd.git":               "has no valid host",
+		"git@github.com:":                         "has no repository path",
+		"git@github.com:/":                        "has no repository path",
+		"git@github.com:argoproj/argo cd.git":     "must not contain whitespace",
+		"ssh://git@github.com":                    "has no repository path",
+		"ssh://git@/argoproj/argo-cd.git":         "has no host",
+		"ssh://git@github.com:argoproj/argo-cd":   "invalid SSH URL",
+		"ssh://git@github.com:port/argoproj/repo": "invalid SSH URL",
+	}
+	for repo, expected := range malformed {
+		require.ErrorContains(t, ValidateSSHURL(repo), expected, repo)
+	}
+}
+
+func TestCanonicalSSHURL(t *testing.T) {
+	data := map[string]string{
+		"git@github.com:argoproj/argo-cd.git":       "ssh://git@github.com/argoproj/argo-cd.git",
+		"git@github.com:/argoproj/argo-cd.git":      "ssh://git@github.com//argoproj/argo-cd.git",
+		"ssh://git@github.com/argoproj/argo-cd.git": "ssh://git@github.com/argoproj/argo-cd.git",
+		"https://github.com/argoproj/argo-cd.git":   "https://github.com/argoproj/argo-cd.git",
+	}
+	for repo, expected := range data {
+		canonical := CanonicalSSHURL(repo)
+		assert.Equal(t, expected, canonical)
+		assert.True(t, SameURL(repo, canonical), repo)
+	}
+}
+
 func TestSameURL(t *testing.T) {
 	data := map[string]string{
 		"git@GITHUB.com:argoproj/test":                     "git@github.com:argoproj/test.git",
@@ -47,6 +47,7 @@ const (
 type CasbinEnforcer interface {
 	EnableLog(bool)
 	Enforce(rvals ...any) (bool, error)
+	EnforceEx(rvals ...any) (bool, []string, error)
 	LoadPolicy() error
 	EnableEnforce(bool)
 	AddFunction(name string, function govaluate.ExpressionFunction)
@@ -134,6 +135,7 @@ type Enforcer struct {
 	namespace          string
 	configmap          string
 	claimsEnforcerFunc ClaimsEnforcerFunc
+	claimsExplainFunc  ClaimsExplainFunc
 	model              model.Model
 	defaultRole        string
 	matchMode          string
@@ -207,6 +209,10 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 // ClaimsEnforcerFunc is func template to enforce a JWT claims. The subject is replaced
 type ClaimsEnforcerFunc func(claims jwt.Claims, rvals ...any) bool
 
+// ClaimsExplainFunc is like ClaimsEnforcerFunc, but additionally returns the policy which decided the enforcement, if
+// any
+type ClaimsExplainFunc func(claims jwt.Claims, rvals ...any) (bool, []string)
+
 func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...any) (e CasbinEnforcer, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -321,12 +327,30 @@ func (e *Enforcer) SetClaimsEnforcerFunc(claimsEnforcer ClaimsEnforcerFunc) {
 	e.claimsEnforcerFunc = claimsEnforcer
 }
 
+// SetClaimsExplainFunc sets the claims explain function used by EnforceExplain, the counterpart of the claims enforce
+// function which also returns the deciding policy
+func (e *Enforcer) SetClaimsExplainFunc(claimsExplain ClaimsExplainFunc) {
+	e.claimsExplainFunc = claimsExplain
+}
+
 // Enforce is a wrapper around casbin.Enforce to additionally enforce a default role and a custom
 // claims function
 func (e *Enforcer) Enforce(rvals ...any) bool {
 	return enforce(e.getCasbinEnforcer("", ""), e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// EnforceExplain is like Enforce, but additionally returns the policy which decided the enforcement, e.g.
+// [role:admin applications sync */* allow]. The policy is empty if no policy matched the request. Unlike Enforce, it
+// does not use the enforcement cache, so it is meant for explaining single requests.
+func (e *Enforcer) EnforceExplain(rvals ...any) (bool, []string) {
+	return explain(e.getCasbinEnforcer("", ""), e.defaultRole, e.claimsExplainFunc, rvals...)
+}
+
+// EnforceExplainWithCustomEnforcer wraps explain with an custom enforcer
+func (e *Enforcer) EnforceExplainWithCustomEnforcer(enf CasbinEnforcer, rvals ...any) (bool, []string) {
+	return explain(enf, e.defaultRole, e.claimsExplainFunc, rvals...)
+}
+
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
 func (e *Enforcer) EnforceErr(rvals ...any) error {
 	if !e.Enforce(rvals...) {
@@ -406,6 +430,42 @@ func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEn
 	return ok && err == nil
 }
 
+// explain is the counterpart of enforce which also returns the deciding policy
+func explain(enf CasbinEnforcer, defaultRole string, claimsExplainFunc ClaimsExplainFunc, rvals ...any) (bool, []string) {
+	if defaultRole != "" && len(rvals) >= 2 {
+		if ok, policy, err := enf.EnforceEx(append([]any{defaultRole}, rvals[1:]...)...); ok && err == nil {
+			return true, policy
+		}
+	}
+	if len(rvals) == 0 {
+		return false, nil
+	}
+	var claimsPolicy []string
+	switch s := rvals[0].(type) {
+	case string:
+		// noop
+	case jwt.Claims:
+		if claimsExplainFunc != nil {
+			var ok bool
+			if ok, claimsPolicy = claimsExplainFunc(s, rvals...); ok {
+				return true, claimsPolicy
+			}
+		}
+		rvals = append([]any{""}, rvals[1:]...)
+	default:
+		rvals = append([]any{""}, rvals[1:]...)
+	}
+	ok, policy, err := enf.EnforceEx(rvals...)
+	if err != nil {
+		return false, claimsPolicy
+	}
+	if !ok && len(policy) == 0 {
+		// a deny policy matching the claims decided the enforcement
+		policy = claimsPolicy
+	}
+	return ok, policy
+}
+
 // SetBuiltinPolicy sets a built-in policy, which augments any user defined policies
 func (e *Enforcer) SetBuiltinPolicy(policy string) error {
 	e.invalidateCache(func() {
@@ -190,6 +190,32 @@ func TestDefaultRole(t *testing.T) {
 	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
 }
 
+func TestEnforceExplain(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(fakeConfigMap(), noOpUpdate))
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy("p, alice, applications, delete, foo/*, deny\ng, alice, role:admin")
+
+	ok, policy := enf.EnforceExplain("alice", "applications", "get", "foo/bar")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"role:readonly", "applications", "get", "*/*", "allow"}, policy)
+
+	ok, policy = enf.EnforceExplain("alice", "applications", "delete", "foo/bar")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"alice", "applications", "delete", "foo/*", "deny"}, policy)
+
+	ok, policy = enf.EnforceExplain("bob", "applications", "get", "foo/bar")
+	assert.False(t, ok)
+	assert.Empty(t, policy)
+
+	// the default role decides when the subject is not granted anything
+	enf.SetDefaultRole("role:readonly")
+	ok, policy = enf.EnforceExplain("bob", "applications", "get", "foo/bar")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"role:readonly", "applications", "get", "*/*", "allow"}, policy)
+}
+
 // TestURLAsObjectName tests the ability to have a URL as an object name
 func TestURLAsObjectName(t *testing.T) {
 	kubeclientset := fake.NewClientset()
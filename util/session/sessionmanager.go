@@ -161,6 +161,11 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateWithAudience(subject, secondsBeforeExpiry, id, "")
+}
+
+// CreateWithAudience creates a new token like Create, with the given audience claim unless it is empty.
+func (mgr *SessionManager) CreateWithAudience(subject string, secondsBeforeExpiry int64, id string, audience string) (string, error) {
 	now := time.Now().UTC()
 	claims := jwt.RegisteredClaims{
 		IssuedAt:  jwt.NewNumericDate(now),
@@ -173,6 +178,9 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = jwt.NewNumericDate(expires)
 	}
+	if audience != "" {
+		claims.Audience = jwt.ClaimStrings{audience}
+	}
 
 	return mgr.signClaims(claims)
 }
@@ -200,6 +208,31 @@ func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
 	return token.SignedString(settings.ServerSignature)
 }
 
+// VerifyTokenSource checks that a project role token is used from one of the networks listed in the role's token
+// source ranges. Tokens of other subjects are not restricted.
+func (mgr *SessionManager) VerifyTokenSource(claims jwt.Claims, clientIP net.IP) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	projName, roleName, ok := rbacpolicy.GetProjectRoleFromSubject(jwtutil.GetUserIdentifier(mapClaims))
+	if !ok {
+		return nil
+	}
+	proj, err := mgr.projectsLister.Get(projName)
+	if err != nil {
+		return err
+	}
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return err
+	}
+	if !role.IsTokenSourceAllowed(clientIP) {
+		return fmt.Errorf("tokens of role '%s' in project '%s' cannot be used from %s", roleName, projName, clientIP)
+	}
+	return nil
+}
+
 // GetSubjectAccountAndCapability analyzes Argo CD account token subject and extract account name
 // and the capability it was generated for (default capability is API Key).
 func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCapability) {
@@ -492,6 +525,21 @@ type TokenVerifier interface {
 	VerifyToken(token string) (jwt.Claims, string, error)
 }
 
+// TokenSourceVerifier is implemented by token verifiers which restrict the networks tokens can be used from
+type TokenSourceVerifier interface {
+	VerifyTokenSource(claims jwt.Claims, clientIP net.IP) error
+}
+
+// RequestClientIP returns the address of the peer which sent the HTTP request. X-Forwarded-For headers are not trusted,
+// as clients can set them freely.
+func RequestClientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
 // WithAuthMiddleware is an HTTP middleware used to ensure incoming
 // requests are authenticated before invoking the target handler. If
 // disabled is true, it will just invoke the next handler in the chain.
@@ -509,6 +557,12 @@ func WithAuthMiddleware(disabled bool, authn TokenVerifier, next http.Handler) h
 				http.Error(w, "Invalid token", http.StatusUnauthorized)
 				return
 			}
+			if sourceVerifier, ok := authn.(TokenSourceVerifier); ok && claims != nil {
+				if err := sourceVerifier.VerifyTokenSource(claims, RequestClientIP(r)); err != nil {
+					http.Error(w, "Invalid token", http.StatusUnauthorized)
+					return
+				}
+			}
 			ctx := r.Context()
 			// Add claims to the context to inspect for RBAC
 			//nolint:staticcheck
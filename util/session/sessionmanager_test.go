@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -218,6 +219,82 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 	})
 }
 
+func TestSessionManager_VerifyTokenSource(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	proj := appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default",
+			Namespace: "argocd",
+		},
+		Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{
+			{Name: "restricted", TokenSourceRanges: []string{"10.0.0.0/8", "2001:db8::/32"}},
+			{Name: "unrestricted"},
+		}},
+	}
+	mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+
+	t.Run("In Range", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "proj:default:restricted"}
+		require.NoError(t, mgr.VerifyTokenSource(claims, net.ParseIP("10.1.2.3")))
+		require.NoError(t, mgr.VerifyTokenSource(claims, net.ParseIP("2001:db8::1")))
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "proj:default:restricted"}
+		require.ErrorContains(t, mgr.VerifyTokenSource(claims, net.ParseIP("192.168.1.1")), "cannot be used from 192.168.1.1")
+		require.Error(t, mgr.VerifyTokenSource(claims, nil))
+	})
+
+	t.Run("Unrestricted Role", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "proj:default:unrestricted"}
+		require.NoError(t, mgr.VerifyTokenSource(claims, net.ParseIP("192.168.1.1")))
+	})
+
+	t.Run("Not A Project Token", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "admin"}
+		require.NoError(t, mgr.VerifyTokenSource(claims, net.ParseIP("192.168.1.1")))
+	})
+}
+
+func TestSessionManager_WithAuthMiddleware_TokenSource(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	proj := appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{
+			{Name: "remote", TokenSourceRanges: []string{"10.0.0.0/8"}},
+			{Name: "local", TokenSourceRanges: []string{"127.0.0.0/8", "::1/128"}},
+		}},
+		Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+			"remote": {Items: []appv1.JWTToken{{ID: "remote-id", IssuedAt: time.Now().Unix()}}},
+			"local":  {Items: []appv1.JWTToken{{ID: "local-id", IssuedAt: time.Now().Unix()}}},
+		}},
+	}
+	mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+	ts := httptest.NewServer(WithAuthMiddleware(false, mgr, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+	defer ts.Close()
+
+	get := func(t *testing.T, subject, id string) int {
+		t.Helper()
+		token, err := mgr.Create(subject, 100, id)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, ts.URL, http.NoBody)
+		require.NoError(t, err)
+		req.Header.Add("Cookie", "argocd.token="+token)
+		// forwarded addresses are not trusted
+		req.Header.Set("X-Forwarded-For", "10.1.2.3")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// the test client connects from the loopback interface, outside the range of the remote role
+	assert.Equal(t, http.StatusUnauthorized, get(t, "proj:default:remote", "remote-id"))
+	assert.Equal(t, http.StatusOK, get(t, "proj:default:local", "local-id"))
+}
+
 type tokenVerifierMock struct {
 	claims jwt.Claims
 	err    error
@@ -478,6 +478,20 @@ const (
 	kustomizePathPrefixKey = "kustomize.path"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// projectsRequireDescriptionKey is the key to a boolean determining whether new projects must have a description
+	projectsRequireDescriptionKey = "projects.requireDescription"
+	// projectsWarnResourceScopeMismatchKey is the key to a boolean determining whether saving a project warns about
+	// namespaced kinds in its cluster resource lists and cluster-scoped kinds in its namespace resource lists
+	projectsWarnResourceScopeMismatchKey = "projects.warnResourceScopeMismatch"
+	// projectsWildcardPolicyKey is the key to the policy for projects with a wildcard source repository or destination,
+	// either "warn" or "reject"
+	projectsWildcardPolicyKey = "projects.wildcardPolicy"
+	// projectsAdminRolePolicyKey is the key to the policy for project roles allowing every action on every object, either
+	// "warn" or "reject"
+	projectsAdminRolePolicyKey = "projects.adminRolePolicy"
+	// projectsNeverFiringSyncWindowPolicyKey is the key to the policy for sync windows whose schedule never fires, either
+	// "warn" or "reject"
+	projectsNeverFiringSyncWindowPolicyKey = "projects.neverFiringSyncWindowPolicy"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
 	// diffOptions is the key where diff options are configured
@@ -955,6 +969,110 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// GetProjectsRequireDescription returns whether new projects must be created with a non-empty description
+func (mgr *SettingsManager) GetProjectsRequireDescription() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	if argoCDCM.Data[projectsRequireDescriptionKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[projectsRequireDescriptionKey])
+}
+
+// GetProjectsWarnResourceScopeMismatch returns whether saving a project warns about resource list entries of the
+// wrong scope
+func (mgr *SettingsManager) GetProjectsWarnResourceScopeMismatch() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	if argoCDCM.Data[projectsWarnResourceScopeMismatchKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[projectsWarnResourceScopeMismatchKey])
+}
+
+const (
+	// ProjectsWildcardPolicyWarn logs a warning when a project is saved with a wildcard source repository or destination
+	ProjectsWildcardPolicyWarn = "warn"
+	// ProjectsWildcardPolicyReject rejects saving a project with a wildcard source repository or destination unless the
+	// wildcard is explicitly allowed
+	ProjectsWildcardPolicyReject = "reject"
+)
+
+// GetProjectsWildcardPolicy returns the policy for projects with a wildcard source repository or destination, or an
+// empty string if wildcards are not guarded
+func (mgr *SettingsManager) GetProjectsWildcardPolicy() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	switch policy := argoCDCM.Data[projectsWildcardPolicyKey]; policy {
+	case "", ProjectsWildcardPolicyWarn, ProjectsWildcardPolicyReject:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid value '%s' of %s, must be '%s' or '%s'", policy, projectsWildcardPolicyKey, ProjectsWildcardPolicyWarn, ProjectsWildcardPolicyReject)
+	}
+}
+
+const (
+	// ProjectsAdminRolePolicyWarn logs a warning when a project is saved with a role policy allowing every action on
+	// every object
+	ProjectsAdminRolePolicyWarn = "warn"
+	// ProjectsAdminRolePolicyReject rejects saving a project with a role policy allowing every action on every object
+	// unless the admin role is explicitly allowed
+	ProjectsAdminRolePolicyReject = "reject"
+)
+
+// GetProjectsAdminRolePolicy returns the policy for project roles allowing every action on every object, or an empty
+// string if such roles are not guarded
+func (mgr *SettingsManager) GetProjectsAdminRolePolicy() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	switch policy := argoCDCM.Data[projectsAdminRolePolicyKey]; policy {
+	case "", ProjectsAdminRolePolicyWarn, ProjectsAdminRolePolicyReject:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid value '%s' of %s, must be '%s' or '%s'", policy, projectsAdminRolePolicyKey, ProjectsAdminRolePolicyWarn, ProjectsAdminRolePolicyReject)
+	}
+}
+
+const (
+	// ProjectsNeverFiringSyncWindowPolicyWarn logs a warning when a project is saved with a sync window whose schedule
+	// never fires
+	ProjectsNeverFiringSyncWindowPolicyWarn = "warn"
+	// ProjectsNeverFiringSyncWindowPolicyReject rejects saving a project with a sync window whose schedule never fires
+	ProjectsNeverFiringSyncWindowPolicyReject = "reject"
+)
+
+// GetProjectsNeverFiringSyncWindowPolicy returns the policy for sync windows whose schedule never fires, which warns
+// about them unless configured otherwise
+func (mgr *SettingsManager) GetProjectsNeverFiringSyncWindowPolicy() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	switch policy := argoCDCM.Data[projectsNeverFiringSyncWindowPolicyKey]; policy {
+	case "":
+		return ProjectsNeverFiringSyncWindowPolicyWarn, nil
+	case ProjectsNeverFiringSyncWindowPolicyWarn, ProjectsNeverFiringSyncWindowPolicyReject:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid value '%s' of %s, must be '%s' or '%s'", policy, projectsNeverFiringSyncWindowPolicyKey, ProjectsNeverFiringSyncWindowPolicyWarn, ProjectsNeverFiringSyncWindowPolicyReject)
+	}
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
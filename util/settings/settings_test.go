@@ -293,6 +293,94 @@ func TestGetIsIgnoreResourceUpdatesEnabledFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetProjectsRequireDescription(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	requireDescription, err := settingsManager.GetProjectsRequireDescription()
+	require.NoError(t, err)
+	assert.False(t, requireDescription)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.requireDescription": "true",
+	})
+	requireDescription, err = settingsManager.GetProjectsRequireDescription()
+	require.NoError(t, err)
+	assert.True(t, requireDescription)
+}
+
+func TestGetProjectsWarnResourceScopeMismatch(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	warn, err := settingsManager.GetProjectsWarnResourceScopeMismatch()
+	require.NoError(t, err)
+	assert.False(t, warn)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.warnResourceScopeMismatch": "true",
+	})
+	warn, err = settingsManager.GetProjectsWarnResourceScopeMismatch()
+	require.NoError(t, err)
+	assert.True(t, warn)
+}
+
+func TestGetProjectsWildcardPolicy(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	policy, err := settingsManager.GetProjectsWildcardPolicy()
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.wildcardPolicy": "reject",
+	})
+	policy, err = settingsManager.GetProjectsWildcardPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, ProjectsWildcardPolicyReject, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.wildcardPolicy": "deny",
+	})
+	_, err = settingsManager.GetProjectsWildcardPolicy()
+	require.ErrorContains(t, err, "invalid value 'deny' of projects.wildcardPolicy")
+}
+
+func TestGetProjectsAdminRolePolicy(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	policy, err := settingsManager.GetProjectsAdminRolePolicy()
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.adminRolePolicy": "warn",
+	})
+	policy, err = settingsManager.GetProjectsAdminRolePolicy()
+	require.NoError(t, err)
+	assert.Equal(t, ProjectsAdminRolePolicyWarn, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.adminRolePolicy": "deny",
+	})
+	_, err = settingsManager.GetProjectsAdminRolePolicy()
+	require.ErrorContains(t, err, "invalid value 'deny' of projects.adminRolePolicy")
+}
+
+func TestGetProjectsNeverFiringSyncWindowPolicy(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	policy, err := settingsManager.GetProjectsNeverFiringSyncWindowPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, ProjectsNeverFiringSyncWindowPolicyWarn, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.neverFiringSyncWindowPolicy": "reject",
+	})
+	policy, err = settingsManager.GetProjectsNeverFiringSyncWindowPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, ProjectsNeverFiringSyncWindowPolicyReject, policy)
+
+	_, settingsManager = fixtures(map[string]string{
+		"projects.neverFiringSyncWindowPolicy": "deny",
+	})
+	_, err = settingsManager.GetProjectsNeverFiringSyncWindowPolicy()
+	require.ErrorContains(t, err, "invalid value 'deny' of projects.neverFiringSyncWindowPolicy")
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},